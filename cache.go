@@ -0,0 +1,311 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"sort"
+)
+
+// HashCache caches the roots of the fields of a container. A container opts in by
+// declaring a field of this type, then the generated HashTreeRootWith only recomputes
+// the fields marked as changed with MarkDirty (or the setters) and the path from
+// them to the root. The large lists and vectors also cache their chunks, so changing
+// one element costs O(log n) hashes. The cache is not safe for concurrent use.
+type HashCache struct {
+	// zero is the table of zero hashes of the hasher of the cached roots. It identifies
+	// the hash function without comparing the functions, which may not be comparable.
+	zero *[32]byte
+	// fields is the tree of the roots of the fields
+	fields merkleCache
+	// dirty are the fields changed since the last hash
+	dirty []bool
+	// lists are the chunks of the list and vector fields
+	lists map[int]*listCache
+}
+
+// listCache caches the chunks of a list or vector field
+type listCache struct {
+	chunks merkleCache
+	// num is the number of elements of the cached chunks
+	num int
+	// dirty are the elements changed since the last hash, all of them if 'all' is set
+	dirty map[int]struct{}
+	all   bool
+}
+
+// Prepare is called by the generated HashTreeRootWith before hashing a container
+// with 'num' fields. The cache is cleared if it was used with other hash function,
+// which is a hasher with other table of zero hashes. The hashers of SHA-256 share
+// the table, the hashers of other functions keep the cache only with themselves.
+func (c *HashCache) Prepare(hh *Hasher, num int) {
+	zero := &hh.zeroHashes[0]
+	if c.zero == zero && len(c.dirty) == num {
+		return
+	}
+	*c = HashCache{zero: zero, dirty: make([]bool, num)}
+	for i := range c.dirty {
+		c.dirty[i] = true
+	}
+	c.fields.resize(num)
+}
+
+// Reset clears the cache, all the fields are hashed again
+func (c *HashCache) Reset() {
+	*c = HashCache{}
+}
+
+// Copy returns a deep copy of the cache
+func (c *HashCache) Copy() HashCache {
+	cpy := HashCache{
+		zero:   c.zero,
+		fields: c.fields.copy(),
+		dirty:  append([]bool(nil), c.dirty...),
+	}
+	if c.lists != nil {
+		cpy.lists = make(map[int]*listCache, len(c.lists))
+		for field, l := range c.lists {
+			dirty := make(map[int]struct{}, len(l.dirty))
+			for i := range l.dirty {
+				dirty[i] = struct{}{}
+			}
+			cpy.lists[field] = &listCache{chunks: l.chunks.copy(), num: l.num, dirty: dirty, all: l.all}
+		}
+	}
+	return cpy
+}
+
+// MarkDirty marks the field with the index 'field' as changed. For the list and
+// vector fields 'indices' are the changed elements, all of them if there are none.
+func (c *HashCache) MarkDirty(field int, indices ...int) {
+	if field < 0 || field >= len(c.dirty) {
+		// nothing is cached yet
+		return
+	}
+	c.dirty[field] = true
+	if l, ok := c.lists[field]; ok {
+		if len(indices) == 0 {
+			l.all = true
+		}
+		for _, i := range indices {
+			l.dirty[i] = struct{}{}
+		}
+	}
+}
+
+// Field updates the root of the field if it is dirty. 'f' must append the root of the field.
+func (c *HashCache) Field(hh *Hasher, field int, f func(hh *Hasher) error) error {
+	if !c.dirty[field] {
+		return nil
+	}
+	root, err := hashChunk(hh, func() error { return f(hh) })
+	if err != nil {
+		return err
+	}
+	c.fields.set(field, root)
+	c.dirty[field] = false
+	return nil
+}
+
+// List updates the root of the list field if it is dirty. The list has 'num' elements packed
+// in chunks of 'perChunk' elements and a limit of 'limit' chunks. 'f' appends the element
+// with the index 'indx', only the chunks with dirty elements are hashed again.
+func (c *HashCache) List(hh *Hasher, field, num, perChunk int, limit uint64, f func(indx int, hh *Hasher) error) error {
+	return c.list(hh, field, num, perChunk, limit, true, f)
+}
+
+// Vector updates the root of the vector field if it is dirty. The vector has 'num' elements
+// packed in chunks of 'perChunk' elements. 'f' appends the element with the index 'indx',
+// only the chunks with dirty elements are hashed again.
+func (c *HashCache) Vector(hh *Hasher, field, num, perChunk int, f func(indx int, hh *Hasher) error) error {
+	return c.list(hh, field, num, perChunk, uint64((num+perChunk-1)/perChunk), false, f)
+}
+
+func (c *HashCache) list(hh *Hasher, field, num, perChunk int, limit uint64, mixin bool, f func(indx int, hh *Hasher) error) error {
+	if !c.dirty[field] {
+		return nil
+	}
+	if c.lists == nil {
+		c.lists = map[int]*listCache{}
+	}
+	l, ok := c.lists[field]
+	if !ok {
+		l = &listCache{dirty: map[int]struct{}{}, all: true}
+		c.lists[field] = l
+	}
+
+	// chunks to hash again
+	numChunks := (num + perChunk - 1) / perChunk
+	chunks := map[int]struct{}{}
+	if l.all {
+		for i := 0; i < numChunks; i++ {
+			chunks[i] = struct{}{}
+		}
+	} else {
+		for i := range l.dirty {
+			if i < num {
+				chunks[i/perChunk] = struct{}{}
+			}
+		}
+		if num != l.num {
+			// the elements added or removed change the last chunks
+			from := num
+			if l.num < from {
+				from = l.num
+			}
+			for i := from / perChunk; i < numChunks; i++ {
+				chunks[i] = struct{}{}
+			}
+		}
+	}
+
+	l.chunks.resize(numChunks)
+	for _, i := range sortedKeys(chunks) {
+		end := (i + 1) * perChunk
+		if end > num {
+			end = num
+		}
+		chunk, err := hashChunk(hh, func() error {
+			for j := i * perChunk; j < end; j++ {
+				if err := f(j, hh); err != nil {
+					return err
+				}
+			}
+			hh.FillUpTo32()
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		l.chunks.set(i, chunk)
+	}
+
+	root := l.chunks.root(hh.hashFn, hh.zeroHashes, depth(limit))
+	if mixin {
+		var size [32]byte
+		binary.LittleEndian.PutUint64(size[:8], uint64(num))
+		root = hh.hashFn.Hash(root, size)
+	}
+	l.num, l.all, l.dirty = num, false, map[int]struct{}{}
+
+	c.fields.set(field, root)
+	c.dirty[field] = false
+	return nil
+}
+
+// Root appends the root of the container to the hasher
+func (c *HashCache) Root(hh *Hasher) {
+	root := c.fields.root(hh.hashFn, hh.zeroHashes, depth(uint64(len(c.dirty))))
+	hh.buf = append(hh.buf, root[:]...)
+}
+
+// hashChunk returns the chunk appended to the hasher by 'f' and removes it
+func hashChunk(hh *Hasher, f func() error) (res [32]byte, err error) {
+	indx := hh.Index()
+	if err = f(); err == nil && hh.Index()-indx != 32 {
+		err = ErrIncorrectByteSize
+	}
+	if err == nil {
+		copy(res[:], hh.buf[indx:])
+	}
+	hh.buf = hh.buf[:indx]
+	return
+}
+
+// merkleCache keeps every layer of a merkle tree to update the root with O(log n) hashes
+// when a chunk changes. The layers only have the nodes with chunks under them, the
+// rest of the tree are zero hashes.
+type merkleCache struct {
+	layers [][][32]byte
+	// dirty are the chunks changed since the last root
+	dirty map[int]struct{}
+}
+
+// resize sets the number of chunks, the new chunks must be set before the next root
+func (m *merkleCache) resize(num int) {
+	if len(m.layers) == 0 {
+		m.layers = [][][32]byte{nil}
+	}
+	if m.dirty == nil {
+		m.dirty = map[int]struct{}{}
+	}
+	if chunks := m.layers[0]; num < len(chunks) {
+		m.layers[0] = chunks[:num]
+		for i := range m.dirty {
+			if i >= num {
+				delete(m.dirty, i)
+			}
+		}
+		if num > 0 {
+			// the path of the last chunk has the nodes that lost their right child
+			m.dirty[num-1] = struct{}{}
+		}
+	} else {
+		m.layers[0] = append(chunks, make([][32]byte, num-len(chunks))...)
+	}
+}
+
+// set replaces the chunk with the index 'i'
+func (m *merkleCache) set(i int, chunk [32]byte) {
+	m.layers[0][i] = chunk
+	m.dirty[i] = struct{}{}
+}
+
+// root updates the nodes above the dirty chunks and returns the root of the tree of depth 'dep'
+func (m *merkleCache) root(fn HashFunction, zero [][32]byte, dep uint8) [32]byte {
+	if len(m.layers[0]) == 0 {
+		m.layers = m.layers[:1]
+		m.dirty = map[int]struct{}{}
+		return zero[dep]
+	}
+	for len(m.layers) < int(dep)+1 {
+		m.layers = append(m.layers, nil)
+	}
+	m.layers = m.layers[:dep+1]
+
+	dirty := sortedKeys(m.dirty)
+	for l := 0; l < int(dep); l++ {
+		layer := m.layers[l]
+		size := (len(layer) + 1) / 2
+		if parents := m.layers[l+1]; size <= len(parents) {
+			m.layers[l+1] = parents[:size]
+		} else {
+			m.layers[l+1] = append(parents, make([][32]byte, size-len(parents))...)
+		}
+
+		parents := dirty[:0]
+		for _, i := range dirty {
+			if p := i / 2; len(parents) == 0 || parents[len(parents)-1] != p {
+				parents = append(parents, p)
+			}
+		}
+		for _, p := range parents {
+			right := zero[l]
+			if 2*p+1 < len(layer) {
+				right = layer[2*p+1]
+			}
+			m.layers[l+1][p] = fn.Hash(layer[2*p], right)
+		}
+		dirty = parents
+	}
+	m.dirty = map[int]struct{}{}
+	return m.layers[dep][0]
+}
+
+func (m *merkleCache) copy() merkleCache {
+	cpy := merkleCache{dirty: make(map[int]struct{}, len(m.dirty))}
+	for _, layer := range m.layers {
+		cpy.layers = append(cpy.layers, append([][32]byte(nil), layer...))
+	}
+	for i := range m.dirty {
+		cpy.dirty[i] = struct{}{}
+	}
+	return cpy
+}
+
+func sortedKeys(m map[int]struct{}) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
@@ -0,0 +1,71 @@
+package ssz
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func TestMerkleCache(t *testing.T) {
+	hh := NewHasher()
+	r := rand.New(rand.NewSource(1))
+
+	var m merkleCache
+	var chunks [][32]byte
+	for i := 0; i < 200; i++ {
+		// resize the tree and change some chunks
+		num := r.Intn(40)
+		m.resize(num)
+		for len(chunks) < num {
+			chunks = append(chunks, [32]byte{byte(r.Int())})
+			m.set(len(chunks)-1, chunks[len(chunks)-1])
+		}
+		chunks = chunks[:num]
+		for j := 0; j < r.Intn(4) && num > 0; j++ {
+			indx := r.Intn(num)
+			chunks[indx] = [32]byte{byte(r.Int())}
+			m.set(indx, chunks[indx])
+		}
+
+		input := make([]byte, 0, 32*num)
+		for _, c := range chunks {
+			input = append(input, c[:]...)
+		}
+		expected := hh.merkleizeInput(input, 64)
+		root := m.root(hh.hashFn, hh.zeroHashes, depth(64))
+		if !bytes.Equal(expected, root[:]) {
+			t.Fatalf("%d chunks: expected %x but found %x", num, expected, root)
+		}
+	}
+}
+
+// sliceHash is a hash function that is not comparable
+type sliceHash struct {
+	sha256Hash
+	aux []byte
+}
+
+func TestHashCachePrepare(t *testing.T) {
+	var c HashCache
+	hh := NewHasher()
+	c.Prepare(hh, 2)
+	c.dirty[0] = false
+
+	// the cache is kept with other hasher of SHA-256
+	c.Prepare(NewHasher(), 2)
+	if c.dirty[0] {
+		t.Fatal("cache cleared with the same hash function")
+	}
+
+	// and cleared with other function, even if it is not comparable
+	other := NewHasherWithHashFunction(sliceHash{})
+	c.Prepare(other, 2)
+	if !c.dirty[0] {
+		t.Fatal("cache not cleared with other hash function")
+	}
+	c.dirty[0] = false
+	c.Prepare(other, 2)
+	if c.dirty[0] {
+		t.Fatal("cache cleared with the same hasher")
+	}
+}
@@ -5,10 +5,32 @@ import (
 	"errors"
 	"fmt"
 	"math/bits"
+	"reflect"
+	"strconv"
 )
 
 const bytesPerLengthOffset = 4
 
+// maxUnionSelector is the highest selector allowed for a union
+const maxUnionSelector = 127
+
+// UnmarshallUint256 unmarshals a little endian uint256 from the src input
+// as four uint64 limbs (least significant first)
+func UnmarshallUint256(src []byte) (res [4]uint64) {
+	for i := range res {
+		res[i] = binary.LittleEndian.Uint64(src[i*8 : (i+1)*8])
+	}
+	return
+}
+
+// UnmarshallUint128 unmarshals a little endian uint128 from the src input
+// as two uint64 limbs (least significant first)
+func UnmarshallUint128(src []byte) (res [2]uint64) {
+	res[0] = binary.LittleEndian.Uint64(src[:8])
+	res[1] = binary.LittleEndian.Uint64(src[8:16])
+	return
+}
+
 // UnmarshallUint64 unmarshals a little endian uint64 from the src input
 func UnmarshallUint64(src []byte) uint64 {
 	return binary.LittleEndian.Uint64(src)
@@ -44,8 +66,130 @@ var (
 	ErrDynamicLengthNotOffsetSized = errors.New("list offsets must be multiples of the offset size (4)")
 	ErrDynamicLengthExceedsMax     = errors.New("list length longer than ssz max length for the type")
 	ErrInvalidEncoding             = errors.New("invalid encoding")
+	ErrUnionSelector               = errors.New("union selector out of range")
+	ErrBitvectorPadding            = errors.New("bitvector has bits set beyond its length")
+	ErrUndefinedActiveField        = errors.New("active field is not defined")
 )
 
+// DecodeError is the error returned by the generated UnmarshalSSZ functions. It records
+// where the input is malformed and wraps the cause of the error (i.e. ErrOffset) so that
+// it can be checked with errors.Is.
+type DecodeError struct {
+	// Path is the field that failed to decode (i.e. BeaconState.Validators[17].Pubkey)
+	Path string
+	// Offset is the position in the input of the field that failed to decode
+	Offset uint64
+	// Expected and Actual are the expected and the decoded values (i.e. sizes
+	// or offsets) if the error is caused by a mismatch between them
+	Expected, Actual uint64
+	// Err is the cause of the error
+	Err error
+
+	// typ is the object that owns the path and field is
+	// the path of the field inside that object
+	typ, field string
+}
+
+// NewDecodeError creates a DecodeError for the object 'typ' with the expected and actual values
+func NewDecodeError(err error, typ string, expected, actual uint64) error {
+	e := &DecodeError{Err: err, Expected: expected, Actual: actual, typ: typ}
+	e.Path = typ
+	return e
+}
+
+// WrapDecodeError adds to the path of the error the field of the object 'typ' that
+// starts at 'offset'. If the error is not a DecodeError it is wrapped in one.
+func WrapDecodeError(err error, typ, field string, offset uint64) error {
+	var e *DecodeError
+	if !errors.As(err, &e) {
+		e = &DecodeError{Err: err}
+		err = e
+	}
+	e.typ, e.field = typ, joinDecodePath(field, e.field)
+	e.Offset += offset
+	e.Path = joinDecodePath(e.typ, e.field)
+	return err
+}
+
+// WrapDecodeErrorIndex adds to the path of the error the index of the
+// item of a list or vector that starts at 'offset'.
+func WrapDecodeErrorIndex(err error, indx int, offset uint64) error {
+	return WrapDecodeError(err, "", "["+strconv.Itoa(indx)+"]", offset)
+}
+
+func joinDecodePath(parent, child string) string {
+	if parent == "" {
+		return child
+	}
+	if child == "" || child[0] == '[' {
+		return parent + child
+	}
+	return parent + "." + child
+}
+
+func (e *DecodeError) Error() string {
+	str := e.Err.Error()
+	if e.Expected != e.Actual {
+		str += fmt.Sprintf(" (expected %d, got %d)", e.Expected, e.Actual)
+	}
+	if e.Path != "" {
+		str = e.Path + ": " + str
+	}
+	return fmt.Sprintf("%s at offset %d", str, e.Offset)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// UnmarshalNoCopy unmarshals the object with UnmarshalSSZNoCopy if it implements
+// UnmarshalerNoCopy and with UnmarshalSSZ otherwise. In the first case the byte fields
+// of the object reference 'buf' and it must not be modified while the object is in use.
+func UnmarshalNoCopy(u Unmarshaler, buf []byte) error {
+	if uu, ok := u.(UnmarshalerNoCopy); ok {
+		return uu.UnmarshalSSZNoCopy(buf)
+	}
+	return u.UnmarshalSSZ(buf)
+}
+
+// ValidateObject checks that buf is a valid SSZ encoding of an object of the type of u.
+// If the type implements Validator the encoding is checked without decoding it,
+// otherwise it is decoded into a new object. 'u' can be a nil pointer.
+func ValidateObject(u Unmarshaler, buf []byte) error {
+	if v, ok := u.(Validator); ok {
+		return v.ValidateSSZ(buf)
+	}
+	obj := reflect.New(reflect.TypeOf(u).Elem()).Interface().(Unmarshaler)
+	return obj.UnmarshalSSZ(buf)
+}
+
+// ValidateBitvector validates that the bitvector has 'bitLen' bits and
+// that the unused bits of the last byte are not set
+func ValidateBitvector(buf []byte, bitLen uint64) error {
+	if uint64(len(buf)) != (bitLen+7)/8 {
+		return ErrBytesLength
+	}
+	if rest := bitLen % 8; rest != 0 && buf[len(buf)-1]>>rest != 0 {
+		return ErrBitvectorPadding
+	}
+	return nil
+}
+
+// ValidateActiveFields validates the active fields bitvector of a StableContainer
+// with 'numFields' fields and a capacity of 'bitLen' fields. The bits of the
+// fields that are not defined must not be set.
+func ValidateActiveFields(buf []byte, bitLen, numFields uint64) error {
+	if err := ValidateBitvector(buf, bitLen); err != nil {
+		return err
+	}
+	for i := numFields; i < bitLen; i++ {
+		if buf[i/8]&(1<<(i%8)) != 0 {
+			return ErrUndefinedActiveField
+		}
+	}
+	return nil
+}
+
 // ValidateBitlist validates that the bitlist is correct
 func ValidateBitlist(buf []byte, bitLimit uint64) error {
 	byteLen := len(buf)
@@ -78,7 +222,8 @@ func ValidateBitlist(buf []byte, bitLimit uint64) error {
 	return nil
 }
 
-// DecodeDynamicLength decodes the length from the dynamic input
+// DecodeDynamicLength decodes the length from the dynamic input. The first offset
+// must be within the input so that the length is bounded by the size of the input.
 func DecodeDynamicLength(buf []byte, maxSize int) (int, error) {
 	if len(buf) == 0 {
 		return 0, nil
@@ -90,6 +235,9 @@ func DecodeDynamicLength(buf []byte, maxSize int) (int, error) {
 	if o%bytesPerLengthOffset != 0 || o == 0 {
 		return 0, ErrDynamicLengthNotOffsetSized
 	}
+	if o > len(buf) {
+		return 0, ErrOffsetExceedsSize
+	}
 
 	length := o / bytesPerLengthOffset
 	if length > maxSize {
@@ -135,7 +283,7 @@ func UnmarshalDynamic(src []byte, length int, f func(indx int, b []byte) error)
 
 		err := f(indx, src[offset:endOffset])
 		if err != nil {
-			return err
+			return WrapDecodeErrorIndex(err, indx, offset)
 		}
 
 		indx++
@@ -199,7 +347,7 @@ func ReadOffset(buf []byte) uint64 {
 
 func safeReadOffset(buf []byte) (uint64, []byte, error) {
 	if len(buf) < 4 {
-		return 0, nil, fmt.Errorf("")
+		return 0, nil, ErrDynamicLengthTooShort
 	}
 	offset := ReadOffset(buf)
 	return offset, buf[4:], nil
@@ -214,3 +362,28 @@ func DecodeBool(src []byte) (bool, error) {
 	}
 	return false, ErrInvalidEncoding
 }
+
+// DecodeOptional decodes the prefix of an Optional value and returns the
+// encoding of the value if it is present
+func DecodeOptional(src []byte) ([]byte, bool, error) {
+	if len(src) == 0 {
+		return nil, false, nil
+	}
+	if src[0] != 1 {
+		return nil, false, ErrInvalidEncoding
+	}
+	return src[1:], true, nil
+}
+
+// DecodeUnionSelector decodes the selector of a union with 'numOptions' types
+// and returns it along with the serialized value of the selected type
+func DecodeUnionSelector(src []byte, numOptions int) (uint8, []byte, error) {
+	if len(src) == 0 {
+		return 0, nil, ErrSize
+	}
+	selector := src[0]
+	if selector > maxUnionSelector || int(selector) >= numOptions {
+		return 0, nil, ErrUnionSelector
+	}
+	return selector, src[1:], nil
+}
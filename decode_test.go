@@ -1,6 +1,7 @@
 package ssz
 
 import (
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -94,3 +95,34 @@ func TestBitlist_MaxValue(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeError(t *testing.T) {
+	err := NewDecodeError(ErrBytesLength, "Validator", 48, 50)
+	err = WrapDecodeError(err, "Validator", "Pubkey", 0)
+	err = WrapDecodeErrorIndex(err, 17, 17*121)
+	err = WrapDecodeError(err, "BeaconState", "Validators", 100)
+
+	if !errors.Is(err, ErrBytesLength) {
+		t.Fatal("expected bytes length error")
+	}
+	var derr *DecodeError
+	if !errors.As(err, &derr) {
+		t.Fatal("expected decode error")
+	}
+	if derr.Path != "BeaconState.Validators[17].Pubkey" {
+		t.Fatalf("bad path %s", derr.Path)
+	}
+	if derr.Offset != 100+17*121 {
+		t.Fatalf("bad offset %d", derr.Offset)
+	}
+	expected := "BeaconState.Validators[17].Pubkey: bytes array does not have the correct length (expected 48, got 50) at offset 2157"
+	if err.Error() != expected {
+		t.Fatalf("bad error '%s'", err.Error())
+	}
+
+	// errors that are not decode errors are wrapped
+	err = WrapDecodeErrorIndex(ErrOffset, 2, 8)
+	if !errors.Is(err, ErrOffset) || err.Error() != "[2]: incorrect offset at offset 8" {
+		t.Fatalf("bad error '%v'", err)
+	}
+}
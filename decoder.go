@@ -0,0 +1,178 @@
+package ssz
+
+import (
+	"bufio"
+	"io"
+)
+
+// UnmarshalSSZFromReader unmarshals an object with an encoded size of 'size' bytes
+// from the reader. The object is streamed if it implements DecoderUnmarshaler, otherwise
+// its encoding is read in full and decoded with UnmarshalSSZ.
+func UnmarshalSSZFromReader(r io.Reader, size int, u Unmarshaler) error {
+	if size < 0 {
+		return ErrSize
+	}
+	return NewDecoder(r).DecodeObject(u, uint64(size))
+}
+
+// Decoder reads SSZ encodings from an io.Reader. It keeps track of the
+// boundaries of the object being decoded so that generated code can
+// validate offsets exactly as it does with an in-memory buffer while only
+// holding in memory the fixed part of the objects.
+type Decoder struct {
+	r      io.Reader
+	pos    uint64
+	scopes []decoderScope
+	buf    []byte
+	state  *DecodeState
+}
+
+type decoderScope struct {
+	start, end uint64
+}
+
+// NewDecoder creates a new Decoder that reads from r
+func NewDecoder(r io.Reader) *Decoder {
+	if _, ok := r.(io.ByteReader); !ok {
+		r = bufio.NewReader(r)
+	}
+	return &Decoder{
+		r: r,
+	}
+}
+
+// WithOptions sets the limits used to decode the objects read by the decoder
+func (d *Decoder) WithOptions(opts DecodeOptions) *Decoder {
+	d.state = NewDecodeState(opts)
+	return d
+}
+
+// State returns the DecodeState of the decoder or nil if it does not have limits
+func (d *Decoder) State() *DecodeState {
+	return d.state
+}
+
+// Size returns the encoded size of the object being decoded
+func (d *Decoder) Size() uint64 {
+	if len(d.scopes) == 0 {
+		return 0
+	}
+	s := d.scopes[len(d.scopes)-1]
+	return s.end - s.start
+}
+
+// Remaining returns the number of bytes left to read for the object being decoded
+func (d *Decoder) Remaining() uint64 {
+	if len(d.scopes) == 0 {
+		return 0
+	}
+	return d.scopes[len(d.scopes)-1].end - d.pos
+}
+
+// Read reads n bytes from the object being decoded. The returned slice
+// is only valid until the next call to the Decoder.
+func (d *Decoder) Read(n uint64) ([]byte, error) {
+	if n > d.Remaining() {
+		return nil, ErrSize
+	}
+	if uint64(cap(d.buf)) < n {
+		d.buf = make([]byte, n)
+	}
+	buf := d.buf[:n]
+	if _, err := io.ReadFull(d.r, buf); err != nil {
+		return nil, err
+	}
+	d.pos += n
+	return buf, nil
+}
+
+// DecodeObject decodes an object with an encoded size of 'size' bytes.
+func (d *Decoder) DecodeObject(u Unmarshaler, size uint64) error {
+	if len(d.scopes) != 0 && size > d.Remaining() {
+		return ErrSize
+	}
+	du, ok := u.(DecoderUnmarshaler)
+	if !ok {
+		// the object may keep references to its input (i.e. the views or the
+		// NoCopy decoding), so it is read in its own buffer instead of d.buf
+		buf := make([]byte, size)
+		if _, err := io.ReadFull(d.r, buf); err != nil {
+			return err
+		}
+		d.pos += size
+		return UnmarshalWithState(u, buf, d.state)
+	}
+
+	end := d.pos + size
+	d.scopes = append(d.scopes, decoderScope{start: d.pos, end: end})
+	err := du.UnmarshalSSZFromDecoder(d)
+	d.scopes = d.scopes[:len(d.scopes)-1]
+	if err != nil {
+		return err
+	}
+	if d.pos != end {
+		return ErrSize
+	}
+	return nil
+}
+
+// ReadDynamicOffsets reads the offsets of a list of 'size' bytes with dynamic items
+// and returns the encoded size of each item. It performs the same checks as
+// DecodeDynamicLength and UnmarshalDynamic, though all the offsets are validated
+// before any of the items is decoded.
+func (d *Decoder) ReadDynamicOffsets(size uint64, maxSize int) ([]uint64, error) {
+	if size == 0 {
+		return nil, nil
+	}
+	if size < 4 {
+		return nil, ErrDynamicLengthTooShort
+	}
+	buf, err := d.Read(bytesPerLengthOffset)
+	if err != nil {
+		return nil, err
+	}
+	offset := ReadOffset(buf)
+	if offset%bytesPerLengthOffset != 0 || offset == 0 {
+		return nil, ErrDynamicLengthNotOffsetSized
+	}
+	if offset > size {
+		return nil, ErrOffsetExceedsSize
+	}
+	length := offset / bytesPerLengthOffset
+	if length > uint64(maxSize) {
+		return nil, ErrDynamicLengthExceedsMax
+	}
+
+	// read the rest of the offsets table
+	dst, err := d.Read(offset - bytesPerLengthOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	sizes := []uint64{}
+	var endOffset uint64
+	for {
+		if length != 1 {
+			endOffset, dst, err = safeReadOffset(dst)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			endOffset = size
+		}
+		if offset > endOffset {
+			return nil, ErrOffsetOrdering
+		}
+		if endOffset > size {
+			return nil, ErrOffsetExceedsSize
+		}
+		sizes = append(sizes, endOffset-offset)
+
+		offset = endOffset
+		if length == 1 {
+			break
+		}
+		length--
+	}
+	return sizes, nil
+}
@@ -0,0 +1,99 @@
+package ssz
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDecoderReadDynamicOffsets(t *testing.T) {
+	cases := [][]byte{
+		{},
+		{0x01, 0x00},
+		{0x03, 0x00, 0x00, 0x00},
+		{0x04, 0x00, 0x00, 0x00},
+		{0x04, 0x00, 0x00, 0x00, 0x01, 0x02},
+		{0x08, 0x00, 0x00, 0x00, 0x0a, 0x00, 0x00, 0x00, 0x01, 0x02, 0x03},
+		{0x08, 0x00, 0x00, 0x00, 0x06, 0x00, 0x00, 0x00, 0x01, 0x02, 0x03},
+		{0x08, 0x00, 0x00, 0x00, 0xff, 0x00, 0x00, 0x00, 0x01, 0x02, 0x03},
+		{0x10, 0x00, 0x00, 0x00, 0x10, 0x00, 0x00, 0x00},
+		{0x40, 0x00, 0x00, 0x00, 0x10, 0x00, 0x00, 0x00},
+	}
+
+	for indx, c := range cases {
+		// in-memory decoding
+		var expected []uint64
+		num, err := DecodeDynamicLength(c, 3)
+		if err == nil {
+			err = UnmarshalDynamic(c, num, func(indx int, b []byte) error {
+				expected = append(expected, uint64(len(b)))
+				return nil
+			})
+		}
+
+		dec := NewDecoder(bytes.NewReader(c))
+		dec.scopes = append(dec.scopes, decoderScope{start: 0, end: uint64(len(c))})
+		sizes, err2 := dec.ReadDynamicOffsets(uint64(len(c)), 3)
+
+		if (err == nil) != (err2 == nil) {
+			t.Fatalf("case %d: in-memory error '%v' and decoder error '%v' do not match", indx, err, err2)
+		}
+		if err != nil {
+			if err.Error() != err2.Error() {
+				t.Fatalf("case %d: expected error '%v' but found '%v'", indx, err, err2)
+			}
+			continue
+		}
+		if len(sizes) != len(expected) {
+			t.Fatalf("case %d: expected %d items but found %d", indx, len(expected), len(sizes))
+		}
+		for i := range sizes {
+			if sizes[i] != expected[i] {
+				t.Fatalf("case %d: bad size for item %d", indx, i)
+			}
+		}
+	}
+}
+
+func TestDecoderRead(t *testing.T) {
+	dec := NewDecoder(bytes.NewReader([]byte{1, 2, 3, 4}))
+	dec.scopes = append(dec.scopes, decoderScope{start: 0, end: 3})
+
+	buf, err := dec.Read(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf, []byte{1, 2}) {
+		t.Fatal("bad read")
+	}
+	if dec.Remaining() != 1 {
+		t.Fatalf("expected 1 byte remaining but found %d", dec.Remaining())
+	}
+	if _, err := dec.Read(2); err != ErrSize {
+		t.Fatalf("expected ErrSize but found %v", err)
+	}
+}
+
+// keepInput is an Unmarshaler that references its input
+type keepInput struct {
+	buf []byte
+}
+
+func (k *keepInput) UnmarshalSSZ(buf []byte) error {
+	k.buf = buf
+	return nil
+}
+
+func TestDecoderDecodeObjectKeepsInput(t *testing.T) {
+	dec := NewDecoder(bytes.NewReader([]byte{1, 2, 3, 4, 5, 6}))
+
+	var a, b keepInput
+	if err := dec.DecodeObject(&a, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := dec.DecodeObject(&b, 3); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(a.buf, []byte{1, 2, 3}) || !bytes.Equal(b.buf, []byte{4, 5, 6}) {
+		t.Fatalf("the decoded objects share the input: %v %v", a.buf, b.buf)
+	}
+}
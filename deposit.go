@@ -0,0 +1,321 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// DepositContractDepth is the depth of the tree of the deposit contract
+const DepositContractDepth = 32
+
+var (
+	// ErrDepositIndex is returned when a deposit is not in the tree or it was finalized
+	ErrDepositIndex = errors.New("deposit index out of range")
+	// ErrDepositSnapshot is returned when a snapshot is not consistent
+	ErrDepositSnapshot = errors.New("invalid deposit snapshot")
+	// ErrEth1Data is returned when the eth1 data to finalize does not match the tree
+	ErrEth1Data = errors.New("eth1 data does not match the deposit tree")
+)
+
+// DepositTree is the merkle tree of the deposit contract (EIP-4881). The finalized
+// deposits are pruned and only the roots of the subtrees that hold them are kept,
+// which are the 'finalized' branch of the snapshots.
+type DepositTree struct {
+	// list merkleizes every deposit, finalized or not
+	list *IncrementalList
+
+	// finalized are the roots of the largest complete subtrees of the
+	// finalized deposits, from left to right
+	finalized [][32]byte
+	// finalizedCount is the number of finalized deposits
+	finalizedCount uint64
+
+	// levels are the nodes of the tree that are not finalized, by level from the
+	// leaves up. The nodes of a level start at the one with the first deposit
+	// that is not finalized, so a proof only looks up one node per level.
+	levels [DepositContractDepth + 1][][32]byte
+
+	// executionBlockHash and executionBlockHeight are the execution
+	// block of the last finalized deposit
+	executionBlockHash   [32]byte
+	executionBlockHeight uint64
+}
+
+// Eth1Data is the eth1 data voted by the beacon chain. BlockHash is the hash of the
+// execution block with the deposits.
+type Eth1Data struct {
+	DepositRoot  [32]byte
+	DepositCount uint64
+	BlockHash    [32]byte
+}
+
+// DepositSnapshot is the snapshot of the finalized deposits of a DepositTree
+// (EIP-4881). It is a ssz container and it implements the ssz interfaces.
+type DepositSnapshot struct {
+	// Finalized are the roots of the subtrees of the finalized deposits, from left to right
+	Finalized [][32]byte `ssz-size:"?,32" ssz-max:"32"`
+	// DepositRoot is the root of the tree of the finalized deposits with the count mixed in
+	DepositRoot [32]byte `ssz-size:"32"`
+	// DepositCount is the number of finalized deposits
+	DepositCount uint64
+	// ExecutionBlockHash and ExecutionBlockHeight are the execution block of the
+	// last finalized deposit
+	ExecutionBlockHash   [32]byte `ssz-size:"32"`
+	ExecutionBlockHeight uint64
+}
+
+// NewDepositTree creates an empty deposit tree
+func NewDepositTree() *DepositTree {
+	return &DepositTree{list: NewIncrementalList(1 << DepositContractDepth)}
+}
+
+// Len returns the number of deposits in the tree
+func (t *DepositTree) Len() uint64 {
+	return t.list.Len()
+}
+
+// Push appends the root of a deposit data to the tree
+func (t *DepositTree) Push(leaf [32]byte) error {
+	index := t.Len()
+	if err := t.list.Append(leaf); err != nil {
+		return err
+	}
+
+	// update the path of the deposit up to the root
+	node := leaf
+	for level := uint8(0); ; level++ {
+		pos := index >> level
+		if i := pos - t.finalizedCount>>level; i < uint64(len(t.levels[level])) {
+			t.levels[level][i] = node
+		} else {
+			t.levels[level] = append(t.levels[level], node)
+		}
+		if level == DepositContractDepth {
+			return nil
+		}
+		if pos&1 == 0 {
+			node = SHA256.Hash(node, zeroHashesRaw[level])
+		} else {
+			node = SHA256.Hash(t.node(level, pos-1), node)
+		}
+	}
+}
+
+// Root returns the root of the deposit contract, the root of the tree
+// with the number of deposits mixed in
+func (t *DepositTree) Root() [32]byte {
+	return t.list.HashTreeRoot()
+}
+
+// Proof returns the proof of the deposit with the index 'index' against Root. It has
+// the 32 hashes of the branch and the length, like the proof of the Deposit containers,
+// and it can be checked with VerifyProof. Finalized deposits cannot be proved.
+func (t *DepositTree) Proof(index uint64) (*Proof, error) {
+	if index < t.finalizedCount || index >= t.Len() {
+		return nil, fmt.Errorf("%w: %d is not between %d and %d", ErrDepositIndex, index, t.finalizedCount, t.Len())
+	}
+	leaf := t.node(0, index)
+	proof := &Proof{
+		// the root mixes in the length, the tree is the left child
+		Index:  int(1<<(DepositContractDepth+1) + index),
+		Leaf:   leaf[:],
+		Hashes: make([][]byte, 0, DepositContractDepth+1),
+	}
+	for level := uint8(0); level < DepositContractDepth; level++ {
+		sibling := t.node(level, (index>>level)^1)
+		proof.Hashes = append(proof.Hashes, sibling[:])
+	}
+	size := make([]byte, 32)
+	binary.LittleEndian.PutUint64(size, t.Len())
+	proof.Hashes = append(proof.Hashes, size)
+	return proof, nil
+}
+
+// Finalize prunes the deposits included in the eth1 data, they are replaced by the
+// roots of their subtrees and they cannot be proved anymore. The deposit root of the
+// eth1 data must be the one of the tree with its deposit count. The execution block is
+// the one with the hash of the eth1 data and the height 'executionBlockHeight', and it
+// is kept for the snapshots.
+func (t *DepositTree) Finalize(eth1Data *Eth1Data, executionBlockHeight uint64) error {
+	count := eth1Data.DepositCount
+	if count < t.finalizedCount || count > t.Len() {
+		return fmt.Errorf("%w: cannot finalize %d deposits of %d", ErrDepositIndex, count, t.Len())
+	}
+	finalized := make([][32]byte, 0, bits.OnesCount64(count))
+	for level := uint8(DepositContractDepth); level <= DepositContractDepth; level-- {
+		if count&(1<<level) != 0 {
+			finalized = append(finalized, t.node(level, count>>level-1))
+		}
+	}
+	if root := finalizedRoot(finalized, count); root != eth1Data.DepositRoot {
+		return fmt.Errorf("%w: deposit root %x of %d deposits but found %x", ErrEth1Data, root, count, eth1Data.DepositRoot)
+	}
+
+	// drop the nodes before the first deposit that is not finalized
+	for level := range t.levels {
+		skip := count>>level - t.finalizedCount>>level
+		t.levels[level] = append([][32]byte(nil), t.levels[level][skip:]...)
+	}
+	t.finalized, t.finalizedCount = finalized, count
+	t.executionBlockHash, t.executionBlockHeight = eth1Data.BlockHash, executionBlockHeight
+	return nil
+}
+
+// Snapshot returns the snapshot of the finalized deposits
+func (t *DepositTree) Snapshot() *DepositSnapshot {
+	return &DepositSnapshot{
+		Finalized:            append([][32]byte{}, t.finalized...),
+		DepositRoot:          finalizedRoot(t.finalized, t.finalizedCount),
+		DepositCount:         t.finalizedCount,
+		ExecutionBlockHash:   t.executionBlockHash,
+		ExecutionBlockHeight: t.executionBlockHeight,
+	}
+}
+
+// NewDepositTreeFromSnapshot creates a tree with the finalized deposits of the snapshot.
+// The deposits after them can be pushed to the tree.
+func NewDepositTreeFromSnapshot(s *DepositSnapshot) (*DepositTree, error) {
+	if s.DepositCount > 1<<DepositContractDepth || len(s.Finalized) != bits.OnesCount64(s.DepositCount) {
+		return nil, fmt.Errorf("%w: %d finalized roots for %d deposits", ErrDepositSnapshot, len(s.Finalized), s.DepositCount)
+	}
+	if finalizedRoot(s.Finalized, s.DepositCount) != s.DepositRoot {
+		return nil, fmt.Errorf("%w: bad deposit root", ErrDepositSnapshot)
+	}
+
+	// the finalized roots are the left subtrees of the branch, one per bit of the count
+	t := NewDepositTree()
+	t.list.count = s.DepositCount
+	for level, i := DepositContractDepth, 0; level >= 0; level-- {
+		if s.DepositCount&(1<<level) != 0 {
+			t.list.branch[level] = s.Finalized[i]
+			i++
+		}
+	}
+	t.finalized = append([][32]byte{}, s.Finalized...)
+	t.finalizedCount = s.DepositCount
+	t.executionBlockHash, t.executionBlockHeight = s.ExecutionBlockHash, s.ExecutionBlockHeight
+	return t, nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes of the snapshot
+func (s *DepositSnapshot) SizeSSZ() int {
+	return 84 + len(s.Finalized)*32
+}
+
+// MarshalSSZ ssz marshals the snapshot
+func (s *DepositSnapshot) MarshalSSZ() ([]byte, error) {
+	return MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the snapshot to a target array
+func (s *DepositSnapshot) MarshalSSZTo(buf []byte) ([]byte, error) {
+	if size := len(s.Finalized); size > DepositContractDepth {
+		return nil, ErrListTooBigFn("DepositSnapshot.Finalized", size, DepositContractDepth)
+	}
+	dst := WriteOffset(buf, 84)
+	dst = append(dst, s.DepositRoot[:]...)
+	dst = MarshalUint64(dst, s.DepositCount)
+	dst = append(dst, s.ExecutionBlockHash[:]...)
+	dst = MarshalUint64(dst, s.ExecutionBlockHeight)
+	for _, root := range s.Finalized {
+		dst = append(dst, root[:]...)
+	}
+	return dst, nil
+}
+
+// UnmarshalSSZ ssz unmarshals the snapshot
+func (s *DepositSnapshot) UnmarshalSSZ(buf []byte) error {
+	size := uint64(len(buf))
+	if size < 84 {
+		return NewDecodeError(ErrSize, "DepositSnapshot", 84, size)
+	}
+	if o := ReadOffset(buf[0:4]); o != 84 {
+		return WrapDecodeError(NewDecodeError(ErrInvalidVariableOffset, "", 84, o), "DepositSnapshot", "Finalized", 0)
+	}
+	copy(s.DepositRoot[:], buf[4:36])
+	s.DepositCount = UnmarshallUint64(buf[36:44])
+	copy(s.ExecutionBlockHash[:], buf[44:76])
+	s.ExecutionBlockHeight = UnmarshallUint64(buf[76:84])
+
+	num, err := DivideInt2(len(buf)-84, 32, DepositContractDepth)
+	if err != nil {
+		return WrapDecodeError(err, "DepositSnapshot", "Finalized", 84)
+	}
+	s.Finalized = make([][32]byte, num)
+	for i := range s.Finalized {
+		copy(s.Finalized[i][:], buf[84+i*32:])
+	}
+	return nil
+}
+
+// HashTreeRoot ssz hashes the snapshot
+func (s *DepositSnapshot) HashTreeRoot() ([32]byte, error) {
+	return HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the snapshot with a hasher
+func (s *DepositSnapshot) HashTreeRootWith(hh *Hasher) error {
+	if size := len(s.Finalized); size > DepositContractDepth {
+		return ErrListTooBigFn("DepositSnapshot.Finalized", size, DepositContractDepth)
+	}
+	indx := hh.Index()
+
+	// Field (0) 'Finalized'
+	subIndx := hh.Index()
+	for _, root := range s.Finalized {
+		hh.Append(root[:])
+	}
+	hh.MerkleizeWithMixin(subIndx, uint64(len(s.Finalized)), DepositContractDepth)
+
+	// Field (1) 'DepositRoot'
+	hh.PutBytes(s.DepositRoot[:])
+
+	// Field (2) 'DepositCount'
+	hh.PutUint64(s.DepositCount)
+
+	// Field (3) 'ExecutionBlockHash'
+	hh.PutBytes(s.ExecutionBlockHash[:])
+
+	// Field (4) 'ExecutionBlockHeight'
+	hh.PutUint64(s.ExecutionBlockHeight)
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// node returns the root of the subtree with the index 'pos' at the level 'level' (the
+// leaves are at level 0). The subtrees of the finalized deposits must be finalized roots,
+// which are the subtrees on the left of the first node of the level that is kept.
+func (t *DepositTree) node(level uint8, pos uint64) [32]byte {
+	first := t.finalizedCount >> level
+	if pos < first {
+		// the finalized subtree of the level, there is one per bit of the count
+		return t.finalized[bits.OnesCount64(t.finalizedCount>>level)-1]
+	}
+	if pos<<level >= t.Len() {
+		return zeroHashesRaw[level]
+	}
+	return t.levels[level][pos-first]
+}
+
+// finalizedRoot returns the deposit root of the tree with only the finalized subtrees,
+// with the number of deposits mixed in like the calculate_root of EIP-4881
+func finalizedRoot(finalized [][32]byte, count uint64) [32]byte {
+	root, indx := zeroHashesRaw[0], len(finalized)
+	for level := 0; level < DepositContractDepth; level++ {
+		if count&(1<<level) != 0 {
+			indx--
+			root = SHA256.Hash(finalized[indx], root)
+		} else {
+			root = SHA256.Hash(root, zeroHashesRaw[level])
+		}
+	}
+	if count == 1<<DepositContractDepth {
+		root = finalized[0]
+	}
+	var size [32]byte
+	binary.LittleEndian.PutUint64(size[:8], count)
+	return SHA256.Hash(root, size)
+}
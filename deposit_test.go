@@ -0,0 +1,292 @@
+package ssz
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func randomDeposits(t *testing.T, num int) [][32]byte {
+	leaves := make([][32]byte, num)
+	for i := range leaves {
+		if _, err := rand.Read(leaves[i][:]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return leaves
+}
+
+// eth1DataOf returns the eth1 data with the deposits 'leaves'
+func eth1DataOf(leaves [][32]byte) *Eth1Data {
+	tree := NewDepositTree()
+	for _, leaf := range leaves {
+		tree.Push(leaf)
+	}
+	return &Eth1Data{DepositRoot: tree.Root(), DepositCount: uint64(len(leaves)), BlockHash: [32]byte{byte(len(leaves))}}
+}
+
+func TestDepositTree(t *testing.T) {
+	leaves := randomDeposits(t, 70)
+
+	tree := NewDepositTree()
+	list := NewIncrementalList(1 << DepositContractDepth)
+	for i, leaf := range leaves {
+		if err := tree.Push(leaf); err != nil {
+			t.Fatal(err)
+		}
+		list.Append(leaf)
+		if tree.Root() != list.HashTreeRoot() {
+			t.Fatalf("bad root with %d deposits", i+1)
+		}
+	}
+
+	// all the deposits can be proved
+	root := tree.Root()
+	for i, leaf := range leaves {
+		proof, err := tree.Proof(uint64(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(proof.Hashes) != DepositContractDepth+1 || !bytes.Equal(proof.Leaf, leaf[:]) {
+			t.Fatalf("bad proof for deposit %d", i)
+		}
+		if ok, err := VerifyProof(root[:], proof); err != nil || !ok {
+			t.Fatalf("proof of deposit %d not verified: %v", i, err)
+		}
+	}
+	if _, err := tree.Proof(uint64(len(leaves))); !errors.Is(err, ErrDepositIndex) {
+		t.Fatalf("expected ErrDepositIndex but found %v", err)
+	}
+}
+
+func TestDepositTreeFinalize(t *testing.T) {
+	leaves := randomDeposits(t, 50)
+
+	tree := NewDepositTree()
+	for _, leaf := range leaves[:30] {
+		tree.Push(leaf)
+	}
+	for _, index := range []uint64{0, 3, 16, 21} {
+		if err := tree.Finalize(eth1DataOf(leaves[:index]), index); err != nil {
+			t.Fatal(err)
+		}
+	}
+	root := tree.Root()
+	proof, err := tree.Proof(21)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := VerifyProof(root[:], proof); err != nil || !ok {
+		t.Fatalf("proof not verified after finalizing: %v", err)
+	}
+	if _, err := tree.Proof(20); !errors.Is(err, ErrDepositIndex) {
+		t.Fatalf("expected ErrDepositIndex but found %v", err)
+	}
+	if err := tree.Finalize(eth1DataOf(leaves[:20]), 20); !errors.Is(err, ErrDepositIndex) {
+		t.Fatalf("expected ErrDepositIndex but found %v", err)
+	}
+	if err := tree.Finalize(eth1DataOf(leaves[:31]), 31); !errors.Is(err, ErrDepositIndex) {
+		t.Fatalf("expected ErrDepositIndex but found %v", err)
+	}
+	if err := tree.Finalize(eth1DataOf(leaves[1:31]), 30); !errors.Is(err, ErrEth1Data) {
+		t.Fatalf("expected ErrEth1Data but found %v", err)
+	}
+	if err := tree.Finalize(eth1DataOf(leaves[:30]), 30); err != nil {
+		t.Fatal(err)
+	}
+	for _, leaf := range leaves[30:] {
+		tree.Push(leaf)
+	}
+	eth1Data := eth1DataOf(leaves[:37])
+	if err := tree.Finalize(eth1Data, 37); err != nil {
+		t.Fatal(err)
+	}
+
+	// the snapshot is encoded and imported in other tree
+	snapshot := tree.Snapshot()
+	if snapshot.DepositCount != 37 || len(snapshot.Finalized) != 3 {
+		t.Fatalf("bad snapshot with %d deposits and %d roots", snapshot.DepositCount, len(snapshot.Finalized))
+	}
+	if snapshot.ExecutionBlockHash != eth1Data.BlockHash || snapshot.ExecutionBlockHeight != 37 {
+		t.Fatal("bad execution block of the snapshot")
+	}
+	buf, err := snapshot.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded DepositSnapshot
+	if err := decoded.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !EqualObject(snapshot, &decoded) {
+		t.Fatal("bad decoded snapshot")
+	}
+
+	full := NewDepositTree()
+	for _, leaf := range leaves[:37] {
+		full.Push(leaf)
+	}
+	if decoded.DepositRoot != full.Root() {
+		t.Fatal("bad deposit root of the snapshot")
+	}
+
+	imported, err := NewDepositTreeFromSnapshot(&decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, leaf := range leaves[37:] {
+		imported.Push(leaf)
+		full.Push(leaf)
+	}
+	if imported.Root() != tree.Root() || imported.Root() != full.Root() {
+		t.Fatal("bad root of the imported tree")
+	}
+	root = imported.Root()
+	for i := 37; i < len(leaves); i++ {
+		proof, err := imported.Proof(uint64(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := VerifyProof(root[:], proof); err != nil || !ok {
+			t.Fatalf("proof of deposit %d not verified: %v", i, err)
+		}
+	}
+
+	decoded.DepositRoot[0] ^= 1
+	if _, err := NewDepositTreeFromSnapshot(&decoded); !errors.Is(err, ErrDepositSnapshot) {
+		t.Fatalf("expected ErrDepositSnapshot but found %v", err)
+	}
+}
+
+// eip4881TestCases are the test cases of EIP-4881, downloaded with 'make get-eip4881-tests'
+const eip4881TestCases = "eip-4881-tests/test_cases.yaml"
+
+// hexBytes are the 0x prefixed hex strings of the test cases
+type hexBytes []byte
+
+func (h *hexBytes) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if !strings.HasPrefix(str, "0x") {
+		return fmt.Errorf("hex string '%s' without the 0x prefix", str)
+	}
+	buf, err := hex.DecodeString(str[2:])
+	*h = buf
+	return err
+}
+
+func (h hexBytes) root(t *testing.T) (root [32]byte) {
+	if len(h) != 32 {
+		t.Fatalf("root of %d bytes", len(h))
+	}
+	copy(root[:], h)
+	return
+}
+
+// depositData is the DepositData container of the deposit contract
+type depositData struct {
+	Pubkey                []byte `ssz-size:"48"`
+	WithdrawalCredentials []byte `ssz-size:"32"`
+	Amount                uint64
+	Signature             []byte `ssz-size:"96"`
+}
+
+type eip4881Case struct {
+	DepositData struct {
+		Pubkey                hexBytes `yaml:"pubkey"`
+		WithdrawalCredentials hexBytes `yaml:"withdrawal_credentials"`
+		Amount                uint64   `yaml:"amount"`
+		Signature             hexBytes `yaml:"signature"`
+	} `yaml:"deposit_data"`
+	DepositDataRoot hexBytes `yaml:"deposit_data_root"`
+	Eth1Data        struct {
+		DepositRoot  hexBytes `yaml:"deposit_root"`
+		DepositCount uint64   `yaml:"deposit_count"`
+		BlockHash    hexBytes `yaml:"block_hash"`
+	} `yaml:"eth1_data"`
+	BlockHeight uint64 `yaml:"block_height"`
+	Snapshot    struct {
+		Finalized            []hexBytes `yaml:"finalized"`
+		DepositRoot          hexBytes   `yaml:"deposit_root"`
+		DepositCount         uint64     `yaml:"deposit_count"`
+		ExecutionBlockHash   hexBytes   `yaml:"execution_block_hash"`
+		ExecutionBlockHeight uint64     `yaml:"execution_block_height"`
+	} `yaml:"snapshot"`
+}
+
+func TestDepositTreeEIP4881(t *testing.T) {
+	content, err := os.ReadFile(eip4881TestCases)
+	if os.IsNotExist(err) {
+		t.Skipf("%s not found, run 'make get-eip4881-tests'", eip4881TestCases)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cases []*eip4881Case
+	if err := yaml.Unmarshal(content, &cases); err != nil {
+		t.Fatal(err)
+	}
+
+	// every case pushes a deposit and finalizes the tree up to it
+	tree := NewDepositTree()
+	for i, c := range cases {
+		data := &depositData{
+			Pubkey:                c.DepositData.Pubkey,
+			WithdrawalCredentials: c.DepositData.WithdrawalCredentials,
+			Amount:                c.DepositData.Amount,
+			Signature:             c.DepositData.Signature,
+		}
+		leaf, err := HashTreeRoot(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if leaf != c.DepositDataRoot.root(t) {
+			t.Fatalf("case %d: bad deposit data root", i)
+		}
+		if err := tree.Push(leaf); err != nil {
+			t.Fatal(err)
+		}
+		if tree.Root() != c.Eth1Data.DepositRoot.root(t) {
+			t.Fatalf("case %d: bad deposit root", i)
+		}
+
+		eth1Data := &Eth1Data{
+			DepositRoot:  c.Eth1Data.DepositRoot.root(t),
+			DepositCount: c.Eth1Data.DepositCount,
+			BlockHash:    c.Eth1Data.BlockHash.root(t),
+		}
+		if err := tree.Finalize(eth1Data, c.BlockHeight); err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+		expected := &DepositSnapshot{
+			DepositRoot:          c.Snapshot.DepositRoot.root(t),
+			DepositCount:         c.Snapshot.DepositCount,
+			ExecutionBlockHash:   c.Snapshot.ExecutionBlockHash.root(t),
+			ExecutionBlockHeight: c.Snapshot.ExecutionBlockHeight,
+		}
+		for _, root := range c.Snapshot.Finalized {
+			expected.Finalized = append(expected.Finalized, root.root(t))
+		}
+		snapshot := tree.Snapshot()
+		if !EqualObject(snapshot, expected) {
+			t.Fatalf("case %d: bad snapshot", i)
+		}
+
+		imported, err := NewDepositTreeFromSnapshot(expected)
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+		if imported.Root() != tree.Root() {
+			t.Fatalf("case %d: bad root of the imported tree", i)
+		}
+	}
+}
@@ -19,6 +19,7 @@ var (
 	ErrListTooBig            = fmt.Errorf("list length is higher than max value")
 	ErrEmptyBitlist          = fmt.Errorf("bitlist is empty")
 	ErrInvalidVariableOffset = fmt.Errorf("invalid ssz encoding. first variable element offset indexes into fixed value data")
+	ErrUnionType             = fmt.Errorf("union value is not any of the union types")
 )
 
 func ErrBytesLengthFn(name string, found, expected int) error {
@@ -33,6 +34,22 @@ func ErrListTooBigFn(name string, found, max int) error {
 	return fmt.Errorf("%s (%v): max expected %d and %d found", name, ErrListTooBig, max, found)
 }
 
+// MarshalUint256 marshals a little endian uint256 represented as four
+// uint64 limbs (least significant first) to dst
+func MarshalUint256(dst []byte, i [4]uint64) []byte {
+	for _, limb := range i {
+		dst = binary.LittleEndian.AppendUint64(dst, limb)
+	}
+	return dst
+}
+
+// MarshalUint128 marshals a little endian uint128 represented as two
+// uint64 limbs (least significant first) to dst
+func MarshalUint128(dst []byte, i [2]uint64) []byte {
+	dst = binary.LittleEndian.AppendUint64(dst, i[0])
+	return binary.LittleEndian.AppendUint64(dst, i[1])
+}
+
 // MarshalUint64 marshals a little endian uint64 to dst
 func MarshalUint64(dst []byte, i uint64) []byte {
 	return binary.LittleEndian.AppendUint64(dst, i)
@@ -62,6 +79,15 @@ func MarshalBool(dst []byte, b bool) []byte {
 	return append(dst, 0)
 }
 
+// MarshalOptional marshals the prefix of an Optional value to dst. A present
+// value is prefixed with 0x01 while an absent one (None) has an empty encoding.
+func MarshalOptional(dst []byte, present bool) []byte {
+	if present {
+		return append(dst, 1)
+	}
+	return dst
+}
+
 // WriteOffset writes an offset to dst
 func WriteOffset(dst []byte, i int) []byte {
 	return MarshalUint32(dst, uint32(i))
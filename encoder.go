@@ -0,0 +1,147 @@
+package ssz
+
+import (
+	"io"
+)
+
+// encoderBufferSize is the number of bytes the Encoder accumulates
+// before it flushes them into the underlying writer
+const encoderBufferSize = 4096
+
+// MarshalSSZToWriter marshals an object into the writer. The object is streamed
+// if it implements EncoderMarshaler, otherwise it is marshaled with MarshalSSZTo.
+func MarshalSSZToWriter(w io.Writer, m Marshaler) error {
+	enc := NewEncoder(w)
+	if err := enc.EncodeObject(m); err != nil {
+		return err
+	}
+	return enc.Flush()
+}
+
+// Encoder writes SSZ encodings into an io.Writer. Writes are buffered in
+// a small internal buffer, the first write error is retained and it is
+// returned by Flush.
+type Encoder struct {
+	w   io.Writer
+	buf []byte
+	err error
+	n   int
+}
+
+// NewEncoder creates a new Encoder that writes into w
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{
+		w:   w,
+		buf: make([]byte, 0, encoderBufferSize),
+	}
+}
+
+// EncodeUint256 writes a little endian uint256
+func (e *Encoder) EncodeUint256(i [4]uint64) {
+	e.buf = MarshalUint256(e.buf, i)
+	e.maybeFlush()
+}
+
+// EncodeUint128 writes a little endian uint128
+func (e *Encoder) EncodeUint128(i [2]uint64) {
+	e.buf = MarshalUint128(e.buf, i)
+	e.maybeFlush()
+}
+
+// EncodeUint64 writes a little endian uint64
+func (e *Encoder) EncodeUint64(i uint64) {
+	e.buf = MarshalUint64(e.buf, i)
+	e.maybeFlush()
+}
+
+// EncodeUint32 writes a little endian uint32
+func (e *Encoder) EncodeUint32(i uint32) {
+	e.buf = MarshalUint32(e.buf, i)
+	e.maybeFlush()
+}
+
+// EncodeUint16 writes a little endian uint16
+func (e *Encoder) EncodeUint16(i uint16) {
+	e.buf = MarshalUint16(e.buf, i)
+	e.maybeFlush()
+}
+
+// EncodeUint8 writes a little endian uint8
+func (e *Encoder) EncodeUint8(i uint8) {
+	e.buf = MarshalUint8(e.buf, i)
+	e.maybeFlush()
+}
+
+// EncodeBool writes a boolean
+func (e *Encoder) EncodeBool(b bool) {
+	e.buf = MarshalBool(e.buf, b)
+	e.maybeFlush()
+}
+
+// EncodeOffset writes an offset
+func (e *Encoder) EncodeOffset(i int) {
+	e.buf = WriteOffset(e.buf, i)
+	e.maybeFlush()
+}
+
+// EncodeBytes writes a byte array. Arrays bigger than the internal
+// buffer are written straight into the writer.
+func (e *Encoder) EncodeBytes(b []byte) {
+	if len(e.buf)+len(b) <= cap(e.buf) {
+		e.buf = append(e.buf, b...)
+		e.maybeFlush()
+		return
+	}
+	e.flush()
+	e.write(b)
+}
+
+// EncodeObject writes an object. The object is streamed if it implements
+// EncoderMarshaler, otherwise it is marshaled with MarshalSSZTo into the
+// internal buffer.
+func (e *Encoder) EncodeObject(m MarshalerTo) error {
+	if em, ok := m.(EncoderMarshaler); ok {
+		return em.MarshalSSZToEncoder(e)
+	}
+	var err error
+	if e.buf, err = m.MarshalSSZTo(e.buf); err != nil {
+		return err
+	}
+	e.maybeFlush()
+	return nil
+}
+
+// Written returns the number of bytes written into the writer so far
+func (e *Encoder) Written() int {
+	return e.n
+}
+
+// Flush writes any buffered data into the writer and returns the
+// first error found while writing
+func (e *Encoder) Flush() error {
+	e.flush()
+	return e.err
+}
+
+func (e *Encoder) maybeFlush() {
+	if len(e.buf) >= encoderBufferSize {
+		e.flush()
+	}
+}
+
+func (e *Encoder) flush() {
+	e.write(e.buf)
+	e.buf = e.buf[:0]
+}
+
+func (e *Encoder) write(b []byte) {
+	if e.err != nil || len(b) == 0 {
+		return
+	}
+	n, err := e.w.Write(b)
+	e.n += n
+	if err == nil && n != len(b) {
+		err = io.ErrShortWrite
+	}
+	e.err = err
+}
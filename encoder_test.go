@@ -0,0 +1,68 @@
+package ssz
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+type errWriter struct {
+	n int
+}
+
+func (e *errWriter) Write(p []byte) (int, error) {
+	if e.n < len(p) {
+		return e.n, errors.New("write failed")
+	}
+	e.n -= len(p)
+	return len(p), nil
+}
+
+func TestEncoder(t *testing.T) {
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+
+	big := make([]byte, encoderBufferSize+10)
+	for i := range big {
+		big[i] = byte(i)
+	}
+
+	enc.EncodeUint64(1)
+	enc.EncodeUint32(2)
+	enc.EncodeUint16(3)
+	enc.EncodeUint8(4)
+	enc.EncodeBool(true)
+	enc.EncodeOffset(5)
+	enc.EncodeBytes([]byte{6, 7})
+	enc.EncodeBytes(big)
+	if err := enc.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []byte{}
+	expected = MarshalUint64(expected, 1)
+	expected = MarshalUint32(expected, 2)
+	expected = MarshalUint16(expected, 3)
+	expected = MarshalUint8(expected, 4)
+	expected = MarshalBool(expected, true)
+	expected = WriteOffset(expected, 5)
+	expected = append(expected, 6, 7)
+	expected = append(expected, big...)
+
+	if !bytes.Equal(buf.Bytes(), expected) {
+		t.Fatal("bad encoding")
+	}
+	if enc.Written() != len(expected) {
+		t.Fatalf("expected %d bytes written but found %d", len(expected), enc.Written())
+	}
+}
+
+func TestEncoderWriteError(t *testing.T) {
+	enc := NewEncoder(&errWriter{n: 10})
+	for i := 0; i < encoderBufferSize; i++ {
+		enc.EncodeUint64(uint64(i))
+	}
+	if err := enc.Flush(); err == nil {
+		t.Fatal("expected a write error")
+	}
+}
@@ -0,0 +1,78 @@
+package ssz
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// FieldDiff is a field with a different value in two objects
+type FieldDiff struct {
+	// Path is the path of the field (i.e. Body.Attestations[1].Signature)
+	Path string
+	// A and B are the values of the field in each object
+	A, B interface{}
+}
+
+func (d FieldDiff) String() string {
+	return fmt.Sprintf("%s: %v != %v", d.Path, d.A, d.B)
+}
+
+// AppendDiffs appends the differences of the field at 'path' to diffs
+func AppendDiffs(diffs []FieldDiff, path string, fieldDiffs []FieldDiff) []FieldDiff {
+	for _, d := range fieldDiffs {
+		d.Path = joinDecodePath(path, d.Path)
+		diffs = append(diffs, d)
+	}
+	return diffs
+}
+
+// IndexPath returns the path of the i-th item of the list at 'path'
+func IndexPath(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+// EqualObject returns true if the objects have the same SSZ encoding. It uses the
+// EqualSSZ function of the objects if they have one, otherwise it compares their encodings.
+// Nil pointers are equal to empty objects.
+func EqualObject(a, b interface{}) bool {
+	if m, ok := reflect.TypeOf(a).MethodByName("EqualSSZ"); ok && m.Type.NumIn() == 2 && m.Type.In(1) == reflect.TypeOf(b) {
+		return reflect.ValueOf(a).Method(m.Index).Call([]reflect.Value{reflect.ValueOf(b)})[0].Bool()
+	}
+	return len(DiffObject(a, b)) == 0
+}
+
+// DiffObject returns the fields that differ between the objects. It uses the DiffSSZ
+// function of the objects if they have one, otherwise it compares their encodings
+// and reports the objects as a whole.
+func DiffObject(a, b interface{}) []FieldDiff {
+	if m, ok := reflect.TypeOf(a).MethodByName("DiffSSZ"); ok && m.Type.NumIn() == 2 && m.Type.In(1) == reflect.TypeOf(b) {
+		return reflect.ValueOf(a).Method(m.Index).Call([]reflect.Value{reflect.ValueOf(b)})[0].Interface().([]FieldDiff)
+	}
+	bufA, errA := encodeObject(orEmpty(a))
+	bufB, errB := encodeObject(orEmpty(b))
+	if errA != nil || errB != nil || !bytes.Equal(bufA, bufB) {
+		return []FieldDiff{{A: a, B: b}}
+	}
+	return nil
+}
+
+// encodeObject returns the SSZ encoding of v. Some external types
+// only implement MarshalSSZTo so it is used before reflection.
+func encodeObject(v interface{}) ([]byte, error) {
+	if m, ok := v.(interface {
+		MarshalSSZTo(dst []byte) ([]byte, error)
+	}); ok {
+		return m.MarshalSSZTo(nil)
+	}
+	return Marshal(v)
+}
+
+// orEmpty returns an empty object if v is a nil pointer
+func orEmpty(v interface{}) interface{} {
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return reflect.New(rv.Type().Elem()).Interface()
+	}
+	return v
+}
@@ -9,7 +9,6 @@ import (
 	"sync"
 
 	"github.com/minio/sha256-simd"
-	"github.com/prysmaticlabs/gohashtree"
 )
 
 var (
@@ -61,13 +60,18 @@ func init() {
 // HashWithDefaultHasher hashes a HashRoot object with a Hasher from
 // the default HasherPool
 func HashWithDefaultHasher(v HashRoot) ([32]byte, error) {
-	hh := DefaultHasherPool.Get()
+	return HashWithHasherPool(v, &DefaultHasherPool)
+}
+
+// HashWithHasherPool hashes a HashRoot object with a Hasher from the pool
+func HashWithHasherPool(v HashRoot, pool *HasherPool) ([32]byte, error) {
+	hh := pool.Get()
 	if err := v.HashTreeRootWith(hh); err != nil {
-		DefaultHasherPool.Put(hh)
+		pool.Put(hh)
 		return [32]byte{}, err
 	}
 	root, err := hh.HashRoot()
-	DefaultHasherPool.Put(hh)
+	pool.Put(hh)
 	return root, err
 }
 
@@ -87,30 +91,53 @@ type Hasher struct {
 	// tmp array used during the merkleize process
 	merkleizeTmp []byte
 
-	// sha256 hash function
-	hash hash.Hash
+	// hash function and its zero hashes
+	hashFn     HashFunction
+	zeroHashes [][32]byte
+
+	// number of workers and minimum number of chunks or elements to hash in parallel
+	workers   int
+	threshold int
 }
 
 // NewHasher creates a new Hasher object
 func NewHasher() *Hasher {
-	return &Hasher{
-		hash: sha256.New(),
-		tmp:  make([]byte, 32),
-	}
+	return NewHasherWithHashFunction(SHA256)
 }
 
-// NewHasher creates a new Hasher object with a custom hash function
+// NewHasherWithHash creates a new Hasher object with a custom hash function
 func NewHasherWithHash(hh hash.Hash) *Hasher {
+	return NewHasherWithHashFunction(HashFunctionFromHash(hh))
+}
+
+// NewHasherWithHashFunction creates a new Hasher object that merkleizes
+// with the hash function 'fn'
+func NewHasherWithHashFunction(fn HashFunction) *Hasher {
+	return NewHasherWithOptions(HasherOptions{HashFunction: fn})
+}
+
+// NewHasherWithOptions creates a new Hasher object with the given options
+func NewHasherWithOptions(opts HasherOptions) *Hasher {
+	fn := opts.HashFunction
+	if fn == nil {
+		fn = SHA256
+	}
+	threshold := opts.Threshold
+	if threshold == 0 {
+		threshold = defaultParallelThreshold
+	}
 	return &Hasher{
-		hash: hh,
-		tmp:  make([]byte, 32),
+		hashFn:     fn,
+		zeroHashes: ZeroHashes(fn),
+		tmp:        make([]byte, 32),
+		workers:    opts.Workers,
+		threshold:  threshold,
 	}
 }
 
 // Reset resets the Hasher obj
 func (h *Hasher) Reset() {
 	h.buf = h.buf[:0]
-	h.hash.Reset()
 }
 
 func (h *Hasher) AppendBytes32(b []byte) {
@@ -121,6 +148,21 @@ func (h *Hasher) AppendBytes32(b []byte) {
 	}
 }
 
+// PutZero appends an empty chunk of 32 bytes
+func (h *Hasher) PutZero() {
+	h.buf = append(h.buf, zeroBytes[:32]...)
+}
+
+// PutUint256 appends a uint256 in 32 bytes
+func (h *Hasher) PutUint256(i [4]uint64) {
+	h.buf = MarshalUint256(h.buf, i)
+}
+
+// PutUint128 appends a uint128 in 32 bytes
+func (h *Hasher) PutUint128(i [2]uint64) {
+	h.AppendBytes32(MarshalUint128(h.tmp[:0], i))
+}
+
 // PutUint64 appends a uint64 in 32 bytes
 func (h *Hasher) PutUint64(i uint64) {
 	buf := make([]byte, 8)
@@ -173,6 +215,14 @@ func (h *Hasher) AppendUint64(i uint64) {
 	h.buf = MarshalUint64(h.buf, i)
 }
 
+func (h *Hasher) AppendUint256(i [4]uint64) {
+	h.buf = MarshalUint256(h.buf, i)
+}
+
+func (h *Hasher) AppendUint128(i [2]uint64) {
+	h.buf = MarshalUint128(h.buf, i)
+}
+
 func (h *Hasher) Append(i []byte) {
 	h.buf = append(h.buf, i...)
 }
@@ -219,6 +269,45 @@ func (h *Hasher) PutUint64Array(b []uint64, maxCapacity ...uint64) {
 	}
 }
 
+// PutUint256Array appends an array of uint256
+func (h *Hasher) PutUint256Array(b [][4]uint64, maxCapacity ...uint64) {
+	indx := h.Index()
+	for _, i := range b {
+		h.AppendUint256(i)
+	}
+
+	if len(maxCapacity) == 0 {
+		// Array with fixed size
+		h.Merkleize(indx)
+	} else {
+		numItems := uint64(len(b))
+		limit := CalculateLimit(maxCapacity[0], numItems, 32)
+
+		h.MerkleizeWithMixin(indx, numItems, limit)
+	}
+}
+
+// PutUint128Array appends an array of uint128
+func (h *Hasher) PutUint128Array(b [][2]uint64, maxCapacity ...uint64) {
+	indx := h.Index()
+	for _, i := range b {
+		h.AppendUint128(i)
+	}
+
+	// pad zero bytes to the left
+	h.FillUpTo32()
+
+	if len(maxCapacity) == 0 {
+		// Array with fixed size
+		h.Merkleize(indx)
+	} else {
+		numItems := uint64(len(b))
+		limit := CalculateLimit(maxCapacity[0], numItems, 16)
+
+		h.MerkleizeWithMixin(indx, numItems, limit)
+	}
+}
+
 func parseBitlist(dst, buf []byte) ([]byte, uint64) {
 	msb := uint8(bits.Len8(buf[len(buf)-1])) - 1
 	size := uint64(8*(len(buf)-1) + int(msb))
@@ -278,12 +367,12 @@ func (h *Hasher) Index() int {
 
 // Merkleize is used to merkleize the last group of the hasher
 func (h *Hasher) Merkleize(indx int) {
-	h.buf = append(h.buf[:indx], merkleizeInput(h.buf[indx:], 0)...)
+	h.buf = append(h.buf[:indx], h.merkleizeInput(h.buf[indx:], 0)...)
 }
 
 // MerkleizeWithMixin is used to merkleize the last group of the hasher
 func (h *Hasher) MerkleizeWithMixin(indx int, num, limit uint64) {
-	input := merkleizeInput(h.buf[indx:], limit)
+	input := h.merkleizeInput(h.buf[indx:], limit)
 	// mixin with the size
 	sizemix := h.tmp[:32]
 	for indx := range sizemix {
@@ -293,6 +382,47 @@ func (h *Hasher) MerkleizeWithMixin(indx int, num, limit uint64) {
 	h.buf = append(h.buf[:indx], h.doHash(input, input, sizemix)...)
 }
 
+// MerkleizeProgressive is used to merkleize the last group of the hasher
+// with progressive merkleization (EIP-7916)
+func (h *Hasher) MerkleizeProgressive(indx int) {
+	h.buf = append(h.buf[:indx], h.merkleizeProgressiveInput(h.buf[indx:])...)
+}
+
+// MerkleizeProgressiveWithMixin is used to merkleize the last group of the hasher
+// with progressive merkleization (EIP-7916) and mix in the size
+func (h *Hasher) MerkleizeProgressiveWithMixin(indx int, num uint64) {
+	input := h.merkleizeProgressiveInput(h.buf[indx:])
+	// mixin with the size
+	sizemix := h.tmp[:32]
+	for indx := range sizemix {
+		sizemix[indx] = 0
+	}
+	MarshalUint64(sizemix[:0], num)
+	h.buf = append(h.buf[:indx], h.doHash(input, input, sizemix)...)
+}
+
+// MerkleizeWithSelector is used to merkleize the last group of the hasher
+// and mix in the selector of a union
+func (h *Hasher) MerkleizeWithSelector(indx int, selector uint8) {
+	input := h.merkleizeInput(h.buf[indx:], 0)
+	// mixin with the selector
+	selectormix := h.tmp[:32]
+	for indx := range selectormix {
+		selectormix[indx] = 0
+	}
+	selectormix[0] = selector
+	h.buf = append(h.buf[:indx], h.doHash(input, input, selectormix)...)
+}
+
+// MerkleizeWithActiveFields is used to merkleize the fields of a StableContainer
+// (EIP-7495) padded to 'limit' fields and mix in the active fields bitvector
+func (h *Hasher) MerkleizeWithActiveFields(indx int, activeFields []byte, limit uint64) {
+	input := h.merkleizeInput(h.buf[indx:], limit)
+	// the bitvector is packed in chunks of 256 bits
+	activeRoot := h.merkleizeInput(activeFields, (limit+255)/256)
+	h.buf = append(h.buf[:indx], h.doHash(input, input, activeRoot)...)
+}
+
 // HashRoot creates the hash final hash root
 func (h *Hasher) HashRoot() (res [32]byte, err error) {
 	if len(h.buf) != 32 {
@@ -303,16 +433,38 @@ func (h *Hasher) HashRoot() (res [32]byte, err error) {
 	return
 }
 
+// HasherOptions are the options of the Hashers. The zero value
+// hashes with SHA-256 on a single goroutine.
+type HasherOptions struct {
+	// HashFunction is the hash function, SHA-256 if nil. It must be safe for
+	// concurrent use if Workers is set.
+	HashFunction HashFunction
+
+	// Workers is the number of goroutines that hash the large lists and vectors.
+	// The input is split in subtrees hashed in parallel and combined afterwards.
+	Workers int
+
+	// Threshold is the minimum number of chunks or list elements that are hashed
+	// in parallel. Smaller inputs are hashed serially. The default is 1024.
+	Threshold int
+}
+
 // HasherPool may be used for pooling Hashers for similarly typed SSZs.
 type HasherPool struct {
 	pool sync.Pool
+	opts HasherOptions
+}
+
+// NewHasherPool creates a HasherPool whose Hashers use the given options
+func NewHasherPool(opts HasherOptions) *HasherPool {
+	return &HasherPool{opts: opts}
 }
 
 // Get acquires a Hasher from the pool.
 func (hh *HasherPool) Get() *Hasher {
 	h := hh.pool.Get()
 	if h == nil {
-		return NewHasher()
+		return NewHasherWithOptions(hh.opts)
 	}
 	return h.(*Hasher)
 }
@@ -346,14 +498,11 @@ func getDepth(d uint64) uint8 {
 }
 
 func (h *Hasher) doHash(dst []byte, a []byte, b []byte) []byte {
-	h.hash.Write(a)
-	h.hash.Write(b)
-	h.hash.Sum(dst[:0])
-	h.hash.Reset()
-	return dst
+	res := h.hashFn.Hash(toChunk(a), toChunk(b))
+	return append(dst[:0], res[:]...)
 }
 
-func merkleizeInput(input []byte, limit uint64) []byte {
+func (h *Hasher) merkleizeInput(input []byte, limit uint64) []byte {
 	chunkCount := (len(input) + 31) / 32
 	chunks := make([][32]byte, chunkCount)
 	for i, j := 0, 0; j < chunkCount; i, j = i+32, j+1 {
@@ -366,32 +515,72 @@ func merkleizeInput(input []byte, limit uint64) []byte {
 
 	var result [32]byte
 	if limit == 0 {
-		result = merkleizeVector(chunks, uint64(chunkCount))
+		result = h.merkleizeVector(chunks, uint64(chunkCount))
 	} else {
-		result = merkleizeVector(chunks, limit)
+		result = h.merkleizeVector(chunks, limit)
 	}
 
 	return result[:]
 }
 
-// MerkleizeVector uses our optimized routine to hash a list of 32-byte
-// elements.
-func merkleizeVector(elements [][32]byte, length uint64) [32]byte {
+func (h *Hasher) merkleizeProgressiveInput(input []byte) []byte {
+	chunkCount := (len(input) + 31) / 32
+	chunks := make([][32]byte, chunkCount)
+	for i := 0; i < chunkCount; i++ {
+		copy(chunks[i][:], input[i*32:])
+	}
+	result := h.merkleizeProgressive(chunks, 1)
+	return result[:]
+}
+
+// merkleizeProgressive merkleizes the chunks in subtrees of 1, 4, 16... leaves. Each
+// subtree is the right child of a node whose left child holds the rest of the chunks.
+func (h *Hasher) merkleizeProgressive(chunks [][32]byte, numLeaves uint64) [32]byte {
+	if len(chunks) == 0 {
+		return [32]byte{}
+	}
+	num := numLeaves
+	if uint64(len(chunks)) < num {
+		num = uint64(len(chunks))
+	}
+	rest := h.merkleizeProgressive(chunks[num:], numLeaves*4)
+
+	// merkleizeVector hashes in place, cap the subtree so that
+	// the zero padding does not overwrite the rest of the chunks
+	pair := [][32]byte{rest, h.merkleizeVector(chunks[:num:num], numLeaves)}
+	if err := h.hashFn.HashPairs(pair, pair); err != nil {
+		panic(err)
+	}
+	return pair[0]
+}
+
+// MerkleizeVector uses the batched routine of the hash function to hash a
+// list of 32-byte elements.
+func (h *Hasher) merkleizeVector(elements [][32]byte, length uint64) [32]byte {
 	dep := depth(length)
+	if h.workers > 1 && len(elements) >= h.threshold {
+		return h.merkleizeParallel(elements, dep)
+	}
+	return h.merkleizeLevels(elements, 0, dep)
+}
+
+// merkleizeLevels hashes the elements at the level 'start' of the
+// tree up to the level 'end' and returns the root
+func (h *Hasher) merkleizeLevels(elements [][32]byte, start, end uint8) [32]byte {
 	// Return zerohash at depth
 	if len(elements) == 0 {
-		return zeroHashesRaw[dep]
+		return h.zeroHashes[end]
 	}
-	for i := uint8(0); i < dep; i++ {
+	for i := start; i < end; i++ {
 		layerLen := len(elements)
 		oddNodeLength := layerLen%2 == 1
 		if oddNodeLength {
-			zerohash := zeroHashesRaw[i]
+			zerohash := h.zeroHashes[i]
 			elements = append(elements, zerohash)
 		}
 		outputLen := len(elements) / 2
-		// gohashtree concurrently overwrites elements
-		err := gohashtree.Hash(elements, elements)
+		// the hash function overwrites elements in place
+		err := h.hashFn.HashPairs(elements, elements)
 		if err != nil {
 			panic(err)
 		}
@@ -0,0 +1,167 @@
+package ssz
+
+import (
+	"context"
+	"sync"
+)
+
+// defaultParallelThreshold is the default minimum number of chunks
+// or list elements that are hashed in parallel
+const defaultParallelThreshold = 1024
+
+// merkleizeParallel splits the elements in at most 'workers' subtrees of the same
+// depth, hashes them in parallel and combines their roots up to the depth 'dep'.
+// The root is the same as the one of merkleizeLevels.
+func (h *Hasher) merkleizeParallel(elements [][32]byte, dep uint8) [32]byte {
+	sub := depth(uint64((len(elements) + h.workers - 1) / h.workers))
+	if sub == 0 || sub >= dep {
+		return h.merkleizeLevels(elements, 0, dep)
+	}
+	size := 1 << sub
+
+	roots := make([][32]byte, (len(elements)+size-1)/size)
+	var wg sync.WaitGroup
+	for i := range roots {
+		end := (i + 1) * size
+		if end > len(elements) {
+			end = len(elements)
+		}
+		wg.Add(1)
+		go func(i int, subtree [][32]byte) {
+			defer wg.Done()
+			roots[i] = h.merkleizeLevels(subtree, 0, sub)
+		}(i, elements[i*size:end:end])
+	}
+	wg.Wait()
+
+	return h.merkleizeLevels(roots, sub, dep)
+}
+
+// HashElements appends the roots of 'num' list elements hashed by 'f', which must
+// append exactly one root to the Hasher. If the Hasher has workers and the list is
+// large enough, the elements are split between the workers, each one hashing with
+// its own Hasher, and the roots are appended in order.
+func (h *Hasher) HashElements(num int, f func(indx int, hh *Hasher) error) error {
+	if h.workers <= 1 || num < h.threshold {
+		for i := 0; i < num; i++ {
+			if err := f(i, h); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	indx := h.Index()
+	h.buf = append(h.buf, make([]byte, 32*num)...)
+	roots := h.buf[indx:]
+
+	per := (num + h.workers - 1) / h.workers
+	errs := make([]error, h.workers)
+	var wg sync.WaitGroup
+	for w := 0; w*per < num; w++ {
+		start, end := w*per, (w+1)*per
+		if end > num {
+			end = num
+		}
+		wg.Add(1)
+		go func(w, start, end int) {
+			defer wg.Done()
+			// the elements are hashed serially in each worker
+			hh := &Hasher{hashFn: h.hashFn, zeroHashes: h.zeroHashes, tmp: make([]byte, 32)}
+			for i := start; i < end; i++ {
+				hh.Reset()
+				if err := f(i, hh); err != nil {
+					errs[w] = err
+					return
+				}
+				root, err := hh.HashRoot()
+				if err != nil {
+					errs[w] = err
+					return
+				}
+				copy(roots[i*32:], root[:])
+			}
+		}(w, start, end)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			h.buf = h.buf[:indx]
+			return err
+		}
+	}
+	return nil
+}
+
+// HashFieldsParallel computes the roots of the fields of a container with up to 'workers'
+// goroutines and merkleizes them in order. Each field is hashed by 'fields[i]' with its
+// own Hasher from 'pool' and it must append exactly one root, so the fields and the
+// container are hashed with the options of the pool. Once the context is cancelled or
+// a field fails the remaining fields are not hashed. The error of the first failed
+// field, in the order of the fields, is returned.
+func HashFieldsParallel(ctx context.Context, pool *HasherPool, workers int, fields []func(hh *Hasher) error) ([32]byte, error) {
+	if workers < 1 {
+		workers = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	roots := make([]byte, 32*len(fields))
+	errs := make([]error, len(fields))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(fields); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if errs[i] = hashField(pool, fields[i], roots[i*32:]); errs[i] != nil {
+					cancel()
+				}
+			}
+		}()
+	}
+
+LOOP:
+	for i := range fields {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break LOOP
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return [32]byte{}, err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return [32]byte{}, err
+	}
+
+	hh := pool.Get()
+	defer pool.Put(hh)
+	hh.Append(roots)
+	hh.Merkleize(0)
+	return hh.HashRoot()
+}
+
+// hashField writes the root of the field hashed by 'f' in 'dst'
+func hashField(pool *HasherPool, f func(hh *Hasher) error, dst []byte) error {
+	hh := pool.Get()
+	defer pool.Put(hh)
+	if err := f(hh); err != nil {
+		return err
+	}
+	root, err := hh.HashRoot()
+	if err != nil {
+		return err
+	}
+	copy(dst, root[:])
+	return nil
+}
@@ -31,8 +31,172 @@ func TestNextPowerOfTwo(t *testing.T) {
 }
 
 func TestMerkleize8ByteVector(t *testing.T) {
-	result := merkleizeInput([]byte{'1', '2', '3', '4', '5', '6', '7', '8'}, 0)
+	result := NewHasher().merkleizeInput([]byte{'1', '2', '3', '4', '5', '6', '7', '8'}, 0)
 	if !bytes.Equal(result, []byte{49, 50, 51, 52, 53, 54, 55, 56, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}) {
 		t.Fatalf("Unexpected result: %v", result)
 	}
 }
+
+func TestPutUint128Array(t *testing.T) {
+	vals := [][2]uint64{{1, 2}, {3, 4}, {5, 6}}
+
+	// three packed uint128 take two chunks and the limit of 15 items is 8 chunks
+	chunks := make([]byte, 8*32)
+	for i, v := range vals {
+		MarshalUint128(chunks[i*16:i*16], v)
+	}
+	root := NewHasher().merkleizeInput(chunks, 0)
+
+	length := make([]byte, 32)
+	length[0] = 3
+	expected := hashPair(SHA256, root, length)
+
+	hh := NewHasher()
+	hh.PutUint128Array(vals, 15)
+	res, err := hh.HashRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res[:], expected) {
+		t.Fatalf("bad root, expected %x but found %x", expected, res)
+	}
+}
+
+func TestPutUint256(t *testing.T) {
+	hh := NewHasher()
+	hh.PutUint256([4]uint64{1, 2, 3, 4})
+	res, err := hh.HashRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res[:], MarshalUint256(nil, [4]uint64{1, 2, 3, 4})) {
+		t.Fatalf("bad root %x", res)
+	}
+	if UnmarshallUint256(res[:]) != [4]uint64{1, 2, 3, 4} {
+		t.Fatal("bad unmarshal")
+	}
+}
+
+func TestMerkleizeWithSelector(t *testing.T) {
+	selector := make([]byte, 32)
+	selector[0] = 2
+
+	root := make([]byte, 32)
+	root[0] = 1
+	expected := hashPair(SHA256, root, selector)
+
+	hh := NewHasher()
+	indx := hh.Index()
+	hh.PutBytes(root)
+	hh.MerkleizeWithSelector(indx, 2)
+	res, err := hh.HashRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res[:], expected) {
+		t.Fatalf("bad root, expected %x but found %x", expected, res)
+	}
+}
+
+func TestMerkleizeProgressive(t *testing.T) {
+	// merkleize_progressive as defined in EIP-7916
+	var progressive func(chunks [][]byte, numLeaves int) []byte
+	progressive = func(chunks [][]byte, numLeaves int) []byte {
+		if len(chunks) == 0 {
+			return make([]byte, 32)
+		}
+		num := numLeaves
+		if len(chunks) < num {
+			num = len(chunks)
+		}
+		rest := progressive(chunks[num:], numLeaves*4)
+		subtree := NewHasher().merkleizeInput(bytes.Join(chunks[:num], nil), uint64(numLeaves))
+		return hashPair(SHA256, rest, subtree)
+	}
+
+	for _, num := range []int{0, 1, 2, 4, 5, 6, 21, 22, 100} {
+		chunks := make([][]byte, num)
+		for i := range chunks {
+			chunks[i] = make([]byte, 32)
+			chunks[i][0] = byte(i + 1)
+		}
+		expected := progressive(chunks, 1)
+
+		hh := NewHasher()
+		indx := hh.Index()
+		for _, c := range chunks {
+			hh.Append(c)
+		}
+		hh.MerkleizeProgressive(indx)
+		res, err := hh.HashRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(res[:], expected) {
+			t.Fatalf("%d: bad root, expected %x but found %x", num, expected, res)
+		}
+
+		leaves := make([]*Node, num)
+		for i, c := range chunks {
+			leaves[i] = NewNodeWithValue(c)
+		}
+		tree, err := TreeFromNodesProgressive(leaves)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(tree.Hash(), expected) {
+			t.Fatalf("%d: bad tree root, expected %x but found %x", num, expected, tree.Hash())
+		}
+	}
+}
+
+func TestMerkleizeParallel(t *testing.T) {
+	serial := NewHasher()
+	parallel := NewHasherWithOptions(HasherOptions{Workers: 3, Threshold: 2})
+
+	for _, num := range []int{2, 3, 7, 8, 9, 100, 1025} {
+		for _, limit := range []uint64{uint64(num), 2048, 1 << 40} {
+			chunks := make([]byte, num*32)
+			for i := range chunks {
+				chunks[i] = byte(i * 7)
+			}
+			expected := serial.merkleizeInput(chunks, limit)
+			if found := parallel.merkleizeInput(chunks, limit); !bytes.Equal(expected, found) {
+				t.Fatalf("num %d, limit %d: expected %x but found %x", num, limit, expected, found)
+			}
+		}
+	}
+}
+
+func TestHashElementsParallel(t *testing.T) {
+	roots := func(hh *Hasher, num int) ([]byte, error) {
+		err := hh.HashElements(num, func(indx int, hh *Hasher) error {
+			if indx == 50 {
+				return ErrIncorrectListSize
+			}
+			hh.PutUint64(uint64(indx))
+			return nil
+		})
+		return hh.buf, err
+	}
+
+	parallel := NewHasherWithOptions(HasherOptions{Workers: 4, Threshold: 2})
+	for _, num := range []int{1, 2, 5, 13, 50} {
+		expected, _ := roots(NewHasher(), num)
+		found, err := roots(parallel, num)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(expected, found) {
+			t.Fatalf("num %d: expected %x but found %x", num, expected, found)
+		}
+		parallel.Reset()
+	}
+
+	if _, err := roots(parallel, 51); err != ErrIncorrectListSize {
+		t.Fatalf("expected the error of the element but found %v", err)
+	}
+	if parallel.Index() != 0 {
+		t.Fatal("the roots of the failed elements were not removed")
+	}
+}
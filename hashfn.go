@@ -0,0 +1,103 @@
+package ssz
+
+import (
+	"hash"
+
+	"github.com/minio/sha256-simd"
+	"github.com/prysmaticlabs/gohashtree"
+)
+
+// HashFunction is the hash used to merkleize the objects. The shape of the
+// trees does not depend on the hash function, so any function that hashes two
+// 32 bytes chunks into one (i.e. a Poseidon stand-in) can replace SHA-256.
+type HashFunction interface {
+	// Hash returns the hash of the concatenation of the chunks 'a' and 'b'
+	Hash(a, b [32]byte) [32]byte
+
+	// HashPairs hashes each pair of consecutive chunks of 'chunks' and writes the
+	// result in 'digests', which has half the length of 'chunks'. Both slices can
+	// be the same.
+	HashPairs(digests, chunks [][32]byte) error
+}
+
+// SHA256 is the SHA-256 hash function used by the consensus specs. It is the
+// default hash function of the Hasher, the tree and the proofs.
+var SHA256 HashFunction = sha256Hash{}
+
+type sha256Hash struct{}
+
+func (sha256Hash) Hash(a, b [32]byte) [32]byte {
+	var buf [64]byte
+	copy(buf[:32], a[:])
+	copy(buf[32:], b[:])
+	return sha256.Sum256(buf[:])
+}
+
+func (sha256Hash) HashPairs(digests, chunks [][32]byte) error {
+	return gohashtree.Hash(digests, chunks)
+}
+
+// HashFunctionFromHash returns a HashFunction that uses 'hh' to hash the pairs
+// of chunks. The hash is not safe for concurrent use and neither is the result.
+func HashFunctionFromHash(hh hash.Hash) HashFunction {
+	return &stdHash{hash: hh}
+}
+
+type stdHash struct {
+	hash hash.Hash
+}
+
+func (s *stdHash) Hash(a, b [32]byte) (res [32]byte) {
+	s.hash.Reset()
+	s.hash.Write(a[:])
+	s.hash.Write(b[:])
+	copy(res[:], s.hash.Sum(res[:0]))
+	return
+}
+
+func (s *stdHash) HashPairs(digests, chunks [][32]byte) error {
+	return hashPairs(s, digests, chunks)
+}
+
+// hashPairs hashes the pairs of chunks one at a time. It can be used to
+// implement HashPairs for functions without a batched routine.
+func hashPairs(fn HashFunction, digests, chunks [][32]byte) error {
+	if len(chunks)%2 != 0 || len(digests) < len(chunks)/2 {
+		return ErrIncorrectListSize
+	}
+	for i := 0; i < len(chunks)/2; i++ {
+		digests[i] = fn.Hash(chunks[2*i], chunks[2*i+1])
+	}
+	return nil
+}
+
+// ZeroHashes returns the roots of the empty trees of depth 0 to 99 with the hash
+// function. The table of SHA-256 is shared and must not be modified.
+func ZeroHashes(fn HashFunction) [][32]byte {
+	if _, ok := fn.(sha256Hash); ok {
+		return zeroHashesRaw[:]
+	}
+	zero := make([][32]byte, len(zeroHashesRaw))
+	for i := 1; i < len(zero); i++ {
+		zero[i] = fn.Hash(zero[i-1], zero[i-1])
+	}
+	return zero
+}
+
+// hashPair returns the hash of the chunks 'a' and 'b'. SHA-256 hashes their
+// concatenation, whatever their size, like the tree always did. The other
+// functions hash 32 bytes chunks, the shorter chunks are padded with zeros.
+func hashPair(fn HashFunction, a, b []byte) []byte {
+	if _, ok := fn.(sha256Hash); ok && (len(a) != 32 || len(b) != 32) {
+		res := sha256.Sum256(append(append(make([]byte, 0, len(a)+len(b)), a...), b...))
+		return res[:]
+	}
+	res := fn.Hash(toChunk(a), toChunk(b))
+	return res[:]
+}
+
+// toChunk pads 'b' with zeros to a 32 bytes chunk
+func toChunk(b []byte) (chunk [32]byte) {
+	copy(chunk[:], b)
+	return
+}
@@ -0,0 +1,112 @@
+package ssz
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"testing"
+
+	"github.com/minio/sha256-simd"
+)
+
+func TestZeroHashes(t *testing.T) {
+	// the generic table of SHA-256 matches the precomputed one
+	zero := ZeroHashes(HashFunctionFromHash(sha256.New()))
+	for i := range zero {
+		if zero[i] != zeroHashesRaw[i] {
+			t.Fatalf("bad zero hash at depth %d", i)
+		}
+	}
+}
+
+func TestHashFunctionFromHash(t *testing.T) {
+	vals := []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+	hh := NewHasher()
+	hh.PutUint64Array(vals, 100)
+	expected, err := hh.HashRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hh = NewHasherWithHash(sha256.New())
+	hh.PutUint64Array(vals, 100)
+	res, err := hh.HashRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != expected {
+		t.Fatalf("bad root, expected %x but found %x", expected, res)
+	}
+}
+
+func TestCustomHashFunction(t *testing.T) {
+	fn := HashFunctionFromHash(sha512.New512_256())
+
+	// the list of 5 uint64 takes 2 chunks and the limit of 40 items is 16 chunks
+	vals := []uint64{1, 2, 3, 4, 5}
+	hh := NewHasherWithHashFunction(fn)
+	hh.PutUint64Array(vals, 40)
+	root, err := hh.HashRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sha := NewHasher()
+	sha.PutUint64Array(vals, 40)
+	if shaRoot, _ := sha.HashRoot(); shaRoot == root {
+		t.Fatal("the root does not depend on the hash function")
+	}
+
+	// the tree has the same shape with the hash function
+	chunks := make([]byte, 64)
+	for i, v := range vals {
+		MarshalUint64(chunks[i*8:i*8], v)
+	}
+	tree, err := TreeFromNodesWithMixin([]*Node{NewNodeWithValue(chunks[:32]), NewNodeWithValue(chunks[32:])}, len(vals), 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tree.HashWith(fn), root[:]) {
+		t.Fatalf("bad tree root, expected %x but found %x", root, tree.HashWith(fn))
+	}
+
+	// the second chunk is at the general index 2*16+1
+	proof, err := tree.ProveWith(33, fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := VerifyProofWith(root[:], proof, fn); err != nil || !ok {
+		t.Fatalf("bad proof %v", err)
+	}
+	if ok, _ := VerifyProof(root[:], proof); ok {
+		t.Fatal("proof verified with the wrong hash function")
+	}
+
+	multi, err := tree.ProveMultiWith([]int{32, 33}, fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := VerifyMultiproofWith(root[:], multi.Hashes, multi.Leaves, multi.Indices, fn); err != nil || !ok {
+		t.Fatalf("bad multiproof %v", err)
+	}
+}
+
+func TestHashPairPadding(t *testing.T) {
+	short, padded := []byte{1, 2, 3}, make([]byte, 32)
+	copy(padded, short)
+
+	// SHA-256 hashes the concatenation of the nodes like the tree always did
+	expected := sha256.Sum256(append(append([]byte{}, short...), short...))
+	if !bytes.Equal(hashPair(SHA256, short, short), expected[:]) {
+		t.Fatal("short nodes are not concatenated with SHA-256")
+	}
+	// the other functions pad them to a chunk
+	fn := HashFunctionFromHash(sha512.New512_256())
+	if !bytes.Equal(hashPair(fn, short, short), hashPair(fn, padded, padded)) {
+		t.Fatal("short nodes are not padded")
+	}
+
+	proof := &Proof{Index: 2, Leaf: make([]byte, 33), Hashes: [][]byte{make([]byte, 32)}}
+	if _, err := VerifyProofWith(make([]byte, 32), proof, fn); err == nil {
+		t.Fatal("expected an error for a leaf longer than a chunk")
+	}
+}
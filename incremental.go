@@ -0,0 +1,103 @@
+package ssz
+
+import (
+	"encoding/binary"
+)
+
+// IncrementalList merkleizes a list of roots that only grows by appending (i.e. the
+// historical roots or the validator registry). It keeps the left branch of the tree,
+// so appending a root and computing the root of the list cost O(log n) hashes instead
+// of hashing the whole list again. The root is the same as the one of MerkleizeWithMixin.
+type IncrementalList struct {
+	fn    HashFunction
+	zero  [][32]byte
+	limit uint64
+	depth uint8
+	count uint64
+
+	// branch has the roots of the complete subtrees waiting
+	// for their right sibling, one per level of the tree
+	branch [][32]byte
+}
+
+// NewIncrementalList creates an empty list of at most 'limit' roots
+// merkleized with SHA-256
+func NewIncrementalList(limit uint64) *IncrementalList {
+	return NewIncrementalListWithHashFunction(limit, SHA256)
+}
+
+// NewIncrementalListWithHashFunction creates an empty list of at most
+// 'limit' roots merkleized with the hash function 'fn'
+func NewIncrementalListWithHashFunction(limit uint64, fn HashFunction) *IncrementalList {
+	dep := depth(limit)
+	return &IncrementalList{
+		fn:     fn,
+		zero:   ZeroHashes(fn),
+		limit:  limit,
+		depth:  dep,
+		branch: make([][32]byte, dep+1),
+	}
+}
+
+// Len returns the number of roots in the list
+func (l *IncrementalList) Len() uint64 {
+	return l.count
+}
+
+// Append adds a root at the end of the list
+func (l *IncrementalList) Append(root [32]byte) error {
+	if l.count >= l.limit {
+		return ErrIncorrectListSize
+	}
+	l.count++
+
+	// merge the complete subtrees on the left up to the first
+	// level with a free slot in the branch
+	node := root
+	for i, size := 0, l.count; i <= int(l.depth); i, size = i+1, size>>1 {
+		if size&1 == 1 {
+			l.branch[i] = node
+			return nil
+		}
+		node = l.fn.Hash(l.branch[i], node)
+	}
+	return nil
+}
+
+// Root returns the root of the tree of the list without the length
+func (l *IncrementalList) Root() [32]byte {
+	if l.depth < 64 && l.count == 1<<l.depth {
+		// the tree is complete
+		return l.branch[l.depth]
+	}
+	node := l.zero[0]
+	for i, size := 0, l.count; i < int(l.depth); i, size = i+1, size>>1 {
+		if size&1 == 1 {
+			node = l.fn.Hash(l.branch[i], node)
+		} else {
+			node = l.fn.Hash(node, l.zero[i])
+		}
+	}
+	return node
+}
+
+// HashTreeRoot returns the root of the list with the length mixed in
+func (l *IncrementalList) HashTreeRoot() [32]byte {
+	var size [32]byte
+	binary.LittleEndian.PutUint64(size[:8], l.count)
+	return l.fn.Hash(l.Root(), size)
+}
+
+// Copy returns a copy of the list that can be appended to independently
+func (l *IncrementalList) Copy() *IncrementalList {
+	cpy := *l
+	cpy.branch = append([][32]byte(nil), l.branch...)
+	return &cpy
+}
+
+// PutIncrementalList appends the root of the list with the length mixed in. It
+// can replace the merkleization of an append only list in HashTreeRootWith.
+func (h *Hasher) PutIncrementalList(l *IncrementalList) {
+	root := l.HashTreeRoot()
+	h.buf = append(h.buf, root[:]...)
+}
@@ -0,0 +1,63 @@
+package ssz
+
+import (
+	"crypto/sha512"
+	"testing"
+)
+
+func TestIncrementalList(t *testing.T) {
+	for _, limit := range []uint64{1, 2, 5, 16, 100, 1 << 40} {
+		for _, fn := range []HashFunction{SHA256, HashFunctionFromHash(sha512.New512_256())} {
+			l := NewIncrementalListWithHashFunction(limit, fn)
+			hh := NewHasherWithHashFunction(fn)
+
+			roots := [][]byte{}
+			for i := 0; i < 40 && uint64(i) <= limit; i++ {
+				hh.Reset()
+				if err := hh.PutRootVector(roots, limit); err != nil {
+					t.Fatal(err)
+				}
+				expected, err := hh.HashRoot()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if found := l.HashTreeRoot(); found != expected {
+					t.Fatalf("limit %d, %d roots: expected %x but found %x", limit, i, expected, found)
+				}
+
+				root := [32]byte{byte(i), byte(i >> 8), 1}
+				if uint64(i) == limit {
+					if err := l.Append(root); err != ErrIncorrectListSize {
+						t.Fatalf("limit %d: expected the list to be full", limit)
+					}
+					break
+				}
+				if err := l.Append(root); err != nil {
+					t.Fatal(err)
+				}
+				roots = append(roots, root[:])
+			}
+		}
+	}
+}
+
+func TestIncrementalListCopy(t *testing.T) {
+	l := NewIncrementalList(8)
+	l.Append([32]byte{1})
+	cpy := l.Copy()
+	l.Append([32]byte{2})
+	cpy.Append([32]byte{3})
+
+	expected := NewIncrementalList(8)
+	expected.Append([32]byte{1})
+	expected.Append([32]byte{3})
+	if cpy.Len() != 2 || cpy.HashTreeRoot() != expected.HashTreeRoot() {
+		t.Fatal("the copy shares the branch")
+	}
+
+	hh := NewHasher()
+	hh.PutIncrementalList(l)
+	if root, _ := hh.HashRoot(); root != l.HashTreeRoot() {
+		t.Fatal("bad root of PutIncrementalList")
+	}
+}
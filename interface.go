@@ -7,11 +7,51 @@ type Marshaler interface {
 	SizeSSZ() int
 }
 
+// MarshalerTo is the interface implemented by types that can append their SSZ encoding to a target array.
+type MarshalerTo interface {
+	MarshalSSZTo(dst []byte) ([]byte, error)
+}
+
+// EncoderMarshaler is the interface implemented by types that can stream their SSZ encoding into an Encoder.
+type EncoderMarshaler interface {
+	MarshalSSZToEncoder(enc *Encoder) error
+}
+
 // Unmarshaler is the interface implemented by types that can unmarshal a SSZ description of themselves
 type Unmarshaler interface {
 	UnmarshalSSZ(buf []byte) error
 }
 
+// UnmarshalerNoCopy is the interface implemented by types that can unmarshal a SSZ description of
+// themselves with the byte fields referencing the input buffer instead of a copy of it.
+type UnmarshalerNoCopy interface {
+	UnmarshalSSZNoCopy(buf []byte) error
+}
+
+// UnmarshalerWithState is the interface implemented by types that can unmarshal a SSZ
+// description of themselves within the limits of a DecodeState.
+type UnmarshalerWithState interface {
+	UnmarshalSSZWithState(buf []byte, st *DecodeState) error
+}
+
+// Validator is the interface implemented by types that can check a SSZ description
+// of themselves without decoding it. It accepts the same inputs as Unmarshaler.
+type Validator interface {
+	ValidateSSZ(buf []byte) error
+}
+
+// DecoderUnmarshaler is the interface implemented by types that can stream a SSZ description of themselves from a Decoder.
+type DecoderUnmarshaler interface {
+	UnmarshalSSZFromDecoder(dec *Decoder) error
+}
+
+// FixedSizer is implemented by types that encode themselves with a layout the reflection
+// codec cannot infer (i.e. structs with unexported fields). FixedSizeSSZ returns the size
+// of the encoding and true if it is fixed, or false if the encoding has a variable size.
+type FixedSizer interface {
+	FixedSizeSSZ() (int, bool)
+}
+
 type HashRoot interface {
 	HashTreeRoot() ([32]byte, error)
 	HashTreeRootWith(hh *Hasher) error
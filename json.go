@@ -0,0 +1,260 @@
+package ssz
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+var (
+	// ErrMissingField is returned when a field is missing or null in the JSON encoding
+	ErrMissingField = errors.New("missing field")
+	// ErrJSONHex is returned when a JSON string is not 0x prefixed hex
+	ErrJSONHex = errors.New("invalid 0x prefixed hex string")
+	// ErrJSONUint is returned when a JSON string is not a decimal number in the range of the uint
+	ErrJSONUint = errors.New("invalid decimal uint")
+)
+
+// JSONError is the error returned by the generated UnmarshalJSON functions. It
+// records the field that failed to decode and wraps the cause of the error.
+type JSONError struct {
+	// Path is the field that failed to decode (i.e. Body.Attestations[1].Signature)
+	Path string
+	// Err is the cause of the error
+	Err error
+}
+
+// WrapJSONError adds the field to the path of the error. If the
+// error is not a JSONError it is wrapped in one.
+func WrapJSONError(err error, field string) error {
+	var e *JSONError
+	if !errors.As(err, &e) {
+		e = &JSONError{Err: err}
+		err = e
+	}
+	e.Path = joinDecodePath(field, e.Path)
+	return err
+}
+
+func (e *JSONError) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *JSONError) Unwrap() error {
+	return e.Err
+}
+
+// IsJSONNull returns true if the JSON value is missing or null
+func IsJSONNull(raw []byte) bool {
+	return len(raw) == 0 || string(raw) == "null"
+}
+
+// MarshalJSONUint64 appends the uint as a quoted decimal string
+func MarshalJSONUint64(dst []byte, i uint64) []byte {
+	dst = append(dst, '"')
+	dst = strconv.AppendUint(dst, i, 10)
+	return append(dst, '"')
+}
+
+// MarshalJSONUint appends the little endian uint (i.e. an uint256) as a quoted decimal string
+func MarshalJSONUint(dst []byte, le []byte) []byte {
+	be := make([]byte, len(le))
+	for i := range le {
+		be[len(le)-1-i] = le[i]
+	}
+	dst = append(dst, '"')
+	dst = new(big.Int).SetBytes(be).Append(dst, 10)
+	return append(dst, '"')
+}
+
+// MarshalJSONBool appends the bool as a JSON bool
+func MarshalJSONBool(dst []byte, b bool) []byte {
+	return strconv.AppendBool(dst, b)
+}
+
+// MarshalJSONBytes appends the bytes as a quoted 0x prefixed hex string
+func MarshalJSONBytes(dst []byte, b []byte) []byte {
+	dst = append(dst, `"0x`...)
+	dst = append(dst, make([]byte, hex.EncodedLen(len(b)))...)
+	hex.Encode(dst[len(dst)-hex.EncodedLen(len(b)):], b)
+	return append(dst, '"')
+}
+
+// MarshalJSONObject appends the JSON encoding of the object. Objects that do not implement
+// json.Marshaler (i.e. types from other packages) are encoded as the hex string of their SSZ
+// encoding. Nil pointers are encoded as empty objects.
+func MarshalJSONObject(dst []byte, v interface{}) ([]byte, error) {
+	v = orEmpty(v)
+	if m, ok := v.(json.Marshaler); ok {
+		buf, err := m.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		return append(dst, buf...), nil
+	}
+	buf, err := encodeObject(v)
+	if err != nil {
+		return nil, err
+	}
+	return MarshalJSONBytes(dst, buf), nil
+}
+
+// UnmarshalJSONUint64 decodes a quoted decimal string as an uint of 'size' bytes
+func UnmarshalJSONUint64(raw []byte, size int) (uint64, error) {
+	str, err := unmarshalJSONString(raw)
+	if err != nil {
+		return 0, err
+	}
+	i, err := strconv.ParseUint(str, 10, size*8)
+	if err != nil {
+		return 0, fmt.Errorf("%w '%s'", ErrJSONUint, str)
+	}
+	return i, nil
+}
+
+// UnmarshalJSONUint decodes a quoted decimal string as a little endian uint of 'size' bytes
+func UnmarshalJSONUint(raw []byte, size int) ([]byte, error) {
+	str, err := unmarshalJSONString(raw)
+	if err != nil {
+		return nil, err
+	}
+	i, ok := new(big.Int).SetString(str, 10)
+	if !ok || i.Sign() < 0 || i.BitLen() > size*8 || str[0] == '+' {
+		return nil, fmt.Errorf("%w '%s'", ErrJSONUint, str)
+	}
+	le := i.FillBytes(make([]byte, size))
+	for l, r := 0, len(le)-1; l < r; l, r = l+1, r-1 {
+		le[l], le[r] = le[r], le[l]
+	}
+	return le, nil
+}
+
+// UnmarshalJSONBool decodes a JSON bool
+func UnmarshalJSONBool(raw []byte) (bool, error) {
+	if IsJSONNull(raw) {
+		return false, ErrMissingField
+	}
+	var b bool
+	if err := json.Unmarshal(raw, &b); err != nil {
+		return false, err
+	}
+	return b, nil
+}
+
+// UnmarshalJSONBytes decodes a quoted 0x prefixed hex string. The number
+// of bytes must be between 'min' and 'max', both included.
+func UnmarshalJSONBytes(raw []byte, min, max uint64) ([]byte, error) {
+	str, err := unmarshalJSONString(raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(str) < 2 || str[:2] != "0x" {
+		return nil, ErrJSONHex
+	}
+	buf, err := hex.DecodeString(str[2:])
+	if err != nil {
+		return nil, ErrJSONHex
+	}
+	if size := uint64(len(buf)); size < min || size > max {
+		expected := max
+		if size < min {
+			expected = min
+		}
+		return nil, fmt.Errorf("%w (expected %d, got %d)", ErrBytesLength, expected, size)
+	}
+	return buf, nil
+}
+
+// UnmarshalJSONBitlist decodes a bitlist encoded as a quoted 0x prefixed hex string
+// with the length bit. The bitlist cannot have more than 'bitLimit' bits.
+func UnmarshalJSONBitlist(raw []byte, bitLimit uint64) ([]byte, error) {
+	buf, err := UnmarshalJSONBytes(raw, 1, bitLimit/8+1)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateBitlist(buf, bitLimit); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// UnmarshalJSONList decodes a JSON array and returns its items. The number of
+// items must be between 'min' and 'max', both included.
+func UnmarshalJSONList(raw []byte, min, max uint64) ([]json.RawMessage, error) {
+	if IsJSONNull(raw) {
+		return nil, ErrMissingField
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, err
+	}
+	if size := uint64(len(items)); size < min {
+		return nil, fmt.Errorf("%w (expected %d, got %d)", ErrVectorLength, min, size)
+	} else if size > max {
+		if min == max {
+			return nil, fmt.Errorf("%w (expected %d, got %d)", ErrVectorLength, max, size)
+		}
+		return nil, fmt.Errorf("%w (expected %d, got %d)", ErrListTooBig, max, size)
+	}
+	return items, nil
+}
+
+// UnmarshalJSONUnion decodes a union encoded as an object with the
+// quoted decimal 'selector' and the 'value' of the selected type.
+func UnmarshalJSONUnion(raw []byte) (uint8, json.RawMessage, error) {
+	if IsJSONNull(raw) {
+		return 0, nil, ErrMissingField
+	}
+	var union struct {
+		Selector json.RawMessage `json:"selector"`
+		Value    json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(raw, &union); err != nil {
+		return 0, nil, err
+	}
+	selector, err := UnmarshalJSONUint64(union.Selector, 1)
+	if err != nil {
+		return 0, nil, WrapJSONError(err, "selector")
+	}
+	return uint8(selector), union.Value, nil
+}
+
+// UnmarshalJSONObject decodes the JSON encoding of the object. Objects that do not
+// implement json.Unmarshaler (i.e. types from other packages) are decoded from the
+// hex string of their SSZ encoding.
+func UnmarshalJSONObject(raw []byte, v interface{}) error {
+	if IsJSONNull(raw) {
+		return ErrMissingField
+	}
+	if u, ok := v.(json.Unmarshaler); ok {
+		return u.UnmarshalJSON(raw)
+	}
+	u, ok := v.(Unmarshaler)
+	if !ok {
+		return fmt.Errorf("type %s cannot be decoded from JSON", reflect.TypeOf(v))
+	}
+	buf, err := UnmarshalJSONBytes(raw, 0, uint64(len(raw)))
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalSSZ(buf)
+}
+
+// unmarshalJSONString decodes a JSON string
+func unmarshalJSONString(raw []byte) (string, error) {
+	if IsJSONNull(raw) {
+		return "", ErrMissingField
+	}
+	var str string
+	if err := json.Unmarshal(raw, &str); err != nil {
+		return "", err
+	}
+	return str, nil
+}
@@ -0,0 +1,126 @@
+package ssz
+
+import "errors"
+
+var (
+	ErrMaxAlloc = errors.New("allocation budget exceeded")
+	ErrMaxDepth = errors.New("maximum nesting depth exceeded")
+)
+
+// DecodeOptions are the limits used to decode untrusted inputs. A limit of zero means
+// that there is no limit. Exceeding a limit returns a DecodeError that wraps ErrMaxAlloc
+// or ErrMaxDepth with the limit as the expected value.
+type DecodeOptions struct {
+	// MaxAlloc is the maximum number of bytes preallocated for the lists and vectors of
+	// the decoded object, including the structs of the lists of pointers. Byte fields are
+	// not counted since they are copied from the input.
+	MaxAlloc uint64
+	// MaxDepth is the maximum nesting depth of the containers and the lists with dynamic items
+	MaxDepth int
+}
+
+// DecodeState tracks the allocations and the nesting depth of a decoding against its
+// DecodeOptions. A nil DecodeState does not have any limit.
+type DecodeState struct {
+	opts  DecodeOptions
+	alloc uint64
+	depth int
+}
+
+// NewDecodeState creates a DecodeState for a single decoding with the given limits
+func NewDecodeState(opts DecodeOptions) *DecodeState {
+	return &DecodeState{opts: opts}
+}
+
+// Allocated returns the number of bytes allocated so far
+func (s *DecodeState) Allocated() uint64 {
+	if s == nil {
+		return 0
+	}
+	return s.alloc
+}
+
+// Alloc adds 'num' items of 'size' bytes to the allocation budget. It must be
+// called before the items are allocated.
+func (s *DecodeState) Alloc(num, size int) error {
+	if s == nil || num <= 0 {
+		return nil
+	}
+	n := uint64(num) * uint64(size)
+	if s.opts.MaxAlloc != 0 && (n > s.opts.MaxAlloc || s.alloc+n > s.opts.MaxAlloc) {
+		return NewDecodeError(ErrMaxAlloc, "", s.opts.MaxAlloc, s.alloc+n)
+	}
+	s.alloc += n
+	return nil
+}
+
+// Enter increases the nesting depth, every successful call must be followed by a call to Leave
+func (s *DecodeState) Enter() error {
+	if s == nil {
+		return nil
+	}
+	if s.opts.MaxDepth != 0 && s.depth >= s.opts.MaxDepth {
+		return NewDecodeError(ErrMaxDepth, "", uint64(s.opts.MaxDepth), uint64(s.depth+1))
+	}
+	s.depth++
+	return nil
+}
+
+// Leave decreases the nesting depth
+func (s *DecodeState) Leave() {
+	if s != nil {
+		s.depth--
+	}
+}
+
+// ExtendUint64 is ExtendUint64 with the new items counted in the allocation budget
+func (s *DecodeState) ExtendUint64(b []uint64, needLen int) ([]uint64, error) {
+	if err := s.Alloc(needLen-cap(b), 8); err != nil {
+		return nil, err
+	}
+	return ExtendUint64(b, needLen), nil
+}
+
+// ExtendUint16 is ExtendUint16 with the new items counted in the allocation budget
+func (s *DecodeState) ExtendUint16(b []uint16, needLen int) ([]uint16, error) {
+	if err := s.Alloc(needLen-cap(b), 2); err != nil {
+		return nil, err
+	}
+	return ExtendUint16(b, needLen), nil
+}
+
+// ExtendUint8 is ExtendUint8 with the new items counted in the allocation budget
+func (s *DecodeState) ExtendUint8(b []uint8, needLen int) ([]uint8, error) {
+	if err := s.Alloc(needLen-cap(b), 1); err != nil {
+		return nil, err
+	}
+	return ExtendUint8(b, needLen), nil
+}
+
+// UnmarshalDynamic is UnmarshalDynamic with the list counted as a nesting level
+func (s *DecodeState) UnmarshalDynamic(src []byte, length int, f func(indx int, b []byte) error) error {
+	if err := s.Enter(); err != nil {
+		return err
+	}
+	defer s.Leave()
+	return UnmarshalDynamic(src, length, f)
+}
+
+// UnmarshalWithOptions unmarshals the object within the limits of opts
+func UnmarshalWithOptions(u Unmarshaler, buf []byte, opts DecodeOptions) error {
+	return UnmarshalWithState(u, buf, NewDecodeState(opts))
+}
+
+// UnmarshalWithState unmarshals the object with UnmarshalSSZWithState if it implements
+// UnmarshalerWithState. Otherwise the object is decoded with UnmarshalSSZ and only
+// counted as a nesting level.
+func UnmarshalWithState(u Unmarshaler, buf []byte, s *DecodeState) error {
+	if uu, ok := u.(UnmarshalerWithState); ok {
+		return uu.UnmarshalSSZWithState(buf, s)
+	}
+	if err := s.Enter(); err != nil {
+		return err
+	}
+	defer s.Leave()
+	return u.UnmarshalSSZ(buf)
+}
@@ -0,0 +1,72 @@
+package ssz
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDecodeStateAlloc(t *testing.T) {
+	// a nil state does not have limits
+	var st *DecodeState
+	if err := st.Alloc(1<<40, 8); err != nil {
+		t.Fatal(err)
+	}
+
+	st = NewDecodeState(DecodeOptions{MaxAlloc: 100})
+	if err := st.Alloc(10, 8); err != nil {
+		t.Fatal(err)
+	}
+	// only the items beyond the capacity of the slice are allocated
+	b, err := st.ExtendUint64(make([]uint64, 0, 5), 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) != 7 || st.Allocated() != 96 {
+		t.Fatalf("bad allocation %d", st.Allocated())
+	}
+
+	err = st.Alloc(1, 8)
+	if !errors.Is(err, ErrMaxAlloc) {
+		t.Fatalf("expected alloc error but found %v", err)
+	}
+	var derr *DecodeError
+	if !errors.As(err, &derr) || derr.Expected != 100 || derr.Actual != 104 {
+		t.Fatalf("bad error %v", err)
+	}
+	if st.Allocated() != 96 {
+		t.Fatal("the failed allocation is counted")
+	}
+}
+
+func TestDecodeStateDepth(t *testing.T) {
+	st := NewDecodeState(DecodeOptions{MaxDepth: 2})
+	for i := 0; i < 2; i++ {
+		if err := st.Enter(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := st.Enter(); !errors.Is(err, ErrMaxDepth) {
+		t.Fatalf("expected depth error but found %v", err)
+	}
+
+	// lists with dynamic items are a nesting level
+	buf := []byte{0x04, 0x00, 0x00, 0x00}
+	err := st.UnmarshalDynamic(buf, 1, func(indx int, b []byte) error {
+		return nil
+	})
+	if !errors.Is(err, ErrMaxDepth) {
+		t.Fatalf("expected depth error but found %v", err)
+	}
+	st.Leave()
+	if err := st.UnmarshalDynamic(buf, 1, func(indx int, b []byte) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDecodeDynamicLengthExceedsSize(t *testing.T) {
+	// the length of the list cannot be larger than what the input can hold
+	buf := []byte{0x00, 0x10, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00}
+	if _, err := DecodeDynamicLength(buf, 1<<20); err != ErrOffsetExceedsSize {
+		t.Fatalf("expected offset error but found %v", err)
+	}
+}
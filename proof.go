@@ -6,36 +6,59 @@ import (
 	"fmt"
 	"math"
 	"sort"
-
-	"github.com/minio/sha256-simd"
 )
 
 // VerifyProof verifies a single merkle branch. It's more
 // efficient than VerifyMultiproof for proving one leaf.
 func VerifyProof(root []byte, proof *Proof) (bool, error) {
+	return VerifyProofWith(root, proof, SHA256)
+}
+
+// VerifyProofWith verifies a single merkle branch of a tree
+// merkleized with the hash function 'fn'.
+func VerifyProofWith(root []byte, proof *Proof, fn HashFunction) (bool, error) {
 	if len(proof.Hashes) != getPathLength(proof.Index) {
 		return false, errors.New("invalid proof length")
 	}
+	if err := checkChunks(fn, append([][]byte{proof.Leaf}, proof.Hashes...)); err != nil {
+		return false, err
+	}
 
 	node := proof.Leaf[:]
-	tmp := make([]byte, 64)
 	for i, h := range proof.Hashes {
 		if getPosAtLevel(proof.Index, i) {
-			copy(tmp[:32], h[:])
-			copy(tmp[32:], node[:])
-			node = hashFn(tmp)
+			node = hashPair(fn, h, node)
 		} else {
-			copy(tmp[:32], node[:])
-			copy(tmp[32:], h[:])
-			node = hashFn(tmp)
+			node = hashPair(fn, node, h)
 		}
 	}
 
 	return bytes.Equal(root, node), nil
 }
 
+// checkChunks rejects the leaves and the hashes of the proofs that are longer than
+// a chunk if the hash function is not SHA-256, which hashes any size like before.
+// The shorter ones are padded with zeros when they are hashed.
+func checkChunks(fn HashFunction, chunks [][]byte) error {
+	if _, ok := fn.(sha256Hash); ok {
+		return nil
+	}
+	for _, c := range chunks {
+		if len(c) > 32 {
+			return fmt.Errorf("node of %d bytes is longer than a chunk", len(c))
+		}
+	}
+	return nil
+}
+
 // VerifyMultiproof verifies a proof for multiple leaves against the given root.
 func VerifyMultiproof(root []byte, proof [][]byte, leaves [][]byte, indices []int) (bool, error) {
+	return VerifyMultiproofWith(root, proof, leaves, indices, SHA256)
+}
+
+// VerifyMultiproofWith verifies a proof for multiple leaves against the
+// given root of a tree merkleized with the hash function 'fn'.
+func VerifyMultiproofWith(root []byte, proof [][]byte, leaves [][]byte, indices []int, fn HashFunction) (bool, error) {
 	if len(leaves) != len(indices) {
 		return false, errors.New("number of leaves and indices mismatch")
 	}
@@ -44,6 +67,12 @@ func VerifyMultiproof(root []byte, proof [][]byte, leaves [][]byte, indices []in
 	if len(reqIndices) != len(proof) {
 		return false, fmt.Errorf("number of proof hashes %d and required indices %d mismatch", len(proof), len(reqIndices))
 	}
+	if err := checkChunks(fn, leaves); err != nil {
+		return false, err
+	}
+	if err := checkChunks(fn, proof); err != nil {
+		return false, err
+	}
 
 	keys := make([]int, len(indices)+len(reqIndices))
 	nk := 0
@@ -63,7 +92,6 @@ func VerifyMultiproof(root []byte, proof [][]byte, leaves [][]byte, indices []in
 	sort.Sort(sort.Reverse(sort.IntSlice(keys)))
 
 	pos := 0
-	tmp := make([]byte, 64)
 	for pos < len(keys) {
 		k := keys[pos]
 		// Root has been reached
@@ -83,9 +111,7 @@ func VerifyMultiproof(root []byte, proof [][]byte, leaves [][]byte, indices []in
 			return false, fmt.Errorf("proof is missing required nodes, either %d or %d", (k|1)^1, k|1)
 		}
 
-		copy(tmp[:32], left[:])
-		copy(tmp[32:], right[:])
-		db[getParent(k)] = hashFn(tmp)
+		db[getParent(k)] = hashPair(fn, left, right)
 		keys = append(keys, getParent(k))
 
 		pos++
@@ -159,8 +185,3 @@ func getRequiredIndices(leafIndices []int) []int {
 	sort.Sort(sort.Reverse(sort.IntSlice(requiredList)))
 	return requiredList
 }
-
-func hashFn(data []byte) []byte {
-	res := sha256.Sum256(data)
-	return res[:]
-}
@@ -0,0 +1,875 @@
+package ssz
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Marshal marshals v using reflection. It uses the same 'ssz-size', 'ssz-max' and
+// 'ssz:"bitlist"' tags as sszgen. Types that implement Marshaler are marshaled with
+// their own methods.
+func Marshal(v interface{}) ([]byte, error) {
+	if m, ok := v.(Marshaler); ok {
+		return m.MarshalSSZ()
+	}
+	rv, p, err := reflectValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return p.marshal(make([]byte, 0, p.sizeOf(rv)), rv)
+}
+
+// Unmarshal unmarshals buf into the value pointed by v using reflection. Types
+// that implement Unmarshaler are unmarshaled with their own methods.
+func Unmarshal(buf []byte, v interface{}) error {
+	if u, ok := v.(Unmarshaler); ok {
+		return u.UnmarshalSSZ(buf)
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("unmarshal target must be a non-nil pointer")
+	}
+	p, err := planOf(rv.Type().Elem())
+	if err != nil {
+		return err
+	}
+	return p.unmarshal(buf, rv.Elem())
+}
+
+// HashTreeRoot hashes v using reflection. Types that implement HashRoot are
+// hashed with their own methods.
+func HashTreeRoot(v interface{}) ([32]byte, error) {
+	if h, ok := v.(HashRoot); ok {
+		return h.HashTreeRoot()
+	}
+	rv, p, err := reflectValue(v)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	hh := DefaultHasherPool.Get()
+	defer DefaultHasherPool.Put(hh)
+
+	if err := p.hashTreeRoot(hh, rv); err != nil {
+		return [32]byte{}, err
+	}
+	return hh.HashRoot()
+}
+
+// CopyObject returns a deep copy of v. If v has a 'Copy() T' method, where T is the
+// type of v, the method is used. Otherwise v is copied field by field with reflection,
+// which preserves nil and empty slices. Unexported fields are copied by value.
+func CopyObject(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	return deepCopy(reflect.ValueOf(v)).Interface()
+}
+
+func deepCopy(v reflect.Value) reflect.Value {
+	typ := v.Type()
+	if m, ok := typ.MethodByName("Copy"); ok && m.Type.NumIn() == 1 && m.Type.NumOut() == 1 && m.Type.Out(0) == typ {
+		return v.Method(m.Index).Call(nil)[0]
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		cpy := reflect.New(typ.Elem())
+		cpy.Elem().Set(deepCopy(v.Elem()))
+		return cpy
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		cpy := reflect.New(typ).Elem()
+		cpy.Set(deepCopy(v.Elem()))
+		return cpy
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		cpy := reflect.MakeSlice(typ, v.Len(), v.Len())
+		if typ.Elem().Kind() == reflect.Uint8 {
+			reflect.Copy(cpy, v)
+			return cpy
+		}
+		for i := 0; i < v.Len(); i++ {
+			cpy.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return cpy
+
+	case reflect.Array:
+		cpy := reflect.New(typ).Elem()
+		cpy.Set(v)
+		if typ.Elem().Kind() != reflect.Uint8 {
+			for i := 0; i < v.Len(); i++ {
+				cpy.Index(i).Set(deepCopy(v.Index(i)))
+			}
+		}
+		return cpy
+
+	case reflect.Struct:
+		cpy := reflect.New(typ).Elem()
+		cpy.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if f := cpy.Field(i); f.CanSet() {
+				f.Set(deepCopy(v.Field(i)))
+			}
+		}
+		return cpy
+
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		cpy := reflect.MakeMapWithSize(typ, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			cpy.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+		return cpy
+
+	default:
+		return v
+	}
+}
+
+// reflectValue returns an addressable value of v and its plan
+func reflectValue(v interface{}) (reflect.Value, *reflectPlan, error) {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return rv, nil, fmt.Errorf("cannot encode a nil value")
+	}
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return rv, nil, fmt.Errorf("cannot encode a nil pointer")
+		}
+		rv = rv.Elem()
+	} else {
+		// copy the value so that it is addressable
+		ptr := reflect.New(rv.Type())
+		ptr.Elem().Set(rv)
+		rv = ptr.Elem()
+	}
+	p, err := planOf(rv.Type())
+	if err != nil {
+		return rv, nil, err
+	}
+	return rv, p, nil
+}
+
+var (
+	marshalerType   = reflect.TypeOf((*Marshaler)(nil)).Elem()
+	unmarshalerType = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
+	hashRootType    = reflect.TypeOf((*HashRoot)(nil)).Elem()
+	fixedSizerType  = reflect.TypeOf((*FixedSizer)(nil)).Elem()
+)
+
+type reflectKind int
+
+const (
+	// reflectUint is a uint8, uint16, uint32 or uint64
+	reflectUint reflectKind = iota
+	// reflectBool is a bool
+	reflectBool
+	// reflectBytes is a byte vector ([N]byte or []byte with ssz-size)
+	reflectBytes
+	// reflectByteList is a byte list ([]byte with ssz-max)
+	reflectByteList
+	// reflectBitlist is a bitlist ([]byte with 'ssz:"bitlist"' and ssz-max)
+	reflectBitlist
+	// reflectVector is a vector of any other type
+	reflectVector
+	// reflectList is a list of any other type
+	reflectList
+	// reflectContainer is a struct
+	reflectContainer
+)
+
+// reflectPlan describes how to encode a Go type in SSZ
+type reflectPlan struct {
+	kind reflectKind
+	typ  reflect.Type
+
+	// fixed is true if the encoding has a fixed size
+	fixed bool
+	// size is the size of fixed types and the size of the
+	// fixed part of variable containers
+	size uint64
+	// length is the size of vectors and the limit of lists
+	length uint64
+
+	elem   *reflectPlan
+	fields []*reflectField
+
+	// the type has its own methods to encode itself
+	marshaler   bool
+	unmarshaler bool
+	hashRoot    bool
+}
+
+type reflectField struct {
+	name  string
+	index int
+	plan  *reflectPlan
+}
+
+// reflectPlans caches the plans of the structs by reflect.Type
+var reflectPlans sync.Map
+
+// planOf returns the plan to encode a type without tags
+func planOf(t reflect.Type) (*reflectPlan, error) {
+	return newPlanBuilder().build(t, nil)
+}
+
+// planBuilder builds the plans of a type and detects recursive types
+type planBuilder struct {
+	inProgress map[reflect.Type]bool
+}
+
+func newPlanBuilder() *planBuilder {
+	return &planBuilder{inProgress: map[reflect.Type]bool{}}
+}
+
+func (b *planBuilder) build(t reflect.Type, dims []*sszDimension) (*reflectPlan, error) {
+	if t.Kind() == reflect.Ptr {
+		if t.Elem().Kind() != reflect.Struct {
+			return nil, fmt.Errorf("type %s not supported", t)
+		}
+		// nil pointers to structs are encoded as the empty struct
+		t = t.Elem()
+	}
+
+	p, err := b.buildPlan(t, dims)
+	if err != nil {
+		ptr := reflect.PtrTo(t)
+		if !ptr.Implements(marshalerType) || !ptr.Implements(unmarshalerType) || !ptr.Implements(hashRootType) {
+			return nil, err
+		}
+		// the type encodes itself but its layout cannot be inferred, only
+		// the type knows if its encoding is fixed and it must declare it
+		if !ptr.Implements(fixedSizerType) {
+			return nil, fmt.Errorf("%v: type %s must implement FixedSizer", err, t)
+		}
+		size, fixed := reflect.New(t).Interface().(FixedSizer).FixedSizeSSZ()
+		if fixed && size <= 0 {
+			return nil, fmt.Errorf("type %s has a fixed size of %d bytes", t, size)
+		}
+		p := &reflectPlan{kind: reflectContainer, typ: t, fixed: fixed, marshaler: true, unmarshaler: true, hashRoot: true}
+		if fixed {
+			p.size = uint64(size)
+		}
+		return p, nil
+	}
+	if t.Kind() != reflect.Struct && t.Name() != "" {
+		// the plans of the structs are shared and they already
+		// know about the methods, the other plans are new
+		p.setMethods(t)
+	}
+	return p, nil
+}
+
+func (p *reflectPlan) setMethods(t reflect.Type) {
+	ptr := reflect.PtrTo(t)
+	p.marshaler = ptr.Implements(marshalerType)
+	p.unmarshaler = ptr.Implements(unmarshalerType)
+	p.hashRoot = ptr.Implements(hashRootType)
+}
+
+func (b *planBuilder) buildPlan(t reflect.Type, dims []*sszDimension) (*reflectPlan, error) {
+	switch t.Kind() {
+	case reflect.Bool:
+		return &reflectPlan{kind: reflectBool, typ: t, fixed: true, size: 1}, nil
+
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &reflectPlan{kind: reflectUint, typ: t, fixed: true, size: uint64(t.Size())}, nil
+
+	case reflect.Array:
+		if len(dims) != 0 {
+			if !dims[0].isVector() || dims[0].size != t.Len() {
+				return nil, fmt.Errorf("ssz-size of array %s does not match its length", t)
+			}
+			dims = dims[1:]
+		}
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &reflectPlan{kind: reflectBytes, typ: t, fixed: true, size: uint64(t.Len()), length: uint64(t.Len())}, nil
+		}
+		return b.buildCollection(t, reflectVector, uint64(t.Len()), dims)
+
+	case reflect.Slice:
+		if len(dims) == 0 {
+			return nil, fmt.Errorf("slice %s does not have ssz-size or ssz-max tags", t)
+		}
+		dim, dims := dims[0], dims[1:]
+		if t.Elem().Kind() == reflect.Uint8 && len(dims) == 0 {
+			switch {
+			case dim.isVector():
+				return &reflectPlan{kind: reflectBytes, typ: t, fixed: true, size: uint64(dim.size), length: uint64(dim.size)}, nil
+			case dim.bitlist:
+				return &reflectPlan{kind: reflectBitlist, typ: t, length: uint64(dim.max)}, nil
+			default:
+				return &reflectPlan{kind: reflectByteList, typ: t, length: uint64(dim.max)}, nil
+			}
+		}
+		if dim.isVector() {
+			return b.buildCollection(t, reflectVector, uint64(dim.size), dims)
+		}
+		return b.buildCollection(t, reflectList, uint64(dim.max), dims)
+
+	case reflect.Struct:
+		if p, ok := reflectPlans.Load(t); ok {
+			return p.(*reflectPlan), nil
+		}
+		if b.inProgress[t] {
+			return nil, fmt.Errorf("recursive type %s not supported", t)
+		}
+		b.inProgress[t] = true
+		p, err := b.buildContainer(t)
+		delete(b.inProgress, t)
+		if err != nil {
+			return nil, err
+		}
+		actual, _ := reflectPlans.LoadOrStore(t, p)
+		return actual.(*reflectPlan), nil
+
+	default:
+		return nil, fmt.Errorf("type %s not supported", t)
+	}
+}
+
+func (b *planBuilder) buildCollection(t reflect.Type, kind reflectKind, length uint64, dims []*sszDimension) (*reflectPlan, error) {
+	elem, err := b.build(t.Elem(), dims)
+	if err != nil {
+		return nil, err
+	}
+	p := &reflectPlan{kind: kind, typ: t, length: length, elem: elem}
+	if kind == reflectVector && elem.fixed {
+		p.fixed = true
+		p.size = length * elem.size
+	}
+	return p, nil
+}
+
+func (b *planBuilder) buildContainer(t reflect.Type) (*reflectPlan, error) {
+	p := &reflectPlan{kind: reflectContainer, typ: t, fixed: true}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, hasTag := f.Tag.Lookup("ssz")
+		if tag == "-" {
+			continue
+		}
+		if f.PkgPath != "" {
+			if f.Name == "_" && f.Tag != "" {
+				// struct level tags like ssz-stable-container
+				return nil, fmt.Errorf("struct %s with tags '%s' not supported", t, f.Tag)
+			}
+			// unexported field
+			continue
+		}
+		if hasTag && !isBitlistTag(tag) {
+			return nil, fmt.Errorf("field %s.%s with tag 'ssz:\"%s\"' not supported", t, f.Name, tag)
+		}
+		if _, ok := f.Tag.Lookup("ssz-union"); ok {
+			return nil, fmt.Errorf("field %s.%s with union tag not supported", t, f.Name)
+		}
+
+		dims, err := parseSSZDimensions(f.Tag)
+		if err != nil {
+			return nil, fmt.Errorf("field %s.%s: %v", t, f.Name, err)
+		}
+		fp, err := b.build(f.Type, dims)
+		if err != nil {
+			return nil, fmt.Errorf("field %s.%s: %v", t, f.Name, err)
+		}
+		if fp.fixed {
+			p.size += fp.size
+		} else {
+			p.fixed = false
+			p.size += bytesPerLengthOffset
+		}
+		p.fields = append(p.fields, &reflectField{name: f.Name, index: i, plan: fp})
+	}
+	if len(p.fields) == 0 {
+		return nil, fmt.Errorf("struct %s does not have fields", t)
+	}
+	p.setMethods(t)
+	return p, nil
+}
+
+// sszDimension is a dimension of the 'ssz-size' and 'ssz-max' tags
+type sszDimension struct {
+	size    int
+	max     int
+	bitlist bool
+}
+
+func (d *sszDimension) isVector() bool {
+	return d.size != -1
+}
+
+func isBitlistTag(tag string) bool {
+	for _, p := range strings.Split(tag, ",") {
+		if p == "bitlist" {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSSZDimensions parses the 'ssz-size' and 'ssz-max' tags. Each comma separated
+// value is a dimension of a nested array, '?' means that the other tag applies.
+func parseSSZDimensions(tag reflect.StructTag) ([]*sszDimension, error) {
+	sszSizes, sizeDefined := tag.Lookup("ssz-size")
+	sszMax, maxDefined := tag.Lookup("ssz-max")
+	if !sizeDefined && !maxDefined {
+		return nil, nil
+	}
+
+	sizeSplit := strings.Split(sszSizes, ",")
+	maxSplit := strings.Split(sszMax, ",")
+	ndims := len(sizeSplit)
+	if len(maxSplit) > ndims {
+		ndims = len(maxSplit)
+	}
+	dims := make([]*sszDimension, ndims)
+	for i := 0; i < ndims; i++ {
+		var szi, mxi string
+		if len(sizeSplit) > i {
+			szi = sizeSplit[i]
+		}
+		if len(maxSplit) > i {
+			mxi = maxSplit[i]
+		}
+		dim := &sszDimension{size: -1, max: -1}
+		if szi != "" && szi != "?" {
+			s, err := strconv.Atoi(szi)
+			if err != nil {
+				return nil, fmt.Errorf("invalid ssz-size '%s' at dimension %d", szi, i)
+			}
+			dim.size = s
+		} else {
+			if mxi == "" || mxi == "?" {
+				return nil, fmt.Errorf("no ssz-size or ssz-max at dimension %d", i)
+			}
+			m, err := strconv.Atoi(mxi)
+			if err != nil {
+				return nil, fmt.Errorf("invalid ssz-max '%s' at dimension %d", mxi, i)
+			}
+			dim.max = m
+		}
+		// bitlist can only be the inner-most element by definition
+		if i == ndims-1 {
+			dim.bitlist = isBitlistTag(tag.Get("ssz"))
+		}
+		dims[i] = dim
+	}
+	return dims, nil
+}
+
+// indirect returns the value pointed by v. Nil pointers are allocated
+// if alloc is set, otherwise they are returned as an empty value.
+func indirect(v reflect.Value, alloc bool) reflect.Value {
+	if v.Kind() != reflect.Ptr {
+		return v
+	}
+	if v.IsNil() {
+		if !alloc {
+			return reflect.New(v.Type().Elem()).Elem()
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+	}
+	return v.Elem()
+}
+
+// addr returns a pointer to v to call the methods of the type
+func addr(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v.Addr()
+	}
+	ptr := reflect.New(v.Type())
+	ptr.Elem().Set(v)
+	return ptr
+}
+
+// bytesOf returns the content of a byte slice or array
+func bytesOf(v reflect.Value) []byte {
+	if v.Kind() == reflect.Slice {
+		return v.Bytes()
+	}
+	if !v.CanAddr() {
+		v = addr(v).Elem()
+	}
+	return v.Slice(0, v.Len()).Bytes()
+}
+
+// setBytes copies buf into a byte slice reusing its capacity
+func setBytes(v reflect.Value, buf []byte) {
+	b := v.Bytes()
+	if cap(b) == 0 {
+		b = make([]byte, 0, len(buf))
+	}
+	v.SetBytes(append(b[:0], buf...))
+}
+
+func (p *reflectPlan) sizeOf(v reflect.Value) int {
+	v = indirect(v, false)
+	if p.marshaler {
+		return addr(v).Interface().(Marshaler).SizeSSZ()
+	}
+	if p.fixed {
+		return int(p.size)
+	}
+
+	switch p.kind {
+	case reflectByteList, reflectBitlist:
+		return v.Len()
+
+	case reflectVector, reflectList:
+		if p.elem.fixed {
+			return v.Len() * int(p.elem.size)
+		}
+		size := 0
+		for i := 0; i < v.Len(); i++ {
+			size += bytesPerLengthOffset + p.elem.sizeOf(v.Index(i))
+		}
+		return size
+
+	case reflectContainer:
+		size := int(p.size)
+		for _, f := range p.fields {
+			if !f.plan.fixed {
+				size += f.plan.sizeOf(v.Field(f.index))
+			}
+		}
+		return size
+
+	default:
+		panic(fmt.Errorf("size not implemented for type %s", p.typ))
+	}
+}
+
+func (p *reflectPlan) marshal(dst []byte, v reflect.Value) ([]byte, error) {
+	v = indirect(v, false)
+	if p.marshaler {
+		return addr(v).Interface().(Marshaler).MarshalSSZTo(dst)
+	}
+
+	switch p.kind {
+	case reflectUint:
+		return marshalUint(dst, v.Uint(), p.size), nil
+
+	case reflectBool:
+		return MarshalBool(dst, v.Bool()), nil
+
+	case reflectBytes:
+		b := bytesOf(v)
+		if len(b) != int(p.length) {
+			return nil, ErrBytesLengthFn(p.typ.String(), len(b), int(p.length))
+		}
+		return append(dst, b...), nil
+
+	case reflectByteList:
+		b := v.Bytes()
+		if len(b) > int(p.length) {
+			return nil, ErrBytesLengthFn(p.typ.String(), len(b), int(p.length))
+		}
+		return append(dst, b...), nil
+
+	case reflectBitlist:
+		b := v.Bytes()
+		if err := ValidateBitlist(b, p.length); err != nil {
+			return nil, err
+		}
+		return append(dst, b...), nil
+
+	case reflectVector, reflectList:
+		num := v.Len()
+		if p.kind == reflectVector && num != int(p.length) {
+			return nil, ErrVectorLengthFn(p.typ.String(), num, int(p.length))
+		}
+		if p.kind == reflectList && num > int(p.length) {
+			return nil, ErrListTooBigFn(p.typ.String(), num, int(p.length))
+		}
+		var err error
+		if !p.elem.fixed {
+			offset := bytesPerLengthOffset * num
+			for i := 0; i < num; i++ {
+				dst = WriteOffset(dst, offset)
+				offset += p.elem.sizeOf(v.Index(i))
+			}
+		}
+		for i := 0; i < num; i++ {
+			if dst, err = p.elem.marshal(dst, v.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+		return dst, nil
+
+	case reflectContainer:
+		var err error
+		offset := int(p.size)
+		for _, f := range p.fields {
+			fv := v.Field(f.index)
+			if f.plan.fixed {
+				if dst, err = f.plan.marshal(dst, fv); err != nil {
+					return nil, err
+				}
+			} else {
+				dst = WriteOffset(dst, offset)
+				offset += f.plan.sizeOf(fv)
+			}
+		}
+		for _, f := range p.fields {
+			if !f.plan.fixed {
+				if dst, err = f.plan.marshal(dst, v.Field(f.index)); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return dst, nil
+
+	default:
+		panic(fmt.Errorf("marshal not implemented for type %s", p.typ))
+	}
+}
+
+func marshalUint(dst []byte, i uint64, size uint64) []byte {
+	switch size {
+	case 1:
+		return MarshalUint8(dst, uint8(i))
+	case 2:
+		return MarshalUint16(dst, uint16(i))
+	case 4:
+		return MarshalUint32(dst, uint32(i))
+	default:
+		return MarshalUint64(dst, i)
+	}
+}
+
+func unmarshalUint(src []byte) uint64 {
+	switch len(src) {
+	case 1:
+		return uint64(UnmarshallUint8(src))
+	case 2:
+		return uint64(UnmarshallUint16(src))
+	case 4:
+		return uint64(UnmarshallUint32(src))
+	default:
+		return UnmarshallUint64(src)
+	}
+}
+
+func (p *reflectPlan) unmarshal(buf []byte, v reflect.Value) error {
+	v = indirect(v, true)
+	if p.unmarshaler {
+		return v.Addr().Interface().(Unmarshaler).UnmarshalSSZ(buf)
+	}
+	if p.fixed && uint64(len(buf)) != p.size {
+		if p.kind == reflectBytes {
+			return ErrBytesLength
+		}
+		return ErrSize
+	}
+
+	switch p.kind {
+	case reflectUint:
+		v.SetUint(unmarshalUint(buf))
+
+	case reflectBool:
+		b, err := DecodeBool(buf)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+
+	case reflectBytes:
+		if v.Kind() == reflect.Array {
+			reflect.Copy(v, reflect.ValueOf(buf))
+		} else {
+			setBytes(v, buf)
+		}
+
+	case reflectByteList:
+		if len(buf) > int(p.length) {
+			return ErrBytesLength
+		}
+		setBytes(v, buf)
+
+	case reflectBitlist:
+		if err := ValidateBitlist(buf, p.length); err != nil {
+			return err
+		}
+		setBytes(v, buf)
+
+	case reflectVector, reflectList:
+		var num int
+		var err error
+		if p.elem.fixed {
+			if p.kind == reflectVector {
+				num = int(p.length)
+			} else if num, err = DivideInt2(len(buf), int(p.elem.size), int(p.length)); err != nil {
+				return err
+			}
+		} else {
+			if num, err = DecodeDynamicLength(buf, int(p.length)); err != nil {
+				return err
+			}
+			if p.kind == reflectVector && num != int(p.length) {
+				return ErrVectorLength
+			}
+		}
+		if v.Kind() == reflect.Slice {
+			v.Set(reflect.MakeSlice(v.Type(), num, num))
+		}
+		if p.elem.fixed {
+			size := int(p.elem.size)
+			for i := 0; i < num; i++ {
+				if err := p.elem.unmarshal(buf[i*size:(i+1)*size], v.Index(i)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		return UnmarshalDynamic(buf, num, func(indx int, buf []byte) error {
+			return p.elem.unmarshal(buf, v.Index(indx))
+		})
+
+	case reflectContainer:
+		size := uint64(len(buf))
+		if size < p.size {
+			return ErrSize
+		}
+
+		// read the fixed fields and the offsets of the variable ones
+		var pos uint64
+		offsets := []uint64{}
+		for _, f := range p.fields {
+			if f.plan.fixed {
+				if err := f.plan.unmarshal(buf[pos:pos+f.plan.size], v.Field(f.index)); err != nil {
+					return err
+				}
+				pos += f.plan.size
+				continue
+			}
+			offset := ReadOffset(buf[pos : pos+bytesPerLengthOffset])
+			if len(offsets) == 0 && offset != p.size {
+				return ErrInvalidVariableOffset
+			}
+			if offset > size || (len(offsets) != 0 && offset < offsets[len(offsets)-1]) {
+				return ErrOffset
+			}
+			offsets = append(offsets, offset)
+			pos += bytesPerLengthOffset
+		}
+
+		// decode the variable fields
+		offsets = append(offsets, size)
+		indx := 0
+		for _, f := range p.fields {
+			if f.plan.fixed {
+				continue
+			}
+			if err := f.plan.unmarshal(buf[offsets[indx]:offsets[indx+1]], v.Field(f.index)); err != nil {
+				return err
+			}
+			indx++
+		}
+
+	default:
+		panic(fmt.Errorf("unmarshal not implemented for type %s", p.typ))
+	}
+	return nil
+}
+
+func (p *reflectPlan) hashTreeRoot(hh *Hasher, v reflect.Value) error {
+	v = indirect(v, false)
+	if p.hashRoot {
+		return addr(v).Interface().(HashRoot).HashTreeRootWith(hh)
+	}
+
+	switch p.kind {
+	case reflectUint:
+		hh.buf = marshalUint(hh.buf, v.Uint(), p.size)
+		hh.FillUpTo32()
+
+	case reflectBool:
+		hh.PutBool(v.Bool())
+
+	case reflectBytes:
+		b := bytesOf(v)
+		if len(b) != int(p.length) {
+			return ErrBytesLength
+		}
+		hh.PutBytes(b)
+
+	case reflectByteList:
+		b := v.Bytes()
+		if len(b) > int(p.length) {
+			return ErrIncorrectListSize
+		}
+		indx := hh.Index()
+		hh.AppendBytes32(b)
+		hh.MerkleizeWithMixin(indx, uint64(len(b)), (p.length+31)/32)
+
+	case reflectBitlist:
+		hh.PutBitlist(v.Bytes(), p.length)
+
+	case reflectVector, reflectList:
+		num := v.Len()
+		if p.kind == reflectVector && num != int(p.length) {
+			return ErrVectorLength
+		}
+		if p.kind == reflectList && num > int(p.length) {
+			return ErrIncorrectListSize
+		}
+
+		indx := hh.Index()
+		basic := p.elem.kind == reflectUint || p.elem.kind == reflectBool
+		for i := 0; i < num; i++ {
+			elem := v.Index(i)
+			if basic {
+				// basic types are packed
+				if p.elem.kind == reflectBool {
+					hh.buf = MarshalBool(hh.buf, elem.Bool())
+				} else {
+					hh.buf = marshalUint(hh.buf, elem.Uint(), p.elem.size)
+				}
+				continue
+			}
+			if err := p.elem.hashTreeRoot(hh, elem); err != nil {
+				return err
+			}
+		}
+		if basic {
+			hh.FillUpTo32()
+		}
+
+		if p.kind == reflectVector {
+			hh.Merkleize(indx)
+		} else if basic {
+			hh.MerkleizeWithMixin(indx, uint64(num), CalculateLimit(p.length, uint64(num), p.elem.size))
+		} else {
+			hh.MerkleizeWithMixin(indx, uint64(num), p.length)
+		}
+
+	case reflectContainer:
+		indx := hh.Index()
+		for _, f := range p.fields {
+			if err := f.plan.hashTreeRoot(hh, v.Field(f.index)); err != nil {
+				return err
+			}
+		}
+		hh.Merkleize(indx)
+
+	default:
+		panic(fmt.Errorf("hash not implemented for type %s", p.typ))
+	}
+	return nil
+}
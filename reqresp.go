@@ -0,0 +1,185 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+
+	"github.com/golang/snappy"
+)
+
+// ErrChunkLength is returned when the length prefix of a req/resp chunk is out of bounds
+var ErrChunkLength = errors.New("chunk length out of bounds")
+
+var (
+	snappyWriterPool = sync.Pool{
+		New: func() interface{} {
+			return snappy.NewWriter(nil)
+		},
+	}
+	snappyReaderPool = sync.Pool{
+		New: func() interface{} {
+			return snappy.NewReader(nil)
+		},
+	}
+)
+
+// WriteSSZSnappyFramed writes the object as a chunk of the req/resp protocol: the
+// uvarint encoded length of its SSZ encoding followed by the encoding compressed with
+// the snappy framing format.
+func WriteSSZSnappyFramed(w io.Writer, m Marshaler) error {
+	buf := getSnappyBuffer(m.SizeSSZ())
+	defer snappyPool.Put(buf)
+
+	dst, err := m.MarshalSSZTo((*buf)[:0])
+	if err != nil {
+		return err
+	}
+	// keep the buffer if the object grew it
+	*buf = dst
+
+	var prefix [binary.MaxVarintLen64]byte
+	if _, err := w.Write(prefix[:binary.PutUvarint(prefix[:], uint64(len(dst)))]); err != nil {
+		return err
+	}
+	if len(dst) == 0 {
+		return nil
+	}
+
+	sw := snappyWriterPool.Get().(*snappy.Writer)
+	defer snappyWriterPool.Put(sw)
+
+	sw.Reset(w)
+	_, err = sw.Write(dst)
+	sw.Reset(nil)
+	return err
+}
+
+// ReadSSZSnappyFramed reads a chunk of the req/resp protocol into the object. The length
+// prefix is checked before the payload is read: it cannot be smaller than the size of
+// the empty object (the SizeSSZ of a new object of the same type if it implements
+// Marshaler) nor larger than 'maxLen', and it must be the size of the encoding if the
+// type has a fixed size. The compressed payload is read up to the worst case size of
+// the snappy framing for that length and it cannot hold more data than the prefix.
+// The uncompressed encoding is reused by later calls so the object must not retain it.
+func ReadSSZSnappyFramed(r io.Reader, u Unmarshaler, maxLen uint64) error {
+	size, err := binary.ReadUvarint(byteReader{r})
+	if err != nil {
+		return err
+	}
+	bounds := chunkBoundsOf(u)
+	if size < bounds.min {
+		return NewDecodeError(ErrChunkLength, "", bounds.min, size)
+	}
+	if bounds.fixed && size != bounds.min {
+		return NewDecodeError(ErrChunkLength, "", bounds.min, size)
+	}
+	if size > maxLen {
+		return NewDecodeError(ErrChunkLength, "", maxLen, size)
+	}
+
+	dst := getSnappyBuffer(int(size))
+	defer snappyPool.Put(dst)
+
+	if size != 0 {
+		sr := snappyReaderPool.Get().(*snappy.Reader)
+		defer snappyReaderPool.Put(sr)
+
+		lr := &io.LimitedReader{R: r, N: int64(maxCompressedLen(size))}
+		sr.Reset(lr)
+		err = readSnappyChunk(sr, *dst, lr)
+		sr.Reset(nil)
+		if err != nil {
+			return err
+		}
+	}
+	return u.UnmarshalSSZ(*dst)
+}
+
+// readSnappyChunk fills dst with the uncompressed payload and checks that the last
+// frame does not hold more data. The frames after the payload belong to the next
+// chunk, so the limited reader is drained before the check to not read them.
+func readSnappyChunk(sr *snappy.Reader, dst []byte, lr *io.LimitedReader) error {
+	limit := lr.N
+	if _, err := io.ReadFull(sr, dst); err != nil {
+		if lr.N == 0 {
+			// the payload did not fit in the compressed size limit
+			return fmt.Errorf("%w: compressed payload larger than %d bytes", ErrChunkLength, limit)
+		}
+		return err
+	}
+	lr.N = 0
+
+	var aux [1]byte
+	if n, _ := sr.Read(aux[:]); n != 0 {
+		return fmt.Errorf("%w: payload larger than %d bytes", ErrChunkLength, len(dst))
+	}
+	return nil
+}
+
+// maxCompressedLen returns the worst case size of 'n' bytes in the snappy framing
+// format: the stream identifier and one chunk for every block of 64KiB with the
+// header, the checksum and the worst case size of the block compressed.
+func maxCompressedLen(n uint64) uint64 {
+	const (
+		blockSize   = 65536
+		streamIDLen = 10
+		chunkHeader = 8
+	)
+	blocks := (n + blockSize - 1) / blockSize
+	return streamIDLen + blocks*(chunkHeader+32) + n + n/6
+}
+
+// chunkBounds are the bounds of the length prefix of the chunks of a type
+type chunkBounds struct {
+	// min is the size of the encoding of an empty object
+	min uint64
+	// fixed is true if every encoding has 'min' bytes
+	fixed bool
+}
+
+// chunkBoundsCache caches the chunkBounds by reflect.Type
+var chunkBoundsCache sync.Map
+
+// chunkBoundsOf returns the bounds of the chunks of the type of u. The encoding has a
+// fixed size if the type says so with FixedSizer or if the reflection codec finds it.
+func chunkBoundsOf(u Unmarshaler) chunkBounds {
+	typ := reflect.TypeOf(u)
+	if b, ok := chunkBoundsCache.Load(typ); ok {
+		return b.(chunkBounds)
+	}
+
+	var b chunkBounds
+	if _, ok := u.(Marshaler); ok && typ.Kind() == reflect.Ptr {
+		empty := reflect.New(typ.Elem()).Interface()
+		b.min = uint64(empty.(Marshaler).SizeSSZ())
+
+		if fs, ok := empty.(FixedSizer); ok {
+			_, b.fixed = fs.FixedSizeSSZ()
+		} else if p, err := planOf(typ.Elem()); err == nil {
+			b.fixed = p.fixed
+		}
+	}
+	chunkBoundsCache.Store(typ, b)
+	return b
+}
+
+// byteReader reads the bytes of the length prefix one at a time
+// so that the payload that follows is not consumed.
+type byteReader struct {
+	r io.Reader
+}
+
+func (b byteReader) ReadByte() (byte, error) {
+	if br, ok := b.r.(io.ByteReader); ok {
+		return br.ReadByte()
+	}
+	var buf [1]byte
+	if _, err := io.ReadFull(b.r, buf[:]); err != nil {
+		return 0, err
+	}
+	return buf[0], nil
+}
@@ -0,0 +1,184 @@
+package ssz
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// testBytes is a list of bytes with the encoding of the bytes
+type testBytes []byte
+
+func (t *testBytes) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, *t...), nil
+}
+
+func (t *testBytes) MarshalSSZ() ([]byte, error) {
+	return t.MarshalSSZTo(nil)
+}
+
+func (t *testBytes) SizeSSZ() int {
+	return len(*t)
+}
+
+func (t *testBytes) UnmarshalSSZ(buf []byte) error {
+	*t = append((*t)[:0], buf...)
+	return nil
+}
+
+// testUint64 is an uint64 with a fixed size encoding
+type testUint64 uint64
+
+func (t *testUint64) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return MarshalUint64(dst, uint64(*t)), nil
+}
+
+func (t *testUint64) MarshalSSZ() ([]byte, error) {
+	return t.MarshalSSZTo(nil)
+}
+
+func (t *testUint64) SizeSSZ() int {
+	return 8
+}
+
+func (t *testUint64) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != 8 {
+		return ErrSize
+	}
+	*t = testUint64(UnmarshallUint64(buf))
+	return nil
+}
+
+func TestSSZSnappyFramedVectors(t *testing.T) {
+	cases := []struct {
+		payload, encoded string
+	}{
+		{
+			"",
+			"00",
+		},
+		{
+			// uncompressed chunk
+			"68656c6c6f",
+			"05ff060000734e6150705901090000bb1f1c1968656c6c6f",
+		},
+		{
+			// compressed chunk with 65 zero bytes
+			"0000000000000000000000000000000000000000000000000000000000000000" +
+				"0000000000000000000000000000000000000000000000000000000000000000" +
+				"00",
+			"41ff060000734e61507059000a0000bcb9ea7a410000fe0100",
+		},
+	}
+
+	for _, c := range cases {
+		payload, _ := hex.DecodeString(c.payload)
+		obj := testBytes(payload)
+
+		var buf bytes.Buffer
+		if err := WriteSSZSnappyFramed(&buf, &obj); err != nil {
+			t.Fatal(err)
+		}
+		if encoded := hex.EncodeToString(buf.Bytes()); encoded != c.encoded {
+			t.Fatalf("expected %s but found %s", c.encoded, encoded)
+		}
+
+		obj2 := testBytes{}
+		if err := ReadSSZSnappyFramed(&buf, &obj2, 100); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(obj, obj2) {
+			t.Fatal("bad decoding")
+		}
+		if buf.Len() != 0 {
+			t.Fatal("the chunk is not read in full")
+		}
+	}
+}
+
+func TestSSZSnappyFramedStream(t *testing.T) {
+	// consecutive chunks are read one at a time
+	var buf bytes.Buffer
+	for i := 0; i < 3; i++ {
+		obj := testBytes(bytes.Repeat([]byte{byte(i)}, 70000*i))
+		if err := WriteSSZSnappyFramed(&buf, &obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		obj := testBytes{}
+		if err := ReadSSZSnappyFramed(&buf, &obj, 1<<20); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(obj, bytes.Repeat([]byte{byte(i)}, 70000*i)) {
+			t.Fatalf("bad chunk %d", i)
+		}
+	}
+}
+
+func TestSSZSnappyFramedBounds(t *testing.T) {
+	cases := []struct {
+		prefix string
+		max    uint64
+	}{
+		// the payload of an uint64 is at least 8 bytes
+		{"07", 8},
+		{"09", 8},
+		// the payload of an uint64 is exactly 8 bytes
+		{"09", 16},
+		// the limit is checked before the payload
+		{"80808001", 1 << 20},
+	}
+	for _, c := range cases {
+		prefix, _ := hex.DecodeString(c.prefix)
+		var obj testUint64
+		err := ReadSSZSnappyFramed(bytes.NewReader(prefix), &obj, c.max)
+		if !errors.Is(err, ErrChunkLength) {
+			t.Fatalf("expected chunk length error but found %v", err)
+		}
+	}
+
+	obj := testUint64(10)
+	var buf bytes.Buffer
+	if err := WriteSSZSnappyFramed(&buf, &obj); err != nil {
+		t.Fatal(err)
+	}
+	var obj2 testUint64
+	if err := ReadSSZSnappyFramed(&buf, &obj2, 8); err != nil {
+		t.Fatal(err)
+	}
+	if obj2 != 10 {
+		t.Fatal("bad decoding")
+	}
+}
+
+func TestSSZSnappyFramedPayload(t *testing.T) {
+	cases := []struct {
+		chunk string
+		err   string
+	}{
+		// the chunk of 'hello' with a length prefix of 4 bytes
+		{
+			"04ff060000734e6150705901090000bb1f1c1968656c6c6f",
+			"payload larger than 4 bytes",
+		},
+		// a padding chunk larger than the worst case compressed size
+		{
+			"05ff060000734e61507059fe000100" + strings.Repeat("00", 256),
+			"compressed payload larger than 55 bytes",
+		},
+	}
+	for _, c := range cases {
+		buf, _ := hex.DecodeString(c.chunk)
+		obj := testBytes{}
+		err := ReadSSZSnappyFramed(bytes.NewReader(buf), &obj, 100)
+		if !errors.Is(err, ErrChunkLength) {
+			t.Fatalf("expected chunk length error but found %v", err)
+		}
+		if expected := ErrChunkLength.Error() + ": " + c.err; err.Error() != expected {
+			t.Fatalf("expected '%s' but found '%s'", expected, err.Error())
+		}
+	}
+}
@@ -0,0 +1,11 @@
+#!/bin/bash
+
+DIR_NAME=eip-4881-tests
+DOWNLOAD_URL=https://raw.githubusercontent.com/ethereum/EIPs/master/assets/eip-4881/test_cases.yaml
+
+# Remove dir if it already exists
+rm -rf $DIR_NAME
+mkdir $DIR_NAME
+
+echo $DOWNLOAD_URL
+wget $DOWNLOAD_URL -O $DIR_NAME/test_cases.yaml
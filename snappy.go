@@ -0,0 +1,69 @@
+package ssz
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/golang/snappy"
+)
+
+// ErrSnappyLength is returned when the uncompressed size of a ssz_snappy encoding exceeds the limit
+var ErrSnappyLength = errors.New("uncompressed size exceeds the limit")
+
+// snappyPool holds the buffers with the uncompressed SSZ encodings
+var snappyPool = sync.Pool{
+	New: func() interface{} {
+		return new([]byte)
+	},
+}
+
+func getSnappyBuffer(size int) *[]byte {
+	buf := snappyPool.Get().(*[]byte)
+	if cap(*buf) < size {
+		*buf = make([]byte, size)
+	}
+	*buf = (*buf)[:size]
+	return buf
+}
+
+// MarshalSSZSnappy returns the ssz_snappy encoding of the object, the snappy
+// block compression of its SSZ encoding as used by the gossip protocol.
+func MarshalSSZSnappy(m Marshaler) ([]byte, error) {
+	buf := getSnappyBuffer(m.SizeSSZ())
+	defer snappyPool.Put(buf)
+
+	dst, err := m.MarshalSSZTo((*buf)[:0])
+	if err != nil {
+		return nil, err
+	}
+	// keep the buffer if the object grew it
+	*buf = dst
+	return snappy.Encode(nil, dst), nil
+}
+
+// DecodedLenSSZSnappy returns the uncompressed size of the ssz_snappy encoding
+// in buf without decompressing it.
+func DecodedLenSSZSnappy(buf []byte) (int, error) {
+	return snappy.DecodedLen(buf)
+}
+
+// UnmarshalSSZSnappy unmarshals the ssz_snappy encoding in buf into the object. The
+// uncompressed size is checked against 'maxLen' before decompressing the input. The
+// uncompressed encoding is reused by later calls so the object must not retain it.
+func UnmarshalSSZSnappy(buf []byte, u Unmarshaler, maxLen int) error {
+	size, err := snappy.DecodedLen(buf)
+	if err != nil {
+		return err
+	}
+	if size > maxLen {
+		return NewDecodeError(ErrSnappyLength, "", uint64(maxLen), uint64(size))
+	}
+	dst := getSnappyBuffer(size)
+	defer snappyPool.Put(dst)
+
+	enc, err := snappy.Decode(*dst, buf)
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalSSZ(enc)
+}
@@ -3,6 +3,12 @@
 package spectests
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"unsafe"
+
 	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/fastssz/spectests/external"
 	"github.com/prysmaticlabs/fastssz/spectests/external2"
@@ -41,12 +47,53 @@ func (a *AggregateAndProof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the AggregateAndProof object to a writer
+func (a *AggregateAndProof) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, a)
+}
+
+// MarshalSSZToEncoder ssz marshals the AggregateAndProof object to an encoder
+func (a *AggregateAndProof) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(108)
+
+	// Field (0) 'Index'
+	enc.EncodeUint64(a.Index)
+
+	// Offset (1) 'Aggregate'
+	enc.EncodeOffset(offset)
+	if a.Aggregate == nil {
+		a.Aggregate = new(Attestation)
+	}
+	offset += a.Aggregate.SizeSSZ()
+
+	// Field (2) 'SelectionProof'
+	if err = enc.EncodeObject(&a.SelectionProof); err != nil {
+		return
+	}
+
+	// Field (1) 'Aggregate'
+	if err = enc.EncodeObject(a.Aggregate); err != nil {
+		return
+	}
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the AggregateAndProof object
 func (a *AggregateAndProof) UnmarshalSSZ(buf []byte) error {
+	return a.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the AggregateAndProof object within the limits of the decode state
+func (a *AggregateAndProof) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
 	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "AggregateAndProof", "", 0)
+	}
+	defer st.Leave()
 	size := uint64(len(buf))
 	if size < 108 {
-		return ssz.ErrSize
+		return ssz.NewDecodeError(ssz.ErrSize, "AggregateAndProof", 108, size)
 	}
 
 	tail := buf
@@ -57,16 +104,16 @@ func (a *AggregateAndProof) UnmarshalSSZ(buf []byte) error {
 
 	// Offset (1) 'Aggregate'
 	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
-		return ssz.ErrOffset
+		return ssz.WrapDecodeError(ssz.ErrOffset, "AggregateAndProof", "Aggregate", 8)
 	}
 
-	if o1 < 108 {
-		return ssz.ErrInvalidVariableOffset
+	if o1 != 108 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 108, o1), "AggregateAndProof", "Aggregate", 8)
 	}
 
 	// Field (2) 'SelectionProof'
-	if err = a.SelectionProof.UnmarshalSSZ(buf[12:108]); err != nil {
-		return err
+	if err = ssz.UnmarshalWithState(&a.SelectionProof, buf[12:108], st); err != nil {
+		return ssz.WrapDecodeError(err, "AggregateAndProof", "SelectionProof", 12)
 	}
 
 	// Field (1) 'Aggregate'
@@ -75,13 +122,100 @@ func (a *AggregateAndProof) UnmarshalSSZ(buf []byte) error {
 		if a.Aggregate == nil {
 			a.Aggregate = new(Attestation)
 		}
-		if err = a.Aggregate.UnmarshalSSZ(buf); err != nil {
-			return err
+		if err = ssz.UnmarshalWithState(a.Aggregate, buf, st); err != nil {
+			return ssz.WrapDecodeError(err, "AggregateAndProof", "Aggregate", o1)
+		}
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a AggregateAndProof object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (a *AggregateAndProof) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 108 {
+		return ssz.NewDecodeError(ssz.ErrSize, "AggregateAndProof", 108, size)
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Offset (1) 'Aggregate'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "AggregateAndProof", "Aggregate", 8)
+	}
+
+	if o1 != 108 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 108, o1), "AggregateAndProof", "Aggregate", 8)
+	}
+
+	// Field (2) 'SelectionProof'
+	if err = ssz.ValidateObject((*external.Signature)(nil), buf[12:108]); err != nil {
+		return ssz.WrapDecodeError(err, "AggregateAndProof", "SelectionProof", 12)
+	}
+
+	// Field (1) 'Aggregate'
+	{
+		buf = tail[o1:]
+		if err = (*Attestation)(nil).ValidateSSZ(buf); err != nil {
+			return ssz.WrapDecodeError(err, "AggregateAndProof", "Aggregate", o1)
 		}
 	}
 	return err
 }
 
+// UnmarshalSSZFromReader ssz unmarshals the AggregateAndProof object from a reader with an encoded size of 'size' bytes
+func (a *AggregateAndProof) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, a)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the AggregateAndProof object from a decoder
+func (a *AggregateAndProof) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 108 {
+		return ssz.NewDecodeError(ssz.ErrSize, "AggregateAndProof", 108, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "AggregateAndProof", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(108)
+	if err != nil {
+		return err
+	}
+	var o1 uint64
+
+	// Field (0) 'Index'
+	a.Index = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'Aggregate'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "AggregateAndProof", "Aggregate", 8)
+	}
+
+	if o1 != 108 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 108, o1), "AggregateAndProof", "Aggregate", 8)
+	}
+
+	// Field (2) 'SelectionProof'
+	if err = ssz.UnmarshalWithState(&a.SelectionProof, buf[12:108], st); err != nil {
+		return ssz.WrapDecodeError(err, "AggregateAndProof", "SelectionProof", 12)
+	}
+
+	// Field (1) 'Aggregate'
+	{
+		if a.Aggregate == nil {
+			a.Aggregate = new(Attestation)
+		}
+		if err = dec.DecodeObject(a.Aggregate, size-o1); err != nil {
+			return ssz.WrapDecodeError(err, "AggregateAndProof", "Aggregate", o1)
+		}
+	}
+	return nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the AggregateAndProof object
 func (a *AggregateAndProof) SizeSSZ() (size int) {
 	size = 108
@@ -121,6 +255,212 @@ func (a *AggregateAndProof) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
+// HashTreeRootParallel ssz hashes the AggregateAndProof object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (a *AggregateAndProof) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return a.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the AggregateAndProof object like HashTreeRootParallel
+// with the Hashers of the pool
+func (a *AggregateAndProof) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Index'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(a.Index)
+			return
+		},
+		// Field (1) 'Aggregate'
+		func(hh *ssz.Hasher) (err error) {
+			if err = a.Aggregate.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (2) 'SelectionProof'
+		func(hh *ssz.Hasher) (err error) {
+			if err = a.SelectionProof.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+	})
+}
+
+// AggregateAndProofView is a lazy view over the SSZ encoding of a AggregateAndProof object
+type AggregateAndProofView struct {
+	v ssz.View
+}
+
+// NewAggregateAndProofView creates a view over the SSZ encoding of a AggregateAndProof object
+func NewAggregateAndProofView(buf []byte) AggregateAndProofView {
+	return AggregateAndProofViewOf(ssz.NewView(buf))
+}
+
+// AggregateAndProofViewOf returns the view of the AggregateAndProof object encoded in v
+func AggregateAndProofViewOf(v ssz.View) AggregateAndProofView {
+	return AggregateAndProofView{v.Container(108, 8)}
+}
+
+// Raw returns the untyped view of the AggregateAndProof object
+func (v AggregateAndProofView) Raw() ssz.View {
+	return v.v
+}
+
+// AggregateAndProofListView is a lazy view over a list of AggregateAndProof objects
+type AggregateAndProofListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th AggregateAndProof object of the list
+func (l AggregateAndProofListView) At(i int) AggregateAndProofView {
+	return AggregateAndProofViewOf(l.ListView.At(i))
+}
+
+// Index returns the Index field of the AggregateAndProof object
+func (v AggregateAndProofView) Index() (uint64, error) {
+	return v.v.Field(0, 8).Uint64()
+}
+
+// Aggregate returns the Aggregate field of the AggregateAndProof object
+func (v AggregateAndProofView) Aggregate() AttestationView {
+	return AttestationViewOf(v.v.DynamicField(8, 0))
+}
+
+// SelectionProof returns the SelectionProof field of the AggregateAndProof object
+func (v AggregateAndProofView) SelectionProof() ssz.View {
+	return v.v.Field(12, 108)
+}
+
+// Copy returns a deep copy of the AggregateAndProof object
+func (a *AggregateAndProof) Copy() *AggregateAndProof {
+	if a == nil {
+		return nil
+	}
+	cpy := new(AggregateAndProof)
+	*cpy = *a
+
+	// Field 'Aggregate'
+	cpy.Aggregate = cpy.Aggregate.Copy()
+
+	// Field 'SelectionProof'
+	cpy.SelectionProof = *ssz.CopyObject(&cpy.SelectionProof).(*external.Signature)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the AggregateAndProof objects have the same SSZ encoding
+func (a *AggregateAndProof) EqualSSZ(other *AggregateAndProof) bool {
+	if a == nil {
+		a = new(AggregateAndProof)
+	}
+	if other == nil {
+		other = new(AggregateAndProof)
+	}
+
+	// Field 'Index'
+	if a.Index != other.Index {
+		return false
+	}
+
+	// Field 'Aggregate'
+	if !a.Aggregate.EqualSSZ(other.Aggregate) {
+		return false
+	}
+
+	// Field 'SelectionProof'
+	if !ssz.EqualObject(&a.SelectionProof, &other.SelectionProof) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the AggregateAndProof objects
+func (a *AggregateAndProof) DiffSSZ(other *AggregateAndProof) []ssz.FieldDiff {
+	if a == nil {
+		a = new(AggregateAndProof)
+	}
+	if other == nil {
+		other = new(AggregateAndProof)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Index'
+	if a.Index != other.Index {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Index", A: a.Index, B: other.Index})
+	}
+
+	// Field 'Aggregate'
+	diffs = ssz.AppendDiffs(diffs, "Aggregate", a.Aggregate.DiffSSZ(other.Aggregate))
+
+	// Field 'SelectionProof'
+	diffs = ssz.AppendDiffs(diffs, "SelectionProof", ssz.DiffObject(&a.SelectionProof, &other.SelectionProof))
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the AggregateAndProof object following the Beacon API conventions
+func (a *AggregateAndProof) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		a = new(AggregateAndProof)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'Index'
+	dst = append(dst, "\"aggregator_index\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(a.Index))
+
+	// Field (1) 'Aggregate'
+	dst = append(dst, ",\"aggregate\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, a.Aggregate); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'SelectionProof'
+	dst = append(dst, ",\"selection_proof\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, &a.SelectionProof); err != nil {
+		return nil, err
+	}
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the AggregateAndProof object following the Beacon API conventions
+func (a *AggregateAndProof) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Index'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["aggregator_index"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Index")
+		}
+		a.Index = uint64(val)
+	}
+
+	// Field (1) 'Aggregate'
+	if a.Aggregate == nil {
+		a.Aggregate = new(Attestation)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["aggregate"], a.Aggregate); err != nil {
+		return ssz.WrapJSONError(err, "Aggregate")
+	}
+
+	// Field (2) 'SelectionProof'
+	if err = ssz.UnmarshalJSONObject(fields["selection_proof"], &a.SelectionProof); err != nil {
+		return ssz.WrapJSONError(err, "SelectionProof")
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Checkpoint object
 func (c *Checkpoint) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -143,12 +483,42 @@ func (c *Checkpoint) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Checkpoint object to a writer
+func (c *Checkpoint) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, c)
+}
+
+// MarshalSSZToEncoder ssz marshals the Checkpoint object to an encoder
+func (c *Checkpoint) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	// Field (0) 'Epoch'
+	enc.EncodeUint64(uint64(c.Epoch))
+
+	// Field (1) 'Root'
+	if size := len(c.Root); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Root", size, 32)
+		return
+	}
+	enc.EncodeBytes(c.Root)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Checkpoint object
 func (c *Checkpoint) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the Checkpoint object within the limits of the decode state
+func (c *Checkpoint) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
 	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "Checkpoint", "", 0)
+	}
+	defer st.Leave()
 	size := uint64(len(buf))
 	if size != 40 {
-		return ssz.ErrSize
+		return ssz.NewDecodeError(ssz.ErrSize, "Checkpoint", 40, size)
 	}
 
 	// Field (0) 'Epoch'
@@ -163,6 +533,35 @@ func (c *Checkpoint) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// ValidateSSZ checks that buf is a valid SSZ encoding of a Checkpoint object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (c *Checkpoint) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Checkpoint", 40, size)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the Checkpoint object from a reader with an encoded size of 'size' bytes
+func (c *Checkpoint) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, c)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the Checkpoint object from a decoder
+func (c *Checkpoint) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 40 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Checkpoint", 40, dec.Size())
+	}
+	buf, err := dec.Read(40)
+	if err != nil {
+		return err
+	}
+	return c.UnmarshalSSZWithState(buf, dec.State())
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the Checkpoint object
 func (c *Checkpoint) SizeSSZ() (size int) {
 	size = 40
@@ -192,49 +591,265 @@ func (c *Checkpoint) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the AttestationData object
-func (a *AttestationData) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(a)
+// HashTreeRootParallel ssz hashes the Checkpoint object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (c *Checkpoint) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return c.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
 }
 
-// MarshalSSZTo ssz marshals the AttestationData object to a target array
-func (a *AttestationData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// HashTreeRootParallelWithPool ssz hashes the Checkpoint object like HashTreeRootParallel
+// with the Hashers of the pool
+func (c *Checkpoint) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Epoch'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(uint64(c.Epoch))
+			return
+		},
+		// Field (1) 'Root'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(c.Root); size != 32 {
+				err = ssz.ErrBytesLengthFn("--.Root", size, 32)
+				return
+			}
+			hh.PutBytes(c.Root)
+			return
+		},
+	})
+}
 
-	// Field (0) 'Slot'
-	dst = ssz.MarshalUint64(dst, uint64(a.Slot))
+// CheckpointView is a lazy view over the SSZ encoding of a Checkpoint object
+type CheckpointView struct {
+	v ssz.View
+}
 
-	// Field (1) 'Index'
-	dst = ssz.MarshalUint64(dst, a.Index)
+// NewCheckpointView creates a view over the SSZ encoding of a Checkpoint object
+func NewCheckpointView(buf []byte) CheckpointView {
+	return CheckpointViewOf(ssz.NewView(buf))
+}
 
-	// Field (2) 'BeaconBlockHash'
-	dst = append(dst, a.BeaconBlockHash[:]...)
+// CheckpointViewOf returns the view of the Checkpoint object encoded in v
+func CheckpointViewOf(v ssz.View) CheckpointView {
+	return CheckpointView{v.Container(40, -1)}
+}
 
-	// Field (3) 'Source'
-	if a.Source == nil {
-		a.Source = new(Checkpoint)
+// Raw returns the untyped view of the Checkpoint object
+func (v CheckpointView) Raw() ssz.View {
+	return v.v
+}
+
+// CheckpointListView is a lazy view over a list of Checkpoint objects
+type CheckpointListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th Checkpoint object of the list
+func (l CheckpointListView) At(i int) CheckpointView {
+	return CheckpointViewOf(l.ListView.At(i))
+}
+
+// Epoch returns the Epoch field of the Checkpoint object
+func (v CheckpointView) Epoch() (external2.EpochAlias, error) {
+	val, err := v.v.Field(0, 8).Uint64()
+	return external2.EpochAlias(val), err
+}
+
+// Root returns the Root field of the Checkpoint object
+func (v CheckpointView) Root() ([]byte, error) {
+	return v.v.Field(8, 40).Bytes()
+}
+
+// Copy returns a deep copy of the Checkpoint object
+func (c *Checkpoint) Copy() *Checkpoint {
+	if c == nil {
+		return nil
 	}
-	if dst, err = a.Source.MarshalSSZTo(dst); err != nil {
-		return
+	cpy := new(Checkpoint)
+	*cpy = *c
+
+	// Field 'Root'
+	cpy.Root = append(cpy.Root[:0:0], cpy.Root...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the Checkpoint objects have the same SSZ encoding
+func (c *Checkpoint) EqualSSZ(other *Checkpoint) bool {
+	if c == nil {
+		c = new(Checkpoint)
+	}
+	if other == nil {
+		other = new(Checkpoint)
 	}
 
-	// Field (4) 'Target'
-	if a.Target == nil {
-		a.Target = new(Checkpoint)
+	// Field 'Epoch'
+	if c.Epoch != other.Epoch {
+		return false
 	}
-	if dst, err = a.Target.MarshalSSZTo(dst); err != nil {
-		return
+
+	// Field 'Root'
+	if !bytes.Equal(c.Root, other.Root) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// UnmarshalSSZ ssz unmarshals the AttestationData object
-func (a *AttestationData) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 128 {
-		return ssz.ErrSize
+// DiffSSZ returns the fields with a different SSZ encoding in the Checkpoint objects
+func (c *Checkpoint) DiffSSZ(other *Checkpoint) []ssz.FieldDiff {
+	if c == nil {
+		c = new(Checkpoint)
+	}
+	if other == nil {
+		other = new(Checkpoint)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Epoch'
+	if c.Epoch != other.Epoch {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Epoch", A: c.Epoch, B: other.Epoch})
+	}
+
+	// Field 'Root'
+	if !bytes.Equal(c.Root, other.Root) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Root", A: c.Root, B: other.Root})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the Checkpoint object following the Beacon API conventions
+func (c *Checkpoint) MarshalJSON() ([]byte, error) {
+	if c == nil {
+		c = new(Checkpoint)
+	}
+	dst := []byte{'{'}
+
+	// Field (0) 'Epoch'
+	dst = append(dst, "\"epoch\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(c.Epoch))
+
+	// Field (1) 'Root'
+	dst = append(dst, ",\"root\":"...)
+	dst = ssz.MarshalJSONBytes(dst, c.Root)
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the Checkpoint object following the Beacon API conventions
+func (c *Checkpoint) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Epoch'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["epoch"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Epoch")
+		}
+		c.Epoch = external2.EpochAlias(val)
+	}
+
+	// Field (1) 'Root'
+	if c.Root, err = ssz.UnmarshalJSONBytes(fields["root"], 32, 32); err != nil {
+		return ssz.WrapJSONError(err, "Root")
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the AttestationData object
+func (a *AttestationData) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(a)
+}
+
+// MarshalSSZTo ssz marshals the AttestationData object to a target array
+func (a *AttestationData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, uint64(a.Slot))
+
+	// Field (1) 'Index'
+	dst = ssz.MarshalUint64(dst, a.Index)
+
+	// Field (2) 'BeaconBlockHash'
+	dst = append(dst, a.BeaconBlockHash[:]...)
+
+	// Field (3) 'Source'
+	if a.Source == nil {
+		a.Source = new(Checkpoint)
+	}
+	if dst, err = a.Source.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'Target'
+	if a.Target == nil {
+		a.Target = new(Checkpoint)
+	}
+	if dst, err = a.Target.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the AttestationData object to a writer
+func (a *AttestationData) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, a)
+}
+
+// MarshalSSZToEncoder ssz marshals the AttestationData object to an encoder
+func (a *AttestationData) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	// Field (0) 'Slot'
+	enc.EncodeUint64(uint64(a.Slot))
+
+	// Field (1) 'Index'
+	enc.EncodeUint64(a.Index)
+
+	// Field (2) 'BeaconBlockHash'
+	enc.EncodeBytes(a.BeaconBlockHash[:])
+
+	// Field (3) 'Source'
+	if a.Source == nil {
+		a.Source = new(Checkpoint)
+	}
+	if err = enc.EncodeObject(a.Source); err != nil {
+		return
+	}
+
+	// Field (4) 'Target'
+	if a.Target == nil {
+		a.Target = new(Checkpoint)
+	}
+	if err = enc.EncodeObject(a.Target); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the AttestationData object
+func (a *AttestationData) UnmarshalSSZ(buf []byte) error {
+	return a.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the AttestationData object within the limits of the decode state
+func (a *AttestationData) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "AttestationData", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 128 {
+		return ssz.NewDecodeError(ssz.ErrSize, "AttestationData", 128, size)
 	}
 
 	// Field (0) 'Slot'
@@ -250,21 +865,60 @@ func (a *AttestationData) UnmarshalSSZ(buf []byte) error {
 	if a.Source == nil {
 		a.Source = new(Checkpoint)
 	}
-	if err = a.Source.UnmarshalSSZ(buf[48:88]); err != nil {
-		return err
+	if err = ssz.UnmarshalWithState(a.Source, buf[48:88], st); err != nil {
+		return ssz.WrapDecodeError(err, "AttestationData", "Source", 48)
 	}
 
 	// Field (4) 'Target'
 	if a.Target == nil {
 		a.Target = new(Checkpoint)
 	}
-	if err = a.Target.UnmarshalSSZ(buf[88:128]); err != nil {
-		return err
+	if err = ssz.UnmarshalWithState(a.Target, buf[88:128], st); err != nil {
+		return ssz.WrapDecodeError(err, "AttestationData", "Target", 88)
+	}
+
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a AttestationData object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (a *AttestationData) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 128 {
+		return ssz.NewDecodeError(ssz.ErrSize, "AttestationData", 128, size)
+	}
+
+	// Field (3) 'Source'
+	if err = (*Checkpoint)(nil).ValidateSSZ(buf[48:88]); err != nil {
+		return ssz.WrapDecodeError(err, "AttestationData", "Source", 48)
+	}
+
+	// Field (4) 'Target'
+	if err = (*Checkpoint)(nil).ValidateSSZ(buf[88:128]); err != nil {
+		return ssz.WrapDecodeError(err, "AttestationData", "Target", 88)
 	}
 
 	return err
 }
 
+// UnmarshalSSZFromReader ssz unmarshals the AttestationData object from a reader with an encoded size of 'size' bytes
+func (a *AttestationData) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, a)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the AttestationData object from a decoder
+func (a *AttestationData) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 128 {
+		return ssz.NewDecodeError(ssz.ErrSize, "AttestationData", 128, dec.Size())
+	}
+	buf, err := dec.Read(128)
+	if err != nil {
+		return err
+	}
+	return a.UnmarshalSSZWithState(buf, dec.State())
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the AttestationData object
 func (a *AttestationData) SizeSSZ() (size int) {
 	size = 128
@@ -303,6 +957,282 @@ func (a *AttestationData) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
+// HashTreeRootParallel ssz hashes the AttestationData object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (a *AttestationData) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return a.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the AttestationData object like HashTreeRootParallel
+// with the Hashers of the pool
+func (a *AttestationData) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Slot'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(uint64(a.Slot))
+			return
+		},
+		// Field (1) 'Index'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(a.Index)
+			return
+		},
+		// Field (2) 'BeaconBlockHash'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutBytes(a.BeaconBlockHash[:])
+			return
+		},
+		// Field (3) 'Source'
+		func(hh *ssz.Hasher) (err error) {
+			if err = a.Source.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (4) 'Target'
+		func(hh *ssz.Hasher) (err error) {
+			if err = a.Target.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+	})
+}
+
+// AttestationDataView is a lazy view over the SSZ encoding of a AttestationData object
+type AttestationDataView struct {
+	v ssz.View
+}
+
+// NewAttestationDataView creates a view over the SSZ encoding of a AttestationData object
+func NewAttestationDataView(buf []byte) AttestationDataView {
+	return AttestationDataViewOf(ssz.NewView(buf))
+}
+
+// AttestationDataViewOf returns the view of the AttestationData object encoded in v
+func AttestationDataViewOf(v ssz.View) AttestationDataView {
+	return AttestationDataView{v.Container(128, -1)}
+}
+
+// Raw returns the untyped view of the AttestationData object
+func (v AttestationDataView) Raw() ssz.View {
+	return v.v
+}
+
+// AttestationDataListView is a lazy view over a list of AttestationData objects
+type AttestationDataListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th AttestationData object of the list
+func (l AttestationDataListView) At(i int) AttestationDataView {
+	return AttestationDataViewOf(l.ListView.At(i))
+}
+
+// Slot returns the Slot field of the AttestationData object
+func (v AttestationDataView) Slot() (Slot, error) {
+	val, err := v.v.Field(0, 8).Uint64()
+	return Slot(val), err
+}
+
+// Index returns the Index field of the AttestationData object
+func (v AttestationDataView) Index() (uint64, error) {
+	return v.v.Field(8, 16).Uint64()
+}
+
+// BeaconBlockHash returns the BeaconBlockHash field of the AttestationData object
+func (v AttestationDataView) BeaconBlockHash() ([]byte, error) {
+	return v.v.Field(16, 48).Bytes()
+}
+
+// Source returns the Source field of the AttestationData object
+func (v AttestationDataView) Source() CheckpointView {
+	return CheckpointViewOf(v.v.Field(48, 88))
+}
+
+// Target returns the Target field of the AttestationData object
+func (v AttestationDataView) Target() CheckpointView {
+	return CheckpointViewOf(v.v.Field(88, 128))
+}
+
+// Copy returns a deep copy of the AttestationData object
+func (a *AttestationData) Copy() *AttestationData {
+	if a == nil {
+		return nil
+	}
+	cpy := new(AttestationData)
+	*cpy = *a
+
+	// Field 'Source'
+	cpy.Source = cpy.Source.Copy()
+
+	// Field 'Target'
+	cpy.Target = cpy.Target.Copy()
+
+	return cpy
+}
+
+// EqualSSZ returns true if the AttestationData objects have the same SSZ encoding
+func (a *AttestationData) EqualSSZ(other *AttestationData) bool {
+	if a == nil {
+		a = new(AttestationData)
+	}
+	if other == nil {
+		other = new(AttestationData)
+	}
+
+	// Field 'Slot'
+	if a.Slot != other.Slot {
+		return false
+	}
+
+	// Field 'Index'
+	if a.Index != other.Index {
+		return false
+	}
+
+	// Field 'BeaconBlockHash'
+	if a.BeaconBlockHash != other.BeaconBlockHash {
+		return false
+	}
+
+	// Field 'Source'
+	if !a.Source.EqualSSZ(other.Source) {
+		return false
+	}
+
+	// Field 'Target'
+	if !a.Target.EqualSSZ(other.Target) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the AttestationData objects
+func (a *AttestationData) DiffSSZ(other *AttestationData) []ssz.FieldDiff {
+	if a == nil {
+		a = new(AttestationData)
+	}
+	if other == nil {
+		other = new(AttestationData)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Slot'
+	if a.Slot != other.Slot {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Slot", A: a.Slot, B: other.Slot})
+	}
+
+	// Field 'Index'
+	if a.Index != other.Index {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Index", A: a.Index, B: other.Index})
+	}
+
+	// Field 'BeaconBlockHash'
+	if a.BeaconBlockHash != other.BeaconBlockHash {
+		diffs = append(diffs, ssz.FieldDiff{Path: "BeaconBlockHash", A: a.BeaconBlockHash, B: other.BeaconBlockHash})
+	}
+
+	// Field 'Source'
+	diffs = ssz.AppendDiffs(diffs, "Source", a.Source.DiffSSZ(other.Source))
+
+	// Field 'Target'
+	diffs = ssz.AppendDiffs(diffs, "Target", a.Target.DiffSSZ(other.Target))
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the AttestationData object following the Beacon API conventions
+func (a *AttestationData) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		a = new(AttestationData)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'Slot'
+	dst = append(dst, "\"slot\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(a.Slot))
+
+	// Field (1) 'Index'
+	dst = append(dst, ",\"index\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(a.Index))
+
+	// Field (2) 'BeaconBlockHash'
+	dst = append(dst, ",\"beacon_block_root\":"...)
+	dst = ssz.MarshalJSONBytes(dst, a.BeaconBlockHash[:])
+
+	// Field (3) 'Source'
+	dst = append(dst, ",\"source\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, a.Source); err != nil {
+		return nil, err
+	}
+
+	// Field (4) 'Target'
+	dst = append(dst, ",\"target\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, a.Target); err != nil {
+		return nil, err
+	}
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the AttestationData object following the Beacon API conventions
+func (a *AttestationData) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Slot'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["slot"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Slot")
+		}
+		a.Slot = Slot(val)
+	}
+
+	// Field (1) 'Index'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["index"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Index")
+		}
+		a.Index = uint64(val)
+	}
+
+	// Field (2) 'BeaconBlockHash'
+	{
+		val, err := ssz.UnmarshalJSONBytes(fields["beacon_block_root"], 32, 32)
+		if err != nil {
+			return ssz.WrapJSONError(err, "BeaconBlockHash")
+		}
+		copy(a.BeaconBlockHash[:], val)
+	}
+
+	// Field (3) 'Source'
+	if a.Source == nil {
+		a.Source = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["source"], a.Source); err != nil {
+		return ssz.WrapJSONError(err, "Source")
+	}
+
+	// Field (4) 'Target'
+	if a.Target == nil {
+		a.Target = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["target"], a.Target); err != nil {
+		return ssz.WrapJSONError(err, "Target")
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the Attestation object
 func (a *Attestation) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(a)
@@ -343,47 +1273,95 @@ func (a *Attestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the Attestation object
-func (a *Attestation) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 228 {
-		return ssz.ErrSize
-	}
+// MarshalSSZToWriter ssz marshals the Attestation object to a writer
+func (a *Attestation) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, a)
+}
 
-	tail := buf
-	var o0 uint64
+// MarshalSSZToEncoder ssz marshals the Attestation object to an encoder
+func (a *Attestation) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(228)
 
 	// Offset (0) 'AggregationBits'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
+	enc.EncodeOffset(offset)
+	offset += len(a.AggregationBits)
+
+	// Field (1) 'Data'
+	if a.Data == nil {
+		a.Data = new(AttestationData)
+	}
+	if err = enc.EncodeObject(a.Data); err != nil {
+		return
+	}
+
+	// Field (2) 'Signature'
+	if a.Signature == nil {
+		a.Signature = new(external.Signature)
+	}
+	if err = enc.EncodeObject(a.Signature); err != nil {
+		return
+	}
+
+	// Field (0) 'AggregationBits'
+	if size := len(a.AggregationBits); size > 2048 {
+		err = ssz.ErrBytesLengthFn("--.AggregationBits", size, 2048)
+		return
+	}
+	enc.EncodeBytes(a.AggregationBits)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Attestation object
+func (a *Attestation) UnmarshalSSZ(buf []byte) error {
+	return a.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the Attestation object within the limits of the decode state
+func (a *Attestation) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "Attestation", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 228 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Attestation", 228, size)
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'AggregationBits'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "Attestation", "AggregationBits", 0)
 	}
 
-	if o0 < 228 {
-		return ssz.ErrInvalidVariableOffset
+	if o0 != 228 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 228, o0), "Attestation", "AggregationBits", 0)
 	}
 
 	// Field (1) 'Data'
 	if a.Data == nil {
 		a.Data = new(AttestationData)
 	}
-	if err = a.Data.UnmarshalSSZ(buf[4:132]); err != nil {
-		return err
+	if err = ssz.UnmarshalWithState(a.Data, buf[4:132], st); err != nil {
+		return ssz.WrapDecodeError(err, "Attestation", "Data", 4)
 	}
 
 	// Field (2) 'Signature'
 	if a.Signature == nil {
 		a.Signature = new(external.Signature)
 	}
-	if err = a.Signature.UnmarshalSSZ(buf[132:228]); err != nil {
-		return err
+	if err = ssz.UnmarshalWithState(a.Signature, buf[132:228], st); err != nil {
+		return ssz.WrapDecodeError(err, "Attestation", "Signature", 132)
 	}
 
 	// Field (0) 'AggregationBits'
 	{
 		buf = tail[o0:]
 		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
-			return err
+			return ssz.WrapDecodeError(err, "Attestation", "AggregationBits", o0)
 		}
 		if cap(a.AggregationBits) == 0 {
 			a.AggregationBits = make([]byte, 0, len(buf))
@@ -393,6 +1371,110 @@ func (a *Attestation) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// ValidateSSZ checks that buf is a valid SSZ encoding of a Attestation object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (a *Attestation) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 228 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Attestation", 228, size)
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'AggregationBits'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "Attestation", "AggregationBits", 0)
+	}
+
+	if o0 != 228 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 228, o0), "Attestation", "AggregationBits", 0)
+	}
+
+	// Field (1) 'Data'
+	if err = (*AttestationData)(nil).ValidateSSZ(buf[4:132]); err != nil {
+		return ssz.WrapDecodeError(err, "Attestation", "Data", 4)
+	}
+
+	// Field (2) 'Signature'
+	if err = ssz.ValidateObject((*external.Signature)(nil), buf[132:228]); err != nil {
+		return ssz.WrapDecodeError(err, "Attestation", "Signature", 132)
+	}
+
+	// Field (0) 'AggregationBits'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return ssz.WrapDecodeError(err, "Attestation", "AggregationBits", o0)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the Attestation object from a reader with an encoded size of 'size' bytes
+func (a *Attestation) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, a)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the Attestation object from a decoder
+func (a *Attestation) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 228 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Attestation", 228, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "Attestation", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(228)
+	if err != nil {
+		return err
+	}
+	var o0 uint64
+
+	// Offset (0) 'AggregationBits'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "Attestation", "AggregationBits", 0)
+	}
+
+	if o0 != 228 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 228, o0), "Attestation", "AggregationBits", 0)
+	}
+
+	// Field (1) 'Data'
+	if a.Data == nil {
+		a.Data = new(AttestationData)
+	}
+	if err = ssz.UnmarshalWithState(a.Data, buf[4:132], st); err != nil {
+		return ssz.WrapDecodeError(err, "Attestation", "Data", 4)
+	}
+
+	// Field (2) 'Signature'
+	if a.Signature == nil {
+		a.Signature = new(external.Signature)
+	}
+	if err = ssz.UnmarshalWithState(a.Signature, buf[132:228], st); err != nil {
+		return ssz.WrapDecodeError(err, "Attestation", "Signature", 132)
+	}
+
+	// Field (0) 'AggregationBits'
+	{
+		if buf, err = dec.Read(size - o0); err != nil {
+			return ssz.WrapDecodeError(err, "Attestation", "AggregationBits", o0)
+		}
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return ssz.WrapDecodeError(err, "Attestation", "AggregationBits", o0)
+		}
+		if cap(a.AggregationBits) == 0 {
+			a.AggregationBits = make([]byte, 0, len(buf))
+		}
+		a.AggregationBits = append(a.AggregationBits, buf...)
+	}
+	return nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the Attestation object
 func (a *Attestation) SizeSSZ() (size int) {
 	size = 228
@@ -433,6 +1515,219 @@ func (a *Attestation) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
+// HashTreeRootParallel ssz hashes the Attestation object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (a *Attestation) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return a.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the Attestation object like HashTreeRootParallel
+// with the Hashers of the pool
+func (a *Attestation) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'AggregationBits'
+		func(hh *ssz.Hasher) (err error) {
+			if len(a.AggregationBits) == 0 {
+				err = ssz.ErrEmptyBitlist
+				return
+			}
+			hh.PutBitlist(a.AggregationBits, 2048)
+
+			return
+		},
+		// Field (1) 'Data'
+		func(hh *ssz.Hasher) (err error) {
+			if err = a.Data.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (2) 'Signature'
+		func(hh *ssz.Hasher) (err error) {
+			if err = a.Signature.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+	})
+}
+
+// AttestationView is a lazy view over the SSZ encoding of a Attestation object
+type AttestationView struct {
+	v ssz.View
+}
+
+// NewAttestationView creates a view over the SSZ encoding of a Attestation object
+func NewAttestationView(buf []byte) AttestationView {
+	return AttestationViewOf(ssz.NewView(buf))
+}
+
+// AttestationViewOf returns the view of the Attestation object encoded in v
+func AttestationViewOf(v ssz.View) AttestationView {
+	return AttestationView{v.Container(228, 0)}
+}
+
+// Raw returns the untyped view of the Attestation object
+func (v AttestationView) Raw() ssz.View {
+	return v.v
+}
+
+// AttestationListView is a lazy view over a list of Attestation objects
+type AttestationListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th Attestation object of the list
+func (l AttestationListView) At(i int) AttestationView {
+	return AttestationViewOf(l.ListView.At(i))
+}
+
+// AggregationBits returns the AggregationBits field of the Attestation object
+func (v AttestationView) AggregationBits() ([]byte, error) {
+	return v.v.DynamicField(0, 0).Bitlist(2048)
+}
+
+// Data returns the Data field of the Attestation object
+func (v AttestationView) Data() AttestationDataView {
+	return AttestationDataViewOf(v.v.Field(4, 132))
+}
+
+// Signature returns the Signature field of the Attestation object
+func (v AttestationView) Signature() ssz.View {
+	return v.v.Field(132, 228)
+}
+
+// Copy returns a deep copy of the Attestation object
+func (a *Attestation) Copy() *Attestation {
+	if a == nil {
+		return nil
+	}
+	cpy := new(Attestation)
+	*cpy = *a
+
+	// Field 'AggregationBits'
+	cpy.AggregationBits = append(cpy.AggregationBits[:0:0], cpy.AggregationBits...)
+
+	// Field 'Data'
+	cpy.Data = cpy.Data.Copy()
+
+	// Field 'Signature'
+	cpy.Signature = ssz.CopyObject(cpy.Signature).(*external.Signature)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the Attestation objects have the same SSZ encoding
+func (a *Attestation) EqualSSZ(other *Attestation) bool {
+	if a == nil {
+		a = new(Attestation)
+	}
+	if other == nil {
+		other = new(Attestation)
+	}
+
+	// Field 'AggregationBits'
+	if !bytes.Equal(a.AggregationBits, other.AggregationBits) {
+		return false
+	}
+
+	// Field 'Data'
+	if !a.Data.EqualSSZ(other.Data) {
+		return false
+	}
+
+	// Field 'Signature'
+	if !ssz.EqualObject(a.Signature, other.Signature) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the Attestation objects
+func (a *Attestation) DiffSSZ(other *Attestation) []ssz.FieldDiff {
+	if a == nil {
+		a = new(Attestation)
+	}
+	if other == nil {
+		other = new(Attestation)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'AggregationBits'
+	if !bytes.Equal(a.AggregationBits, other.AggregationBits) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "AggregationBits", A: a.AggregationBits, B: other.AggregationBits})
+	}
+
+	// Field 'Data'
+	diffs = ssz.AppendDiffs(diffs, "Data", a.Data.DiffSSZ(other.Data))
+
+	// Field 'Signature'
+	diffs = ssz.AppendDiffs(diffs, "Signature", ssz.DiffObject(a.Signature, other.Signature))
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the Attestation object following the Beacon API conventions
+func (a *Attestation) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		a = new(Attestation)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'AggregationBits'
+	dst = append(dst, "\"aggregation_bits\":"...)
+	dst = ssz.MarshalJSONBytes(dst, a.AggregationBits)
+
+	// Field (1) 'Data'
+	dst = append(dst, ",\"data\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, a.Data); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Signature'
+	dst = append(dst, ",\"signature\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, a.Signature); err != nil {
+		return nil, err
+	}
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the Attestation object following the Beacon API conventions
+func (a *Attestation) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'AggregationBits'
+	if a.AggregationBits, err = ssz.UnmarshalJSONBitlist(fields["aggregation_bits"], 2048); err != nil {
+		return ssz.WrapJSONError(err, "AggregationBits")
+	}
+
+	// Field (1) 'Data'
+	if a.Data == nil {
+		a.Data = new(AttestationData)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["data"], a.Data); err != nil {
+		return ssz.WrapJSONError(err, "Data")
+	}
+
+	// Field (2) 'Signature'
+	if a.Signature == nil {
+		a.Signature = new(external.Signature)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["signature"], a.Signature); err != nil {
+		return ssz.WrapJSONError(err, "Signature")
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the DepositData object
 func (d *DepositData) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(d)
@@ -461,12 +1756,48 @@ func (d *DepositData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the DepositData object to a writer
+func (d *DepositData) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, d)
+}
+
+// MarshalSSZToEncoder ssz marshals the DepositData object to an encoder
+func (d *DepositData) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	// Field (0) 'Pubkey'
+	enc.EncodeBytes(d.Pubkey[:])
+
+	// Field (1) 'WithdrawalCredentials'
+	enc.EncodeBytes(d.WithdrawalCredentials[:])
+
+	// Field (2) 'Amount'
+	enc.EncodeUint64(d.Amount)
+
+	// Field (3) 'Signature'
+	if size := len(d.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	enc.EncodeBytes(d.Signature)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the DepositData object
 func (d *DepositData) UnmarshalSSZ(buf []byte) error {
+	return d.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the DepositData object within the limits of the decode state
+func (d *DepositData) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
 	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "DepositData", "", 0)
+	}
+	defer st.Leave()
 	size := uint64(len(buf))
 	if size != 184 {
-		return ssz.ErrSize
+		return ssz.NewDecodeError(ssz.ErrSize, "DepositData", 184, size)
 	}
 
 	// Field (0) 'Pubkey'
@@ -487,6 +1818,35 @@ func (d *DepositData) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// ValidateSSZ checks that buf is a valid SSZ encoding of a DepositData object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (d *DepositData) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 184 {
+		return ssz.NewDecodeError(ssz.ErrSize, "DepositData", 184, size)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the DepositData object from a reader with an encoded size of 'size' bytes
+func (d *DepositData) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, d)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the DepositData object from a decoder
+func (d *DepositData) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 184 {
+		return ssz.NewDecodeError(ssz.ErrSize, "DepositData", 184, dec.Size())
+	}
+	buf, err := dec.Read(184)
+	if err != nil {
+		return err
+	}
+	return d.UnmarshalSSZWithState(buf, dec.State())
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the DepositData object
 func (d *DepositData) SizeSSZ() (size int) {
 	size = 184
@@ -522,27 +1882,263 @@ func (d *DepositData) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the Deposit object
-func (d *Deposit) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(d)
+// HashTreeRootParallel ssz hashes the DepositData object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (d *DepositData) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return d.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
 }
 
-// MarshalSSZTo ssz marshals the Deposit object to a target array
-func (d *Deposit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-
-	// Field (0) 'Proof'
-	if size := len(d.Proof); size != 33 {
-		err = ssz.ErrVectorLengthFn("--.Proof", size, 33)
-		return
-	}
-	for ii := 0; ii < 33; ii++ {
-		if size := len(d.Proof[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("--.Proof[ii]", size, 32)
+// HashTreeRootParallelWithPool ssz hashes the DepositData object like HashTreeRootParallel
+// with the Hashers of the pool
+func (d *DepositData) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Pubkey'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutBytes(d.Pubkey[:])
 			return
-		}
-		dst = append(dst, d.Proof[ii]...)
-	}
+		},
+		// Field (1) 'WithdrawalCredentials'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutBytes(d.WithdrawalCredentials[:])
+			return
+		},
+		// Field (2) 'Amount'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(d.Amount)
+			return
+		},
+		// Field (3) 'Signature'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(d.Signature); size != 96 {
+				err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+				return
+			}
+			hh.PutBytes(d.Signature)
+			return
+		},
+	})
+}
+
+// DepositDataView is a lazy view over the SSZ encoding of a DepositData object
+type DepositDataView struct {
+	v ssz.View
+}
+
+// NewDepositDataView creates a view over the SSZ encoding of a DepositData object
+func NewDepositDataView(buf []byte) DepositDataView {
+	return DepositDataViewOf(ssz.NewView(buf))
+}
+
+// DepositDataViewOf returns the view of the DepositData object encoded in v
+func DepositDataViewOf(v ssz.View) DepositDataView {
+	return DepositDataView{v.Container(184, -1)}
+}
+
+// Raw returns the untyped view of the DepositData object
+func (v DepositDataView) Raw() ssz.View {
+	return v.v
+}
+
+// DepositDataListView is a lazy view over a list of DepositData objects
+type DepositDataListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th DepositData object of the list
+func (l DepositDataListView) At(i int) DepositDataView {
+	return DepositDataViewOf(l.ListView.At(i))
+}
+
+// Pubkey returns the Pubkey field of the DepositData object
+func (v DepositDataView) Pubkey() ([]byte, error) {
+	return v.v.Field(0, 48).Bytes()
+}
+
+// WithdrawalCredentials returns the WithdrawalCredentials field of the DepositData object
+func (v DepositDataView) WithdrawalCredentials() ([]byte, error) {
+	return v.v.Field(48, 80).Bytes()
+}
+
+// Amount returns the Amount field of the DepositData object
+func (v DepositDataView) Amount() (uint64, error) {
+	return v.v.Field(80, 88).Uint64()
+}
+
+// Signature returns the Signature field of the DepositData object
+func (v DepositDataView) Signature() ([]byte, error) {
+	return v.v.Field(88, 184).Bytes()
+}
+
+// Copy returns a deep copy of the DepositData object
+func (d *DepositData) Copy() *DepositData {
+	if d == nil {
+		return nil
+	}
+	cpy := new(DepositData)
+	*cpy = *d
+
+	// Field 'Signature'
+	cpy.Signature = append(cpy.Signature[:0:0], cpy.Signature...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the DepositData objects have the same SSZ encoding
+func (d *DepositData) EqualSSZ(other *DepositData) bool {
+	if d == nil {
+		d = new(DepositData)
+	}
+	if other == nil {
+		other = new(DepositData)
+	}
+
+	// Field 'Pubkey'
+	if d.Pubkey != other.Pubkey {
+		return false
+	}
+
+	// Field 'WithdrawalCredentials'
+	if d.WithdrawalCredentials != other.WithdrawalCredentials {
+		return false
+	}
+
+	// Field 'Amount'
+	if d.Amount != other.Amount {
+		return false
+	}
+
+	// Field 'Signature'
+	if !bytes.Equal(d.Signature, other.Signature) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the DepositData objects
+func (d *DepositData) DiffSSZ(other *DepositData) []ssz.FieldDiff {
+	if d == nil {
+		d = new(DepositData)
+	}
+	if other == nil {
+		other = new(DepositData)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Pubkey'
+	if d.Pubkey != other.Pubkey {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Pubkey", A: d.Pubkey, B: other.Pubkey})
+	}
+
+	// Field 'WithdrawalCredentials'
+	if d.WithdrawalCredentials != other.WithdrawalCredentials {
+		diffs = append(diffs, ssz.FieldDiff{Path: "WithdrawalCredentials", A: d.WithdrawalCredentials, B: other.WithdrawalCredentials})
+	}
+
+	// Field 'Amount'
+	if d.Amount != other.Amount {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Amount", A: d.Amount, B: other.Amount})
+	}
+
+	// Field 'Signature'
+	if !bytes.Equal(d.Signature, other.Signature) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Signature", A: d.Signature, B: other.Signature})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the DepositData object following the Beacon API conventions
+func (d *DepositData) MarshalJSON() ([]byte, error) {
+	if d == nil {
+		d = new(DepositData)
+	}
+	dst := []byte{'{'}
+
+	// Field (0) 'Pubkey'
+	dst = append(dst, "\"pubkey\":"...)
+	dst = ssz.MarshalJSONBytes(dst, d.Pubkey[:])
+
+	// Field (1) 'WithdrawalCredentials'
+	dst = append(dst, ",\"withdrawal_credentials\":"...)
+	dst = ssz.MarshalJSONBytes(dst, d.WithdrawalCredentials[:])
+
+	// Field (2) 'Amount'
+	dst = append(dst, ",\"amount\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(d.Amount))
+
+	// Field (3) 'Signature'
+	dst = append(dst, ",\"signature\":"...)
+	dst = ssz.MarshalJSONBytes(dst, d.Signature)
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the DepositData object following the Beacon API conventions
+func (d *DepositData) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Pubkey'
+	{
+		val, err := ssz.UnmarshalJSONBytes(fields["pubkey"], 48, 48)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Pubkey")
+		}
+		copy(d.Pubkey[:], val)
+	}
+
+	// Field (1) 'WithdrawalCredentials'
+	{
+		val, err := ssz.UnmarshalJSONBytes(fields["withdrawal_credentials"], 32, 32)
+		if err != nil {
+			return ssz.WrapJSONError(err, "WithdrawalCredentials")
+		}
+		copy(d.WithdrawalCredentials[:], val)
+	}
+
+	// Field (2) 'Amount'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["amount"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Amount")
+		}
+		d.Amount = uint64(val)
+	}
+
+	// Field (3) 'Signature'
+	if d.Signature, err = ssz.UnmarshalJSONBytes(fields["signature"], 96, 96); err != nil {
+		return ssz.WrapJSONError(err, "Signature")
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Deposit object
+func (d *Deposit) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(d)
+}
+
+// MarshalSSZTo ssz marshals the Deposit object to a target array
+func (d *Deposit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Proof'
+	if size := len(d.Proof); size != 33 {
+		err = ssz.ErrVectorLengthFn("--.Proof", size, 33)
+		return
+	}
+	for ii := 0; ii < 33; ii++ {
+		if size := len(d.Proof[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.Proof[ii]", size, 32)
+			return
+		}
+		dst = append(dst, d.Proof[ii]...)
+	}
 
 	// Field (1) 'Data'
 	if d.Data == nil {
@@ -555,15 +2151,59 @@ func (d *Deposit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Deposit object to a writer
+func (d *Deposit) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, d)
+}
+
+// MarshalSSZToEncoder ssz marshals the Deposit object to an encoder
+func (d *Deposit) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	// Field (0) 'Proof'
+	if size := len(d.Proof); size != 33 {
+		err = ssz.ErrVectorLengthFn("--.Proof", size, 33)
+		return
+	}
+	for ii := 0; ii < 33; ii++ {
+		if size := len(d.Proof[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.Proof[ii]", size, 32)
+			return
+		}
+		enc.EncodeBytes(d.Proof[ii])
+	}
+
+	// Field (1) 'Data'
+	if d.Data == nil {
+		d.Data = new(DepositData)
+	}
+	if err = enc.EncodeObject(d.Data); err != nil {
+		return
+	}
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Deposit object
 func (d *Deposit) UnmarshalSSZ(buf []byte) error {
+	return d.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the Deposit object within the limits of the decode state
+func (d *Deposit) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
 	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "Deposit", "", 0)
+	}
+	defer st.Leave()
 	size := uint64(len(buf))
 	if size != 1240 {
-		return ssz.ErrSize
+		return ssz.NewDecodeError(ssz.ErrSize, "Deposit", 1240, size)
 	}
 
 	// Field (0) 'Proof'
+	if err = st.Alloc(33, 24); err != nil {
+		return ssz.WrapDecodeError(err, "Deposit", "Proof", 0)
+	}
 	d.Proof = make([][]byte, 33)
 	for ii := 0; ii < 33; ii++ {
 		if cap(d.Proof[ii]) == 0 {
@@ -576,13 +2216,47 @@ func (d *Deposit) UnmarshalSSZ(buf []byte) error {
 	if d.Data == nil {
 		d.Data = new(DepositData)
 	}
-	if err = d.Data.UnmarshalSSZ(buf[1056:1240]); err != nil {
-		return err
+	if err = ssz.UnmarshalWithState(d.Data, buf[1056:1240], st); err != nil {
+		return ssz.WrapDecodeError(err, "Deposit", "Data", 1056)
+	}
+
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a Deposit object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (d *Deposit) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 1240 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Deposit", 1240, size)
+	}
+
+	// Field (1) 'Data'
+	if err = (*DepositData)(nil).ValidateSSZ(buf[1056:1240]); err != nil {
+		return ssz.WrapDecodeError(err, "Deposit", "Data", 1056)
 	}
 
 	return err
 }
 
+// UnmarshalSSZFromReader ssz unmarshals the Deposit object from a reader with an encoded size of 'size' bytes
+func (d *Deposit) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, d)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the Deposit object from a decoder
+func (d *Deposit) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 1240 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Deposit", 1240, dec.Size())
+	}
+	buf, err := dec.Read(1240)
+	if err != nil {
+		return err
+	}
+	return d.UnmarshalSSZWithState(buf, dec.State())
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the Deposit object
 func (d *Deposit) SizeSSZ() (size int) {
 	size = 1240
@@ -624,6 +2298,222 @@ func (d *Deposit) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
+// HashTreeRootParallel ssz hashes the Deposit object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (d *Deposit) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return d.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the Deposit object like HashTreeRootParallel
+// with the Hashers of the pool
+func (d *Deposit) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Proof'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				if size := len(d.Proof); size != 33 {
+					err = ssz.ErrVectorLengthFn("--.Proof", size, 33)
+					return
+				}
+				subIndx := hh.Index()
+				for _, i := range d.Proof {
+					if len(i) != 32 {
+						err = ssz.ErrBytesLength
+						return
+					}
+					hh.Append(i)
+				}
+				hh.Merkleize(subIndx)
+			}
+			return
+		},
+		// Field (1) 'Data'
+		func(hh *ssz.Hasher) (err error) {
+			if err = d.Data.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+	})
+}
+
+// DepositView is a lazy view over the SSZ encoding of a Deposit object
+type DepositView struct {
+	v ssz.View
+}
+
+// NewDepositView creates a view over the SSZ encoding of a Deposit object
+func NewDepositView(buf []byte) DepositView {
+	return DepositViewOf(ssz.NewView(buf))
+}
+
+// DepositViewOf returns the view of the Deposit object encoded in v
+func DepositViewOf(v ssz.View) DepositView {
+	return DepositView{v.Container(1240, -1)}
+}
+
+// Raw returns the untyped view of the Deposit object
+func (v DepositView) Raw() ssz.View {
+	return v.v
+}
+
+// DepositListView is a lazy view over a list of Deposit objects
+type DepositListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th Deposit object of the list
+func (l DepositListView) At(i int) DepositView {
+	return DepositViewOf(l.ListView.At(i))
+}
+
+// Proof returns the Proof field of the Deposit object
+func (v DepositView) Proof() ssz.ListView {
+	return v.v.Field(0, 1056).List(32, 33)
+}
+
+// Data returns the Data field of the Deposit object
+func (v DepositView) Data() DepositDataView {
+	return DepositDataViewOf(v.v.Field(1056, 1240))
+}
+
+// Copy returns a deep copy of the Deposit object
+func (d *Deposit) Copy() *Deposit {
+	if d == nil {
+		return nil
+	}
+	cpy := new(Deposit)
+	*cpy = *d
+
+	// Field 'Proof'
+	cpy.Proof = append(cpy.Proof[:0:0], cpy.Proof...)
+	for i0 := range cpy.Proof {
+		cpy.Proof[i0] = append(cpy.Proof[i0][:0:0], cpy.Proof[i0]...)
+	}
+
+	// Field 'Data'
+	cpy.Data = cpy.Data.Copy()
+
+	return cpy
+}
+
+// EqualSSZ returns true if the Deposit objects have the same SSZ encoding
+func (d *Deposit) EqualSSZ(other *Deposit) bool {
+	if d == nil {
+		d = new(Deposit)
+	}
+	if other == nil {
+		other = new(Deposit)
+	}
+
+	// Field 'Proof'
+	if len(d.Proof) != len(other.Proof) {
+		return false
+	} else {
+		for i0 := range d.Proof {
+			if !bytes.Equal(d.Proof[i0], other.Proof[i0]) {
+				return false
+			}
+		}
+	}
+
+	// Field 'Data'
+	if !d.Data.EqualSSZ(other.Data) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the Deposit objects
+func (d *Deposit) DiffSSZ(other *Deposit) []ssz.FieldDiff {
+	if d == nil {
+		d = new(Deposit)
+	}
+	if other == nil {
+		other = new(Deposit)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Proof'
+	if len(d.Proof) != len(other.Proof) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Proof", A: d.Proof, B: other.Proof})
+	} else {
+		for i0 := range d.Proof {
+			if !bytes.Equal(d.Proof[i0], other.Proof[i0]) {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("Proof", i0), A: d.Proof[i0], B: other.Proof[i0]})
+			}
+		}
+	}
+
+	// Field 'Data'
+	diffs = ssz.AppendDiffs(diffs, "Data", d.Data.DiffSSZ(other.Data))
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the Deposit object following the Beacon API conventions
+func (d *Deposit) MarshalJSON() ([]byte, error) {
+	if d == nil {
+		d = new(Deposit)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'Proof'
+	dst = append(dst, "\"Proof\":"...)
+	dst = append(dst, '[')
+	for i0 := range d.Proof {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONBytes(dst, d.Proof[i0])
+	}
+	dst = append(dst, ']')
+
+	// Field (1) 'Data'
+	dst = append(dst, ",\"Data\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, d.Data); err != nil {
+		return nil, err
+	}
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the Deposit object following the Beacon API conventions
+func (d *Deposit) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Proof'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["Proof"], 33, 33)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Proof")
+		}
+		d.Proof = make([][]byte, len(items0))
+		for i0 := range items0 {
+			if d.Proof[i0], err = ssz.UnmarshalJSONBytes(items0[i0], 32, 32); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("Proof", i0))
+			}
+		}
+	}
+
+	// Field (1) 'Data'
+	if d.Data == nil {
+		d.Data = new(DepositData)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["Data"], d.Data); err != nil {
+		return ssz.WrapJSONError(err, "Data")
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the DepositMessage object
 func (d *DepositMessage) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(d)
@@ -653,13 +2543,50 @@ func (d *DepositMessage) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the DepositMessage object
-func (d *DepositMessage) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 88 {
-		return ssz.ErrSize
-	}
+// MarshalSSZToWriter ssz marshals the DepositMessage object to a writer
+func (d *DepositMessage) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, d)
+}
+
+// MarshalSSZToEncoder ssz marshals the DepositMessage object to an encoder
+func (d *DepositMessage) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	// Field (0) 'Pubkey'
+	if size := len(d.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	enc.EncodeBytes(d.Pubkey)
+
+	// Field (1) 'WithdrawalCredentials'
+	if size := len(d.WithdrawalCredentials); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
+		return
+	}
+	enc.EncodeBytes(d.WithdrawalCredentials)
+
+	// Field (2) 'Amount'
+	enc.EncodeUint64(d.Amount)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the DepositMessage object
+func (d *DepositMessage) UnmarshalSSZ(buf []byte) error {
+	return d.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the DepositMessage object within the limits of the decode state
+func (d *DepositMessage) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "DepositMessage", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 88 {
+		return ssz.NewDecodeError(ssz.ErrSize, "DepositMessage", 88, size)
+	}
 
 	// Field (0) 'Pubkey'
 	if cap(d.Pubkey) == 0 {
@@ -679,6 +2606,35 @@ func (d *DepositMessage) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// ValidateSSZ checks that buf is a valid SSZ encoding of a DepositMessage object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (d *DepositMessage) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 88 {
+		return ssz.NewDecodeError(ssz.ErrSize, "DepositMessage", 88, size)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the DepositMessage object from a reader with an encoded size of 'size' bytes
+func (d *DepositMessage) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, d)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the DepositMessage object from a decoder
+func (d *DepositMessage) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 88 {
+		return ssz.NewDecodeError(ssz.ErrSize, "DepositMessage", 88, dec.Size())
+	}
+	buf, err := dec.Read(88)
+	if err != nil {
+		return err
+	}
+	return d.UnmarshalSSZWithState(buf, dec.State())
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the DepositMessage object
 func (d *DepositMessage) SizeSSZ() (size int) {
 	size = 88
@@ -715,6 +2671,212 @@ func (d *DepositMessage) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
+// HashTreeRootParallel ssz hashes the DepositMessage object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (d *DepositMessage) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return d.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the DepositMessage object like HashTreeRootParallel
+// with the Hashers of the pool
+func (d *DepositMessage) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Pubkey'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(d.Pubkey); size != 48 {
+				err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+				return
+			}
+			hh.PutBytes(d.Pubkey)
+			return
+		},
+		// Field (1) 'WithdrawalCredentials'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(d.WithdrawalCredentials); size != 32 {
+				err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
+				return
+			}
+			hh.PutBytes(d.WithdrawalCredentials)
+			return
+		},
+		// Field (2) 'Amount'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(d.Amount)
+			return
+		},
+	})
+}
+
+// DepositMessageView is a lazy view over the SSZ encoding of a DepositMessage object
+type DepositMessageView struct {
+	v ssz.View
+}
+
+// NewDepositMessageView creates a view over the SSZ encoding of a DepositMessage object
+func NewDepositMessageView(buf []byte) DepositMessageView {
+	return DepositMessageViewOf(ssz.NewView(buf))
+}
+
+// DepositMessageViewOf returns the view of the DepositMessage object encoded in v
+func DepositMessageViewOf(v ssz.View) DepositMessageView {
+	return DepositMessageView{v.Container(88, -1)}
+}
+
+// Raw returns the untyped view of the DepositMessage object
+func (v DepositMessageView) Raw() ssz.View {
+	return v.v
+}
+
+// DepositMessageListView is a lazy view over a list of DepositMessage objects
+type DepositMessageListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th DepositMessage object of the list
+func (l DepositMessageListView) At(i int) DepositMessageView {
+	return DepositMessageViewOf(l.ListView.At(i))
+}
+
+// Pubkey returns the Pubkey field of the DepositMessage object
+func (v DepositMessageView) Pubkey() ([]byte, error) {
+	return v.v.Field(0, 48).Bytes()
+}
+
+// WithdrawalCredentials returns the WithdrawalCredentials field of the DepositMessage object
+func (v DepositMessageView) WithdrawalCredentials() ([]byte, error) {
+	return v.v.Field(48, 80).Bytes()
+}
+
+// Amount returns the Amount field of the DepositMessage object
+func (v DepositMessageView) Amount() (uint64, error) {
+	return v.v.Field(80, 88).Uint64()
+}
+
+// Copy returns a deep copy of the DepositMessage object
+func (d *DepositMessage) Copy() *DepositMessage {
+	if d == nil {
+		return nil
+	}
+	cpy := new(DepositMessage)
+	*cpy = *d
+
+	// Field 'Pubkey'
+	cpy.Pubkey = append(cpy.Pubkey[:0:0], cpy.Pubkey...)
+
+	// Field 'WithdrawalCredentials'
+	cpy.WithdrawalCredentials = append(cpy.WithdrawalCredentials[:0:0], cpy.WithdrawalCredentials...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the DepositMessage objects have the same SSZ encoding
+func (d *DepositMessage) EqualSSZ(other *DepositMessage) bool {
+	if d == nil {
+		d = new(DepositMessage)
+	}
+	if other == nil {
+		other = new(DepositMessage)
+	}
+
+	// Field 'Pubkey'
+	if !bytes.Equal(d.Pubkey, other.Pubkey) {
+		return false
+	}
+
+	// Field 'WithdrawalCredentials'
+	if !bytes.Equal(d.WithdrawalCredentials, other.WithdrawalCredentials) {
+		return false
+	}
+
+	// Field 'Amount'
+	if d.Amount != other.Amount {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the DepositMessage objects
+func (d *DepositMessage) DiffSSZ(other *DepositMessage) []ssz.FieldDiff {
+	if d == nil {
+		d = new(DepositMessage)
+	}
+	if other == nil {
+		other = new(DepositMessage)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Pubkey'
+	if !bytes.Equal(d.Pubkey, other.Pubkey) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Pubkey", A: d.Pubkey, B: other.Pubkey})
+	}
+
+	// Field 'WithdrawalCredentials'
+	if !bytes.Equal(d.WithdrawalCredentials, other.WithdrawalCredentials) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "WithdrawalCredentials", A: d.WithdrawalCredentials, B: other.WithdrawalCredentials})
+	}
+
+	// Field 'Amount'
+	if d.Amount != other.Amount {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Amount", A: d.Amount, B: other.Amount})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the DepositMessage object following the Beacon API conventions
+func (d *DepositMessage) MarshalJSON() ([]byte, error) {
+	if d == nil {
+		d = new(DepositMessage)
+	}
+	dst := []byte{'{'}
+
+	// Field (0) 'Pubkey'
+	dst = append(dst, "\"pubkey\":"...)
+	dst = ssz.MarshalJSONBytes(dst, d.Pubkey)
+
+	// Field (1) 'WithdrawalCredentials'
+	dst = append(dst, ",\"withdrawal_credentials\":"...)
+	dst = ssz.MarshalJSONBytes(dst, d.WithdrawalCredentials)
+
+	// Field (2) 'Amount'
+	dst = append(dst, ",\"amount\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(d.Amount))
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the DepositMessage object following the Beacon API conventions
+func (d *DepositMessage) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Pubkey'
+	if d.Pubkey, err = ssz.UnmarshalJSONBytes(fields["pubkey"], 48, 48); err != nil {
+		return ssz.WrapJSONError(err, "Pubkey")
+	}
+
+	// Field (1) 'WithdrawalCredentials'
+	if d.WithdrawalCredentials, err = ssz.UnmarshalJSONBytes(fields["withdrawal_credentials"], 32, 32); err != nil {
+		return ssz.WrapJSONError(err, "WithdrawalCredentials")
+	}
+
+	// Field (2) 'Amount'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["amount"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Amount")
+		}
+		d.Amount = uint64(val)
+	}
+
+	return nil
+}
+
 // MarshalSSZ ssz marshals the IndexedAttestation object
 func (i *IndexedAttestation) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(i)
@@ -756,12 +2918,61 @@ func (i *IndexedAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the IndexedAttestation object to a writer
+func (i *IndexedAttestation) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, i)
+}
+
+// MarshalSSZToEncoder ssz marshals the IndexedAttestation object to an encoder
+func (i *IndexedAttestation) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(228)
+
+	// Offset (0) 'AttestationIndices'
+	enc.EncodeOffset(offset)
+	offset += len(i.AttestationIndices) * 8
+
+	// Field (1) 'Data'
+	if i.Data == nil {
+		i.Data = new(AttestationData)
+	}
+	if err = enc.EncodeObject(i.Data); err != nil {
+		return
+	}
+
+	// Field (2) 'Signature'
+	if size := len(i.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	enc.EncodeBytes(i.Signature)
+
+	// Field (0) 'AttestationIndices'
+	if size := len(i.AttestationIndices); size > 2048 {
+		err = ssz.ErrListTooBigFn("--.AttestationIndices", size, 2048)
+		return
+	}
+	for ii := 0; ii < len(i.AttestationIndices); ii++ {
+		enc.EncodeUint64(i.AttestationIndices[ii])
+	}
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the IndexedAttestation object
 func (i *IndexedAttestation) UnmarshalSSZ(buf []byte) error {
+	return i.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the IndexedAttestation object within the limits of the decode state
+func (i *IndexedAttestation) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
 	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "IndexedAttestation", "", 0)
+	}
+	defer st.Leave()
 	size := uint64(len(buf))
 	if size < 228 {
-		return ssz.ErrSize
+		return ssz.NewDecodeError(ssz.ErrSize, "IndexedAttestation", 228, size)
 	}
 
 	tail := buf
@@ -769,19 +2980,19 @@ func (i *IndexedAttestation) UnmarshalSSZ(buf []byte) error {
 
 	// Offset (0) 'AttestationIndices'
 	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
+		return ssz.WrapDecodeError(ssz.ErrOffset, "IndexedAttestation", "AttestationIndices", 0)
 	}
 
-	if o0 < 228 {
-		return ssz.ErrInvalidVariableOffset
+	if o0 != 228 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 228, o0), "IndexedAttestation", "AttestationIndices", 0)
 	}
 
 	// Field (1) 'Data'
 	if i.Data == nil {
 		i.Data = new(AttestationData)
 	}
-	if err = i.Data.UnmarshalSSZ(buf[4:132]); err != nil {
-		return err
+	if err = ssz.UnmarshalWithState(i.Data, buf[4:132], st); err != nil {
+		return ssz.WrapDecodeError(err, "IndexedAttestation", "Data", 4)
 	}
 
 	// Field (2) 'Signature'
@@ -795,9 +3006,11 @@ func (i *IndexedAttestation) UnmarshalSSZ(buf []byte) error {
 		buf = tail[o0:]
 		num, err := ssz.DivideInt2(len(buf), 8, 2048)
 		if err != nil {
-			return err
+			return ssz.WrapDecodeError(err, "IndexedAttestation", "AttestationIndices", o0)
+		}
+		if i.AttestationIndices, err = st.ExtendUint64(i.AttestationIndices, num); err != nil {
+			return ssz.WrapDecodeError(err, "IndexedAttestation", "AttestationIndices", o0)
 		}
-		i.AttestationIndices = ssz.ExtendUint64(i.AttestationIndices, num)
 		for ii := 0; ii < num; ii++ {
 			i.AttestationIndices[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
 		}
@@ -805,6 +3018,106 @@ func (i *IndexedAttestation) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// ValidateSSZ checks that buf is a valid SSZ encoding of a IndexedAttestation object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (i *IndexedAttestation) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 228 {
+		return ssz.NewDecodeError(ssz.ErrSize, "IndexedAttestation", 228, size)
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'AttestationIndices'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "IndexedAttestation", "AttestationIndices", 0)
+	}
+
+	if o0 != 228 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 228, o0), "IndexedAttestation", "AttestationIndices", 0)
+	}
+
+	// Field (1) 'Data'
+	if err = (*AttestationData)(nil).ValidateSSZ(buf[4:132]); err != nil {
+		return ssz.WrapDecodeError(err, "IndexedAttestation", "Data", 4)
+	}
+
+	// Field (0) 'AttestationIndices'
+	{
+		buf = tail[o0:]
+		if _, err = ssz.DivideInt2(len(buf), 8, 2048); err != nil {
+			return ssz.WrapDecodeError(err, "IndexedAttestation", "AttestationIndices", o0)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the IndexedAttestation object from a reader with an encoded size of 'size' bytes
+func (i *IndexedAttestation) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, i)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the IndexedAttestation object from a decoder
+func (i *IndexedAttestation) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 228 {
+		return ssz.NewDecodeError(ssz.ErrSize, "IndexedAttestation", 228, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "IndexedAttestation", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(228)
+	if err != nil {
+		return err
+	}
+	var o0 uint64
+
+	// Offset (0) 'AttestationIndices'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "IndexedAttestation", "AttestationIndices", 0)
+	}
+
+	if o0 != 228 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 228, o0), "IndexedAttestation", "AttestationIndices", 0)
+	}
+
+	// Field (1) 'Data'
+	if i.Data == nil {
+		i.Data = new(AttestationData)
+	}
+	if err = ssz.UnmarshalWithState(i.Data, buf[4:132], st); err != nil {
+		return ssz.WrapDecodeError(err, "IndexedAttestation", "Data", 4)
+	}
+
+	// Field (2) 'Signature'
+	if cap(i.Signature) == 0 {
+		i.Signature = make([]byte, 0, len(buf[132:228]))
+	}
+	i.Signature = append(i.Signature, buf[132:228]...)
+
+	// Field (0) 'AttestationIndices'
+	{
+		num, err := ssz.DivideInt2(int(size-o0), 8, 2048)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "IndexedAttestation", "AttestationIndices", o0)
+		}
+		if i.AttestationIndices, err = st.ExtendUint64(i.AttestationIndices, num); err != nil {
+			return ssz.WrapDecodeError(err, "IndexedAttestation", "AttestationIndices", o0)
+		}
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(8); err != nil {
+				return ssz.WrapDecodeError(err, "IndexedAttestation", "AttestationIndices", o0)
+			}
+			i.AttestationIndices[ii] = ssz.UnmarshallUint64(buf)
+		}
+	}
+	return nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the IndexedAttestation object
 func (i *IndexedAttestation) SizeSSZ() (size int) {
 	size = 228
@@ -856,30 +3169,282 @@ func (i *IndexedAttestation) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the PendingAttestation object
-func (p *PendingAttestation) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(p)
+// HashTreeRootParallel ssz hashes the IndexedAttestation object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (i *IndexedAttestation) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return i.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
 }
 
-// MarshalSSZTo ssz marshals the PendingAttestation object to a target array
-func (p *PendingAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(148)
+// HashTreeRootParallelWithPool ssz hashes the IndexedAttestation object like HashTreeRootParallel
+// with the Hashers of the pool
+func (i *IndexedAttestation) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'AttestationIndices'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				if size := len(i.AttestationIndices); size > 2048 {
+					err = ssz.ErrListTooBigFn("--.AttestationIndices", size, 2048)
+					return
+				}
+				subIndx := hh.Index()
+				for _, i := range i.AttestationIndices {
+					hh.AppendUint64(i)
+				}
+				hh.FillUpTo32()
+
+				numItems := uint64(len(i.AttestationIndices))
+				hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(2048, numItems, 8))
+			}
+			return
+		},
+		// Field (1) 'Data'
+		func(hh *ssz.Hasher) (err error) {
+			if err = i.Data.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (2) 'Signature'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(i.Signature); size != 96 {
+				err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+				return
+			}
+			hh.PutBytes(i.Signature)
+			return
+		},
+	})
+}
 
-	// Offset (0) 'AggregationBits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(p.AggregationBits)
+// IndexedAttestationView is a lazy view over the SSZ encoding of a IndexedAttestation object
+type IndexedAttestationView struct {
+	v ssz.View
+}
 
-	// Field (1) 'Data'
-	if p.Data == nil {
-		p.Data = new(AttestationData)
-	}
-	if dst, err = p.Data.MarshalSSZTo(dst); err != nil {
-		return
-	}
+// NewIndexedAttestationView creates a view over the SSZ encoding of a IndexedAttestation object
+func NewIndexedAttestationView(buf []byte) IndexedAttestationView {
+	return IndexedAttestationViewOf(ssz.NewView(buf))
+}
 
-	// Field (2) 'InclusionDelay'
-	dst = ssz.MarshalUint64(dst, p.InclusionDelay)
+// IndexedAttestationViewOf returns the view of the IndexedAttestation object encoded in v
+func IndexedAttestationViewOf(v ssz.View) IndexedAttestationView {
+	return IndexedAttestationView{v.Container(228, 0)}
+}
+
+// Raw returns the untyped view of the IndexedAttestation object
+func (v IndexedAttestationView) Raw() ssz.View {
+	return v.v
+}
+
+// IndexedAttestationListView is a lazy view over a list of IndexedAttestation objects
+type IndexedAttestationListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th IndexedAttestation object of the list
+func (l IndexedAttestationListView) At(i int) IndexedAttestationView {
+	return IndexedAttestationViewOf(l.ListView.At(i))
+}
+
+// AttestationIndices returns the AttestationIndices field of the IndexedAttestation object
+func (v IndexedAttestationView) AttestationIndices() ssz.ListView {
+	return v.v.DynamicField(0, 0).List(8, 2048)
+}
+
+// Data returns the Data field of the IndexedAttestation object
+func (v IndexedAttestationView) Data() AttestationDataView {
+	return AttestationDataViewOf(v.v.Field(4, 132))
+}
+
+// Signature returns the Signature field of the IndexedAttestation object
+func (v IndexedAttestationView) Signature() ([]byte, error) {
+	return v.v.Field(132, 228).Bytes()
+}
+
+// Copy returns a deep copy of the IndexedAttestation object
+func (i *IndexedAttestation) Copy() *IndexedAttestation {
+	if i == nil {
+		return nil
+	}
+	cpy := new(IndexedAttestation)
+	*cpy = *i
+
+	// Field 'AttestationIndices'
+	cpy.AttestationIndices = append(cpy.AttestationIndices[:0:0], cpy.AttestationIndices...)
+
+	// Field 'Data'
+	cpy.Data = cpy.Data.Copy()
+
+	// Field 'Signature'
+	cpy.Signature = append(cpy.Signature[:0:0], cpy.Signature...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the IndexedAttestation objects have the same SSZ encoding
+func (i *IndexedAttestation) EqualSSZ(other *IndexedAttestation) bool {
+	if i == nil {
+		i = new(IndexedAttestation)
+	}
+	if other == nil {
+		other = new(IndexedAttestation)
+	}
+
+	// Field 'AttestationIndices'
+	if len(i.AttestationIndices) != len(other.AttestationIndices) {
+		return false
+	} else {
+		for i0 := range i.AttestationIndices {
+			if i.AttestationIndices[i0] != other.AttestationIndices[i0] {
+				return false
+			}
+		}
+	}
+
+	// Field 'Data'
+	if !i.Data.EqualSSZ(other.Data) {
+		return false
+	}
+
+	// Field 'Signature'
+	if !bytes.Equal(i.Signature, other.Signature) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the IndexedAttestation objects
+func (i *IndexedAttestation) DiffSSZ(other *IndexedAttestation) []ssz.FieldDiff {
+	if i == nil {
+		i = new(IndexedAttestation)
+	}
+	if other == nil {
+		other = new(IndexedAttestation)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'AttestationIndices'
+	if len(i.AttestationIndices) != len(other.AttestationIndices) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "AttestationIndices", A: i.AttestationIndices, B: other.AttestationIndices})
+	} else {
+		for i0 := range i.AttestationIndices {
+			if i.AttestationIndices[i0] != other.AttestationIndices[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("AttestationIndices", i0), A: i.AttestationIndices[i0], B: other.AttestationIndices[i0]})
+			}
+		}
+	}
+
+	// Field 'Data'
+	diffs = ssz.AppendDiffs(diffs, "Data", i.Data.DiffSSZ(other.Data))
+
+	// Field 'Signature'
+	if !bytes.Equal(i.Signature, other.Signature) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Signature", A: i.Signature, B: other.Signature})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the IndexedAttestation object following the Beacon API conventions
+func (i *IndexedAttestation) MarshalJSON() ([]byte, error) {
+	if i == nil {
+		i = new(IndexedAttestation)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'AttestationIndices'
+	dst = append(dst, "\"attesting_indices\":"...)
+	dst = append(dst, '[')
+	for i0 := range i.AttestationIndices {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONUint64(dst, uint64(i.AttestationIndices[i0]))
+	}
+	dst = append(dst, ']')
+
+	// Field (1) 'Data'
+	dst = append(dst, ",\"data\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, i.Data); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Signature'
+	dst = append(dst, ",\"signature\":"...)
+	dst = ssz.MarshalJSONBytes(dst, i.Signature)
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the IndexedAttestation object following the Beacon API conventions
+func (i *IndexedAttestation) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'AttestationIndices'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["attesting_indices"], 0, 2048)
+		if err != nil {
+			return ssz.WrapJSONError(err, "AttestationIndices")
+		}
+		i.AttestationIndices = make([]uint64, len(items0))
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONUint64(items0[i0], 8)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("AttestationIndices", i0))
+				}
+				i.AttestationIndices[i0] = uint64(val)
+			}
+		}
+	}
+
+	// Field (1) 'Data'
+	if i.Data == nil {
+		i.Data = new(AttestationData)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["data"], i.Data); err != nil {
+		return ssz.WrapJSONError(err, "Data")
+	}
+
+	// Field (2) 'Signature'
+	if i.Signature, err = ssz.UnmarshalJSONBytes(fields["signature"], 96, 96); err != nil {
+		return ssz.WrapJSONError(err, "Signature")
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the PendingAttestation object
+func (p *PendingAttestation) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the PendingAttestation object to a target array
+func (p *PendingAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(148)
+
+	// Offset (0) 'AggregationBits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.AggregationBits)
+
+	// Field (1) 'Data'
+	if p.Data == nil {
+		p.Data = new(AttestationData)
+	}
+	if dst, err = p.Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'InclusionDelay'
+	dst = ssz.MarshalUint64(dst, p.InclusionDelay)
 
 	// Field (3) 'ProposerIndex'
 	dst = ssz.MarshalUint64(dst, p.ProposerIndex)
@@ -894,12 +3459,58 @@ func (p *PendingAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the PendingAttestation object to a writer
+func (p *PendingAttestation) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, p)
+}
+
+// MarshalSSZToEncoder ssz marshals the PendingAttestation object to an encoder
+func (p *PendingAttestation) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(148)
+
+	// Offset (0) 'AggregationBits'
+	enc.EncodeOffset(offset)
+	offset += len(p.AggregationBits)
+
+	// Field (1) 'Data'
+	if p.Data == nil {
+		p.Data = new(AttestationData)
+	}
+	if err = enc.EncodeObject(p.Data); err != nil {
+		return
+	}
+
+	// Field (2) 'InclusionDelay'
+	enc.EncodeUint64(p.InclusionDelay)
+
+	// Field (3) 'ProposerIndex'
+	enc.EncodeUint64(p.ProposerIndex)
+
+	// Field (0) 'AggregationBits'
+	if size := len(p.AggregationBits); size > 2048 {
+		err = ssz.ErrBytesLengthFn("--.AggregationBits", size, 2048)
+		return
+	}
+	enc.EncodeBytes(p.AggregationBits)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the PendingAttestation object
 func (p *PendingAttestation) UnmarshalSSZ(buf []byte) error {
+	return p.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the PendingAttestation object within the limits of the decode state
+func (p *PendingAttestation) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
 	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "PendingAttestation", "", 0)
+	}
+	defer st.Leave()
 	size := uint64(len(buf))
 	if size < 148 {
-		return ssz.ErrSize
+		return ssz.NewDecodeError(ssz.ErrSize, "PendingAttestation", 148, size)
 	}
 
 	tail := buf
@@ -907,19 +3518,19 @@ func (p *PendingAttestation) UnmarshalSSZ(buf []byte) error {
 
 	// Offset (0) 'AggregationBits'
 	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
+		return ssz.WrapDecodeError(ssz.ErrOffset, "PendingAttestation", "AggregationBits", 0)
 	}
 
-	if o0 < 148 {
-		return ssz.ErrInvalidVariableOffset
+	if o0 != 148 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 148, o0), "PendingAttestation", "AggregationBits", 0)
 	}
 
 	// Field (1) 'Data'
 	if p.Data == nil {
 		p.Data = new(AttestationData)
 	}
-	if err = p.Data.UnmarshalSSZ(buf[4:132]); err != nil {
-		return err
+	if err = ssz.UnmarshalWithState(p.Data, buf[4:132], st); err != nil {
+		return ssz.WrapDecodeError(err, "PendingAttestation", "Data", 4)
 	}
 
 	// Field (2) 'InclusionDelay'
@@ -932,7 +3543,7 @@ func (p *PendingAttestation) UnmarshalSSZ(buf []byte) error {
 	{
 		buf = tail[o0:]
 		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
-			return err
+			return ssz.WrapDecodeError(err, "PendingAttestation", "AggregationBits", o0)
 		}
 		if cap(p.AggregationBits) == 0 {
 			p.AggregationBits = make([]byte, 0, len(buf))
@@ -942,6 +3553,103 @@ func (p *PendingAttestation) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// ValidateSSZ checks that buf is a valid SSZ encoding of a PendingAttestation object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (p *PendingAttestation) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 148 {
+		return ssz.NewDecodeError(ssz.ErrSize, "PendingAttestation", 148, size)
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'AggregationBits'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "PendingAttestation", "AggregationBits", 0)
+	}
+
+	if o0 != 148 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 148, o0), "PendingAttestation", "AggregationBits", 0)
+	}
+
+	// Field (1) 'Data'
+	if err = (*AttestationData)(nil).ValidateSSZ(buf[4:132]); err != nil {
+		return ssz.WrapDecodeError(err, "PendingAttestation", "Data", 4)
+	}
+
+	// Field (0) 'AggregationBits'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return ssz.WrapDecodeError(err, "PendingAttestation", "AggregationBits", o0)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the PendingAttestation object from a reader with an encoded size of 'size' bytes
+func (p *PendingAttestation) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, p)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the PendingAttestation object from a decoder
+func (p *PendingAttestation) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 148 {
+		return ssz.NewDecodeError(ssz.ErrSize, "PendingAttestation", 148, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "PendingAttestation", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(148)
+	if err != nil {
+		return err
+	}
+	var o0 uint64
+
+	// Offset (0) 'AggregationBits'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "PendingAttestation", "AggregationBits", 0)
+	}
+
+	if o0 != 148 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 148, o0), "PendingAttestation", "AggregationBits", 0)
+	}
+
+	// Field (1) 'Data'
+	if p.Data == nil {
+		p.Data = new(AttestationData)
+	}
+	if err = ssz.UnmarshalWithState(p.Data, buf[4:132], st); err != nil {
+		return ssz.WrapDecodeError(err, "PendingAttestation", "Data", 4)
+	}
+
+	// Field (2) 'InclusionDelay'
+	p.InclusionDelay = ssz.UnmarshallUint64(buf[132:140])
+
+	// Field (3) 'ProposerIndex'
+	p.ProposerIndex = ssz.UnmarshallUint64(buf[140:148])
+
+	// Field (0) 'AggregationBits'
+	{
+		if buf, err = dec.Read(size - o0); err != nil {
+			return ssz.WrapDecodeError(err, "PendingAttestation", "AggregationBits", o0)
+		}
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return ssz.WrapDecodeError(err, "PendingAttestation", "AggregationBits", o0)
+		}
+		if cap(p.AggregationBits) == 0 {
+			p.AggregationBits = make([]byte, 0, len(buf))
+		}
+		p.AggregationBits = append(p.AggregationBits, buf...)
+	}
+	return nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the PendingAttestation object
 func (p *PendingAttestation) SizeSSZ() (size int) {
 	size = 148
@@ -983,2793 +3691,3375 @@ func (p *PendingAttestation) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the Fork object
-func (f *Fork) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(f)
+// HashTreeRootParallel ssz hashes the PendingAttestation object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (p *PendingAttestation) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return p.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
 }
 
-// MarshalSSZTo ssz marshals the Fork object to a target array
-func (f *Fork) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// HashTreeRootParallelWithPool ssz hashes the PendingAttestation object like HashTreeRootParallel
+// with the Hashers of the pool
+func (p *PendingAttestation) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'AggregationBits'
+		func(hh *ssz.Hasher) (err error) {
+			if len(p.AggregationBits) == 0 {
+				err = ssz.ErrEmptyBitlist
+				return
+			}
+			hh.PutBitlist(p.AggregationBits, 2048)
 
-	// Field (0) 'PreviousVersion'
-	if size := len(f.PreviousVersion); size != 4 {
-		err = ssz.ErrBytesLengthFn("--.PreviousVersion", size, 4)
-		return
-	}
-	dst = append(dst, f.PreviousVersion...)
+			return
+		},
+		// Field (1) 'Data'
+		func(hh *ssz.Hasher) (err error) {
+			if err = p.Data.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (2) 'InclusionDelay'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(p.InclusionDelay)
+			return
+		},
+		// Field (3) 'ProposerIndex'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(p.ProposerIndex)
+			return
+		},
+	})
+}
 
-	// Field (1) 'CurrentVersion'
-	if size := len(f.CurrentVersion); size != 4 {
-		err = ssz.ErrBytesLengthFn("--.CurrentVersion", size, 4)
-		return
-	}
-	dst = append(dst, f.CurrentVersion...)
+// PendingAttestationView is a lazy view over the SSZ encoding of a PendingAttestation object
+type PendingAttestationView struct {
+	v ssz.View
+}
 
-	// Field (2) 'Epoch'
-	dst = ssz.MarshalUint64(dst, f.Epoch)
+// NewPendingAttestationView creates a view over the SSZ encoding of a PendingAttestation object
+func NewPendingAttestationView(buf []byte) PendingAttestationView {
+	return PendingAttestationViewOf(ssz.NewView(buf))
+}
 
-	return
+// PendingAttestationViewOf returns the view of the PendingAttestation object encoded in v
+func PendingAttestationViewOf(v ssz.View) PendingAttestationView {
+	return PendingAttestationView{v.Container(148, 0)}
 }
 
-// UnmarshalSSZ ssz unmarshals the Fork object
-func (f *Fork) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 16 {
-		return ssz.ErrSize
-	}
+// Raw returns the untyped view of the PendingAttestation object
+func (v PendingAttestationView) Raw() ssz.View {
+	return v.v
+}
 
-	// Field (0) 'PreviousVersion'
-	if cap(f.PreviousVersion) == 0 {
-		f.PreviousVersion = make([]byte, 0, len(buf[0:4]))
-	}
-	f.PreviousVersion = append(f.PreviousVersion, buf[0:4]...)
+// PendingAttestationListView is a lazy view over a list of PendingAttestation objects
+type PendingAttestationListView struct {
+	ssz.ListView
+}
 
-	// Field (1) 'CurrentVersion'
-	if cap(f.CurrentVersion) == 0 {
-		f.CurrentVersion = make([]byte, 0, len(buf[4:8]))
-	}
-	f.CurrentVersion = append(f.CurrentVersion, buf[4:8]...)
+// At returns the view of the i-th PendingAttestation object of the list
+func (l PendingAttestationListView) At(i int) PendingAttestationView {
+	return PendingAttestationViewOf(l.ListView.At(i))
+}
 
-	// Field (2) 'Epoch'
-	f.Epoch = ssz.UnmarshallUint64(buf[8:16])
+// AggregationBits returns the AggregationBits field of the PendingAttestation object
+func (v PendingAttestationView) AggregationBits() ([]byte, error) {
+	return v.v.DynamicField(0, 0).Bitlist(2048)
+}
 
-	return err
+// Data returns the Data field of the PendingAttestation object
+func (v PendingAttestationView) Data() AttestationDataView {
+	return AttestationDataViewOf(v.v.Field(4, 132))
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the Fork object
-func (f *Fork) SizeSSZ() (size int) {
-	size = 16
-	return
+// InclusionDelay returns the InclusionDelay field of the PendingAttestation object
+func (v PendingAttestationView) InclusionDelay() (uint64, error) {
+	return v.v.Field(132, 140).Uint64()
 }
 
-// HashTreeRoot ssz hashes the Fork object
-func (f *Fork) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(f)
+// ProposerIndex returns the ProposerIndex field of the PendingAttestation object
+func (v PendingAttestationView) ProposerIndex() (uint64, error) {
+	return v.v.Field(140, 148).Uint64()
 }
 
-// HashTreeRootWith ssz hashes the Fork object with a hasher
-func (f *Fork) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
-
-	// Field (0) 'PreviousVersion'
-	if size := len(f.PreviousVersion); size != 4 {
-		err = ssz.ErrBytesLengthFn("--.PreviousVersion", size, 4)
-		return
+// Copy returns a deep copy of the PendingAttestation object
+func (p *PendingAttestation) Copy() *PendingAttestation {
+	if p == nil {
+		return nil
 	}
-	hh.PutBytes(f.PreviousVersion)
+	cpy := new(PendingAttestation)
+	*cpy = *p
 
-	// Field (1) 'CurrentVersion'
-	if size := len(f.CurrentVersion); size != 4 {
-		err = ssz.ErrBytesLengthFn("--.CurrentVersion", size, 4)
-		return
-	}
-	hh.PutBytes(f.CurrentVersion)
+	// Field 'AggregationBits'
+	cpy.AggregationBits = append(cpy.AggregationBits[:0:0], cpy.AggregationBits...)
 
-	// Field (2) 'Epoch'
-	hh.PutUint64(f.Epoch)
+	// Field 'Data'
+	cpy.Data = cpy.Data.Copy()
 
-	hh.Merkleize(indx)
-	return
+	return cpy
 }
 
-// MarshalSSZ ssz marshals the Validator object
-func (v *Validator) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(v)
-}
+// EqualSSZ returns true if the PendingAttestation objects have the same SSZ encoding
+func (p *PendingAttestation) EqualSSZ(other *PendingAttestation) bool {
+	if p == nil {
+		p = new(PendingAttestation)
+	}
+	if other == nil {
+		other = new(PendingAttestation)
+	}
 
-// MarshalSSZTo ssz marshals the Validator object to a target array
-func (v *Validator) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+	// Field 'AggregationBits'
+	if !bytes.Equal(p.AggregationBits, other.AggregationBits) {
+		return false
+	}
 
-	// Field (0) 'Pubkey'
-	if size := len(v.Pubkey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
-		return
+	// Field 'Data'
+	if !p.Data.EqualSSZ(other.Data) {
+		return false
 	}
-	dst = append(dst, v.Pubkey...)
 
-	// Field (1) 'WithdrawalCredentials'
-	if size := len(v.WithdrawalCredentials); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
-		return
+	// Field 'InclusionDelay'
+	if p.InclusionDelay != other.InclusionDelay {
+		return false
 	}
-	dst = append(dst, v.WithdrawalCredentials...)
 
-	// Field (2) 'EffectiveBalance'
-	dst = ssz.MarshalUint64(dst, v.EffectiveBalance)
+	// Field 'ProposerIndex'
+	if p.ProposerIndex != other.ProposerIndex {
+		return false
+	}
 
-	// Field (3) 'Slashed'
-	dst = ssz.MarshalBool(dst, v.Slashed)
+	return true
+}
 
-	// Field (4) 'ActivationEligibilityEpoch'
-	dst = ssz.MarshalUint64(dst, v.ActivationEligibilityEpoch)
+// DiffSSZ returns the fields with a different SSZ encoding in the PendingAttestation objects
+func (p *PendingAttestation) DiffSSZ(other *PendingAttestation) []ssz.FieldDiff {
+	if p == nil {
+		p = new(PendingAttestation)
+	}
+	if other == nil {
+		other = new(PendingAttestation)
+	}
+	var diffs []ssz.FieldDiff
 
-	// Field (5) 'ActivationEpoch'
-	dst = ssz.MarshalUint64(dst, v.ActivationEpoch)
+	// Field 'AggregationBits'
+	if !bytes.Equal(p.AggregationBits, other.AggregationBits) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "AggregationBits", A: p.AggregationBits, B: other.AggregationBits})
+	}
 
-	// Field (6) 'ExitEpoch'
-	dst = ssz.MarshalUint64(dst, v.ExitEpoch)
+	// Field 'Data'
+	diffs = ssz.AppendDiffs(diffs, "Data", p.Data.DiffSSZ(other.Data))
 
-	// Field (7) 'WithdrawableEpoch'
-	dst = ssz.MarshalUint64(dst, v.WithdrawableEpoch)
+	// Field 'InclusionDelay'
+	if p.InclusionDelay != other.InclusionDelay {
+		diffs = append(diffs, ssz.FieldDiff{Path: "InclusionDelay", A: p.InclusionDelay, B: other.InclusionDelay})
+	}
 
-	return
+	// Field 'ProposerIndex'
+	if p.ProposerIndex != other.ProposerIndex {
+		diffs = append(diffs, ssz.FieldDiff{Path: "ProposerIndex", A: p.ProposerIndex, B: other.ProposerIndex})
+	}
+
+	return diffs
 }
 
-// UnmarshalSSZ ssz unmarshals the Validator object
-func (v *Validator) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 121 {
-		return ssz.ErrSize
+// MarshalJSON returns the JSON encoding of the PendingAttestation object following the Beacon API conventions
+func (p *PendingAttestation) MarshalJSON() ([]byte, error) {
+	if p == nil {
+		p = new(PendingAttestation)
 	}
+	var err error
+	dst := []byte{'{'}
 
-	// Field (0) 'Pubkey'
-	if cap(v.Pubkey) == 0 {
-		v.Pubkey = make([]byte, 0, len(buf[0:48]))
-	}
-	v.Pubkey = append(v.Pubkey, buf[0:48]...)
+	// Field (0) 'AggregationBits'
+	dst = append(dst, "\"aggregation_bits\":"...)
+	dst = ssz.MarshalJSONBytes(dst, p.AggregationBits)
 
-	// Field (1) 'WithdrawalCredentials'
-	if cap(v.WithdrawalCredentials) == 0 {
-		v.WithdrawalCredentials = make([]byte, 0, len(buf[48:80]))
+	// Field (1) 'Data'
+	dst = append(dst, ",\"data\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, p.Data); err != nil {
+		return nil, err
 	}
-	v.WithdrawalCredentials = append(v.WithdrawalCredentials, buf[48:80]...)
 
-	// Field (2) 'EffectiveBalance'
-	v.EffectiveBalance = ssz.UnmarshallUint64(buf[80:88])
+	// Field (2) 'InclusionDelay'
+	dst = append(dst, ",\"inclusion_delay\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(p.InclusionDelay))
 
-	// Field (3) 'Slashed'
-	v.Slashed = ssz.UnmarshalBool(buf[88:89])
+	// Field (3) 'ProposerIndex'
+	dst = append(dst, ",\"proposer_index\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(p.ProposerIndex))
 
-	// Field (4) 'ActivationEligibilityEpoch'
-	v.ActivationEligibilityEpoch = ssz.UnmarshallUint64(buf[89:97])
+	dst = append(dst, '}')
+	return dst, nil
+}
 
-	// Field (5) 'ActivationEpoch'
-	v.ActivationEpoch = ssz.UnmarshallUint64(buf[97:105])
+// UnmarshalJSON decodes the JSON encoding of the PendingAttestation object following the Beacon API conventions
+func (p *PendingAttestation) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
 
-	// Field (6) 'ExitEpoch'
-	v.ExitEpoch = ssz.UnmarshallUint64(buf[105:113])
+	// Field (0) 'AggregationBits'
+	if p.AggregationBits, err = ssz.UnmarshalJSONBitlist(fields["aggregation_bits"], 2048); err != nil {
+		return ssz.WrapJSONError(err, "AggregationBits")
+	}
 
-	// Field (7) 'WithdrawableEpoch'
-	v.WithdrawableEpoch = ssz.UnmarshallUint64(buf[113:121])
+	// Field (1) 'Data'
+	if p.Data == nil {
+		p.Data = new(AttestationData)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["data"], p.Data); err != nil {
+		return ssz.WrapJSONError(err, "Data")
+	}
 
-	return err
-}
+	// Field (2) 'InclusionDelay'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["inclusion_delay"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "InclusionDelay")
+		}
+		p.InclusionDelay = uint64(val)
+	}
 
-// SizeSSZ returns the ssz encoded size in bytes for the Validator object
-func (v *Validator) SizeSSZ() (size int) {
-	size = 121
-	return
+	// Field (3) 'ProposerIndex'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["proposer_index"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "ProposerIndex")
+		}
+		p.ProposerIndex = uint64(val)
+	}
+
+	return nil
 }
 
-// HashTreeRoot ssz hashes the Validator object
-func (v *Validator) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(v)
+// MarshalSSZ ssz marshals the Fork object
+func (f *Fork) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(f)
 }
 
-// HashTreeRootWith ssz hashes the Validator object with a hasher
-func (v *Validator) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+// MarshalSSZTo ssz marshals the Fork object to a target array
+func (f *Fork) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
-	// Field (0) 'Pubkey'
-	if size := len(v.Pubkey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+	// Field (0) 'PreviousVersion'
+	if size := len(f.PreviousVersion); size != 4 {
+		err = ssz.ErrBytesLengthFn("--.PreviousVersion", size, 4)
 		return
 	}
-	hh.PutBytes(v.Pubkey)
+	dst = append(dst, f.PreviousVersion...)
 
-	// Field (1) 'WithdrawalCredentials'
-	if size := len(v.WithdrawalCredentials); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
+	// Field (1) 'CurrentVersion'
+	if size := len(f.CurrentVersion); size != 4 {
+		err = ssz.ErrBytesLengthFn("--.CurrentVersion", size, 4)
 		return
 	}
-	hh.PutBytes(v.WithdrawalCredentials)
+	dst = append(dst, f.CurrentVersion...)
 
-	// Field (2) 'EffectiveBalance'
-	hh.PutUint64(v.EffectiveBalance)
+	// Field (2) 'Epoch'
+	dst = ssz.MarshalUint64(dst, f.Epoch)
 
-	// Field (3) 'Slashed'
-	hh.PutBool(v.Slashed)
+	return
+}
 
-	// Field (4) 'ActivationEligibilityEpoch'
-	hh.PutUint64(v.ActivationEligibilityEpoch)
+// MarshalSSZToWriter ssz marshals the Fork object to a writer
+func (f *Fork) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, f)
+}
 
-	// Field (5) 'ActivationEpoch'
-	hh.PutUint64(v.ActivationEpoch)
+// MarshalSSZToEncoder ssz marshals the Fork object to an encoder
+func (f *Fork) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
 
-	// Field (6) 'ExitEpoch'
-	hh.PutUint64(v.ExitEpoch)
+	// Field (0) 'PreviousVersion'
+	if size := len(f.PreviousVersion); size != 4 {
+		err = ssz.ErrBytesLengthFn("--.PreviousVersion", size, 4)
+		return
+	}
+	enc.EncodeBytes(f.PreviousVersion)
 
-	// Field (7) 'WithdrawableEpoch'
-	hh.PutUint64(v.WithdrawableEpoch)
+	// Field (1) 'CurrentVersion'
+	if size := len(f.CurrentVersion); size != 4 {
+		err = ssz.ErrBytesLengthFn("--.CurrentVersion", size, 4)
+		return
+	}
+	enc.EncodeBytes(f.CurrentVersion)
+
+	// Field (2) 'Epoch'
+	enc.EncodeUint64(f.Epoch)
 
-	hh.Merkleize(indx)
 	return
 }
 
-// MarshalSSZ ssz marshals the VoluntaryExit object
-func (v *VoluntaryExit) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(v)
+// UnmarshalSSZ ssz unmarshals the Fork object
+func (f *Fork) UnmarshalSSZ(buf []byte) error {
+	return f.UnmarshalSSZWithState(buf, nil)
 }
 
-// MarshalSSZTo ssz marshals the VoluntaryExit object to a target array
-func (v *VoluntaryExit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// UnmarshalSSZWithState ssz unmarshals the Fork object within the limits of the decode state
+func (f *Fork) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "Fork", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Fork", 16, size)
+	}
 
-	// Field (0) 'Epoch'
-	dst = ssz.MarshalUint64(dst, v.Epoch)
+	// Field (0) 'PreviousVersion'
+	if cap(f.PreviousVersion) == 0 {
+		f.PreviousVersion = make([]byte, 0, len(buf[0:4]))
+	}
+	f.PreviousVersion = append(f.PreviousVersion, buf[0:4]...)
 
-	// Field (1) 'ValidatorIndex'
-	dst = ssz.MarshalUint64(dst, v.ValidatorIndex)
+	// Field (1) 'CurrentVersion'
+	if cap(f.CurrentVersion) == 0 {
+		f.CurrentVersion = make([]byte, 0, len(buf[4:8]))
+	}
+	f.CurrentVersion = append(f.CurrentVersion, buf[4:8]...)
 
-	return
+	// Field (2) 'Epoch'
+	f.Epoch = ssz.UnmarshallUint64(buf[8:16])
+
+	return err
 }
 
-// UnmarshalSSZ ssz unmarshals the VoluntaryExit object
-func (v *VoluntaryExit) UnmarshalSSZ(buf []byte) error {
+// ValidateSSZ checks that buf is a valid SSZ encoding of a Fork object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (f *Fork) ValidateSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 16 {
-		return ssz.ErrSize
+		return ssz.NewDecodeError(ssz.ErrSize, "Fork", 16, size)
 	}
 
-	// Field (0) 'Epoch'
-	v.Epoch = ssz.UnmarshallUint64(buf[0:8])
+	return err
+}
 
-	// Field (1) 'ValidatorIndex'
-	v.ValidatorIndex = ssz.UnmarshallUint64(buf[8:16])
+// UnmarshalSSZFromReader ssz unmarshals the Fork object from a reader with an encoded size of 'size' bytes
+func (f *Fork) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, f)
+}
 
-	return err
+// UnmarshalSSZFromDecoder ssz unmarshals the Fork object from a decoder
+func (f *Fork) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 16 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Fork", 16, dec.Size())
+	}
+	buf, err := dec.Read(16)
+	if err != nil {
+		return err
+	}
+	return f.UnmarshalSSZWithState(buf, dec.State())
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the VoluntaryExit object
-func (v *VoluntaryExit) SizeSSZ() (size int) {
+// SizeSSZ returns the ssz encoded size in bytes for the Fork object
+func (f *Fork) SizeSSZ() (size int) {
 	size = 16
 	return
 }
 
-// HashTreeRoot ssz hashes the VoluntaryExit object
-func (v *VoluntaryExit) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(v)
+// HashTreeRoot ssz hashes the Fork object
+func (f *Fork) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
 }
 
-// HashTreeRootWith ssz hashes the VoluntaryExit object with a hasher
-func (v *VoluntaryExit) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the Fork object with a hasher
+func (f *Fork) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'Epoch'
-	hh.PutUint64(v.Epoch)
-
-	// Field (1) 'ValidatorIndex'
-	hh.PutUint64(v.ValidatorIndex)
+	// Field (0) 'PreviousVersion'
+	if size := len(f.PreviousVersion); size != 4 {
+		err = ssz.ErrBytesLengthFn("--.PreviousVersion", size, 4)
+		return
+	}
+	hh.PutBytes(f.PreviousVersion)
 
-	hh.Merkleize(indx)
-	return
-}
+	// Field (1) 'CurrentVersion'
+	if size := len(f.CurrentVersion); size != 4 {
+		err = ssz.ErrBytesLengthFn("--.CurrentVersion", size, 4)
+		return
+	}
+	hh.PutBytes(f.CurrentVersion)
 
-// MarshalSSZ ssz marshals the SignedVoluntaryExit object
-func (s *SignedVoluntaryExit) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
+	// Field (2) 'Epoch'
+	hh.PutUint64(f.Epoch)
+
+	hh.Merkleize(indx)
+	return
 }
 
-// MarshalSSZTo ssz marshals the SignedVoluntaryExit object to a target array
-func (s *SignedVoluntaryExit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// HashTreeRootParallel ssz hashes the Fork object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (f *Fork) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return f.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
 
-	// Field (0) 'Exit'
-	if s.Exit == nil {
-		s.Exit = new(VoluntaryExit)
-	}
-	if dst, err = s.Exit.MarshalSSZTo(dst); err != nil {
-		return
-	}
+// HashTreeRootParallelWithPool ssz hashes the Fork object like HashTreeRootParallel
+// with the Hashers of the pool
+func (f *Fork) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'PreviousVersion'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(f.PreviousVersion); size != 4 {
+				err = ssz.ErrBytesLengthFn("--.PreviousVersion", size, 4)
+				return
+			}
+			hh.PutBytes(f.PreviousVersion)
+			return
+		},
+		// Field (1) 'CurrentVersion'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(f.CurrentVersion); size != 4 {
+				err = ssz.ErrBytesLengthFn("--.CurrentVersion", size, 4)
+				return
+			}
+			hh.PutBytes(f.CurrentVersion)
+			return
+		},
+		// Field (2) 'Epoch'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(f.Epoch)
+			return
+		},
+	})
+}
 
-	// Field (1) 'Signature'
-	dst = append(dst, s.Signature[:]...)
+// ForkView is a lazy view over the SSZ encoding of a Fork object
+type ForkView struct {
+	v ssz.View
+}
 
-	return
+// NewForkView creates a view over the SSZ encoding of a Fork object
+func NewForkView(buf []byte) ForkView {
+	return ForkViewOf(ssz.NewView(buf))
 }
 
-// UnmarshalSSZ ssz unmarshals the SignedVoluntaryExit object
-func (s *SignedVoluntaryExit) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 112 {
-		return ssz.ErrSize
-	}
+// ForkViewOf returns the view of the Fork object encoded in v
+func ForkViewOf(v ssz.View) ForkView {
+	return ForkView{v.Container(16, -1)}
+}
 
-	// Field (0) 'Exit'
-	if s.Exit == nil {
-		s.Exit = new(VoluntaryExit)
-	}
-	if err = s.Exit.UnmarshalSSZ(buf[0:16]); err != nil {
-		return err
-	}
+// Raw returns the untyped view of the Fork object
+func (v ForkView) Raw() ssz.View {
+	return v.v
+}
 
-	// Field (1) 'Signature'
-	copy(s.Signature[:], buf[16:112])
+// ForkListView is a lazy view over a list of Fork objects
+type ForkListView struct {
+	ssz.ListView
+}
 
-	return err
+// At returns the view of the i-th Fork object of the list
+func (l ForkListView) At(i int) ForkView {
+	return ForkViewOf(l.ListView.At(i))
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedVoluntaryExit object
-func (s *SignedVoluntaryExit) SizeSSZ() (size int) {
-	size = 112
-	return
+// PreviousVersion returns the PreviousVersion field of the Fork object
+func (v ForkView) PreviousVersion() ([]byte, error) {
+	return v.v.Field(0, 4).Bytes()
 }
 
-// HashTreeRoot ssz hashes the SignedVoluntaryExit object
-func (s *SignedVoluntaryExit) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+// CurrentVersion returns the CurrentVersion field of the Fork object
+func (v ForkView) CurrentVersion() ([]byte, error) {
+	return v.v.Field(4, 8).Bytes()
 }
 
-// HashTreeRootWith ssz hashes the SignedVoluntaryExit object with a hasher
-func (s *SignedVoluntaryExit) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+// Epoch returns the Epoch field of the Fork object
+func (v ForkView) Epoch() (uint64, error) {
+	return v.v.Field(8, 16).Uint64()
+}
 
-	// Field (0) 'Exit'
-	if err = s.Exit.HashTreeRootWith(hh); err != nil {
-		return
+// Copy returns a deep copy of the Fork object
+func (f *Fork) Copy() *Fork {
+	if f == nil {
+		return nil
 	}
+	cpy := new(Fork)
+	*cpy = *f
 
-	// Field (1) 'Signature'
-	hh.PutBytes(s.Signature[:])
+	// Field 'PreviousVersion'
+	cpy.PreviousVersion = append(cpy.PreviousVersion[:0:0], cpy.PreviousVersion...)
 
-	hh.Merkleize(indx)
-	return
-}
+	// Field 'CurrentVersion'
+	cpy.CurrentVersion = append(cpy.CurrentVersion[:0:0], cpy.CurrentVersion...)
 
-// MarshalSSZ ssz marshals the Eth1Block object
-func (e *Eth1Block) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
+	return cpy
 }
 
-// MarshalSSZTo ssz marshals the Eth1Block object to a target array
-func (e *Eth1Block) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// EqualSSZ returns true if the Fork objects have the same SSZ encoding
+func (f *Fork) EqualSSZ(other *Fork) bool {
+	if f == nil {
+		f = new(Fork)
+	}
+	if other == nil {
+		other = new(Fork)
+	}
 
-	// Field (0) 'Timestamp'
-	dst = ssz.MarshalUint64(dst, e.Timestamp)
+	// Field 'PreviousVersion'
+	if !bytes.Equal(f.PreviousVersion, other.PreviousVersion) {
+		return false
+	}
 
-	// Field (1) 'DepositRoot'
-	if size := len(e.DepositRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.DepositRoot", size, 32)
-		return
+	// Field 'CurrentVersion'
+	if !bytes.Equal(f.CurrentVersion, other.CurrentVersion) {
+		return false
 	}
-	dst = append(dst, e.DepositRoot...)
 
-	// Field (2) 'DepositCount'
-	dst = ssz.MarshalUint64(dst, e.DepositCount)
+	// Field 'Epoch'
+	if f.Epoch != other.Epoch {
+		return false
+	}
 
-	return
+	return true
 }
 
-// UnmarshalSSZ ssz unmarshals the Eth1Block object
-func (e *Eth1Block) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 48 {
-		return ssz.ErrSize
+// DiffSSZ returns the fields with a different SSZ encoding in the Fork objects
+func (f *Fork) DiffSSZ(other *Fork) []ssz.FieldDiff {
+	if f == nil {
+		f = new(Fork)
+	}
+	if other == nil {
+		other = new(Fork)
 	}
+	var diffs []ssz.FieldDiff
 
-	// Field (0) 'Timestamp'
-	e.Timestamp = ssz.UnmarshallUint64(buf[0:8])
+	// Field 'PreviousVersion'
+	if !bytes.Equal(f.PreviousVersion, other.PreviousVersion) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "PreviousVersion", A: f.PreviousVersion, B: other.PreviousVersion})
+	}
 
-	// Field (1) 'DepositRoot'
-	if cap(e.DepositRoot) == 0 {
-		e.DepositRoot = make([]byte, 0, len(buf[8:40]))
+	// Field 'CurrentVersion'
+	if !bytes.Equal(f.CurrentVersion, other.CurrentVersion) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "CurrentVersion", A: f.CurrentVersion, B: other.CurrentVersion})
 	}
-	e.DepositRoot = append(e.DepositRoot, buf[8:40]...)
 
-	// Field (2) 'DepositCount'
-	e.DepositCount = ssz.UnmarshallUint64(buf[40:48])
+	// Field 'Epoch'
+	if f.Epoch != other.Epoch {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Epoch", A: f.Epoch, B: other.Epoch})
+	}
 
-	return err
+	return diffs
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the Eth1Block object
-func (e *Eth1Block) SizeSSZ() (size int) {
-	size = 48
-	return
-}
+// MarshalJSON returns the JSON encoding of the Fork object following the Beacon API conventions
+func (f *Fork) MarshalJSON() ([]byte, error) {
+	if f == nil {
+		f = new(Fork)
+	}
+	dst := []byte{'{'}
 
-// HashTreeRoot ssz hashes the Eth1Block object
-func (e *Eth1Block) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(e)
+	// Field (0) 'PreviousVersion'
+	dst = append(dst, "\"previous_version\":"...)
+	dst = ssz.MarshalJSONBytes(dst, f.PreviousVersion)
+
+	// Field (1) 'CurrentVersion'
+	dst = append(dst, ",\"current_version\":"...)
+	dst = ssz.MarshalJSONBytes(dst, f.CurrentVersion)
+
+	// Field (2) 'Epoch'
+	dst = append(dst, ",\"epoch\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(f.Epoch))
+
+	dst = append(dst, '}')
+	return dst, nil
 }
 
-// HashTreeRootWith ssz hashes the Eth1Block object with a hasher
-func (e *Eth1Block) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+// UnmarshalJSON decodes the JSON encoding of the Fork object following the Beacon API conventions
+func (f *Fork) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
 
-	// Field (0) 'Timestamp'
-	hh.PutUint64(e.Timestamp)
+	// Field (0) 'PreviousVersion'
+	if f.PreviousVersion, err = ssz.UnmarshalJSONBytes(fields["previous_version"], 4, 4); err != nil {
+		return ssz.WrapJSONError(err, "PreviousVersion")
+	}
 
-	// Field (1) 'DepositRoot'
-	if size := len(e.DepositRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.DepositRoot", size, 32)
-		return
+	// Field (1) 'CurrentVersion'
+	if f.CurrentVersion, err = ssz.UnmarshalJSONBytes(fields["current_version"], 4, 4); err != nil {
+		return ssz.WrapJSONError(err, "CurrentVersion")
 	}
-	hh.PutBytes(e.DepositRoot)
 
-	// Field (2) 'DepositCount'
-	hh.PutUint64(e.DepositCount)
+	// Field (2) 'Epoch'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["epoch"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Epoch")
+		}
+		f.Epoch = uint64(val)
+	}
 
-	hh.Merkleize(indx)
-	return
+	return nil
 }
 
-// MarshalSSZ ssz marshals the Eth1Data object
-func (e *Eth1Data) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
+// MarshalSSZ ssz marshals the Validator object
+func (v *Validator) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
 }
 
-// MarshalSSZTo ssz marshals the Eth1Data object to a target array
-func (e *Eth1Data) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the Validator object to a target array
+func (v *Validator) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 
-	// Field (0) 'DepositRoot'
-	if size := len(e.DepositRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.DepositRoot", size, 32)
+	// Field (0) 'Pubkey'
+	if size := len(v.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
 		return
 	}
-	dst = append(dst, e.DepositRoot...)
-
-	// Field (1) 'DepositCount'
-	dst = ssz.MarshalUint64(dst, e.DepositCount)
+	dst = append(dst, v.Pubkey...)
 
-	// Field (2) 'BlockHash'
-	if size := len(e.BlockHash); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.BlockHash", size, 32)
+	// Field (1) 'WithdrawalCredentials'
+	if size := len(v.WithdrawalCredentials); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
 		return
 	}
-	dst = append(dst, e.BlockHash...)
+	dst = append(dst, v.WithdrawalCredentials...)
 
-	return
-}
+	// Field (2) 'EffectiveBalance'
+	dst = ssz.MarshalUint64(dst, v.EffectiveBalance)
 
-// UnmarshalSSZ ssz unmarshals the Eth1Data object
-func (e *Eth1Data) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 72 {
-		return ssz.ErrSize
-	}
+	// Field (3) 'Slashed'
+	dst = ssz.MarshalBool(dst, v.Slashed)
 
-	// Field (0) 'DepositRoot'
-	if cap(e.DepositRoot) == 0 {
-		e.DepositRoot = make([]byte, 0, len(buf[0:32]))
-	}
-	e.DepositRoot = append(e.DepositRoot, buf[0:32]...)
+	// Field (4) 'ActivationEligibilityEpoch'
+	dst = ssz.MarshalUint64(dst, v.ActivationEligibilityEpoch)
 
-	// Field (1) 'DepositCount'
-	e.DepositCount = ssz.UnmarshallUint64(buf[32:40])
+	// Field (5) 'ActivationEpoch'
+	dst = ssz.MarshalUint64(dst, v.ActivationEpoch)
 
-	// Field (2) 'BlockHash'
-	if cap(e.BlockHash) == 0 {
-		e.BlockHash = make([]byte, 0, len(buf[40:72]))
-	}
-	e.BlockHash = append(e.BlockHash, buf[40:72]...)
+	// Field (6) 'ExitEpoch'
+	dst = ssz.MarshalUint64(dst, v.ExitEpoch)
 
-	return err
-}
+	// Field (7) 'WithdrawableEpoch'
+	dst = ssz.MarshalUint64(dst, v.WithdrawableEpoch)
 
-// SizeSSZ returns the ssz encoded size in bytes for the Eth1Data object
-func (e *Eth1Data) SizeSSZ() (size int) {
-	size = 72
 	return
 }
 
-// HashTreeRoot ssz hashes the Eth1Data object
-func (e *Eth1Data) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(e)
+// MarshalSSZToWriter ssz marshals the Validator object to a writer
+func (v *Validator) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, v)
 }
 
-// HashTreeRootWith ssz hashes the Eth1Data object with a hasher
-func (e *Eth1Data) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+// MarshalSSZToEncoder ssz marshals the Validator object to an encoder
+func (v *Validator) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
 
-	// Field (0) 'DepositRoot'
-	if size := len(e.DepositRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.DepositRoot", size, 32)
+	// Field (0) 'Pubkey'
+	if size := len(v.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
 		return
 	}
-	hh.PutBytes(e.DepositRoot)
-
-	// Field (1) 'DepositCount'
-	hh.PutUint64(e.DepositCount)
+	enc.EncodeBytes(v.Pubkey)
 
-	// Field (2) 'BlockHash'
-	if size := len(e.BlockHash); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.BlockHash", size, 32)
+	// Field (1) 'WithdrawalCredentials'
+	if size := len(v.WithdrawalCredentials); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
 		return
 	}
-	hh.PutBytes(e.BlockHash)
+	enc.EncodeBytes(v.WithdrawalCredentials)
 
-	hh.Merkleize(indx)
-	return
-}
+	// Field (2) 'EffectiveBalance'
+	enc.EncodeUint64(v.EffectiveBalance)
 
-// MarshalSSZ ssz marshals the SigningRoot object
-func (s *SigningRoot) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
-}
+	// Field (3) 'Slashed'
+	enc.EncodeBool(v.Slashed)
 
-// MarshalSSZTo ssz marshals the SigningRoot object to a target array
-func (s *SigningRoot) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+	// Field (4) 'ActivationEligibilityEpoch'
+	enc.EncodeUint64(v.ActivationEligibilityEpoch)
 
-	// Field (0) 'ObjectRoot'
-	if size := len(s.ObjectRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.ObjectRoot", size, 32)
-		return
-	}
-	dst = append(dst, s.ObjectRoot...)
+	// Field (5) 'ActivationEpoch'
+	enc.EncodeUint64(v.ActivationEpoch)
 
-	// Field (1) 'Domain'
-	if size := len(s.Domain); size != 8 {
-		err = ssz.ErrBytesLengthFn("--.Domain", size, 8)
-		return
-	}
-	dst = append(dst, s.Domain...)
+	// Field (6) 'ExitEpoch'
+	enc.EncodeUint64(v.ExitEpoch)
+
+	// Field (7) 'WithdrawableEpoch'
+	enc.EncodeUint64(v.WithdrawableEpoch)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the SigningRoot object
-func (s *SigningRoot) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the Validator object
+func (v *Validator) UnmarshalSSZ(buf []byte) error {
+	return v.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the Validator object within the limits of the decode state
+func (v *Validator) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
 	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "Validator", "", 0)
+	}
+	defer st.Leave()
 	size := uint64(len(buf))
-	if size != 40 {
-		return ssz.ErrSize
+	if size != 121 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Validator", 121, size)
 	}
 
-	// Field (0) 'ObjectRoot'
-	if cap(s.ObjectRoot) == 0 {
-		s.ObjectRoot = make([]byte, 0, len(buf[0:32]))
+	// Field (0) 'Pubkey'
+	if cap(v.Pubkey) == 0 {
+		v.Pubkey = make([]byte, 0, len(buf[0:48]))
 	}
-	s.ObjectRoot = append(s.ObjectRoot, buf[0:32]...)
+	v.Pubkey = append(v.Pubkey, buf[0:48]...)
 
-	// Field (1) 'Domain'
-	if cap(s.Domain) == 0 {
-		s.Domain = make([]byte, 0, len(buf[32:40]))
+	// Field (1) 'WithdrawalCredentials'
+	if cap(v.WithdrawalCredentials) == 0 {
+		v.WithdrawalCredentials = make([]byte, 0, len(buf[48:80]))
 	}
-	s.Domain = append(s.Domain, buf[32:40]...)
+	v.WithdrawalCredentials = append(v.WithdrawalCredentials, buf[48:80]...)
+
+	// Field (2) 'EffectiveBalance'
+	v.EffectiveBalance = ssz.UnmarshallUint64(buf[80:88])
+
+	// Field (3) 'Slashed'
+	v.Slashed, err = ssz.DecodeBool(buf[88:89])
+	if err != nil {
+		return ssz.WrapDecodeError(err, "Validator", "Slashed", 88)
+	}
+
+	// Field (4) 'ActivationEligibilityEpoch'
+	v.ActivationEligibilityEpoch = ssz.UnmarshallUint64(buf[89:97])
+
+	// Field (5) 'ActivationEpoch'
+	v.ActivationEpoch = ssz.UnmarshallUint64(buf[97:105])
+
+	// Field (6) 'ExitEpoch'
+	v.ExitEpoch = ssz.UnmarshallUint64(buf[105:113])
+
+	// Field (7) 'WithdrawableEpoch'
+	v.WithdrawableEpoch = ssz.UnmarshallUint64(buf[113:121])
 
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SigningRoot object
-func (s *SigningRoot) SizeSSZ() (size int) {
-	size = 40
+// ValidateSSZ checks that buf is a valid SSZ encoding of a Validator object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (v *Validator) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 121 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Validator", 121, size)
+	}
+
+	// Field (3) 'Slashed'
+	if _, err = ssz.DecodeBool(buf[88:89]); err != nil {
+		return ssz.WrapDecodeError(err, "Validator", "Slashed", 88)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the Validator object from a reader with an encoded size of 'size' bytes
+func (v *Validator) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, v)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the Validator object from a decoder
+func (v *Validator) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 121 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Validator", 121, dec.Size())
+	}
+	buf, err := dec.Read(121)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalSSZWithState(buf, dec.State())
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Validator object
+func (v *Validator) SizeSSZ() (size int) {
+	size = 121
 	return
 }
 
-// HashTreeRoot ssz hashes the SigningRoot object
-func (s *SigningRoot) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+// HashTreeRoot ssz hashes the Validator object
+func (v *Validator) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
 }
 
-// HashTreeRootWith ssz hashes the SigningRoot object with a hasher
-func (s *SigningRoot) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the Validator object with a hasher
+func (v *Validator) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'ObjectRoot'
-	if size := len(s.ObjectRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.ObjectRoot", size, 32)
+	// Field (0) 'Pubkey'
+	if size := len(v.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
 		return
 	}
-	hh.PutBytes(s.ObjectRoot)
+	hh.PutBytes(v.Pubkey)
 
-	// Field (1) 'Domain'
-	if size := len(s.Domain); size != 8 {
-		err = ssz.ErrBytesLengthFn("--.Domain", size, 8)
+	// Field (1) 'WithdrawalCredentials'
+	if size := len(v.WithdrawalCredentials); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
 		return
 	}
-	hh.PutBytes(s.Domain)
+	hh.PutBytes(v.WithdrawalCredentials)
+
+	// Field (2) 'EffectiveBalance'
+	hh.PutUint64(v.EffectiveBalance)
+
+	// Field (3) 'Slashed'
+	hh.PutBool(v.Slashed)
+
+	// Field (4) 'ActivationEligibilityEpoch'
+	hh.PutUint64(v.ActivationEligibilityEpoch)
+
+	// Field (5) 'ActivationEpoch'
+	hh.PutUint64(v.ActivationEpoch)
+
+	// Field (6) 'ExitEpoch'
+	hh.PutUint64(v.ExitEpoch)
+
+	// Field (7) 'WithdrawableEpoch'
+	hh.PutUint64(v.WithdrawableEpoch)
 
 	hh.Merkleize(indx)
 	return
 }
 
-// MarshalSSZ ssz marshals the HistoricalBatch object
-func (h *HistoricalBatch) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(h)
+// HashTreeRootParallel ssz hashes the Validator object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (v *Validator) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return v.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
 }
 
-// MarshalSSZTo ssz marshals the HistoricalBatch object to a target array
-func (h *HistoricalBatch) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-
-	// Field (0) 'BlockRoots'
-	for ii := 0; ii < 64; ii++ {
-		dst = append(dst, h.BlockRoots[ii][:]...)
-	}
-
-	// Field (1) 'StateRoots'
-	if size := len(h.StateRoots); size != 64 {
-		err = ssz.ErrVectorLengthFn("--.StateRoots", size, 64)
-		return
-	}
-	for ii := 0; ii < 64; ii++ {
-		if size := len(h.StateRoots[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("--.StateRoots[ii]", size, 32)
+// HashTreeRootParallelWithPool ssz hashes the Validator object like HashTreeRootParallel
+// with the Hashers of the pool
+func (v *Validator) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Pubkey'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(v.Pubkey); size != 48 {
+				err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+				return
+			}
+			hh.PutBytes(v.Pubkey)
 			return
-		}
-		dst = append(dst, h.StateRoots[ii]...)
-	}
+		},
+		// Field (1) 'WithdrawalCredentials'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(v.WithdrawalCredentials); size != 32 {
+				err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
+				return
+			}
+			hh.PutBytes(v.WithdrawalCredentials)
+			return
+		},
+		// Field (2) 'EffectiveBalance'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(v.EffectiveBalance)
+			return
+		},
+		// Field (3) 'Slashed'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutBool(v.Slashed)
+			return
+		},
+		// Field (4) 'ActivationEligibilityEpoch'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(v.ActivationEligibilityEpoch)
+			return
+		},
+		// Field (5) 'ActivationEpoch'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(v.ActivationEpoch)
+			return
+		},
+		// Field (6) 'ExitEpoch'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(v.ExitEpoch)
+			return
+		},
+		// Field (7) 'WithdrawableEpoch'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(v.WithdrawableEpoch)
+			return
+		},
+	})
+}
 
-	return
+// ValidatorView is a lazy view over the SSZ encoding of a Validator object
+type ValidatorView struct {
+	v ssz.View
 }
 
-// UnmarshalSSZ ssz unmarshals the HistoricalBatch object
-func (h *HistoricalBatch) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 4096 {
-		return ssz.ErrSize
-	}
+// NewValidatorView creates a view over the SSZ encoding of a Validator object
+func NewValidatorView(buf []byte) ValidatorView {
+	return ValidatorViewOf(ssz.NewView(buf))
+}
 
-	// Field (0) 'BlockRoots'
+// ValidatorViewOf returns the view of the Validator object encoded in v
+func ValidatorViewOf(v ssz.View) ValidatorView {
+	return ValidatorView{v.Container(121, -1)}
+}
 
-	for ii := 0; ii < 64; ii++ {
-		copy(h.BlockRoots[ii][:], buf[0:2048][ii*32:(ii+1)*32])
-	}
+// Raw returns the untyped view of the Validator object
+func (v ValidatorView) Raw() ssz.View {
+	return v.v
+}
 
-	// Field (1) 'StateRoots'
-	h.StateRoots = make([][]byte, 64)
-	for ii := 0; ii < 64; ii++ {
-		if cap(h.StateRoots[ii]) == 0 {
-			h.StateRoots[ii] = make([]byte, 0, len(buf[2048:4096][ii*32:(ii+1)*32]))
-		}
-		h.StateRoots[ii] = append(h.StateRoots[ii], buf[2048:4096][ii*32:(ii+1)*32]...)
-	}
+// ValidatorListView is a lazy view over a list of Validator objects
+type ValidatorListView struct {
+	ssz.ListView
+}
 
-	return err
+// At returns the view of the i-th Validator object of the list
+func (l ValidatorListView) At(i int) ValidatorView {
+	return ValidatorViewOf(l.ListView.At(i))
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the HistoricalBatch object
-func (h *HistoricalBatch) SizeSSZ() (size int) {
-	size = 4096
-	return
+// Pubkey returns the Pubkey field of the Validator object
+func (v ValidatorView) Pubkey() ([]byte, error) {
+	return v.v.Field(0, 48).Bytes()
 }
 
-// HashTreeRoot ssz hashes the HistoricalBatch object
-func (h *HistoricalBatch) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(h)
+// WithdrawalCredentials returns the WithdrawalCredentials field of the Validator object
+func (v ValidatorView) WithdrawalCredentials() ([]byte, error) {
+	return v.v.Field(48, 80).Bytes()
 }
 
-// HashTreeRootWith ssz hashes the HistoricalBatch object with a hasher
-func (h *HistoricalBatch) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+// EffectiveBalance returns the EffectiveBalance field of the Validator object
+func (v ValidatorView) EffectiveBalance() (uint64, error) {
+	return v.v.Field(80, 88).Uint64()
+}
 
-	// Field (0) 'BlockRoots'
-	{
-		subIndx := hh.Index()
-		for _, i := range h.BlockRoots {
-			hh.Append(i[:])
-		}
-		hh.Merkleize(subIndx)
-	}
+// Slashed returns the Slashed field of the Validator object
+func (v ValidatorView) Slashed() (bool, error) {
+	return v.v.Field(88, 89).Bool()
+}
 
-	// Field (1) 'StateRoots'
-	{
-		if size := len(h.StateRoots); size != 64 {
-			err = ssz.ErrVectorLengthFn("--.StateRoots", size, 64)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range h.StateRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
-	}
+// ActivationEligibilityEpoch returns the ActivationEligibilityEpoch field of the Validator object
+func (v ValidatorView) ActivationEligibilityEpoch() (uint64, error) {
+	return v.v.Field(89, 97).Uint64()
+}
 
-	hh.Merkleize(indx)
-	return
+// ActivationEpoch returns the ActivationEpoch field of the Validator object
+func (v ValidatorView) ActivationEpoch() (uint64, error) {
+	return v.v.Field(97, 105).Uint64()
 }
 
-// MarshalSSZ ssz marshals the ProposerSlashing object
-func (p *ProposerSlashing) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(p)
+// ExitEpoch returns the ExitEpoch field of the Validator object
+func (v ValidatorView) ExitEpoch() (uint64, error) {
+	return v.v.Field(105, 113).Uint64()
 }
 
-// MarshalSSZTo ssz marshals the ProposerSlashing object to a target array
-func (p *ProposerSlashing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// WithdrawableEpoch returns the WithdrawableEpoch field of the Validator object
+func (v ValidatorView) WithdrawableEpoch() (uint64, error) {
+	return v.v.Field(113, 121).Uint64()
+}
 
-	// Field (0) 'Header1'
-	if p.Header1 == nil {
-		p.Header1 = new(SignedBeaconBlockHeader)
-	}
-	if dst, err = p.Header1.MarshalSSZTo(dst); err != nil {
-		return
+// Copy returns a deep copy of the Validator object
+func (v *Validator) Copy() *Validator {
+	if v == nil {
+		return nil
 	}
+	cpy := new(Validator)
+	*cpy = *v
 
-	// Field (1) 'Header2'
-	if p.Header2 == nil {
-		p.Header2 = new(SignedBeaconBlockHeader)
+	// Field 'Pubkey'
+	cpy.Pubkey = append(cpy.Pubkey[:0:0], cpy.Pubkey...)
+
+	// Field 'WithdrawalCredentials'
+	cpy.WithdrawalCredentials = append(cpy.WithdrawalCredentials[:0:0], cpy.WithdrawalCredentials...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the Validator objects have the same SSZ encoding
+func (v *Validator) EqualSSZ(other *Validator) bool {
+	if v == nil {
+		v = new(Validator)
 	}
-	if dst, err = p.Header2.MarshalSSZTo(dst); err != nil {
-		return
+	if other == nil {
+		other = new(Validator)
 	}
 
-	return
-}
+	// Field 'Pubkey'
+	if !bytes.Equal(v.Pubkey, other.Pubkey) {
+		return false
+	}
 
-// UnmarshalSSZ ssz unmarshals the ProposerSlashing object
-func (p *ProposerSlashing) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 416 {
-		return ssz.ErrSize
+	// Field 'WithdrawalCredentials'
+	if !bytes.Equal(v.WithdrawalCredentials, other.WithdrawalCredentials) {
+		return false
 	}
 
-	// Field (0) 'Header1'
-	if p.Header1 == nil {
-		p.Header1 = new(SignedBeaconBlockHeader)
+	// Field 'EffectiveBalance'
+	if v.EffectiveBalance != other.EffectiveBalance {
+		return false
 	}
-	if err = p.Header1.UnmarshalSSZ(buf[0:208]); err != nil {
-		return err
+
+	// Field 'Slashed'
+	if v.Slashed != other.Slashed {
+		return false
 	}
 
-	// Field (1) 'Header2'
-	if p.Header2 == nil {
-		p.Header2 = new(SignedBeaconBlockHeader)
+	// Field 'ActivationEligibilityEpoch'
+	if v.ActivationEligibilityEpoch != other.ActivationEligibilityEpoch {
+		return false
 	}
-	if err = p.Header2.UnmarshalSSZ(buf[208:416]); err != nil {
-		return err
+
+	// Field 'ActivationEpoch'
+	if v.ActivationEpoch != other.ActivationEpoch {
+		return false
 	}
 
-	return err
-}
+	// Field 'ExitEpoch'
+	if v.ExitEpoch != other.ExitEpoch {
+		return false
+	}
 
-// SizeSSZ returns the ssz encoded size in bytes for the ProposerSlashing object
-func (p *ProposerSlashing) SizeSSZ() (size int) {
-	size = 416
-	return
-}
+	// Field 'WithdrawableEpoch'
+	if v.WithdrawableEpoch != other.WithdrawableEpoch {
+		return false
+	}
 
-// HashTreeRoot ssz hashes the ProposerSlashing object
-func (p *ProposerSlashing) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(p)
+	return true
 }
 
-// HashTreeRootWith ssz hashes the ProposerSlashing object with a hasher
-func (p *ProposerSlashing) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
-
-	// Field (0) 'Header1'
-	if err = p.Header1.HashTreeRootWith(hh); err != nil {
-		return
+// DiffSSZ returns the fields with a different SSZ encoding in the Validator objects
+func (v *Validator) DiffSSZ(other *Validator) []ssz.FieldDiff {
+	if v == nil {
+		v = new(Validator)
 	}
+	if other == nil {
+		other = new(Validator)
+	}
+	var diffs []ssz.FieldDiff
 
-	// Field (1) 'Header2'
-	if err = p.Header2.HashTreeRootWith(hh); err != nil {
-		return
+	// Field 'Pubkey'
+	if !bytes.Equal(v.Pubkey, other.Pubkey) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Pubkey", A: v.Pubkey, B: other.Pubkey})
 	}
 
-	hh.Merkleize(indx)
-	return
-}
+	// Field 'WithdrawalCredentials'
+	if !bytes.Equal(v.WithdrawalCredentials, other.WithdrawalCredentials) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "WithdrawalCredentials", A: v.WithdrawalCredentials, B: other.WithdrawalCredentials})
+	}
 
-// MarshalSSZ ssz marshals the AttesterSlashing object
-func (a *AttesterSlashing) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(a)
-}
+	// Field 'EffectiveBalance'
+	if v.EffectiveBalance != other.EffectiveBalance {
+		diffs = append(diffs, ssz.FieldDiff{Path: "EffectiveBalance", A: v.EffectiveBalance, B: other.EffectiveBalance})
+	}
 
-// MarshalSSZTo ssz marshals the AttesterSlashing object to a target array
-func (a *AttesterSlashing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(8)
+	// Field 'Slashed'
+	if v.Slashed != other.Slashed {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Slashed", A: v.Slashed, B: other.Slashed})
+	}
 
-	// Offset (0) 'Attestation1'
-	dst = ssz.WriteOffset(dst, offset)
-	if a.Attestation1 == nil {
-		a.Attestation1 = new(IndexedAttestation)
+	// Field 'ActivationEligibilityEpoch'
+	if v.ActivationEligibilityEpoch != other.ActivationEligibilityEpoch {
+		diffs = append(diffs, ssz.FieldDiff{Path: "ActivationEligibilityEpoch", A: v.ActivationEligibilityEpoch, B: other.ActivationEligibilityEpoch})
 	}
-	offset += a.Attestation1.SizeSSZ()
 
-	// Offset (1) 'Attestation2'
-	dst = ssz.WriteOffset(dst, offset)
-	if a.Attestation2 == nil {
-		a.Attestation2 = new(IndexedAttestation)
+	// Field 'ActivationEpoch'
+	if v.ActivationEpoch != other.ActivationEpoch {
+		diffs = append(diffs, ssz.FieldDiff{Path: "ActivationEpoch", A: v.ActivationEpoch, B: other.ActivationEpoch})
 	}
-	offset += a.Attestation2.SizeSSZ()
 
-	// Field (0) 'Attestation1'
-	if dst, err = a.Attestation1.MarshalSSZTo(dst); err != nil {
-		return
+	// Field 'ExitEpoch'
+	if v.ExitEpoch != other.ExitEpoch {
+		diffs = append(diffs, ssz.FieldDiff{Path: "ExitEpoch", A: v.ExitEpoch, B: other.ExitEpoch})
 	}
 
-	// Field (1) 'Attestation2'
-	if dst, err = a.Attestation2.MarshalSSZTo(dst); err != nil {
-		return
+	// Field 'WithdrawableEpoch'
+	if v.WithdrawableEpoch != other.WithdrawableEpoch {
+		diffs = append(diffs, ssz.FieldDiff{Path: "WithdrawableEpoch", A: v.WithdrawableEpoch, B: other.WithdrawableEpoch})
 	}
 
-	return
+	return diffs
 }
 
-// UnmarshalSSZ ssz unmarshals the AttesterSlashing object
-func (a *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 8 {
-		return ssz.ErrSize
+// MarshalJSON returns the JSON encoding of the Validator object following the Beacon API conventions
+func (v *Validator) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		v = new(Validator)
 	}
+	dst := []byte{'{'}
 
-	tail := buf
-	var o0, o1 uint64
+	// Field (0) 'Pubkey'
+	dst = append(dst, "\"pubkey\":"...)
+	dst = ssz.MarshalJSONBytes(dst, v.Pubkey)
 
-	// Offset (0) 'Attestation1'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
+	// Field (1) 'WithdrawalCredentials'
+	dst = append(dst, ",\"withdrawal_credentials\":"...)
+	dst = ssz.MarshalJSONBytes(dst, v.WithdrawalCredentials)
+
+	// Field (2) 'EffectiveBalance'
+	dst = append(dst, ",\"effective_balance\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(v.EffectiveBalance))
+
+	// Field (3) 'Slashed'
+	dst = append(dst, ",\"slashed\":"...)
+	dst = ssz.MarshalJSONBool(dst, v.Slashed)
+
+	// Field (4) 'ActivationEligibilityEpoch'
+	dst = append(dst, ",\"activation_eligibility_epoch\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(v.ActivationEligibilityEpoch))
+
+	// Field (5) 'ActivationEpoch'
+	dst = append(dst, ",\"activation_epoch\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(v.ActivationEpoch))
+
+	// Field (6) 'ExitEpoch'
+	dst = append(dst, ",\"exit_epoch\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(v.ExitEpoch))
+
+	// Field (7) 'WithdrawableEpoch'
+	dst = append(dst, ",\"withdrawable_epoch\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(v.WithdrawableEpoch))
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the Validator object following the Beacon API conventions
+func (v *Validator) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
 	}
 
-	if o0 < 8 {
-		return ssz.ErrInvalidVariableOffset
+	// Field (0) 'Pubkey'
+	if v.Pubkey, err = ssz.UnmarshalJSONBytes(fields["pubkey"], 48, 48); err != nil {
+		return ssz.WrapJSONError(err, "Pubkey")
 	}
 
-	// Offset (1) 'Attestation2'
-	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
-		return ssz.ErrOffset
+	// Field (1) 'WithdrawalCredentials'
+	if v.WithdrawalCredentials, err = ssz.UnmarshalJSONBytes(fields["withdrawal_credentials"], 32, 32); err != nil {
+		return ssz.WrapJSONError(err, "WithdrawalCredentials")
 	}
 
-	// Field (0) 'Attestation1'
+	// Field (2) 'EffectiveBalance'
 	{
-		buf = tail[o0:o1]
-		if a.Attestation1 == nil {
-			a.Attestation1 = new(IndexedAttestation)
-		}
-		if err = a.Attestation1.UnmarshalSSZ(buf); err != nil {
-			return err
+		val, err := ssz.UnmarshalJSONUint64(fields["effective_balance"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "EffectiveBalance")
 		}
+		v.EffectiveBalance = uint64(val)
 	}
 
-	// Field (1) 'Attestation2'
+	// Field (3) 'Slashed'
+	if v.Slashed, err = ssz.UnmarshalJSONBool(fields["slashed"]); err != nil {
+		return ssz.WrapJSONError(err, "Slashed")
+	}
+
+	// Field (4) 'ActivationEligibilityEpoch'
 	{
-		buf = tail[o1:]
-		if a.Attestation2 == nil {
-			a.Attestation2 = new(IndexedAttestation)
-		}
-		if err = a.Attestation2.UnmarshalSSZ(buf); err != nil {
-			return err
+		val, err := ssz.UnmarshalJSONUint64(fields["activation_eligibility_epoch"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "ActivationEligibilityEpoch")
 		}
+		v.ActivationEligibilityEpoch = uint64(val)
 	}
-	return err
-}
 
-// SizeSSZ returns the ssz encoded size in bytes for the AttesterSlashing object
-func (a *AttesterSlashing) SizeSSZ() (size int) {
-	size = 8
+	// Field (5) 'ActivationEpoch'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["activation_epoch"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "ActivationEpoch")
+		}
+		v.ActivationEpoch = uint64(val)
+	}
 
-	// Field (0) 'Attestation1'
-	if a.Attestation1 == nil {
-		a.Attestation1 = new(IndexedAttestation)
+	// Field (6) 'ExitEpoch'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["exit_epoch"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "ExitEpoch")
+		}
+		v.ExitEpoch = uint64(val)
 	}
-	size += a.Attestation1.SizeSSZ()
 
-	// Field (1) 'Attestation2'
-	if a.Attestation2 == nil {
-		a.Attestation2 = new(IndexedAttestation)
+	// Field (7) 'WithdrawableEpoch'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["withdrawable_epoch"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "WithdrawableEpoch")
+		}
+		v.WithdrawableEpoch = uint64(val)
 	}
-	size += a.Attestation2.SizeSSZ()
 
-	return
+	return nil
 }
 
-// HashTreeRoot ssz hashes the AttesterSlashing object
-func (a *AttesterSlashing) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(a)
+// MarshalSSZ ssz marshals the VoluntaryExit object
+func (v *VoluntaryExit) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
 }
 
-// HashTreeRootWith ssz hashes the AttesterSlashing object with a hasher
-func (a *AttesterSlashing) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+// MarshalSSZTo ssz marshals the VoluntaryExit object to a target array
+func (v *VoluntaryExit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
-	// Field (0) 'Attestation1'
-	if err = a.Attestation1.HashTreeRootWith(hh); err != nil {
-		return
-	}
+	// Field (0) 'Epoch'
+	dst = ssz.MarshalUint64(dst, v.Epoch)
 
-	// Field (1) 'Attestation2'
-	if err = a.Attestation2.HashTreeRootWith(hh); err != nil {
-		return
-	}
+	// Field (1) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, v.ValidatorIndex)
 
-	hh.Merkleize(indx)
 	return
 }
 
-// MarshalSSZ ssz marshals the BeaconState object
-func (b *BeaconState) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+// MarshalSSZToWriter ssz marshals the VoluntaryExit object to a writer
+func (v *VoluntaryExit) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, v)
 }
 
-// MarshalSSZTo ssz marshals the BeaconState object to a target array
-func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(10325)
+// MarshalSSZToEncoder ssz marshals the VoluntaryExit object to an encoder
+func (v *VoluntaryExit) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
 
-	// Field (0) 'GenesisTime'
-	dst = ssz.MarshalUint64(dst, b.GenesisTime)
+	// Field (0) 'Epoch'
+	enc.EncodeUint64(v.Epoch)
 
-	// Field (1) 'GenesisValidatorsRoot'
-	if size := len(b.GenesisValidatorsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.GenesisValidatorsRoot", size, 32)
-		return
-	}
-	dst = append(dst, b.GenesisValidatorsRoot...)
+	// Field (1) 'ValidatorIndex'
+	enc.EncodeUint64(v.ValidatorIndex)
 
-	// Field (2) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
+	return
+}
 
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
-	}
-	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
-		return
-	}
+// UnmarshalSSZ ssz unmarshals the VoluntaryExit object
+func (v *VoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	return v.UnmarshalSSZWithState(buf, nil)
+}
 
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
+// UnmarshalSSZWithState ssz unmarshals the VoluntaryExit object within the limits of the decode state
+func (v *VoluntaryExit) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "VoluntaryExit", "", 0)
 	}
-	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
-		return
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.NewDecodeError(ssz.ErrSize, "VoluntaryExit", 16, size)
 	}
 
-	// Field (5) 'BlockRoots'
-	for ii := 0; ii < 64; ii++ {
-		dst = append(dst, b.BlockRoots[ii][:]...)
-	}
+	// Field (0) 'Epoch'
+	v.Epoch = ssz.UnmarshallUint64(buf[0:8])
 
-	// Field (6) 'StateRoots'
-	if size := len(b.StateRoots); size != 64 {
-		err = ssz.ErrVectorLengthFn("--.StateRoots", size, 64)
-		return
-	}
-	for ii := 0; ii < 64; ii++ {
-		dst = append(dst, b.StateRoots[ii][:]...)
-	}
+	// Field (1) 'ValidatorIndex'
+	v.ValidatorIndex = ssz.UnmarshallUint64(buf[8:16])
 
-	// Offset (7) 'HistoricalRoots'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.HistoricalRoots) * 32
+	return err
+}
 
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
-		return
+// ValidateSSZ checks that buf is a valid SSZ encoding of a VoluntaryExit object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (v *VoluntaryExit) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.NewDecodeError(ssz.ErrSize, "VoluntaryExit", 16, size)
 	}
 
-	// Offset (9) 'Eth1DataVotes'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Eth1DataVotes) * 72
-
-	// Field (10) 'Eth1DepositIndex'
-	dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
-
-	// Offset (11) 'Validators'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Validators) * 121
+	return err
+}
 
-	// Offset (12) 'Balances'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Balances) * 8
+// UnmarshalSSZFromReader ssz unmarshals the VoluntaryExit object from a reader with an encoded size of 'size' bytes
+func (v *VoluntaryExit) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, v)
+}
 
-	// Field (13) 'RandaoMixes'
-	if size := len(b.RandaoMixes); size != 64 {
-		err = ssz.ErrVectorLengthFn("--.RandaoMixes", size, 64)
-		return
+// UnmarshalSSZFromDecoder ssz unmarshals the VoluntaryExit object from a decoder
+func (v *VoluntaryExit) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 16 {
+		return ssz.NewDecodeError(ssz.ErrSize, "VoluntaryExit", 16, dec.Size())
 	}
-	for ii := 0; ii < 64; ii++ {
-		if size := len(b.RandaoMixes[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("--.RandaoMixes[ii]", size, 32)
-			return
-		}
-		dst = append(dst, b.RandaoMixes[ii]...)
+	buf, err := dec.Read(16)
+	if err != nil {
+		return err
 	}
+	return v.UnmarshalSSZWithState(buf, dec.State())
+}
 
-	// Field (14) 'Slashings'
-	if size := len(b.Slashings); size != 64 {
-		err = ssz.ErrVectorLengthFn("--.Slashings", size, 64)
-		return
-	}
-	for ii := 0; ii < 64; ii++ {
-		dst = ssz.MarshalUint64(dst, b.Slashings[ii])
-	}
+// SizeSSZ returns the ssz encoded size in bytes for the VoluntaryExit object
+func (v *VoluntaryExit) SizeSSZ() (size int) {
+	size = 16
+	return
+}
 
-	// Offset (15) 'PreviousEpochParticipation'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.PreviousEpochParticipation) * 1
+// HashTreeRoot ssz hashes the VoluntaryExit object
+func (v *VoluntaryExit) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
 
-	// Offset (16) 'CurrentEpochParticipation'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.CurrentEpochParticipation) * 1
+// HashTreeRootWith ssz hashes the VoluntaryExit object with a hasher
+func (v *VoluntaryExit) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
 
-	// Field (17) 'JustificationBits'
-	if size := len(b.JustificationBits); size != 1 {
-		err = ssz.ErrBytesLengthFn("--.JustificationBits", size, 1)
-		return
-	}
-	dst = append(dst, b.JustificationBits...)
+	// Field (0) 'Epoch'
+	hh.PutUint64(v.Epoch)
 
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return
+	// Field (1) 'ValidatorIndex'
+	hh.PutUint64(v.ValidatorIndex)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootParallel ssz hashes the VoluntaryExit object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (v *VoluntaryExit) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return v.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the VoluntaryExit object like HashTreeRootParallel
+// with the Hashers of the pool
+func (v *VoluntaryExit) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Epoch'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(v.Epoch)
+			return
+		},
+		// Field (1) 'ValidatorIndex'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(v.ValidatorIndex)
+			return
+		},
+	})
+}
+
+// VoluntaryExitView is a lazy view over the SSZ encoding of a VoluntaryExit object
+type VoluntaryExitView struct {
+	v ssz.View
+}
+
+// NewVoluntaryExitView creates a view over the SSZ encoding of a VoluntaryExit object
+func NewVoluntaryExitView(buf []byte) VoluntaryExitView {
+	return VoluntaryExitViewOf(ssz.NewView(buf))
+}
+
+// VoluntaryExitViewOf returns the view of the VoluntaryExit object encoded in v
+func VoluntaryExitViewOf(v ssz.View) VoluntaryExitView {
+	return VoluntaryExitView{v.Container(16, -1)}
+}
+
+// Raw returns the untyped view of the VoluntaryExit object
+func (v VoluntaryExitView) Raw() ssz.View {
+	return v.v
+}
+
+// VoluntaryExitListView is a lazy view over a list of VoluntaryExit objects
+type VoluntaryExitListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th VoluntaryExit object of the list
+func (l VoluntaryExitListView) At(i int) VoluntaryExitView {
+	return VoluntaryExitViewOf(l.ListView.At(i))
+}
+
+// Epoch returns the Epoch field of the VoluntaryExit object
+func (v VoluntaryExitView) Epoch() (uint64, error) {
+	return v.v.Field(0, 8).Uint64()
+}
+
+// ValidatorIndex returns the ValidatorIndex field of the VoluntaryExit object
+func (v VoluntaryExitView) ValidatorIndex() (uint64, error) {
+	return v.v.Field(8, 16).Uint64()
+}
+
+// Copy returns a deep copy of the VoluntaryExit object
+func (v *VoluntaryExit) Copy() *VoluntaryExit {
+	if v == nil {
+		return nil
 	}
+	cpy := new(VoluntaryExit)
+	*cpy = *v
+	return cpy
+}
 
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+// EqualSSZ returns true if the VoluntaryExit objects have the same SSZ encoding
+func (v *VoluntaryExit) EqualSSZ(other *VoluntaryExit) bool {
+	if v == nil {
+		v = new(VoluntaryExit)
 	}
-	if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return
+	if other == nil {
+		other = new(VoluntaryExit)
 	}
 
-	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
+	// Field 'Epoch'
+	if v.Epoch != other.Epoch {
+		return false
 	}
-	if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return
+
+	// Field 'ValidatorIndex'
+	if v.ValidatorIndex != other.ValidatorIndex {
+		return false
 	}
 
-	// Offset (21) 'InactivityScores'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.InactivityScores) * 8
+	return true
+}
 
-	// Field (22) 'CurrentSyncCommitee'
-	if b.CurrentSyncCommitee == nil {
-		b.CurrentSyncCommitee = new(SyncCommitteeMinimal)
+// DiffSSZ returns the fields with a different SSZ encoding in the VoluntaryExit objects
+func (v *VoluntaryExit) DiffSSZ(other *VoluntaryExit) []ssz.FieldDiff {
+	if v == nil {
+		v = new(VoluntaryExit)
 	}
-	if dst, err = b.CurrentSyncCommitee.MarshalSSZTo(dst); err != nil {
-		return
+	if other == nil {
+		other = new(VoluntaryExit)
 	}
+	var diffs []ssz.FieldDiff
 
-	// Field (23) 'NextSyncCommittee'
-	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommitteeMinimal)
-	}
-	if dst, err = b.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
-		return
+	// Field 'Epoch'
+	if v.Epoch != other.Epoch {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Epoch", A: v.Epoch, B: other.Epoch})
 	}
 
-	// Field (7) 'HistoricalRoots'
-	if size := len(b.HistoricalRoots); size > 16777216 {
-		err = ssz.ErrListTooBigFn("--.HistoricalRoots", size, 16777216)
-		return
+	// Field 'ValidatorIndex'
+	if v.ValidatorIndex != other.ValidatorIndex {
+		diffs = append(diffs, ssz.FieldDiff{Path: "ValidatorIndex", A: v.ValidatorIndex, B: other.ValidatorIndex})
 	}
-	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
-		dst = append(dst, b.HistoricalRoots[ii][:]...)
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the VoluntaryExit object following the Beacon API conventions
+func (v *VoluntaryExit) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		v = new(VoluntaryExit)
 	}
+	dst := []byte{'{'}
 
-	// Field (9) 'Eth1DataVotes'
-	if size := len(b.Eth1DataVotes); size > 32 {
-		err = ssz.ErrListTooBigFn("--.Eth1DataVotes", size, 32)
-		return
+	// Field (0) 'Epoch'
+	dst = append(dst, "\"epoch\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(v.Epoch))
+
+	// Field (1) 'ValidatorIndex'
+	dst = append(dst, ",\"validator_index\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(v.ValidatorIndex))
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the VoluntaryExit object following the Beacon API conventions
+func (v *VoluntaryExit) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
 	}
-	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
-		if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
-			return
+
+	// Field (0) 'Epoch'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["epoch"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Epoch")
 		}
+		v.Epoch = uint64(val)
 	}
 
-	// Field (11) 'Validators'
-	if size := len(b.Validators); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("--.Validators", size, 1099511627776)
-		return
-	}
-	for ii := 0; ii < len(b.Validators); ii++ {
-		if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
-			return
+	// Field (1) 'ValidatorIndex'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["validator_index"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "ValidatorIndex")
 		}
+		v.ValidatorIndex = uint64(val)
 	}
 
-	// Field (12) 'Balances'
-	if size := len(b.Balances); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("--.Balances", size, 1099511627776)
-		return
-	}
-	for ii := 0; ii < len(b.Balances); ii++ {
-		dst = ssz.MarshalUint64(dst, b.Balances[ii])
-	}
+	return nil
+}
 
-	// Field (15) 'PreviousEpochParticipation'
-	if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("--.PreviousEpochParticipation", size, 1099511627776)
-		return
-	}
-	for ii := 0; ii < len(b.PreviousEpochParticipation); ii++ {
-		dst = ssz.MarshalUint8(dst, b.PreviousEpochParticipation[ii])
-	}
+// MarshalSSZ ssz marshals the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
 
-	// Field (16) 'CurrentEpochParticipation'
-	if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("--.CurrentEpochParticipation", size, 1099511627776)
-		return
-	}
-	for ii := 0; ii < len(b.CurrentEpochParticipation); ii++ {
-		dst = ssz.MarshalUint8(dst, b.CurrentEpochParticipation[ii])
-	}
+// MarshalSSZTo ssz marshals the SignedVoluntaryExit object to a target array
+func (s *SignedVoluntaryExit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
-	// Field (21) 'InactivityScores'
-	if size := len(b.InactivityScores); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("--.InactivityScores", size, 1099511627776)
-		return
+	// Field (0) 'Exit'
+	if s.Exit == nil {
+		s.Exit = new(VoluntaryExit)
 	}
-	for ii := 0; ii < len(b.InactivityScores); ii++ {
-		dst = ssz.MarshalUint64(dst, b.InactivityScores[ii])
+	if dst, err = s.Exit.MarshalSSZTo(dst); err != nil {
+		return
 	}
 
+	// Field (1) 'Signature'
+	dst = append(dst, s.Signature[:]...)
+
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconState object
-func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 10325 {
-		return ssz.ErrSize
-	}
-
-	tail := buf
-	var o7, o9, o11, o12, o15, o16, o21 uint64
+// MarshalSSZToWriter ssz marshals the SignedVoluntaryExit object to a writer
+func (s *SignedVoluntaryExit) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, s)
+}
 
-	// Field (0) 'GenesisTime'
-	b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
+// MarshalSSZToEncoder ssz marshals the SignedVoluntaryExit object to an encoder
+func (s *SignedVoluntaryExit) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
 
-	// Field (1) 'GenesisValidatorsRoot'
-	if cap(b.GenesisValidatorsRoot) == 0 {
-		b.GenesisValidatorsRoot = make([]byte, 0, len(buf[8:40]))
+	// Field (0) 'Exit'
+	if s.Exit == nil {
+		s.Exit = new(VoluntaryExit)
+	}
+	if err = enc.EncodeObject(s.Exit); err != nil {
+		return
 	}
-	b.GenesisValidatorsRoot = append(b.GenesisValidatorsRoot, buf[8:40]...)
 
-	// Field (2) 'Slot'
-	b.Slot = ssz.UnmarshallUint64(buf[40:48])
+	// Field (1) 'Signature'
+	enc.EncodeBytes(s.Signature[:])
 
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the SignedVoluntaryExit object within the limits of the decode state
+func (s *SignedVoluntaryExit) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "SignedVoluntaryExit", "", 0)
 	}
-	if err = b.Fork.UnmarshalSSZ(buf[48:64]); err != nil {
-		return err
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 112 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SignedVoluntaryExit", 112, size)
 	}
 
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
+	// Field (0) 'Exit'
+	if s.Exit == nil {
+		s.Exit = new(VoluntaryExit)
 	}
-	if err = b.LatestBlockHeader.UnmarshalSSZ(buf[64:176]); err != nil {
-		return err
+	if err = ssz.UnmarshalWithState(s.Exit, buf[0:16], st); err != nil {
+		return ssz.WrapDecodeError(err, "SignedVoluntaryExit", "Exit", 0)
 	}
 
-	// Field (5) 'BlockRoots'
+	// Field (1) 'Signature'
+	copy(s.Signature[:], buf[16:112])
 
-	for ii := 0; ii < 64; ii++ {
-		copy(b.BlockRoots[ii][:], buf[176:2224][ii*32:(ii+1)*32])
-	}
+	return err
+}
 
-	// Field (6) 'StateRoots'
-	b.StateRoots = make([][32]byte, 64)
-	for ii := 0; ii < 64; ii++ {
-		copy(b.StateRoots[ii][:], buf[2224:4272][ii*32:(ii+1)*32])
+// ValidateSSZ checks that buf is a valid SSZ encoding of a SignedVoluntaryExit object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (s *SignedVoluntaryExit) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 112 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SignedVoluntaryExit", 112, size)
 	}
 
-	// Offset (7) 'HistoricalRoots'
-	if o7 = ssz.ReadOffset(buf[4272:4276]); o7 > size {
-		return ssz.ErrOffset
+	// Field (0) 'Exit'
+	if err = (*VoluntaryExit)(nil).ValidateSSZ(buf[0:16]); err != nil {
+		return ssz.WrapDecodeError(err, "SignedVoluntaryExit", "Exit", 0)
 	}
 
-	if o7 < 10325 {
-		return ssz.ErrInvalidVariableOffset
-	}
+	return err
+}
 
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
+// UnmarshalSSZFromReader ssz unmarshals the SignedVoluntaryExit object from a reader with an encoded size of 'size' bytes
+func (s *SignedVoluntaryExit) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, s)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the SignedVoluntaryExit object from a decoder
+func (s *SignedVoluntaryExit) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 112 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SignedVoluntaryExit", 112, dec.Size())
 	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[4276:4348]); err != nil {
+	buf, err := dec.Read(112)
+	if err != nil {
 		return err
 	}
+	return s.UnmarshalSSZWithState(buf, dec.State())
+}
 
-	// Offset (9) 'Eth1DataVotes'
-	if o9 = ssz.ReadOffset(buf[4348:4352]); o9 > size || o7 > o9 {
-		return ssz.ErrOffset
-	}
-
-	// Field (10) 'Eth1DepositIndex'
-	b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[4352:4360])
+// SizeSSZ returns the ssz encoded size in bytes for the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) SizeSSZ() (size int) {
+	size = 112
+	return
+}
 
-	// Offset (11) 'Validators'
-	if o11 = ssz.ReadOffset(buf[4360:4364]); o11 > size || o9 > o11 {
-		return ssz.ErrOffset
-	}
+// HashTreeRoot ssz hashes the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
 
-	// Offset (12) 'Balances'
-	if o12 = ssz.ReadOffset(buf[4364:4368]); o12 > size || o11 > o12 {
-		return ssz.ErrOffset
-	}
+// HashTreeRootWith ssz hashes the SignedVoluntaryExit object with a hasher
+func (s *SignedVoluntaryExit) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
 
-	// Field (13) 'RandaoMixes'
-	b.RandaoMixes = make([][]byte, 64)
-	for ii := 0; ii < 64; ii++ {
-		if cap(b.RandaoMixes[ii]) == 0 {
-			b.RandaoMixes[ii] = make([]byte, 0, len(buf[4368:6416][ii*32:(ii+1)*32]))
-		}
-		b.RandaoMixes[ii] = append(b.RandaoMixes[ii], buf[4368:6416][ii*32:(ii+1)*32]...)
+	// Field (0) 'Exit'
+	if err = s.Exit.HashTreeRootWith(hh); err != nil {
+		return
 	}
 
-	// Field (14) 'Slashings'
-	b.Slashings = ssz.ExtendUint64(b.Slashings, 64)
-	for ii := 0; ii < 64; ii++ {
-		b.Slashings[ii] = ssz.UnmarshallUint64(buf[6416:6928][ii*8 : (ii+1)*8])
-	}
+	// Field (1) 'Signature'
+	hh.PutBytes(s.Signature[:])
 
-	// Offset (15) 'PreviousEpochParticipation'
-	if o15 = ssz.ReadOffset(buf[6928:6932]); o15 > size || o12 > o15 {
-		return ssz.ErrOffset
-	}
+	hh.Merkleize(indx)
+	return
+}
 
-	// Offset (16) 'CurrentEpochParticipation'
-	if o16 = ssz.ReadOffset(buf[6932:6936]); o16 > size || o15 > o16 {
-		return ssz.ErrOffset
-	}
+// HashTreeRootParallel ssz hashes the SignedVoluntaryExit object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (s *SignedVoluntaryExit) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return s.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
 
-	// Field (17) 'JustificationBits'
-	if cap(b.JustificationBits) == 0 {
-		b.JustificationBits = make([]byte, 0, len(buf[6936:6937]))
-	}
-	b.JustificationBits = append(b.JustificationBits, buf[6936:6937]...)
+// HashTreeRootParallelWithPool ssz hashes the SignedVoluntaryExit object like HashTreeRootParallel
+// with the Hashers of the pool
+func (s *SignedVoluntaryExit) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Exit'
+		func(hh *ssz.Hasher) (err error) {
+			if err = s.Exit.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (1) 'Signature'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutBytes(s.Signature[:])
+			return
+		},
+	})
+}
 
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZ(buf[6937:6977]); err != nil {
-		return err
-	}
+// SignedVoluntaryExitView is a lazy view over the SSZ encoding of a SignedVoluntaryExit object
+type SignedVoluntaryExitView struct {
+	v ssz.View
+}
 
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZ(buf[6977:7017]); err != nil {
-		return err
+// NewSignedVoluntaryExitView creates a view over the SSZ encoding of a SignedVoluntaryExit object
+func NewSignedVoluntaryExitView(buf []byte) SignedVoluntaryExitView {
+	return SignedVoluntaryExitViewOf(ssz.NewView(buf))
+}
+
+// SignedVoluntaryExitViewOf returns the view of the SignedVoluntaryExit object encoded in v
+func SignedVoluntaryExitViewOf(v ssz.View) SignedVoluntaryExitView {
+	return SignedVoluntaryExitView{v.Container(112, -1)}
+}
+
+// Raw returns the untyped view of the SignedVoluntaryExit object
+func (v SignedVoluntaryExitView) Raw() ssz.View {
+	return v.v
+}
+
+// SignedVoluntaryExitListView is a lazy view over a list of SignedVoluntaryExit objects
+type SignedVoluntaryExitListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th SignedVoluntaryExit object of the list
+func (l SignedVoluntaryExitListView) At(i int) SignedVoluntaryExitView {
+	return SignedVoluntaryExitViewOf(l.ListView.At(i))
+}
+
+// Exit returns the Exit field of the SignedVoluntaryExit object
+func (v SignedVoluntaryExitView) Exit() VoluntaryExitView {
+	return VoluntaryExitViewOf(v.v.Field(0, 16))
+}
+
+// Signature returns the Signature field of the SignedVoluntaryExit object
+func (v SignedVoluntaryExitView) Signature() ([]byte, error) {
+	return v.v.Field(16, 112).Bytes()
+}
+
+// Copy returns a deep copy of the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) Copy() *SignedVoluntaryExit {
+	if s == nil {
+		return nil
 	}
+	cpy := new(SignedVoluntaryExit)
+	*cpy = *s
 
-	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
+	// Field 'Exit'
+	cpy.Exit = cpy.Exit.Copy()
+
+	return cpy
+}
+
+// EqualSSZ returns true if the SignedVoluntaryExit objects have the same SSZ encoding
+func (s *SignedVoluntaryExit) EqualSSZ(other *SignedVoluntaryExit) bool {
+	if s == nil {
+		s = new(SignedVoluntaryExit)
 	}
-	if err = b.FinalizedCheckpoint.UnmarshalSSZ(buf[7017:7057]); err != nil {
-		return err
+	if other == nil {
+		other = new(SignedVoluntaryExit)
 	}
 
-	// Offset (21) 'InactivityScores'
-	if o21 = ssz.ReadOffset(buf[7057:7061]); o21 > size || o16 > o21 {
-		return ssz.ErrOffset
+	// Field 'Exit'
+	if !s.Exit.EqualSSZ(other.Exit) {
+		return false
 	}
 
-	// Field (22) 'CurrentSyncCommitee'
-	if b.CurrentSyncCommitee == nil {
-		b.CurrentSyncCommitee = new(SyncCommitteeMinimal)
-	}
-	if err = b.CurrentSyncCommitee.UnmarshalSSZ(buf[7061:8693]); err != nil {
-		return err
+	// Field 'Signature'
+	if s.Signature != other.Signature {
+		return false
 	}
 
-	// Field (23) 'NextSyncCommittee'
-	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommitteeMinimal)
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the SignedVoluntaryExit objects
+func (s *SignedVoluntaryExit) DiffSSZ(other *SignedVoluntaryExit) []ssz.FieldDiff {
+	if s == nil {
+		s = new(SignedVoluntaryExit)
 	}
-	if err = b.NextSyncCommittee.UnmarshalSSZ(buf[8693:10325]); err != nil {
-		return err
+	if other == nil {
+		other = new(SignedVoluntaryExit)
 	}
+	var diffs []ssz.FieldDiff
 
-	// Field (7) 'HistoricalRoots'
-	{
-		buf = tail[o7:o9]
-		num, err := ssz.DivideInt2(len(buf), 32, 16777216)
-		if err != nil {
-			return err
-		}
-		b.HistoricalRoots = make([][32]byte, num)
-		for ii := 0; ii < num; ii++ {
-			copy(b.HistoricalRoots[ii][:], buf[ii*32:(ii+1)*32])
-		}
-	}
+	// Field 'Exit'
+	diffs = ssz.AppendDiffs(diffs, "Exit", s.Exit.DiffSSZ(other.Exit))
 
-	// Field (9) 'Eth1DataVotes'
-	{
-		buf = tail[o9:o11]
-		num, err := ssz.DivideInt2(len(buf), 72, 32)
-		if err != nil {
-			return err
-		}
-		b.Eth1DataVotes = make([]*Eth1Data, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Eth1DataVotes[ii] == nil {
-				b.Eth1DataVotes[ii] = new(Eth1Data)
-			}
-			if err = b.Eth1DataVotes[ii].UnmarshalSSZ(buf[ii*72 : (ii+1)*72]); err != nil {
-				return err
-			}
-		}
+	// Field 'Signature'
+	if s.Signature != other.Signature {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Signature", A: s.Signature, B: other.Signature})
 	}
 
-	// Field (11) 'Validators'
-	{
-		buf = tail[o11:o12]
-		num, err := ssz.DivideInt2(len(buf), 121, 1099511627776)
-		if err != nil {
-			return err
-		}
-		b.Validators = make([]*Validator, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Validators[ii] == nil {
-				b.Validators[ii] = new(Validator)
-			}
-			if err = b.Validators[ii].UnmarshalSSZ(buf[ii*121 : (ii+1)*121]); err != nil {
-				return err
-			}
-		}
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the SignedVoluntaryExit object following the Beacon API conventions
+func (s *SignedVoluntaryExit) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		s = new(SignedVoluntaryExit)
 	}
+	var err error
+	dst := []byte{'{'}
 
-	// Field (12) 'Balances'
-	{
-		buf = tail[o12:o15]
-		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
-		if err != nil {
-			return err
-		}
-		b.Balances = ssz.ExtendUint64(b.Balances, num)
-		for ii := 0; ii < num; ii++ {
-			b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
-		}
+	// Field (0) 'Exit'
+	dst = append(dst, "\"message\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, s.Exit); err != nil {
+		return nil, err
 	}
 
-	// Field (15) 'PreviousEpochParticipation'
-	{
-		buf = tail[o15:o16]
-		num, err := ssz.DivideInt2(len(buf), 1, 1099511627776)
-		if err != nil {
-			return err
-		}
-		b.PreviousEpochParticipation = ssz.ExtendUint8(b.PreviousEpochParticipation, num)
-		for ii := 0; ii < num; ii++ {
-			b.PreviousEpochParticipation[ii] = ssz.UnmarshallUint8(buf[ii*1 : (ii+1)*1])
-		}
+	// Field (1) 'Signature'
+	dst = append(dst, ",\"signature\":"...)
+	dst = ssz.MarshalJSONBytes(dst, s.Signature[:])
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the SignedVoluntaryExit object following the Beacon API conventions
+func (s *SignedVoluntaryExit) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
 	}
 
-	// Field (16) 'CurrentEpochParticipation'
-	{
-		buf = tail[o16:o21]
-		num, err := ssz.DivideInt2(len(buf), 1, 1099511627776)
-		if err != nil {
-			return err
-		}
-		b.CurrentEpochParticipation = ssz.ExtendUint8(b.CurrentEpochParticipation, num)
-		for ii := 0; ii < num; ii++ {
-			b.CurrentEpochParticipation[ii] = ssz.UnmarshallUint8(buf[ii*1 : (ii+1)*1])
-		}
+	// Field (0) 'Exit'
+	if s.Exit == nil {
+		s.Exit = new(VoluntaryExit)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["message"], s.Exit); err != nil {
+		return ssz.WrapJSONError(err, "Exit")
 	}
 
-	// Field (21) 'InactivityScores'
+	// Field (1) 'Signature'
 	{
-		buf = tail[o21:]
-		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		val, err := ssz.UnmarshalJSONBytes(fields["signature"], 96, 96)
 		if err != nil {
-			return err
-		}
-		b.InactivityScores = ssz.ExtendUint64(b.InactivityScores, num)
-		for ii := 0; ii < num; ii++ {
-			b.InactivityScores[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+			return ssz.WrapJSONError(err, "Signature")
 		}
+		copy(s.Signature[:], val)
 	}
-	return err
-}
-
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconState object
-func (b *BeaconState) SizeSSZ() (size int) {
-	size = 10325
-
-	// Field (7) 'HistoricalRoots'
-	size += len(b.HistoricalRoots) * 32
 
-	// Field (9) 'Eth1DataVotes'
-	size += len(b.Eth1DataVotes) * 72
+	return nil
+}
 
-	// Field (11) 'Validators'
-	size += len(b.Validators) * 121
+// MarshalSSZ ssz marshals the Eth1Block object
+func (e *Eth1Block) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
 
-	// Field (12) 'Balances'
-	size += len(b.Balances) * 8
+// MarshalSSZTo ssz marshals the Eth1Block object to a target array
+func (e *Eth1Block) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
-	// Field (15) 'PreviousEpochParticipation'
-	size += len(b.PreviousEpochParticipation) * 1
+	// Field (0) 'Timestamp'
+	dst = ssz.MarshalUint64(dst, e.Timestamp)
 
-	// Field (16) 'CurrentEpochParticipation'
-	size += len(b.CurrentEpochParticipation) * 1
+	// Field (1) 'DepositRoot'
+	if size := len(e.DepositRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.DepositRoot", size, 32)
+		return
+	}
+	dst = append(dst, e.DepositRoot...)
 
-	// Field (21) 'InactivityScores'
-	size += len(b.InactivityScores) * 8
+	// Field (2) 'DepositCount'
+	dst = ssz.MarshalUint64(dst, e.DepositCount)
 
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconState object
-func (b *BeaconState) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// MarshalSSZToWriter ssz marshals the Eth1Block object to a writer
+func (e *Eth1Block) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, e)
 }
 
-// HashTreeRootWith ssz hashes the BeaconState object with a hasher
-func (b *BeaconState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+// MarshalSSZToEncoder ssz marshals the Eth1Block object to an encoder
+func (e *Eth1Block) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
 
-	// Field (0) 'GenesisTime'
-	hh.PutUint64(b.GenesisTime)
+	// Field (0) 'Timestamp'
+	enc.EncodeUint64(e.Timestamp)
 
-	// Field (1) 'GenesisValidatorsRoot'
-	if size := len(b.GenesisValidatorsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.GenesisValidatorsRoot", size, 32)
+	// Field (1) 'DepositRoot'
+	if size := len(e.DepositRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.DepositRoot", size, 32)
 		return
 	}
-	hh.PutBytes(b.GenesisValidatorsRoot)
+	enc.EncodeBytes(e.DepositRoot)
 
-	// Field (2) 'Slot'
-	hh.PutUint64(b.Slot)
+	// Field (2) 'DepositCount'
+	enc.EncodeUint64(e.DepositCount)
 
-	// Field (3) 'Fork'
-	if err = b.Fork.HashTreeRootWith(hh); err != nil {
-		return
-	}
+	return
+}
 
-	// Field (4) 'LatestBlockHeader'
-	if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
-		return
-	}
+// UnmarshalSSZ ssz unmarshals the Eth1Block object
+func (e *Eth1Block) UnmarshalSSZ(buf []byte) error {
+	return e.UnmarshalSSZWithState(buf, nil)
+}
 
-	// Field (5) 'BlockRoots'
-	{
-		subIndx := hh.Index()
-		for _, i := range b.BlockRoots {
-			hh.Append(i[:])
-		}
-		hh.Merkleize(subIndx)
+// UnmarshalSSZWithState ssz unmarshals the Eth1Block object within the limits of the decode state
+func (e *Eth1Block) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "Eth1Block", "", 0)
 	}
-
-	// Field (6) 'StateRoots'
-	{
-		if size := len(b.StateRoots); size != 64 {
-			err = ssz.ErrVectorLengthFn("--.StateRoots", size, 64)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.StateRoots {
-			hh.Append(i[:])
-		}
-		hh.Merkleize(subIndx)
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 48 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Eth1Block", 48, size)
 	}
 
-	// Field (7) 'HistoricalRoots'
-	{
-		if size := len(b.HistoricalRoots); size > 16777216 {
-			err = ssz.ErrListTooBigFn("--.HistoricalRoots", size, 16777216)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.HistoricalRoots {
-			hh.Append(i[:])
-		}
+	// Field (0) 'Timestamp'
+	e.Timestamp = ssz.UnmarshallUint64(buf[0:8])
 
-		numItems := uint64(len(b.HistoricalRoots))
-		hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
+	// Field (1) 'DepositRoot'
+	if cap(e.DepositRoot) == 0 {
+		e.DepositRoot = make([]byte, 0, len(buf[8:40]))
 	}
+	e.DepositRoot = append(e.DepositRoot, buf[8:40]...)
 
-	// Field (8) 'Eth1Data'
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
-		return
-	}
+	// Field (2) 'DepositCount'
+	e.DepositCount = ssz.UnmarshallUint64(buf[40:48])
 
-	// Field (9) 'Eth1DataVotes'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Eth1DataVotes))
-		if num > 32 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Eth1DataVotes {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 32)
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a Eth1Block object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (e *Eth1Block) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 48 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Eth1Block", 48, size)
 	}
 
-	// Field (10) 'Eth1DepositIndex'
-	hh.PutUint64(b.Eth1DepositIndex)
+	return err
+}
 
-	// Field (11) 'Validators'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Validators))
-		if num > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Validators {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+// UnmarshalSSZFromReader ssz unmarshals the Eth1Block object from a reader with an encoded size of 'size' bytes
+func (e *Eth1Block) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, e)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the Eth1Block object from a decoder
+func (e *Eth1Block) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 48 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Eth1Block", 48, dec.Size())
+	}
+	buf, err := dec.Read(48)
+	if err != nil {
+		return err
 	}
+	return e.UnmarshalSSZWithState(buf, dec.State())
+}
 
-	// Field (12) 'Balances'
-	{
-		if size := len(b.Balances); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("--.Balances", size, 1099511627776)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.Balances {
-			hh.AppendUint64(i)
-		}
-		hh.FillUpTo32()
+// SizeSSZ returns the ssz encoded size in bytes for the Eth1Block object
+func (e *Eth1Block) SizeSSZ() (size int) {
+	size = 48
+	return
+}
 
-		numItems := uint64(len(b.Balances))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+// HashTreeRoot ssz hashes the Eth1Block object
+func (e *Eth1Block) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the Eth1Block object with a hasher
+func (e *Eth1Block) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Timestamp'
+	hh.PutUint64(e.Timestamp)
+
+	// Field (1) 'DepositRoot'
+	if size := len(e.DepositRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.DepositRoot", size, 32)
+		return
 	}
+	hh.PutBytes(e.DepositRoot)
 
-	// Field (13) 'RandaoMixes'
-	{
-		if size := len(b.RandaoMixes); size != 64 {
-			err = ssz.ErrVectorLengthFn("--.RandaoMixes", size, 64)
+	// Field (2) 'DepositCount'
+	hh.PutUint64(e.DepositCount)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootParallel ssz hashes the Eth1Block object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (e *Eth1Block) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return e.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the Eth1Block object like HashTreeRootParallel
+// with the Hashers of the pool
+func (e *Eth1Block) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Timestamp'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(e.Timestamp)
 			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.RandaoMixes {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
+		},
+		// Field (1) 'DepositRoot'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(e.DepositRoot); size != 32 {
+				err = ssz.ErrBytesLengthFn("--.DepositRoot", size, 32)
 				return
 			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
-	}
-
-	// Field (14) 'Slashings'
-	{
-		if size := len(b.Slashings); size != 64 {
-			err = ssz.ErrVectorLengthFn("--.Slashings", size, 64)
+			hh.PutBytes(e.DepositRoot)
 			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.Slashings {
-			hh.AppendUint64(i)
-		}
-		hh.Merkleize(subIndx)
-	}
-
-	// Field (15) 'PreviousEpochParticipation'
-	{
-		if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("--.PreviousEpochParticipation", size, 1099511627776)
+		},
+		// Field (2) 'DepositCount'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(e.DepositCount)
 			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.PreviousEpochParticipation {
-			hh.AppendUint8(i)
-		}
-		hh.FillUpTo32()
+		},
+	})
+}
 
-		numItems := uint64(len(b.PreviousEpochParticipation))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 1))
-	}
+// Eth1BlockView is a lazy view over the SSZ encoding of a Eth1Block object
+type Eth1BlockView struct {
+	v ssz.View
+}
 
-	// Field (16) 'CurrentEpochParticipation'
-	{
-		if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("--.CurrentEpochParticipation", size, 1099511627776)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.CurrentEpochParticipation {
-			hh.AppendUint8(i)
-		}
-		hh.FillUpTo32()
+// NewEth1BlockView creates a view over the SSZ encoding of a Eth1Block object
+func NewEth1BlockView(buf []byte) Eth1BlockView {
+	return Eth1BlockViewOf(ssz.NewView(buf))
+}
 
-		numItems := uint64(len(b.CurrentEpochParticipation))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 1))
+// Eth1BlockViewOf returns the view of the Eth1Block object encoded in v
+func Eth1BlockViewOf(v ssz.View) Eth1BlockView {
+	return Eth1BlockView{v.Container(48, -1)}
+}
+
+// Raw returns the untyped view of the Eth1Block object
+func (v Eth1BlockView) Raw() ssz.View {
+	return v.v
+}
+
+// Eth1BlockListView is a lazy view over a list of Eth1Block objects
+type Eth1BlockListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th Eth1Block object of the list
+func (l Eth1BlockListView) At(i int) Eth1BlockView {
+	return Eth1BlockViewOf(l.ListView.At(i))
+}
+
+// Timestamp returns the Timestamp field of the Eth1Block object
+func (v Eth1BlockView) Timestamp() (uint64, error) {
+	return v.v.Field(0, 8).Uint64()
+}
+
+// DepositRoot returns the DepositRoot field of the Eth1Block object
+func (v Eth1BlockView) DepositRoot() ([]byte, error) {
+	return v.v.Field(8, 40).Bytes()
+}
+
+// DepositCount returns the DepositCount field of the Eth1Block object
+func (v Eth1BlockView) DepositCount() (uint64, error) {
+	return v.v.Field(40, 48).Uint64()
+}
+
+// Copy returns a deep copy of the Eth1Block object
+func (e *Eth1Block) Copy() *Eth1Block {
+	if e == nil {
+		return nil
 	}
+	cpy := new(Eth1Block)
+	*cpy = *e
 
-	// Field (17) 'JustificationBits'
-	if size := len(b.JustificationBits); size != 1 {
-		err = ssz.ErrBytesLengthFn("--.JustificationBits", size, 1)
-		return
+	// Field 'DepositRoot'
+	cpy.DepositRoot = append(cpy.DepositRoot[:0:0], cpy.DepositRoot...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the Eth1Block objects have the same SSZ encoding
+func (e *Eth1Block) EqualSSZ(other *Eth1Block) bool {
+	if e == nil {
+		e = new(Eth1Block)
+	}
+	if other == nil {
+		other = new(Eth1Block)
 	}
-	hh.PutBytes(b.JustificationBits)
 
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
+	// Field 'Timestamp'
+	if e.Timestamp != other.Timestamp {
+		return false
 	}
 
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
+	// Field 'DepositRoot'
+	if !bytes.Equal(e.DepositRoot, other.DepositRoot) {
+		return false
 	}
 
-	// Field (20) 'FinalizedCheckpoint'
-	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
+	// Field 'DepositCount'
+	if e.DepositCount != other.DepositCount {
+		return false
 	}
 
-	// Field (21) 'InactivityScores'
-	{
-		if size := len(b.InactivityScores); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("--.InactivityScores", size, 1099511627776)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.InactivityScores {
-			hh.AppendUint64(i)
-		}
-		hh.FillUpTo32()
+	return true
+}
 
-		numItems := uint64(len(b.InactivityScores))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+// DiffSSZ returns the fields with a different SSZ encoding in the Eth1Block objects
+func (e *Eth1Block) DiffSSZ(other *Eth1Block) []ssz.FieldDiff {
+	if e == nil {
+		e = new(Eth1Block)
+	}
+	if other == nil {
+		other = new(Eth1Block)
 	}
+	var diffs []ssz.FieldDiff
 
-	// Field (22) 'CurrentSyncCommitee'
-	if err = b.CurrentSyncCommitee.HashTreeRootWith(hh); err != nil {
-		return
+	// Field 'Timestamp'
+	if e.Timestamp != other.Timestamp {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Timestamp", A: e.Timestamp, B: other.Timestamp})
 	}
 
-	// Field (23) 'NextSyncCommittee'
-	if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
-		return
+	// Field 'DepositRoot'
+	if !bytes.Equal(e.DepositRoot, other.DepositRoot) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "DepositRoot", A: e.DepositRoot, B: other.DepositRoot})
 	}
 
-	hh.Merkleize(indx)
-	return
-}
+	// Field 'DepositCount'
+	if e.DepositCount != other.DepositCount {
+		diffs = append(diffs, ssz.FieldDiff{Path: "DepositCount", A: e.DepositCount, B: other.DepositCount})
+	}
 
-// MarshalSSZ ssz marshals the BeaconBlock object
-func (b *BeaconBlock) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+	return diffs
 }
 
-// MarshalSSZTo ssz marshals the BeaconBlock object to a target array
-func (b *BeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(84)
+// MarshalJSON returns the JSON encoding of the Eth1Block object following the Beacon API conventions
+func (e *Eth1Block) MarshalJSON() ([]byte, error) {
+	if e == nil {
+		e = new(Eth1Block)
+	}
+	dst := []byte{'{'}
 
-	// Field (0) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
+	// Field (0) 'Timestamp'
+	dst = append(dst, "\"timestamp\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(e.Timestamp))
 
-	// Field (1) 'ProposerIndex'
-	dst = ssz.MarshalUint64(dst, b.ProposerIndex)
-
-	// Field (2) 'ParentRoot'
-	if size := len(b.ParentRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
-		return
-	}
-	dst = append(dst, b.ParentRoot...)
-
-	// Field (3) 'StateRoot'
-	if size := len(b.StateRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
-		return
-	}
-	dst = append(dst, b.StateRoot...)
-
-	// Offset (4) 'Body'
-	dst = ssz.WriteOffset(dst, offset)
-	if b.Body == nil {
-		b.Body = new(BeaconBlockBody)
-	}
-	offset += b.Body.SizeSSZ()
+	// Field (1) 'DepositRoot'
+	dst = append(dst, ",\"deposit_root\":"...)
+	dst = ssz.MarshalJSONBytes(dst, e.DepositRoot)
 
-	// Field (4) 'Body'
-	if dst, err = b.Body.MarshalSSZTo(dst); err != nil {
-		return
-	}
+	// Field (2) 'DepositCount'
+	dst = append(dst, ",\"deposit_count\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(e.DepositCount))
 
-	return
+	dst = append(dst, '}')
+	return dst, nil
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlock object
-func (b *BeaconBlock) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 84 {
-		return ssz.ErrSize
-	}
-
-	tail := buf
-	var o4 uint64
-
-	// Field (0) 'Slot'
-	b.Slot = ssz.UnmarshallUint64(buf[0:8])
-
-	// Field (1) 'ProposerIndex'
-	b.ProposerIndex = ssz.UnmarshallUint64(buf[8:16])
-
-	// Field (2) 'ParentRoot'
-	if cap(b.ParentRoot) == 0 {
-		b.ParentRoot = make([]byte, 0, len(buf[16:48]))
-	}
-	b.ParentRoot = append(b.ParentRoot, buf[16:48]...)
-
-	// Field (3) 'StateRoot'
-	if cap(b.StateRoot) == 0 {
-		b.StateRoot = make([]byte, 0, len(buf[48:80]))
+// UnmarshalJSON decodes the JSON encoding of the Eth1Block object following the Beacon API conventions
+func (e *Eth1Block) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
 	}
-	b.StateRoot = append(b.StateRoot, buf[48:80]...)
 
-	// Offset (4) 'Body'
-	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
-		return ssz.ErrOffset
+	// Field (0) 'Timestamp'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["timestamp"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Timestamp")
+		}
+		e.Timestamp = uint64(val)
 	}
 
-	if o4 < 84 {
-		return ssz.ErrInvalidVariableOffset
+	// Field (1) 'DepositRoot'
+	if e.DepositRoot, err = ssz.UnmarshalJSONBytes(fields["deposit_root"], 32, 32); err != nil {
+		return ssz.WrapJSONError(err, "DepositRoot")
 	}
 
-	// Field (4) 'Body'
+	// Field (2) 'DepositCount'
 	{
-		buf = tail[o4:]
-		if b.Body == nil {
-			b.Body = new(BeaconBlockBody)
-		}
-		if err = b.Body.UnmarshalSSZ(buf); err != nil {
-			return err
+		val, err := ssz.UnmarshalJSONUint64(fields["deposit_count"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "DepositCount")
 		}
+		e.DepositCount = uint64(val)
 	}
-	return err
-}
-
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlock object
-func (b *BeaconBlock) SizeSSZ() (size int) {
-	size = 84
-
-	// Field (4) 'Body'
-	if b.Body == nil {
-		b.Body = new(BeaconBlockBody)
-	}
-	size += b.Body.SizeSSZ()
 
-	return
+	return nil
 }
 
-// HashTreeRoot ssz hashes the BeaconBlock object
-func (b *BeaconBlock) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// MarshalSSZ ssz marshals the Eth1Data object
+func (e *Eth1Data) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlock object with a hasher
-func (b *BeaconBlock) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
-
-	// Field (0) 'Slot'
-	hh.PutUint64(b.Slot)
-
-	// Field (1) 'ProposerIndex'
-	hh.PutUint64(b.ProposerIndex)
+// MarshalSSZTo ssz marshals the Eth1Data object to a target array
+func (e *Eth1Data) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
-	// Field (2) 'ParentRoot'
-	if size := len(b.ParentRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+	// Field (0) 'DepositRoot'
+	if size := len(e.DepositRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.DepositRoot", size, 32)
 		return
 	}
-	hh.PutBytes(b.ParentRoot)
+	dst = append(dst, e.DepositRoot...)
 
-	// Field (3) 'StateRoot'
-	if size := len(b.StateRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
-		return
-	}
-	hh.PutBytes(b.StateRoot)
+	// Field (1) 'DepositCount'
+	dst = ssz.MarshalUint64(dst, e.DepositCount)
 
-	// Field (4) 'Body'
-	if err = b.Body.HashTreeRootWith(hh); err != nil {
+	// Field (2) 'BlockHash'
+	if size := len(e.BlockHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.BlockHash", size, 32)
 		return
 	}
+	dst = append(dst, e.BlockHash...)
 
-	hh.Merkleize(indx)
 	return
 }
 
-// MarshalSSZ ssz marshals the SignedBeaconBlock object
-func (s *SignedBeaconBlock) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
+// MarshalSSZToWriter ssz marshals the Eth1Data object to a writer
+func (e *Eth1Data) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, e)
 }
 
-// MarshalSSZTo ssz marshals the SignedBeaconBlock object to a target array
-func (s *SignedBeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(100)
-
-	// Offset (0) 'Block'
-	dst = ssz.WriteOffset(dst, offset)
-	if s.Block == nil {
-		s.Block = new(BeaconBlock)
-	}
-	offset += s.Block.SizeSSZ()
+// MarshalSSZToEncoder ssz marshals the Eth1Data object to an encoder
+func (e *Eth1Data) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
 
-	// Field (1) 'Signature'
-	if size := len(s.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+	// Field (0) 'DepositRoot'
+	if size := len(e.DepositRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.DepositRoot", size, 32)
 		return
 	}
-	dst = append(dst, s.Signature...)
+	enc.EncodeBytes(e.DepositRoot)
 
-	// Field (0) 'Block'
-	if dst, err = s.Block.MarshalSSZTo(dst); err != nil {
+	// Field (1) 'DepositCount'
+	enc.EncodeUint64(e.DepositCount)
+
+	// Field (2) 'BlockHash'
+	if size := len(e.BlockHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.BlockHash", size, 32)
 		return
 	}
+	enc.EncodeBytes(e.BlockHash)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the SignedBeaconBlock object
-func (s *SignedBeaconBlock) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the Eth1Data object
+func (e *Eth1Data) UnmarshalSSZ(buf []byte) error {
+	return e.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the Eth1Data object within the limits of the decode state
+func (e *Eth1Data) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
 	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "Eth1Data", "", 0)
+	}
+	defer st.Leave()
 	size := uint64(len(buf))
-	if size < 100 {
-		return ssz.ErrSize
+	if size != 72 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Eth1Data", 72, size)
 	}
 
-	tail := buf
-	var o0 uint64
-
-	// Offset (0) 'Block'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
+	// Field (0) 'DepositRoot'
+	if cap(e.DepositRoot) == 0 {
+		e.DepositRoot = make([]byte, 0, len(buf[0:32]))
 	}
+	e.DepositRoot = append(e.DepositRoot, buf[0:32]...)
 
-	if o0 < 100 {
-		return ssz.ErrInvalidVariableOffset
-	}
+	// Field (1) 'DepositCount'
+	e.DepositCount = ssz.UnmarshallUint64(buf[32:40])
 
-	// Field (1) 'Signature'
-	if cap(s.Signature) == 0 {
-		s.Signature = make([]byte, 0, len(buf[4:100]))
+	// Field (2) 'BlockHash'
+	if cap(e.BlockHash) == 0 {
+		e.BlockHash = make([]byte, 0, len(buf[40:72]))
 	}
-	s.Signature = append(s.Signature, buf[4:100]...)
+	e.BlockHash = append(e.BlockHash, buf[40:72]...)
 
-	// Field (0) 'Block'
-	{
-		buf = tail[o0:]
-		if s.Block == nil {
-			s.Block = new(BeaconBlock)
-		}
-		if err = s.Block.UnmarshalSSZ(buf); err != nil {
-			return err
-		}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a Eth1Data object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (e *Eth1Data) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 72 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Eth1Data", 72, size)
 	}
+
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlock object
-func (s *SignedBeaconBlock) SizeSSZ() (size int) {
-	size = 100
+// UnmarshalSSZFromReader ssz unmarshals the Eth1Data object from a reader with an encoded size of 'size' bytes
+func (e *Eth1Data) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, e)
+}
 
-	// Field (0) 'Block'
-	if s.Block == nil {
-		s.Block = new(BeaconBlock)
+// UnmarshalSSZFromDecoder ssz unmarshals the Eth1Data object from a decoder
+func (e *Eth1Data) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 72 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Eth1Data", 72, dec.Size())
 	}
-	size += s.Block.SizeSSZ()
+	buf, err := dec.Read(72)
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalSSZWithState(buf, dec.State())
+}
 
+// SizeSSZ returns the ssz encoded size in bytes for the Eth1Data object
+func (e *Eth1Data) SizeSSZ() (size int) {
+	size = 72
 	return
 }
 
-// HashTreeRoot ssz hashes the SignedBeaconBlock object
-func (s *SignedBeaconBlock) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+// HashTreeRoot ssz hashes the Eth1Data object
+func (e *Eth1Data) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
 }
 
-// HashTreeRootWith ssz hashes the SignedBeaconBlock object with a hasher
-func (s *SignedBeaconBlock) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the Eth1Data object with a hasher
+func (e *Eth1Data) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'Block'
-	if err = s.Block.HashTreeRootWith(hh); err != nil {
+	// Field (0) 'DepositRoot'
+	if size := len(e.DepositRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.DepositRoot", size, 32)
 		return
 	}
+	hh.PutBytes(e.DepositRoot)
 
-	// Field (1) 'Signature'
-	if size := len(s.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+	// Field (1) 'DepositCount'
+	hh.PutUint64(e.DepositCount)
+
+	// Field (2) 'BlockHash'
+	if size := len(e.BlockHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.BlockHash", size, 32)
 		return
 	}
-	hh.PutBytes(s.Signature)
+	hh.PutBytes(e.BlockHash)
 
 	hh.Merkleize(indx)
 	return
 }
 
-// MarshalSSZ ssz marshals the Transfer object
-func (t *Transfer) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(t)
+// HashTreeRootParallel ssz hashes the Eth1Data object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (e *Eth1Data) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return e.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
 }
 
-// MarshalSSZTo ssz marshals the Transfer object to a target array
-func (t *Transfer) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// HashTreeRootParallelWithPool ssz hashes the Eth1Data object like HashTreeRootParallel
+// with the Hashers of the pool
+func (e *Eth1Data) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'DepositRoot'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(e.DepositRoot); size != 32 {
+				err = ssz.ErrBytesLengthFn("--.DepositRoot", size, 32)
+				return
+			}
+			hh.PutBytes(e.DepositRoot)
+			return
+		},
+		// Field (1) 'DepositCount'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(e.DepositCount)
+			return
+		},
+		// Field (2) 'BlockHash'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(e.BlockHash); size != 32 {
+				err = ssz.ErrBytesLengthFn("--.BlockHash", size, 32)
+				return
+			}
+			hh.PutBytes(e.BlockHash)
+			return
+		},
+	})
+}
 
-	// Field (0) 'Sender'
-	dst = ssz.MarshalUint64(dst, t.Sender)
+// Eth1DataView is a lazy view over the SSZ encoding of a Eth1Data object
+type Eth1DataView struct {
+	v ssz.View
+}
 
-	// Field (1) 'Recipient'
-	dst = ssz.MarshalUint64(dst, t.Recipient)
+// NewEth1DataView creates a view over the SSZ encoding of a Eth1Data object
+func NewEth1DataView(buf []byte) Eth1DataView {
+	return Eth1DataViewOf(ssz.NewView(buf))
+}
 
-	// Field (2) 'Amount'
-	dst = ssz.MarshalUint64(dst, t.Amount)
+// Eth1DataViewOf returns the view of the Eth1Data object encoded in v
+func Eth1DataViewOf(v ssz.View) Eth1DataView {
+	return Eth1DataView{v.Container(72, -1)}
+}
 
-	// Field (3) 'Fee'
-	dst = ssz.MarshalUint64(dst, t.Fee)
+// Raw returns the untyped view of the Eth1Data object
+func (v Eth1DataView) Raw() ssz.View {
+	return v.v
+}
 
-	// Field (4) 'Slot'
-	dst = ssz.MarshalUint64(dst, t.Slot)
+// Eth1DataListView is a lazy view over a list of Eth1Data objects
+type Eth1DataListView struct {
+	ssz.ListView
+}
 
-	// Field (5) 'Pubkey'
-	if size := len(t.Pubkey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
-		return
-	}
-	dst = append(dst, t.Pubkey...)
+// At returns the view of the i-th Eth1Data object of the list
+func (l Eth1DataListView) At(i int) Eth1DataView {
+	return Eth1DataViewOf(l.ListView.At(i))
+}
 
-	// Field (6) 'Signature'
-	if size := len(t.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
-		return
-	}
-	dst = append(dst, t.Signature...)
+// DepositRoot returns the DepositRoot field of the Eth1Data object
+func (v Eth1DataView) DepositRoot() ([]byte, error) {
+	return v.v.Field(0, 32).Bytes()
+}
 
-	return
+// DepositCount returns the DepositCount field of the Eth1Data object
+func (v Eth1DataView) DepositCount() (uint64, error) {
+	return v.v.Field(32, 40).Uint64()
 }
 
-// UnmarshalSSZ ssz unmarshals the Transfer object
-func (t *Transfer) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 184 {
-		return ssz.ErrSize
+// BlockHash returns the BlockHash field of the Eth1Data object
+func (v Eth1DataView) BlockHash() ([]byte, error) {
+	return v.v.Field(40, 72).Bytes()
+}
+
+// Copy returns a deep copy of the Eth1Data object
+func (e *Eth1Data) Copy() *Eth1Data {
+	if e == nil {
+		return nil
 	}
+	cpy := new(Eth1Data)
+	*cpy = *e
 
-	// Field (0) 'Sender'
-	t.Sender = ssz.UnmarshallUint64(buf[0:8])
+	// Field 'DepositRoot'
+	cpy.DepositRoot = append(cpy.DepositRoot[:0:0], cpy.DepositRoot...)
 
-	// Field (1) 'Recipient'
-	t.Recipient = ssz.UnmarshallUint64(buf[8:16])
+	// Field 'BlockHash'
+	cpy.BlockHash = append(cpy.BlockHash[:0:0], cpy.BlockHash...)
 
-	// Field (2) 'Amount'
-	t.Amount = ssz.UnmarshallUint64(buf[16:24])
+	return cpy
+}
 
-	// Field (3) 'Fee'
-	t.Fee = ssz.UnmarshallUint64(buf[24:32])
+// EqualSSZ returns true if the Eth1Data objects have the same SSZ encoding
+func (e *Eth1Data) EqualSSZ(other *Eth1Data) bool {
+	if e == nil {
+		e = new(Eth1Data)
+	}
+	if other == nil {
+		other = new(Eth1Data)
+	}
 
-	// Field (4) 'Slot'
-	t.Slot = ssz.UnmarshallUint64(buf[32:40])
+	// Field 'DepositRoot'
+	if !bytes.Equal(e.DepositRoot, other.DepositRoot) {
+		return false
+	}
 
-	// Field (5) 'Pubkey'
-	if cap(t.Pubkey) == 0 {
-		t.Pubkey = make([]byte, 0, len(buf[40:88]))
+	// Field 'DepositCount'
+	if e.DepositCount != other.DepositCount {
+		return false
 	}
-	t.Pubkey = append(t.Pubkey, buf[40:88]...)
 
-	// Field (6) 'Signature'
-	if cap(t.Signature) == 0 {
-		t.Signature = make([]byte, 0, len(buf[88:184]))
+	// Field 'BlockHash'
+	if !bytes.Equal(e.BlockHash, other.BlockHash) {
+		return false
 	}
-	t.Signature = append(t.Signature, buf[88:184]...)
 
-	return err
+	return true
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the Transfer object
-func (t *Transfer) SizeSSZ() (size int) {
-	size = 184
-	return
+// DiffSSZ returns the fields with a different SSZ encoding in the Eth1Data objects
+func (e *Eth1Data) DiffSSZ(other *Eth1Data) []ssz.FieldDiff {
+	if e == nil {
+		e = new(Eth1Data)
+	}
+	if other == nil {
+		other = new(Eth1Data)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'DepositRoot'
+	if !bytes.Equal(e.DepositRoot, other.DepositRoot) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "DepositRoot", A: e.DepositRoot, B: other.DepositRoot})
+	}
+
+	// Field 'DepositCount'
+	if e.DepositCount != other.DepositCount {
+		diffs = append(diffs, ssz.FieldDiff{Path: "DepositCount", A: e.DepositCount, B: other.DepositCount})
+	}
+
+	// Field 'BlockHash'
+	if !bytes.Equal(e.BlockHash, other.BlockHash) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "BlockHash", A: e.BlockHash, B: other.BlockHash})
+	}
+
+	return diffs
 }
 
-// HashTreeRoot ssz hashes the Transfer object
-func (t *Transfer) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(t)
+// MarshalJSON returns the JSON encoding of the Eth1Data object following the Beacon API conventions
+func (e *Eth1Data) MarshalJSON() ([]byte, error) {
+	if e == nil {
+		e = new(Eth1Data)
+	}
+	dst := []byte{'{'}
+
+	// Field (0) 'DepositRoot'
+	dst = append(dst, "\"deposit_root\":"...)
+	dst = ssz.MarshalJSONBytes(dst, e.DepositRoot)
+
+	// Field (1) 'DepositCount'
+	dst = append(dst, ",\"deposit_count\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(e.DepositCount))
+
+	// Field (2) 'BlockHash'
+	dst = append(dst, ",\"block_hash\":"...)
+	dst = ssz.MarshalJSONBytes(dst, e.BlockHash)
+
+	dst = append(dst, '}')
+	return dst, nil
 }
 
-// HashTreeRootWith ssz hashes the Transfer object with a hasher
-func (t *Transfer) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+// UnmarshalJSON decodes the JSON encoding of the Eth1Data object following the Beacon API conventions
+func (e *Eth1Data) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
 
-	// Field (0) 'Sender'
-	hh.PutUint64(t.Sender)
+	// Field (0) 'DepositRoot'
+	if e.DepositRoot, err = ssz.UnmarshalJSONBytes(fields["deposit_root"], 32, 32); err != nil {
+		return ssz.WrapJSONError(err, "DepositRoot")
+	}
 
-	// Field (1) 'Recipient'
-	hh.PutUint64(t.Recipient)
+	// Field (1) 'DepositCount'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["deposit_count"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "DepositCount")
+		}
+		e.DepositCount = uint64(val)
+	}
 
-	// Field (2) 'Amount'
-	hh.PutUint64(t.Amount)
+	// Field (2) 'BlockHash'
+	if e.BlockHash, err = ssz.UnmarshalJSONBytes(fields["block_hash"], 32, 32); err != nil {
+		return ssz.WrapJSONError(err, "BlockHash")
+	}
 
-	// Field (3) 'Fee'
-	hh.PutUint64(t.Fee)
+	return nil
+}
 
-	// Field (4) 'Slot'
-	hh.PutUint64(t.Slot)
+// MarshalSSZ ssz marshals the SigningRoot object
+func (s *SigningRoot) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
 
-	// Field (5) 'Pubkey'
-	if size := len(t.Pubkey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+// MarshalSSZTo ssz marshals the SigningRoot object to a target array
+func (s *SigningRoot) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ObjectRoot'
+	if size := len(s.ObjectRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ObjectRoot", size, 32)
 		return
 	}
-	hh.PutBytes(t.Pubkey)
+	dst = append(dst, s.ObjectRoot...)
 
-	// Field (6) 'Signature'
-	if size := len(t.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+	// Field (1) 'Domain'
+	if size := len(s.Domain); size != 8 {
+		err = ssz.ErrBytesLengthFn("--.Domain", size, 8)
 		return
 	}
-	hh.PutBytes(t.Signature)
+	dst = append(dst, s.Domain...)
 
-	hh.Merkleize(indx)
 	return
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockBody object
-func (b *BeaconBlockBody) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+// MarshalSSZToWriter ssz marshals the SigningRoot object to a writer
+func (s *SigningRoot) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, s)
 }
 
-// MarshalSSZTo ssz marshals the BeaconBlockBody object to a target array
-func (b *BeaconBlockBody) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(444)
+// MarshalSSZToEncoder ssz marshals the SigningRoot object to an encoder
+func (s *SigningRoot) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
 
-	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+	// Field (0) 'ObjectRoot'
+	if size := len(s.ObjectRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ObjectRoot", size, 32)
 		return
 	}
-	dst = append(dst, b.RandaoReveal...)
+	enc.EncodeBytes(s.ObjectRoot)
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+	// Field (1) 'Domain'
+	if size := len(s.Domain); size != 8 {
+		err = ssz.ErrBytesLengthFn("--.Domain", size, 8)
 		return
 	}
+	enc.EncodeBytes(s.Domain)
 
-	// Field (2) 'Graffiti'
-	dst = append(dst, b.Graffiti[:]...)
+	return
+}
 
-	// Offset (3) 'ProposerSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.ProposerSlashings) * 416
+// UnmarshalSSZ ssz unmarshals the SigningRoot object
+func (s *SigningRoot) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithState(buf, nil)
+}
 
-	// Offset (4) 'AttesterSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		offset += 4
-		offset += b.AttesterSlashings[ii].SizeSSZ()
+// UnmarshalSSZWithState ssz unmarshals the SigningRoot object within the limits of the decode state
+func (s *SigningRoot) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "SigningRoot", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SigningRoot", 40, size)
 	}
 
-	// Offset (5) 'Attestations'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		offset += 4
-		offset += b.Attestations[ii].SizeSSZ()
+	// Field (0) 'ObjectRoot'
+	if cap(s.ObjectRoot) == 0 {
+		s.ObjectRoot = make([]byte, 0, len(buf[0:32]))
 	}
+	s.ObjectRoot = append(s.ObjectRoot, buf[0:32]...)
 
-	// Offset (6) 'Deposits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Deposits) * 1240
+	// Field (1) 'Domain'
+	if cap(s.Domain) == 0 {
+		s.Domain = make([]byte, 0, len(buf[32:40]))
+	}
+	s.Domain = append(s.Domain, buf[32:40]...)
 
-	// Offset (7) 'VoluntaryExits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.VoluntaryExits) * 112
+	return err
+}
 
-	// Field (8) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(SyncAggregate)
-	}
-	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
-		return
+// ValidateSSZ checks that buf is a valid SSZ encoding of a SigningRoot object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (s *SigningRoot) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SigningRoot", 40, size)
 	}
 
-	// Field (3) 'ProposerSlashings'
-	if size := len(b.ProposerSlashings); size > 16 {
-		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
-		return
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the SigningRoot object from a reader with an encoded size of 'size' bytes
+func (s *SigningRoot) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, s)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the SigningRoot object from a decoder
+func (s *SigningRoot) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 40 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SigningRoot", 40, dec.Size())
 	}
-	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
-		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+	buf, err := dec.Read(40)
+	if err != nil {
+		return err
 	}
+	return s.UnmarshalSSZWithState(buf, dec.State())
+}
 
-	// Field (4) 'AttesterSlashings'
-	if size := len(b.AttesterSlashings); size > 2 {
-		err = ssz.ErrListTooBigFn("--.AttesterSlashings", size, 2)
+// SizeSSZ returns the ssz encoded size in bytes for the SigningRoot object
+func (s *SigningRoot) SizeSSZ() (size int) {
+	size = 40
+	return
+}
+
+// HashTreeRoot ssz hashes the SigningRoot object
+func (s *SigningRoot) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SigningRoot object with a hasher
+func (s *SigningRoot) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ObjectRoot'
+	if size := len(s.ObjectRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ObjectRoot", size, 32)
 		return
 	}
-	{
-		offset = 4 * len(b.AttesterSlashings)
-		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.AttesterSlashings[ii].SizeSSZ()
-		}
-	}
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
+	hh.PutBytes(s.ObjectRoot)
 
-	// Field (5) 'Attestations'
-	if size := len(b.Attestations); size > 128 {
-		err = ssz.ErrListTooBigFn("--.Attestations", size, 128)
+	// Field (1) 'Domain'
+	if size := len(s.Domain); size != 8 {
+		err = ssz.ErrBytesLengthFn("--.Domain", size, 8)
 		return
 	}
-	{
-		offset = 4 * len(b.Attestations)
-		for ii := 0; ii < len(b.Attestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.Attestations[ii].SizeSSZ()
-		}
-	}
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
+	hh.PutBytes(s.Domain)
 
-	// Field (6) 'Deposits'
-	if size := len(b.Deposits); size > 16 {
-		err = ssz.ErrListTooBigFn("--.Deposits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.Deposits); ii++ {
-		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
+	hh.Merkleize(indx)
+	return
+}
 
-	// Field (7) 'VoluntaryExits'
-	if size := len(b.VoluntaryExits); size > 16 {
-		err = ssz.ErrListTooBigFn("--.VoluntaryExits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
-		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+// HashTreeRootParallel ssz hashes the SigningRoot object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (s *SigningRoot) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return s.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the SigningRoot object like HashTreeRootParallel
+// with the Hashers of the pool
+func (s *SigningRoot) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'ObjectRoot'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(s.ObjectRoot); size != 32 {
+				err = ssz.ErrBytesLengthFn("--.ObjectRoot", size, 32)
+				return
+			}
+			hh.PutBytes(s.ObjectRoot)
 			return
-		}
-	}
+		},
+		// Field (1) 'Domain'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(s.Domain); size != 8 {
+				err = ssz.ErrBytesLengthFn("--.Domain", size, 8)
+				return
+			}
+			hh.PutBytes(s.Domain)
+			return
+		},
+	})
+}
 
-	return
+// SigningRootView is a lazy view over the SSZ encoding of a SigningRoot object
+type SigningRootView struct {
+	v ssz.View
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockBody object
-func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 444 {
-		return ssz.ErrSize
-	}
+// NewSigningRootView creates a view over the SSZ encoding of a SigningRoot object
+func NewSigningRootView(buf []byte) SigningRootView {
+	return SigningRootViewOf(ssz.NewView(buf))
+}
 
-	tail := buf
-	var o3, o4, o5, o6, o7 uint64
+// SigningRootViewOf returns the view of the SigningRoot object encoded in v
+func SigningRootViewOf(v ssz.View) SigningRootView {
+	return SigningRootView{v.Container(40, -1)}
+}
 
-	// Field (0) 'RandaoReveal'
-	if cap(b.RandaoReveal) == 0 {
-		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
-	}
-	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+// Raw returns the untyped view of the SigningRoot object
+func (v SigningRootView) Raw() ssz.View {
+	return v.v
+}
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
-		return err
-	}
+// SigningRootListView is a lazy view over a list of SigningRoot objects
+type SigningRootListView struct {
+	ssz.ListView
+}
 
-	// Field (2) 'Graffiti'
-	copy(b.Graffiti[:], buf[168:200])
+// At returns the view of the i-th SigningRoot object of the list
+func (l SigningRootListView) At(i int) SigningRootView {
+	return SigningRootViewOf(l.ListView.At(i))
+}
 
-	// Offset (3) 'ProposerSlashings'
-	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
-		return ssz.ErrOffset
-	}
+// ObjectRoot returns the ObjectRoot field of the SigningRoot object
+func (v SigningRootView) ObjectRoot() ([]byte, error) {
+	return v.v.Field(0, 32).Bytes()
+}
 
-	if o3 < 444 {
-		return ssz.ErrInvalidVariableOffset
-	}
+// Domain returns the Domain field of the SigningRoot object
+func (v SigningRootView) Domain() ([]byte, error) {
+	return v.v.Field(32, 40).Bytes()
+}
 
-	// Offset (4) 'AttesterSlashings'
-	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
-		return ssz.ErrOffset
+// Copy returns a deep copy of the SigningRoot object
+func (s *SigningRoot) Copy() *SigningRoot {
+	if s == nil {
+		return nil
 	}
+	cpy := new(SigningRoot)
+	*cpy = *s
 
-	// Offset (5) 'Attestations'
-	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
-		return ssz.ErrOffset
+	// Field 'ObjectRoot'
+	cpy.ObjectRoot = append(cpy.ObjectRoot[:0:0], cpy.ObjectRoot...)
+
+	// Field 'Domain'
+	cpy.Domain = append(cpy.Domain[:0:0], cpy.Domain...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the SigningRoot objects have the same SSZ encoding
+func (s *SigningRoot) EqualSSZ(other *SigningRoot) bool {
+	if s == nil {
+		s = new(SigningRoot)
+	}
+	if other == nil {
+		other = new(SigningRoot)
 	}
 
-	// Offset (6) 'Deposits'
-	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
-		return ssz.ErrOffset
+	// Field 'ObjectRoot'
+	if !bytes.Equal(s.ObjectRoot, other.ObjectRoot) {
+		return false
 	}
 
-	// Offset (7) 'VoluntaryExits'
-	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
-		return ssz.ErrOffset
+	// Field 'Domain'
+	if !bytes.Equal(s.Domain, other.Domain) {
+		return false
 	}
 
-	// Field (8) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(SyncAggregate)
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the SigningRoot objects
+func (s *SigningRoot) DiffSSZ(other *SigningRoot) []ssz.FieldDiff {
+	if s == nil {
+		s = new(SigningRoot)
 	}
-	if err = b.SyncAggregate.UnmarshalSSZ(buf[220:444]); err != nil {
-		return err
+	if other == nil {
+		other = new(SigningRoot)
 	}
+	var diffs []ssz.FieldDiff
 
-	// Field (3) 'ProposerSlashings'
-	{
-		buf = tail[o3:o4]
-		num, err := ssz.DivideInt2(len(buf), 416, 16)
-		if err != nil {
-			return err
-		}
-		b.ProposerSlashings = make([]*ProposerSlashing, num)
-		for ii := 0; ii < num; ii++ {
-			if b.ProposerSlashings[ii] == nil {
-				b.ProposerSlashings[ii] = new(ProposerSlashing)
-			}
-			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
-				return err
-			}
-		}
+	// Field 'ObjectRoot'
+	if !bytes.Equal(s.ObjectRoot, other.ObjectRoot) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "ObjectRoot", A: s.ObjectRoot, B: other.ObjectRoot})
 	}
 
-	// Field (4) 'AttesterSlashings'
-	{
-		buf = tail[o4:o5]
-		num, err := ssz.DecodeDynamicLength(buf, 2)
-		if err != nil {
-			return err
-		}
-		b.AttesterSlashings = make([]*AttesterSlashing, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.AttesterSlashings[indx] == nil {
-				b.AttesterSlashings[indx] = new(AttesterSlashing)
-			}
-			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
-			return err
-		}
+	// Field 'Domain'
+	if !bytes.Equal(s.Domain, other.Domain) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Domain", A: s.Domain, B: other.Domain})
 	}
 
-	// Field (5) 'Attestations'
-	{
-		buf = tail[o5:o6]
-		num, err := ssz.DecodeDynamicLength(buf, 128)
-		if err != nil {
-			return err
-		}
-		b.Attestations = make([]*Attestation, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.Attestations[indx] == nil {
-				b.Attestations[indx] = new(Attestation)
-			}
-			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
-			return err
-		}
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the SigningRoot object following the Beacon API conventions
+func (s *SigningRoot) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		s = new(SigningRoot)
 	}
+	dst := []byte{'{'}
 
-	// Field (6) 'Deposits'
-	{
-		buf = tail[o6:o7]
-		num, err := ssz.DivideInt2(len(buf), 1240, 16)
-		if err != nil {
-			return err
-		}
-		b.Deposits = make([]*Deposit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Deposits[ii] == nil {
-				b.Deposits[ii] = new(Deposit)
-			}
-			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1240 : (ii+1)*1240]); err != nil {
-				return err
-			}
-		}
+	// Field (0) 'ObjectRoot'
+	dst = append(dst, "\"object_root\":"...)
+	dst = ssz.MarshalJSONBytes(dst, s.ObjectRoot)
+
+	// Field (1) 'Domain'
+	dst = append(dst, ",\"domain\":"...)
+	dst = ssz.MarshalJSONBytes(dst, s.Domain)
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the SigningRoot object following the Beacon API conventions
+func (s *SigningRoot) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
 	}
 
-	// Field (7) 'VoluntaryExits'
-	{
-		buf = tail[o7:]
-		num, err := ssz.DivideInt2(len(buf), 112, 16)
-		if err != nil {
-			return err
-		}
-		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.VoluntaryExits[ii] == nil {
-				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
-			}
-			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
-				return err
-			}
-		}
+	// Field (0) 'ObjectRoot'
+	if s.ObjectRoot, err = ssz.UnmarshalJSONBytes(fields["object_root"], 32, 32); err != nil {
+		return ssz.WrapJSONError(err, "ObjectRoot")
 	}
-	return err
+
+	// Field (1) 'Domain'
+	if s.Domain, err = ssz.UnmarshalJSONBytes(fields["domain"], 8, 8); err != nil {
+		return ssz.WrapJSONError(err, "Domain")
+	}
+
+	return nil
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBody object
-func (b *BeaconBlockBody) SizeSSZ() (size int) {
-	size = 444
+// MarshalSSZ ssz marshals the HistoricalBatch object
+func (h *HistoricalBatch) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(h)
+}
 
-	// Field (3) 'ProposerSlashings'
-	size += len(b.ProposerSlashings) * 416
+// MarshalSSZTo ssz marshals the HistoricalBatch object to a target array
+func (h *HistoricalBatch) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
-	// Field (4) 'AttesterSlashings'
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		size += 4
-		size += b.AttesterSlashings[ii].SizeSSZ()
+	// Field (0) 'BlockRoots'
+	for ii := 0; ii < 64; ii++ {
+		dst = append(dst, h.BlockRoots[ii][:]...)
 	}
 
-	// Field (5) 'Attestations'
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		size += 4
-		size += b.Attestations[ii].SizeSSZ()
+	// Field (1) 'StateRoots'
+	if size := len(h.StateRoots); size != 64 {
+		err = ssz.ErrVectorLengthFn("--.StateRoots", size, 64)
+		return
+	}
+	for ii := 0; ii < 64; ii++ {
+		if size := len(h.StateRoots[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.StateRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, h.StateRoots[ii]...)
 	}
-
-	// Field (6) 'Deposits'
-	size += len(b.Deposits) * 1240
-
-	// Field (7) 'VoluntaryExits'
-	size += len(b.VoluntaryExits) * 112
 
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconBlockBody object
-func (b *BeaconBlockBody) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// MarshalSSZToWriter ssz marshals the HistoricalBatch object to a writer
+func (h *HistoricalBatch) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, h)
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlockBody object with a hasher
-func (b *BeaconBlockBody) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+// MarshalSSZToEncoder ssz marshals the HistoricalBatch object to an encoder
+func (h *HistoricalBatch) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
 
-	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
-		return
+	// Field (0) 'BlockRoots'
+	for ii := 0; ii < 64; ii++ {
+		enc.EncodeBytes(h.BlockRoots[ii][:])
 	}
-	hh.PutBytes(b.RandaoReveal)
 
-	// Field (1) 'Eth1Data'
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+	// Field (1) 'StateRoots'
+	if size := len(h.StateRoots); size != 64 {
+		err = ssz.ErrVectorLengthFn("--.StateRoots", size, 64)
 		return
 	}
-
-	// Field (2) 'Graffiti'
-	hh.PutBytes(b.Graffiti[:])
-
-	// Field (3) 'ProposerSlashings'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.ProposerSlashings))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
+	for ii := 0; ii < 64; ii++ {
+		if size := len(h.StateRoots[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.StateRoots[ii]", size, 32)
 			return
 		}
-		for _, elem := range b.ProposerSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
+		enc.EncodeBytes(h.StateRoots[ii])
 	}
 
-	// Field (4) 'AttesterSlashings'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.AttesterSlashings))
-		if num > 2 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.AttesterSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 2)
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the HistoricalBatch object
+func (h *HistoricalBatch) UnmarshalSSZ(buf []byte) error {
+	return h.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the HistoricalBatch object within the limits of the decode state
+func (h *HistoricalBatch) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "HistoricalBatch", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 4096 {
+		return ssz.NewDecodeError(ssz.ErrSize, "HistoricalBatch", 4096, size)
 	}
 
-	// Field (5) 'Attestations'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Attestations))
-		if num > 128 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Attestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+	// Field (0) 'BlockRoots'
+
+	for ii := 0; ii < 64; ii++ {
+		copy(h.BlockRoots[ii][:], buf[0:2048][ii*32:(ii+1)*32])
+	}
+
+	// Field (1) 'StateRoots'
+	if err = st.Alloc(64, 24); err != nil {
+		return ssz.WrapDecodeError(err, "HistoricalBatch", "StateRoots", 2048)
+	}
+	h.StateRoots = make([][]byte, 64)
+	for ii := 0; ii < 64; ii++ {
+		if cap(h.StateRoots[ii]) == 0 {
+			h.StateRoots[ii] = make([]byte, 0, len(buf[2048:4096][ii*32:(ii+1)*32]))
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 128)
+		h.StateRoots[ii] = append(h.StateRoots[ii], buf[2048:4096][ii*32:(ii+1)*32]...)
 	}
 
-	// Field (6) 'Deposits'
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a HistoricalBatch object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (h *HistoricalBatch) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 4096 {
+		return ssz.NewDecodeError(ssz.ErrSize, "HistoricalBatch", 4096, size)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the HistoricalBatch object from a reader with an encoded size of 'size' bytes
+func (h *HistoricalBatch) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, h)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the HistoricalBatch object from a decoder
+func (h *HistoricalBatch) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 4096 {
+		return ssz.NewDecodeError(ssz.ErrSize, "HistoricalBatch", 4096, dec.Size())
+	}
+	buf, err := dec.Read(4096)
+	if err != nil {
+		return err
+	}
+	return h.UnmarshalSSZWithState(buf, dec.State())
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the HistoricalBatch object
+func (h *HistoricalBatch) SizeSSZ() (size int) {
+	size = 4096
+	return
+}
+
+// HashTreeRoot ssz hashes the HistoricalBatch object
+func (h *HistoricalBatch) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(h)
+}
+
+// HashTreeRootWith ssz hashes the HistoricalBatch object with a hasher
+func (h *HistoricalBatch) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'BlockRoots'
 	{
 		subIndx := hh.Index()
-		num := uint64(len(b.Deposits))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Deposits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		for _, i := range h.BlockRoots {
+			hh.Append(i[:])
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
+		hh.Merkleize(subIndx)
 	}
 
-	// Field (7) 'VoluntaryExits'
+	// Field (1) 'StateRoots'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.VoluntaryExits))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
+		if size := len(h.StateRoots); size != 64 {
+			err = ssz.ErrVectorLengthFn("--.StateRoots", size, 64)
 			return
 		}
-		for _, elem := range b.VoluntaryExits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
+		subIndx := hh.Index()
+		for _, i := range h.StateRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
 				return
 			}
+			hh.Append(i)
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
-	}
-
-	// Field (8) 'SyncAggregate'
-	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
-		return
+		hh.Merkleize(subIndx)
 	}
 
 	hh.Merkleize(indx)
 	return
 }
 
-// MarshalSSZ ssz marshals the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
+// HashTreeRootParallel ssz hashes the HistoricalBatch object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (h *HistoricalBatch) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return h.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
 }
 
-// MarshalSSZTo ssz marshals the SignedBeaconBlockHeader object to a target array
-func (s *SignedBeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-
-	// Field (0) 'Header'
-	if s.Header == nil {
-		s.Header = new(BeaconBlockHeader)
-	}
-	if dst, err = s.Header.MarshalSSZTo(dst); err != nil {
-		return
-	}
-
-	// Field (1) 'Signature'
-	if size := len(s.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
-		return
-	}
-	dst = append(dst, s.Signature...)
+// HashTreeRootParallelWithPool ssz hashes the HistoricalBatch object like HashTreeRootParallel
+// with the Hashers of the pool
+func (h *HistoricalBatch) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'BlockRoots'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				subIndx := hh.Index()
+				for _, i := range h.BlockRoots {
+					hh.Append(i[:])
+				}
+				hh.Merkleize(subIndx)
+			}
+			return
+		},
+		// Field (1) 'StateRoots'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				if size := len(h.StateRoots); size != 64 {
+					err = ssz.ErrVectorLengthFn("--.StateRoots", size, 64)
+					return
+				}
+				subIndx := hh.Index()
+				for _, i := range h.StateRoots {
+					if len(i) != 32 {
+						err = ssz.ErrBytesLength
+						return
+					}
+					hh.Append(i)
+				}
+				hh.Merkleize(subIndx)
+			}
+			return
+		},
+	})
+}
 
-	return
+// HistoricalBatchView is a lazy view over the SSZ encoding of a HistoricalBatch object
+type HistoricalBatchView struct {
+	v ssz.View
 }
 
-// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 208 {
-		return ssz.ErrSize
-	}
+// NewHistoricalBatchView creates a view over the SSZ encoding of a HistoricalBatch object
+func NewHistoricalBatchView(buf []byte) HistoricalBatchView {
+	return HistoricalBatchViewOf(ssz.NewView(buf))
+}
 
-	// Field (0) 'Header'
-	if s.Header == nil {
-		s.Header = new(BeaconBlockHeader)
-	}
-	if err = s.Header.UnmarshalSSZ(buf[0:112]); err != nil {
-		return err
-	}
+// HistoricalBatchViewOf returns the view of the HistoricalBatch object encoded in v
+func HistoricalBatchViewOf(v ssz.View) HistoricalBatchView {
+	return HistoricalBatchView{v.Container(4096, -1)}
+}
 
-	// Field (1) 'Signature'
-	if cap(s.Signature) == 0 {
-		s.Signature = make([]byte, 0, len(buf[112:208]))
-	}
-	s.Signature = append(s.Signature, buf[112:208]...)
+// Raw returns the untyped view of the HistoricalBatch object
+func (v HistoricalBatchView) Raw() ssz.View {
+	return v.v
+}
 
-	return err
+// HistoricalBatchListView is a lazy view over a list of HistoricalBatch objects
+type HistoricalBatchListView struct {
+	ssz.ListView
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) SizeSSZ() (size int) {
-	size = 208
-	return
+// At returns the view of the i-th HistoricalBatch object of the list
+func (l HistoricalBatchListView) At(i int) HistoricalBatchView {
+	return HistoricalBatchViewOf(l.ListView.At(i))
 }
 
-// HashTreeRoot ssz hashes the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+// BlockRoots returns the BlockRoots field of the HistoricalBatch object
+func (v HistoricalBatchView) BlockRoots() ssz.ListView {
+	return v.v.Field(0, 2048).List(32, 64)
 }
 
-// HashTreeRootWith ssz hashes the SignedBeaconBlockHeader object with a hasher
-func (s *SignedBeaconBlockHeader) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+// StateRoots returns the StateRoots field of the HistoricalBatch object
+func (v HistoricalBatchView) StateRoots() ssz.ListView {
+	return v.v.Field(2048, 4096).List(32, 64)
+}
 
-	// Field (0) 'Header'
-	if err = s.Header.HashTreeRootWith(hh); err != nil {
-		return
+// Copy returns a deep copy of the HistoricalBatch object
+func (h *HistoricalBatch) Copy() *HistoricalBatch {
+	if h == nil {
+		return nil
 	}
+	cpy := new(HistoricalBatch)
+	*cpy = *h
 
-	// Field (1) 'Signature'
-	if size := len(s.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
-		return
+	// Field 'StateRoots'
+	cpy.StateRoots = append(cpy.StateRoots[:0:0], cpy.StateRoots...)
+	for i0 := range cpy.StateRoots {
+		cpy.StateRoots[i0] = append(cpy.StateRoots[i0][:0:0], cpy.StateRoots[i0]...)
 	}
-	hh.PutBytes(s.Signature)
 
-	hh.Merkleize(indx)
-	return
-}
-
-// MarshalSSZ ssz marshals the BeaconBlockHeader object
-func (b *BeaconBlockHeader) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+	return cpy
 }
 
-// MarshalSSZTo ssz marshals the BeaconBlockHeader object to a target array
-func (b *BeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-
-	// Field (0) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
-
-	// Field (1) 'ProposerIndex'
-	dst = ssz.MarshalUint64(dst, b.ProposerIndex)
-
-	// Field (2) 'ParentRoot'
-	if size := len(b.ParentRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
-		return
+// EqualSSZ returns true if the HistoricalBatch objects have the same SSZ encoding
+func (h *HistoricalBatch) EqualSSZ(other *HistoricalBatch) bool {
+	if h == nil {
+		h = new(HistoricalBatch)
+	}
+	if other == nil {
+		other = new(HistoricalBatch)
 	}
-	dst = append(dst, b.ParentRoot...)
 
-	// Field (3) 'StateRoot'
-	if size := len(b.StateRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
-		return
+	// Field 'BlockRoots'
+	if h.BlockRoots != other.BlockRoots {
+		return false
 	}
-	dst = append(dst, b.StateRoot...)
 
-	// Field (4) 'BodyRoot'
-	if size := len(b.BodyRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.BodyRoot", size, 32)
-		return
+	// Field 'StateRoots'
+	if len(h.StateRoots) != len(other.StateRoots) {
+		return false
+	} else {
+		for i0 := range h.StateRoots {
+			if !bytes.Equal(h.StateRoots[i0], other.StateRoots[i0]) {
+				return false
+			}
+		}
 	}
-	dst = append(dst, b.BodyRoot...)
 
-	return
+	return true
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockHeader object
-func (b *BeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 112 {
-		return ssz.ErrSize
+// DiffSSZ returns the fields with a different SSZ encoding in the HistoricalBatch objects
+func (h *HistoricalBatch) DiffSSZ(other *HistoricalBatch) []ssz.FieldDiff {
+	if h == nil {
+		h = new(HistoricalBatch)
+	}
+	if other == nil {
+		other = new(HistoricalBatch)
 	}
+	var diffs []ssz.FieldDiff
 
-	// Field (0) 'Slot'
-	b.Slot = ssz.UnmarshallUint64(buf[0:8])
+	// Field 'BlockRoots'
+	if h.BlockRoots != other.BlockRoots {
+		diffs = append(diffs, ssz.FieldDiff{Path: "BlockRoots", A: h.BlockRoots, B: other.BlockRoots})
+	}
 
-	// Field (1) 'ProposerIndex'
-	b.ProposerIndex = ssz.UnmarshallUint64(buf[8:16])
+	// Field 'StateRoots'
+	if len(h.StateRoots) != len(other.StateRoots) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "StateRoots", A: h.StateRoots, B: other.StateRoots})
+	} else {
+		for i0 := range h.StateRoots {
+			if !bytes.Equal(h.StateRoots[i0], other.StateRoots[i0]) {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("StateRoots", i0), A: h.StateRoots[i0], B: other.StateRoots[i0]})
+			}
+		}
+	}
 
-	// Field (2) 'ParentRoot'
-	if cap(b.ParentRoot) == 0 {
-		b.ParentRoot = make([]byte, 0, len(buf[16:48]))
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the HistoricalBatch object following the Beacon API conventions
+func (h *HistoricalBatch) MarshalJSON() ([]byte, error) {
+	if h == nil {
+		h = new(HistoricalBatch)
 	}
-	b.ParentRoot = append(b.ParentRoot, buf[16:48]...)
+	dst := []byte{'{'}
 
-	// Field (3) 'StateRoot'
-	if cap(b.StateRoot) == 0 {
-		b.StateRoot = make([]byte, 0, len(buf[48:80]))
+	// Field (0) 'BlockRoots'
+	dst = append(dst, "\"block_roots\":"...)
+	dst = append(dst, '[')
+	for i0 := range h.BlockRoots {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONBytes(dst, h.BlockRoots[i0][:])
 	}
-	b.StateRoot = append(b.StateRoot, buf[48:80]...)
+	dst = append(dst, ']')
 
-	// Field (4) 'BodyRoot'
-	if cap(b.BodyRoot) == 0 {
-		b.BodyRoot = make([]byte, 0, len(buf[80:112]))
+	// Field (1) 'StateRoots'
+	dst = append(dst, ",\"state_roots\":"...)
+	dst = append(dst, '[')
+	for i0 := range h.StateRoots {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONBytes(dst, h.StateRoots[i0])
 	}
-	b.BodyRoot = append(b.BodyRoot, buf[80:112]...)
+	dst = append(dst, ']')
 
-	return err
+	dst = append(dst, '}')
+	return dst, nil
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockHeader object
-func (b *BeaconBlockHeader) SizeSSZ() (size int) {
-	size = 112
-	return
-}
+// UnmarshalJSON decodes the JSON encoding of the HistoricalBatch object following the Beacon API conventions
+func (h *HistoricalBatch) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
 
-// HashTreeRoot ssz hashes the BeaconBlockHeader object
-func (b *BeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
-}
+	// Field (0) 'BlockRoots'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["block_roots"], 64, 64)
+		if err != nil {
+			return ssz.WrapJSONError(err, "BlockRoots")
+		}
 
-// HashTreeRootWith ssz hashes the BeaconBlockHeader object with a hasher
-func (b *BeaconBlockHeader) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONBytes(items0[i0], 32, 32)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("BlockRoots", i0))
+				}
+				copy(h.BlockRoots[i0][:], val)
+			}
+		}
+	}
 
-	// Field (0) 'Slot'
-	hh.PutUint64(b.Slot)
+	// Field (1) 'StateRoots'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["state_roots"], 64, 64)
+		if err != nil {
+			return ssz.WrapJSONError(err, "StateRoots")
+		}
+		h.StateRoots = make([][]byte, len(items0))
+		for i0 := range items0 {
+			if h.StateRoots[i0], err = ssz.UnmarshalJSONBytes(items0[i0], 32, 32); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("StateRoots", i0))
+			}
+		}
+	}
 
-	// Field (1) 'ProposerIndex'
-	hh.PutUint64(b.ProposerIndex)
+	return nil
+}
 
-	// Field (2) 'ParentRoot'
-	if size := len(b.ParentRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
-		return
-	}
-	hh.PutBytes(b.ParentRoot)
+// MarshalSSZ ssz marshals the ProposerSlashing object
+func (p *ProposerSlashing) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
 
-	// Field (3) 'StateRoot'
-	if size := len(b.StateRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
+// MarshalSSZTo ssz marshals the ProposerSlashing object to a target array
+func (p *ProposerSlashing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Header1'
+	if p.Header1 == nil {
+		p.Header1 = new(SignedBeaconBlockHeader)
+	}
+	if dst, err = p.Header1.MarshalSSZTo(dst); err != nil {
 		return
 	}
-	hh.PutBytes(b.StateRoot)
 
-	// Field (4) 'BodyRoot'
-	if size := len(b.BodyRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.BodyRoot", size, 32)
+	// Field (1) 'Header2'
+	if p.Header2 == nil {
+		p.Header2 = new(SignedBeaconBlockHeader)
+	}
+	if dst, err = p.Header2.MarshalSSZTo(dst); err != nil {
 		return
 	}
-	hh.PutBytes(b.BodyRoot)
 
-	hh.Merkleize(indx)
 	return
 }
 
-// MarshalSSZ ssz marshals the ErrorResponse object
-func (e *ErrorResponse) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
+// MarshalSSZToWriter ssz marshals the ProposerSlashing object to a writer
+func (p *ProposerSlashing) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, p)
 }
 
-// MarshalSSZTo ssz marshals the ErrorResponse object to a target array
-func (e *ErrorResponse) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(4)
+// MarshalSSZToEncoder ssz marshals the ProposerSlashing object to an encoder
+func (p *ProposerSlashing) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
 
-	// Offset (0) 'Message'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += e.Message.SizeSSZ()
+	// Field (0) 'Header1'
+	if p.Header1 == nil {
+		p.Header1 = new(SignedBeaconBlockHeader)
+	}
+	if err = enc.EncodeObject(p.Header1); err != nil {
+		return
+	}
 
-	// Field (0) 'Message'
-	if dst, err = e.Message.MarshalSSZTo(dst); err != nil {
+	// Field (1) 'Header2'
+	if p.Header2 == nil {
+		p.Header2 = new(SignedBeaconBlockHeader)
+	}
+	if err = enc.EncodeObject(p.Header2); err != nil {
 		return
 	}
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the ErrorResponse object
-func (e *ErrorResponse) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the ProposerSlashing object
+func (p *ProposerSlashing) UnmarshalSSZ(buf []byte) error {
+	return p.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the ProposerSlashing object within the limits of the decode state
+func (p *ProposerSlashing) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
 	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "ProposerSlashing", "", 0)
+	}
+	defer st.Leave()
 	size := uint64(len(buf))
-	if size < 4 {
-		return ssz.ErrSize
+	if size != 416 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ProposerSlashing", 416, size)
 	}
 
-	tail := buf
-	var o0 uint64
+	// Field (0) 'Header1'
+	if p.Header1 == nil {
+		p.Header1 = new(SignedBeaconBlockHeader)
+	}
+	if err = ssz.UnmarshalWithState(p.Header1, buf[0:208], st); err != nil {
+		return ssz.WrapDecodeError(err, "ProposerSlashing", "Header1", 0)
+	}
 
-	// Offset (0) 'Message'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
+	// Field (1) 'Header2'
+	if p.Header2 == nil {
+		p.Header2 = new(SignedBeaconBlockHeader)
+	}
+	if err = ssz.UnmarshalWithState(p.Header2, buf[208:416], st); err != nil {
+		return ssz.WrapDecodeError(err, "ProposerSlashing", "Header2", 208)
+	}
+
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a ProposerSlashing object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (p *ProposerSlashing) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 416 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ProposerSlashing", 416, size)
 	}
 
-	if o0 < 4 {
-		return ssz.ErrInvalidVariableOffset
+	// Field (0) 'Header1'
+	if err = (*SignedBeaconBlockHeader)(nil).ValidateSSZ(buf[0:208]); err != nil {
+		return ssz.WrapDecodeError(err, "ProposerSlashing", "Header1", 0)
 	}
 
-	// Field (0) 'Message'
-	{
-		buf = tail[o0:]
-		if err = e.Message.UnmarshalSSZ(buf); err != nil {
-			return err
-		}
+	// Field (1) 'Header2'
+	if err = (*SignedBeaconBlockHeader)(nil).ValidateSSZ(buf[208:416]); err != nil {
+		return ssz.WrapDecodeError(err, "ProposerSlashing", "Header2", 208)
 	}
+
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the ErrorResponse object
-func (e *ErrorResponse) SizeSSZ() (size int) {
-	size = 4
+// UnmarshalSSZFromReader ssz unmarshals the ProposerSlashing object from a reader with an encoded size of 'size' bytes
+func (p *ProposerSlashing) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, p)
+}
 
-	// Field (0) 'Message'
-	size += e.Message.SizeSSZ()
+// UnmarshalSSZFromDecoder ssz unmarshals the ProposerSlashing object from a decoder
+func (p *ProposerSlashing) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 416 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ProposerSlashing", 416, dec.Size())
+	}
+	buf, err := dec.Read(416)
+	if err != nil {
+		return err
+	}
+	return p.UnmarshalSSZWithState(buf, dec.State())
+}
 
+// SizeSSZ returns the ssz encoded size in bytes for the ProposerSlashing object
+func (p *ProposerSlashing) SizeSSZ() (size int) {
+	size = 416
 	return
 }
 
-// HashTreeRoot ssz hashes the ErrorResponse object
-func (e *ErrorResponse) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(e)
+// HashTreeRoot ssz hashes the ProposerSlashing object
+func (p *ProposerSlashing) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
 }
 
-// HashTreeRootWith ssz hashes the ErrorResponse object with a hasher
-func (e *ErrorResponse) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the ProposerSlashing object with a hasher
+func (p *ProposerSlashing) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'Message'
-	if err = e.Message.HashTreeRootWith(hh); err != nil {
+	// Field (0) 'Header1'
+	if err = p.Header1.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Header2'
+	if err = p.Header2.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
@@ -3777,939 +7067,11316 @@ func (e *ErrorResponse) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the Dummy object
-func (d *Dummy) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(d)
+// HashTreeRootParallel ssz hashes the ProposerSlashing object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (p *ProposerSlashing) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return p.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
 }
 
-// MarshalSSZTo ssz marshals the Dummy object to a target array
-func (d *Dummy) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// HashTreeRootParallelWithPool ssz hashes the ProposerSlashing object like HashTreeRootParallel
+// with the Hashers of the pool
+func (p *ProposerSlashing) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Header1'
+		func(hh *ssz.Hasher) (err error) {
+			if err = p.Header1.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (1) 'Header2'
+		func(hh *ssz.Hasher) (err error) {
+			if err = p.Header2.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+	})
+}
 
-	return
+// ProposerSlashingView is a lazy view over the SSZ encoding of a ProposerSlashing object
+type ProposerSlashingView struct {
+	v ssz.View
 }
 
-// UnmarshalSSZ ssz unmarshals the Dummy object
-func (d *Dummy) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 0 {
-		return ssz.ErrSize
-	}
+// NewProposerSlashingView creates a view over the SSZ encoding of a ProposerSlashing object
+func NewProposerSlashingView(buf []byte) ProposerSlashingView {
+	return ProposerSlashingViewOf(ssz.NewView(buf))
+}
 
-	return err
+// ProposerSlashingViewOf returns the view of the ProposerSlashing object encoded in v
+func ProposerSlashingViewOf(v ssz.View) ProposerSlashingView {
+	return ProposerSlashingView{v.Container(416, -1)}
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the Dummy object
-func (d *Dummy) SizeSSZ() (size int) {
-	size = 0
-	return
+// Raw returns the untyped view of the ProposerSlashing object
+func (v ProposerSlashingView) Raw() ssz.View {
+	return v.v
 }
 
-// HashTreeRoot ssz hashes the Dummy object
-func (d *Dummy) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(d)
+// ProposerSlashingListView is a lazy view over a list of ProposerSlashing objects
+type ProposerSlashingListView struct {
+	ssz.ListView
 }
 
-// HashTreeRootWith ssz hashes the Dummy object with a hasher
-func (d *Dummy) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+// At returns the view of the i-th ProposerSlashing object of the list
+func (l ProposerSlashingListView) At(i int) ProposerSlashingView {
+	return ProposerSlashingViewOf(l.ListView.At(i))
+}
 
-	hh.Merkleize(indx)
-	return
+// Header1 returns the Header1 field of the ProposerSlashing object
+func (v ProposerSlashingView) Header1() SignedBeaconBlockHeaderView {
+	return SignedBeaconBlockHeaderViewOf(v.v.Field(0, 208))
 }
 
-// MarshalSSZ ssz marshals the SyncCommittee object
-func (s *SyncCommittee) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
+// Header2 returns the Header2 field of the ProposerSlashing object
+func (v ProposerSlashingView) Header2() SignedBeaconBlockHeaderView {
+	return SignedBeaconBlockHeaderViewOf(v.v.Field(208, 416))
 }
 
-// MarshalSSZTo ssz marshals the SyncCommittee object to a target array
-func (s *SyncCommittee) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// Copy returns a deep copy of the ProposerSlashing object
+func (p *ProposerSlashing) Copy() *ProposerSlashing {
+	if p == nil {
+		return nil
+	}
+	cpy := new(ProposerSlashing)
+	*cpy = *p
 
-	// Field (0) 'PubKeys'
-	if size := len(s.PubKeys); size != 1024 {
-		err = ssz.ErrVectorLengthFn("--.PubKeys", size, 1024)
-		return
+	// Field 'Header1'
+	cpy.Header1 = cpy.Header1.Copy()
+
+	// Field 'Header2'
+	cpy.Header2 = cpy.Header2.Copy()
+
+	return cpy
+}
+
+// EqualSSZ returns true if the ProposerSlashing objects have the same SSZ encoding
+func (p *ProposerSlashing) EqualSSZ(other *ProposerSlashing) bool {
+	if p == nil {
+		p = new(ProposerSlashing)
 	}
-	for ii := 0; ii < 1024; ii++ {
-		if size := len(s.PubKeys[ii]); size != 48 {
-			err = ssz.ErrBytesLengthFn("--.PubKeys[ii]", size, 48)
-			return
-		}
-		dst = append(dst, s.PubKeys[ii]...)
+	if other == nil {
+		other = new(ProposerSlashing)
 	}
 
-	// Field (1) 'PubKeyAggregates'
-	for ii := 0; ii < 16; ii++ {
-		dst = append(dst, s.PubKeyAggregates[ii][:]...)
+	// Field 'Header1'
+	if !p.Header1.EqualSSZ(other.Header1) {
+		return false
 	}
 
-	return
+	// Field 'Header2'
+	if !p.Header2.EqualSSZ(other.Header2) {
+		return false
+	}
+
+	return true
 }
 
-// UnmarshalSSZ ssz unmarshals the SyncCommittee object
-func (s *SyncCommittee) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 49920 {
-		return ssz.ErrSize
+// DiffSSZ returns the fields with a different SSZ encoding in the ProposerSlashing objects
+func (p *ProposerSlashing) DiffSSZ(other *ProposerSlashing) []ssz.FieldDiff {
+	if p == nil {
+		p = new(ProposerSlashing)
 	}
-
-	// Field (0) 'PubKeys'
-	s.PubKeys = make([][]byte, 1024)
-	for ii := 0; ii < 1024; ii++ {
-		if cap(s.PubKeys[ii]) == 0 {
-			s.PubKeys[ii] = make([]byte, 0, len(buf[0:49152][ii*48:(ii+1)*48]))
-		}
-		s.PubKeys[ii] = append(s.PubKeys[ii], buf[0:49152][ii*48:(ii+1)*48]...)
+	if other == nil {
+		other = new(ProposerSlashing)
 	}
+	var diffs []ssz.FieldDiff
 
-	// Field (1) 'PubKeyAggregates'
+	// Field 'Header1'
+	diffs = ssz.AppendDiffs(diffs, "Header1", p.Header1.DiffSSZ(other.Header1))
 
-	for ii := 0; ii < 16; ii++ {
-		copy(s.PubKeyAggregates[ii][:], buf[49152:49920][ii*48:(ii+1)*48])
-	}
+	// Field 'Header2'
+	diffs = ssz.AppendDiffs(diffs, "Header2", p.Header2.DiffSSZ(other.Header2))
 
-	return err
+	return diffs
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SyncCommittee object
-func (s *SyncCommittee) SizeSSZ() (size int) {
-	size = 49920
-	return
-}
+// MarshalJSON returns the JSON encoding of the ProposerSlashing object following the Beacon API conventions
+func (p *ProposerSlashing) MarshalJSON() ([]byte, error) {
+	if p == nil {
+		p = new(ProposerSlashing)
+	}
+	var err error
+	dst := []byte{'{'}
 
-// HashTreeRoot ssz hashes the SyncCommittee object
-func (s *SyncCommittee) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+	// Field (0) 'Header1'
+	dst = append(dst, "\"signed_header_1\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, p.Header1); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Header2'
+	dst = append(dst, ",\"signed_header_2\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, p.Header2); err != nil {
+		return nil, err
+	}
+
+	dst = append(dst, '}')
+	return dst, nil
 }
 
-// HashTreeRootWith ssz hashes the SyncCommittee object with a hasher
-func (s *SyncCommittee) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+// UnmarshalJSON decodes the JSON encoding of the ProposerSlashing object following the Beacon API conventions
+func (p *ProposerSlashing) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
 
-	// Field (0) 'PubKeys'
+	// Field (0) 'Header1'
+	if p.Header1 == nil {
+		p.Header1 = new(SignedBeaconBlockHeader)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["signed_header_1"], p.Header1); err != nil {
+		return ssz.WrapJSONError(err, "Header1")
+	}
+
+	// Field (1) 'Header2'
+	if p.Header2 == nil {
+		p.Header2 = new(SignedBeaconBlockHeader)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["signed_header_2"], p.Header2); err != nil {
+		return ssz.WrapJSONError(err, "Header2")
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the AttesterSlashing object
+func (a *AttesterSlashing) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(a)
+}
+
+// MarshalSSZTo ssz marshals the AttesterSlashing object to a target array
+func (a *AttesterSlashing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'Attestation1'
+	dst = ssz.WriteOffset(dst, offset)
+	if a.Attestation1 == nil {
+		a.Attestation1 = new(IndexedAttestation)
+	}
+	offset += a.Attestation1.SizeSSZ()
+
+	// Offset (1) 'Attestation2'
+	dst = ssz.WriteOffset(dst, offset)
+	if a.Attestation2 == nil {
+		a.Attestation2 = new(IndexedAttestation)
+	}
+	offset += a.Attestation2.SizeSSZ()
+
+	// Field (0) 'Attestation1'
+	if dst, err = a.Attestation1.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Attestation2'
+	if dst, err = a.Attestation2.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the AttesterSlashing object to a writer
+func (a *AttesterSlashing) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, a)
+}
+
+// MarshalSSZToEncoder ssz marshals the AttesterSlashing object to an encoder
+func (a *AttesterSlashing) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(8)
+
+	// Offset (0) 'Attestation1'
+	enc.EncodeOffset(offset)
+	if a.Attestation1 == nil {
+		a.Attestation1 = new(IndexedAttestation)
+	}
+	offset += a.Attestation1.SizeSSZ()
+
+	// Offset (1) 'Attestation2'
+	enc.EncodeOffset(offset)
+	if a.Attestation2 == nil {
+		a.Attestation2 = new(IndexedAttestation)
+	}
+	offset += a.Attestation2.SizeSSZ()
+
+	// Field (0) 'Attestation1'
+	if err = enc.EncodeObject(a.Attestation1); err != nil {
+		return
+	}
+
+	// Field (1) 'Attestation2'
+	if err = enc.EncodeObject(a.Attestation2); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the AttesterSlashing object
+func (a *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
+	return a.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the AttesterSlashing object within the limits of the decode state
+func (a *AttesterSlashing) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "AttesterSlashing", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.NewDecodeError(ssz.ErrSize, "AttesterSlashing", 8, size)
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Attestation1'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "AttesterSlashing", "Attestation1", 0)
+	}
+
+	if o0 != 8 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 8, o0), "AttesterSlashing", "Attestation1", 0)
+	}
+
+	// Offset (1) 'Attestation2'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "AttesterSlashing", "Attestation2", 4)
+	}
+
+	// Field (0) 'Attestation1'
 	{
-		if size := len(s.PubKeys); size != 1024 {
-			err = ssz.ErrVectorLengthFn("--.PubKeys", size, 1024)
-			return
+		buf = tail[o0:o1]
+		if a.Attestation1 == nil {
+			a.Attestation1 = new(IndexedAttestation)
 		}
-		subIndx := hh.Index()
-		for _, i := range s.PubKeys {
-			if len(i) != 48 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.PutBytes(i)
+		if err = ssz.UnmarshalWithState(a.Attestation1, buf, st); err != nil {
+			return ssz.WrapDecodeError(err, "AttesterSlashing", "Attestation1", o0)
 		}
-		hh.Merkleize(subIndx)
 	}
 
-	// Field (1) 'PubKeyAggregates'
+	// Field (1) 'Attestation2'
 	{
-		subIndx := hh.Index()
-		for _, i := range s.PubKeyAggregates {
-			hh.PutBytes(i[:])
+		buf = tail[o1:]
+		if a.Attestation2 == nil {
+			a.Attestation2 = new(IndexedAttestation)
 		}
-		hh.Merkleize(subIndx)
+		if err = ssz.UnmarshalWithState(a.Attestation2, buf, st); err != nil {
+			return ssz.WrapDecodeError(err, "AttesterSlashing", "Attestation2", o1)
+		}
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a AttesterSlashing object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (a *AttesterSlashing) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.NewDecodeError(ssz.ErrSize, "AttesterSlashing", 8, size)
 	}
 
-	hh.Merkleize(indx)
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Attestation1'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "AttesterSlashing", "Attestation1", 0)
+	}
+
+	if o0 != 8 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 8, o0), "AttesterSlashing", "Attestation1", 0)
+	}
+
+	// Offset (1) 'Attestation2'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "AttesterSlashing", "Attestation2", 4)
+	}
+
+	// Field (0) 'Attestation1'
+	{
+		buf = tail[o0:o1]
+		if err = (*IndexedAttestation)(nil).ValidateSSZ(buf); err != nil {
+			return ssz.WrapDecodeError(err, "AttesterSlashing", "Attestation1", o0)
+		}
+	}
+
+	// Field (1) 'Attestation2'
+	{
+		buf = tail[o1:]
+		if err = (*IndexedAttestation)(nil).ValidateSSZ(buf); err != nil {
+			return ssz.WrapDecodeError(err, "AttesterSlashing", "Attestation2", o1)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the AttesterSlashing object from a reader with an encoded size of 'size' bytes
+func (a *AttesterSlashing) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, a)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the AttesterSlashing object from a decoder
+func (a *AttesterSlashing) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 8 {
+		return ssz.NewDecodeError(ssz.ErrSize, "AttesterSlashing", 8, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "AttesterSlashing", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(8)
+	if err != nil {
+		return err
+	}
+	var o0, o1 uint64
+
+	// Offset (0) 'Attestation1'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "AttesterSlashing", "Attestation1", 0)
+	}
+
+	if o0 != 8 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 8, o0), "AttesterSlashing", "Attestation1", 0)
+	}
+
+	// Offset (1) 'Attestation2'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "AttesterSlashing", "Attestation2", 4)
+	}
+
+	// Field (0) 'Attestation1'
+	{
+		if a.Attestation1 == nil {
+			a.Attestation1 = new(IndexedAttestation)
+		}
+		if err = dec.DecodeObject(a.Attestation1, o1-o0); err != nil {
+			return ssz.WrapDecodeError(err, "AttesterSlashing", "Attestation1", o0)
+		}
+	}
+
+	// Field (1) 'Attestation2'
+	{
+		if a.Attestation2 == nil {
+			a.Attestation2 = new(IndexedAttestation)
+		}
+		if err = dec.DecodeObject(a.Attestation2, size-o1); err != nil {
+			return ssz.WrapDecodeError(err, "AttesterSlashing", "Attestation2", o1)
+		}
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the AttesterSlashing object
+func (a *AttesterSlashing) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Attestation1'
+	if a.Attestation1 == nil {
+		a.Attestation1 = new(IndexedAttestation)
+	}
+	size += a.Attestation1.SizeSSZ()
+
+	// Field (1) 'Attestation2'
+	if a.Attestation2 == nil {
+		a.Attestation2 = new(IndexedAttestation)
+	}
+	size += a.Attestation2.SizeSSZ()
+
 	return
 }
 
-// MarshalSSZ ssz marshals the SyncAggregate object
-func (s *SyncAggregate) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
+// HashTreeRoot ssz hashes the AttesterSlashing object
+func (a *AttesterSlashing) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(a)
 }
 
-// MarshalSSZTo ssz marshals the SyncAggregate object to a target array
-func (s *SyncAggregate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// HashTreeRootWith ssz hashes the AttesterSlashing object with a hasher
+func (a *AttesterSlashing) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
 
-	// Field (0) 'SyncCommiteeBits'
-	if size := len(s.SyncCommiteeBits); size != 128 {
-		err = ssz.ErrBytesLengthFn("--.SyncCommiteeBits", size, 128)
+	// Field (0) 'Attestation1'
+	if err = a.Attestation1.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Attestation2'
+	if err = a.Attestation2.HashTreeRootWith(hh); err != nil {
 		return
 	}
-	dst = append(dst, s.SyncCommiteeBits...)
-
-	// Field (1) 'SyncCommiteeSignature'
-	dst = append(dst, s.SyncCommiteeSignature[:]...)
 
-	return
-}
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootParallel ssz hashes the AttesterSlashing object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (a *AttesterSlashing) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return a.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the AttesterSlashing object like HashTreeRootParallel
+// with the Hashers of the pool
+func (a *AttesterSlashing) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Attestation1'
+		func(hh *ssz.Hasher) (err error) {
+			if err = a.Attestation1.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (1) 'Attestation2'
+		func(hh *ssz.Hasher) (err error) {
+			if err = a.Attestation2.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+	})
+}
+
+// AttesterSlashingView is a lazy view over the SSZ encoding of a AttesterSlashing object
+type AttesterSlashingView struct {
+	v ssz.View
+}
+
+// NewAttesterSlashingView creates a view over the SSZ encoding of a AttesterSlashing object
+func NewAttesterSlashingView(buf []byte) AttesterSlashingView {
+	return AttesterSlashingViewOf(ssz.NewView(buf))
+}
+
+// AttesterSlashingViewOf returns the view of the AttesterSlashing object encoded in v
+func AttesterSlashingViewOf(v ssz.View) AttesterSlashingView {
+	return AttesterSlashingView{v.Container(8, 0)}
+}
+
+// Raw returns the untyped view of the AttesterSlashing object
+func (v AttesterSlashingView) Raw() ssz.View {
+	return v.v
+}
+
+// AttesterSlashingListView is a lazy view over a list of AttesterSlashing objects
+type AttesterSlashingListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th AttesterSlashing object of the list
+func (l AttesterSlashingListView) At(i int) AttesterSlashingView {
+	return AttesterSlashingViewOf(l.ListView.At(i))
+}
+
+// Attestation1 returns the Attestation1 field of the AttesterSlashing object
+func (v AttesterSlashingView) Attestation1() IndexedAttestationView {
+	return IndexedAttestationViewOf(v.v.DynamicField(0, 4))
+}
+
+// Attestation2 returns the Attestation2 field of the AttesterSlashing object
+func (v AttesterSlashingView) Attestation2() IndexedAttestationView {
+	return IndexedAttestationViewOf(v.v.DynamicField(4, 0))
+}
+
+// Copy returns a deep copy of the AttesterSlashing object
+func (a *AttesterSlashing) Copy() *AttesterSlashing {
+	if a == nil {
+		return nil
+	}
+	cpy := new(AttesterSlashing)
+	*cpy = *a
+
+	// Field 'Attestation1'
+	cpy.Attestation1 = cpy.Attestation1.Copy()
+
+	// Field 'Attestation2'
+	cpy.Attestation2 = cpy.Attestation2.Copy()
+
+	return cpy
+}
+
+// EqualSSZ returns true if the AttesterSlashing objects have the same SSZ encoding
+func (a *AttesterSlashing) EqualSSZ(other *AttesterSlashing) bool {
+	if a == nil {
+		a = new(AttesterSlashing)
+	}
+	if other == nil {
+		other = new(AttesterSlashing)
+	}
+
+	// Field 'Attestation1'
+	if !a.Attestation1.EqualSSZ(other.Attestation1) {
+		return false
+	}
+
+	// Field 'Attestation2'
+	if !a.Attestation2.EqualSSZ(other.Attestation2) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the AttesterSlashing objects
+func (a *AttesterSlashing) DiffSSZ(other *AttesterSlashing) []ssz.FieldDiff {
+	if a == nil {
+		a = new(AttesterSlashing)
+	}
+	if other == nil {
+		other = new(AttesterSlashing)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Attestation1'
+	diffs = ssz.AppendDiffs(diffs, "Attestation1", a.Attestation1.DiffSSZ(other.Attestation1))
+
+	// Field 'Attestation2'
+	diffs = ssz.AppendDiffs(diffs, "Attestation2", a.Attestation2.DiffSSZ(other.Attestation2))
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the AttesterSlashing object following the Beacon API conventions
+func (a *AttesterSlashing) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		a = new(AttesterSlashing)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'Attestation1'
+	dst = append(dst, "\"attestation_1\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, a.Attestation1); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Attestation2'
+	dst = append(dst, ",\"attestation_2\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, a.Attestation2); err != nil {
+		return nil, err
+	}
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the AttesterSlashing object following the Beacon API conventions
+func (a *AttesterSlashing) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Attestation1'
+	if a.Attestation1 == nil {
+		a.Attestation1 = new(IndexedAttestation)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["attestation_1"], a.Attestation1); err != nil {
+		return ssz.WrapJSONError(err, "Attestation1")
+	}
+
+	// Field (1) 'Attestation2'
+	if a.Attestation2 == nil {
+		a.Attestation2 = new(IndexedAttestation)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["attestation_2"], a.Attestation2); err != nil {
+		return ssz.WrapJSONError(err, "Attestation2")
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the BeaconState object
+func (b *BeaconState) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconState object to a target array
+func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(10325)
+
+	// Field (0) 'GenesisTime'
+	dst = ssz.MarshalUint64(dst, b.GenesisTime)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if size := len(b.GenesisValidatorsRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.GenesisValidatorsRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.GenesisValidatorsRoot...)
+
+	// Field (2) 'Slot'
+	dst = ssz.MarshalUint64(dst, b.Slot)
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'BlockRoots'
+	for ii := 0; ii < 64; ii++ {
+		dst = append(dst, b.BlockRoots[ii][:]...)
+	}
+
+	// Field (6) 'StateRoots'
+	if size := len(b.StateRoots); size != 64 {
+		err = ssz.ErrVectorLengthFn("--.StateRoots", size, 64)
+		return
+	}
+	for ii := 0; ii < 64; ii++ {
+		dst = append(dst, b.StateRoots[ii][:]...)
+	}
+
+	// Offset (7) 'HistoricalRoots'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.HistoricalRoots) * 32
+
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (9) 'Eth1DataVotes'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Eth1DataVotes) * 72
+
+	// Field (10) 'Eth1DepositIndex'
+	dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+
+	// Offset (11) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Validators) * 121
+
+	// Offset (12) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Balances) * 8
+
+	// Field (13) 'RandaoMixes'
+	if size := len(b.RandaoMixes); size != 64 {
+		err = ssz.ErrVectorLengthFn("--.RandaoMixes", size, 64)
+		return
+	}
+	for ii := 0; ii < 64; ii++ {
+		if size := len(b.RandaoMixes[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.RandaoMixes[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.RandaoMixes[ii]...)
+	}
+
+	// Field (14) 'Slashings'
+	if size := len(b.Slashings); size != 64 {
+		err = ssz.ErrVectorLengthFn("--.Slashings", size, 64)
+		return
+	}
+	for ii := 0; ii < 64; ii++ {
+		dst = ssz.MarshalUint64(dst, b.Slashings[ii])
+	}
+
+	// Offset (15) 'PreviousEpochParticipation'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.PreviousEpochParticipation) * 1
+
+	// Offset (16) 'CurrentEpochParticipation'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.CurrentEpochParticipation) * 1
+
+	// Field (17) 'JustificationBits'
+	if size := len(b.JustificationBits); size != 1 {
+		err = ssz.ErrBytesLengthFn("--.JustificationBits", size, 1)
+		return
+	}
+	dst = append(dst, b.JustificationBits...)
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (21) 'InactivityScores'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.InactivityScores) * 8
+
+	// Field (22) 'CurrentSyncCommitee'
+	if b.CurrentSyncCommitee == nil {
+		b.CurrentSyncCommitee = new(SyncCommitteeMinimal)
+	}
+	if dst, err = b.CurrentSyncCommitee.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommitteeMinimal)
+	}
+	if dst, err = b.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (7) 'HistoricalRoots'
+	if size := len(b.HistoricalRoots); size > 16777216 {
+		err = ssz.ErrListTooBigFn("--.HistoricalRoots", size, 16777216)
+		return
+	}
+	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+		dst = append(dst, b.HistoricalRoots[ii][:]...)
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	if size := len(b.Eth1DataVotes); size > 32 {
+		err = ssz.ErrListTooBigFn("--.Eth1DataVotes", size, 32)
+		return
+	}
+	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+		if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (11) 'Validators'
+	if size := len(b.Validators); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Validators", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Validators); ii++ {
+		if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (12) 'Balances'
+	if size := len(b.Balances); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Balances", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Balances); ii++ {
+		dst = ssz.MarshalUint64(dst, b.Balances[ii])
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.PreviousEpochParticipation", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.PreviousEpochParticipation); ii++ {
+		dst = ssz.MarshalUint8(dst, b.PreviousEpochParticipation[ii])
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.CurrentEpochParticipation", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.CurrentEpochParticipation); ii++ {
+		dst = ssz.MarshalUint8(dst, b.CurrentEpochParticipation[ii])
+	}
+
+	// Field (21) 'InactivityScores'
+	if size := len(b.InactivityScores); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.InactivityScores", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.InactivityScores); ii++ {
+		dst = ssz.MarshalUint64(dst, b.InactivityScores[ii])
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the BeaconState object to a writer
+func (b *BeaconState) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, b)
+}
+
+// MarshalSSZToEncoder ssz marshals the BeaconState object to an encoder
+func (b *BeaconState) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(10325)
+
+	// Field (0) 'GenesisTime'
+	enc.EncodeUint64(b.GenesisTime)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if size := len(b.GenesisValidatorsRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.GenesisValidatorsRoot", size, 32)
+		return
+	}
+	enc.EncodeBytes(b.GenesisValidatorsRoot)
+
+	// Field (2) 'Slot'
+	enc.EncodeUint64(b.Slot)
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if err = enc.EncodeObject(b.Fork); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if err = enc.EncodeObject(b.LatestBlockHeader); err != nil {
+		return
+	}
+
+	// Field (5) 'BlockRoots'
+	for ii := 0; ii < 64; ii++ {
+		enc.EncodeBytes(b.BlockRoots[ii][:])
+	}
+
+	// Field (6) 'StateRoots'
+	if size := len(b.StateRoots); size != 64 {
+		err = ssz.ErrVectorLengthFn("--.StateRoots", size, 64)
+		return
+	}
+	for ii := 0; ii < 64; ii++ {
+		enc.EncodeBytes(b.StateRoots[ii][:])
+	}
+
+	// Offset (7) 'HistoricalRoots'
+	enc.EncodeOffset(offset)
+	offset += len(b.HistoricalRoots) * 32
+
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = enc.EncodeObject(b.Eth1Data); err != nil {
+		return
+	}
+
+	// Offset (9) 'Eth1DataVotes'
+	enc.EncodeOffset(offset)
+	offset += len(b.Eth1DataVotes) * 72
+
+	// Field (10) 'Eth1DepositIndex'
+	enc.EncodeUint64(b.Eth1DepositIndex)
+
+	// Offset (11) 'Validators'
+	enc.EncodeOffset(offset)
+	offset += len(b.Validators) * 121
+
+	// Offset (12) 'Balances'
+	enc.EncodeOffset(offset)
+	offset += len(b.Balances) * 8
+
+	// Field (13) 'RandaoMixes'
+	if size := len(b.RandaoMixes); size != 64 {
+		err = ssz.ErrVectorLengthFn("--.RandaoMixes", size, 64)
+		return
+	}
+	for ii := 0; ii < 64; ii++ {
+		if size := len(b.RandaoMixes[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.RandaoMixes[ii]", size, 32)
+			return
+		}
+		enc.EncodeBytes(b.RandaoMixes[ii])
+	}
+
+	// Field (14) 'Slashings'
+	if size := len(b.Slashings); size != 64 {
+		err = ssz.ErrVectorLengthFn("--.Slashings", size, 64)
+		return
+	}
+	for ii := 0; ii < 64; ii++ {
+		enc.EncodeUint64(b.Slashings[ii])
+	}
+
+	// Offset (15) 'PreviousEpochParticipation'
+	enc.EncodeOffset(offset)
+	offset += len(b.PreviousEpochParticipation) * 1
+
+	// Offset (16) 'CurrentEpochParticipation'
+	enc.EncodeOffset(offset)
+	offset += len(b.CurrentEpochParticipation) * 1
+
+	// Field (17) 'JustificationBits'
+	if size := len(b.JustificationBits); size != 1 {
+		err = ssz.ErrBytesLengthFn("--.JustificationBits", size, 1)
+		return
+	}
+	enc.EncodeBytes(b.JustificationBits)
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = enc.EncodeObject(b.PreviousJustifiedCheckpoint); err != nil {
+		return
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = enc.EncodeObject(b.CurrentJustifiedCheckpoint); err != nil {
+		return
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if err = enc.EncodeObject(b.FinalizedCheckpoint); err != nil {
+		return
+	}
+
+	// Offset (21) 'InactivityScores'
+	enc.EncodeOffset(offset)
+	offset += len(b.InactivityScores) * 8
+
+	// Field (22) 'CurrentSyncCommitee'
+	if b.CurrentSyncCommitee == nil {
+		b.CurrentSyncCommitee = new(SyncCommitteeMinimal)
+	}
+	if err = enc.EncodeObject(b.CurrentSyncCommitee); err != nil {
+		return
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommitteeMinimal)
+	}
+	if err = enc.EncodeObject(b.NextSyncCommittee); err != nil {
+		return
+	}
+
+	// Field (7) 'HistoricalRoots'
+	if size := len(b.HistoricalRoots); size > 16777216 {
+		err = ssz.ErrListTooBigFn("--.HistoricalRoots", size, 16777216)
+		return
+	}
+	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+		enc.EncodeBytes(b.HistoricalRoots[ii][:])
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	if size := len(b.Eth1DataVotes); size > 32 {
+		err = ssz.ErrListTooBigFn("--.Eth1DataVotes", size, 32)
+		return
+	}
+	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+		if err = enc.EncodeObject(b.Eth1DataVotes[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (11) 'Validators'
+	if size := len(b.Validators); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Validators", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Validators); ii++ {
+		if err = enc.EncodeObject(b.Validators[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (12) 'Balances'
+	if size := len(b.Balances); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Balances", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Balances); ii++ {
+		enc.EncodeUint64(b.Balances[ii])
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.PreviousEpochParticipation", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.PreviousEpochParticipation); ii++ {
+		enc.EncodeUint8(b.PreviousEpochParticipation[ii])
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.CurrentEpochParticipation", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.CurrentEpochParticipation); ii++ {
+		enc.EncodeUint8(b.CurrentEpochParticipation[ii])
+	}
+
+	// Field (21) 'InactivityScores'
+	if size := len(b.InactivityScores); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.InactivityScores", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.InactivityScores); ii++ {
+		enc.EncodeUint64(b.InactivityScores[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconState object
+func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the BeaconState object within the limits of the decode state
+func (b *BeaconState) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 10325 {
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconState", 10325, size)
+	}
+
+	tail := buf
+	var o7, o9, o11, o12, o15, o16, o21 uint64
+
+	// Field (0) 'GenesisTime'
+	b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if cap(b.GenesisValidatorsRoot) == 0 {
+		b.GenesisValidatorsRoot = make([]byte, 0, len(buf[8:40]))
+	}
+	b.GenesisValidatorsRoot = append(b.GenesisValidatorsRoot, buf[8:40]...)
+
+	// Field (2) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[40:48])
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if err = ssz.UnmarshalWithState(b.Fork, buf[48:64], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "Fork", 48)
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if err = ssz.UnmarshalWithState(b.LatestBlockHeader, buf[64:176], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "LatestBlockHeader", 64)
+	}
+
+	// Field (5) 'BlockRoots'
+
+	for ii := 0; ii < 64; ii++ {
+		copy(b.BlockRoots[ii][:], buf[176:2224][ii*32:(ii+1)*32])
+	}
+
+	// Field (6) 'StateRoots'
+	if err = st.Alloc(64, 32); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "StateRoots", 2224)
+	}
+	b.StateRoots = make([][32]byte, 64)
+	for ii := 0; ii < 64; ii++ {
+		copy(b.StateRoots[ii][:], buf[2224:4272][ii*32:(ii+1)*32])
+	}
+
+	// Offset (7) 'HistoricalRoots'
+	if o7 = ssz.ReadOffset(buf[4272:4276]); o7 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "HistoricalRoots", 4272)
+	}
+
+	if o7 != 10325 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 10325, o7), "BeaconState", "HistoricalRoots", 4272)
+	}
+
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = ssz.UnmarshalWithState(b.Eth1Data, buf[4276:4348], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "Eth1Data", 4276)
+	}
+
+	// Offset (9) 'Eth1DataVotes'
+	if o9 = ssz.ReadOffset(buf[4348:4352]); o9 > size || o7 > o9 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "Eth1DataVotes", 4348)
+	}
+
+	// Field (10) 'Eth1DepositIndex'
+	b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[4352:4360])
+
+	// Offset (11) 'Validators'
+	if o11 = ssz.ReadOffset(buf[4360:4364]); o11 > size || o9 > o11 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "Validators", 4360)
+	}
+
+	// Offset (12) 'Balances'
+	if o12 = ssz.ReadOffset(buf[4364:4368]); o12 > size || o11 > o12 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "Balances", 4364)
+	}
+
+	// Field (13) 'RandaoMixes'
+	if err = st.Alloc(64, 24); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "RandaoMixes", 4368)
+	}
+	b.RandaoMixes = make([][]byte, 64)
+	for ii := 0; ii < 64; ii++ {
+		if cap(b.RandaoMixes[ii]) == 0 {
+			b.RandaoMixes[ii] = make([]byte, 0, len(buf[4368:6416][ii*32:(ii+1)*32]))
+		}
+		b.RandaoMixes[ii] = append(b.RandaoMixes[ii], buf[4368:6416][ii*32:(ii+1)*32]...)
+	}
+
+	// Field (14) 'Slashings'
+	if b.Slashings, err = st.ExtendUint64(b.Slashings, 64); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "Slashings", 6416)
+	}
+	for ii := 0; ii < 64; ii++ {
+		b.Slashings[ii] = ssz.UnmarshallUint64(buf[6416:6928][ii*8 : (ii+1)*8])
+	}
+
+	// Offset (15) 'PreviousEpochParticipation'
+	if o15 = ssz.ReadOffset(buf[6928:6932]); o15 > size || o12 > o15 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "PreviousEpochParticipation", 6928)
+	}
+
+	// Offset (16) 'CurrentEpochParticipation'
+	if o16 = ssz.ReadOffset(buf[6932:6936]); o16 > size || o15 > o16 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "CurrentEpochParticipation", 6932)
+	}
+
+	// Field (17) 'JustificationBits'
+	if cap(b.JustificationBits) == 0 {
+		b.JustificationBits = make([]byte, 0, len(buf[6936:6937]))
+	}
+	b.JustificationBits = append(b.JustificationBits, buf[6936:6937]...)
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalWithState(b.PreviousJustifiedCheckpoint, buf[6937:6977], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "PreviousJustifiedCheckpoint", 6937)
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalWithState(b.CurrentJustifiedCheckpoint, buf[6977:7017], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "CurrentJustifiedCheckpoint", 6977)
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalWithState(b.FinalizedCheckpoint, buf[7017:7057], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "FinalizedCheckpoint", 7017)
+	}
+
+	// Offset (21) 'InactivityScores'
+	if o21 = ssz.ReadOffset(buf[7057:7061]); o21 > size || o16 > o21 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "InactivityScores", 7057)
+	}
+
+	// Field (22) 'CurrentSyncCommitee'
+	if b.CurrentSyncCommitee == nil {
+		b.CurrentSyncCommitee = new(SyncCommitteeMinimal)
+	}
+	if err = ssz.UnmarshalWithState(b.CurrentSyncCommitee, buf[7061:8693], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "CurrentSyncCommitee", 7061)
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommitteeMinimal)
+	}
+	if err = ssz.UnmarshalWithState(b.NextSyncCommittee, buf[8693:10325], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "NextSyncCommittee", 8693)
+	}
+
+	// Field (7) 'HistoricalRoots'
+	{
+		buf = tail[o7:o9]
+		num, err := ssz.DivideInt2(len(buf), 32, 16777216)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "HistoricalRoots", o7)
+		}
+		if err = st.Alloc(num, 32); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "HistoricalRoots", o7)
+		}
+		b.HistoricalRoots = make([][32]byte, num)
+		for ii := 0; ii < num; ii++ {
+			copy(b.HistoricalRoots[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	{
+		buf = tail[o9:o11]
+		num, err := ssz.DivideInt2(len(buf), 72, 32)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "Eth1DataVotes", o9)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Eth1Data{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "Eth1DataVotes", o9)
+		}
+		b.Eth1DataVotes = make([]*Eth1Data, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Eth1DataVotes[ii] == nil {
+				b.Eth1DataVotes[ii] = new(Eth1Data)
+			}
+			if err = ssz.UnmarshalWithState(b.Eth1DataVotes[ii], buf[ii*72:(ii+1)*72], st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*72)), "BeaconState", "Eth1DataVotes", o9)
+			}
+		}
+	}
+
+	// Field (11) 'Validators'
+	{
+		buf = tail[o11:o12]
+		num, err := ssz.DivideInt2(len(buf), 121, 1099511627776)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "Validators", o11)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Validator{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "Validators", o11)
+		}
+		b.Validators = make([]*Validator, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Validators[ii] == nil {
+				b.Validators[ii] = new(Validator)
+			}
+			if err = ssz.UnmarshalWithState(b.Validators[ii], buf[ii*121:(ii+1)*121], st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*121)), "BeaconState", "Validators", o11)
+			}
+		}
+	}
+
+	// Field (12) 'Balances'
+	{
+		buf = tail[o12:o15]
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "Balances", o12)
+		}
+		if b.Balances, err = st.ExtendUint64(b.Balances, num); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "Balances", o12)
+		}
+		for ii := 0; ii < num; ii++ {
+			b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	{
+		buf = tail[o15:o16]
+		num, err := ssz.DivideInt2(len(buf), 1, 1099511627776)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "PreviousEpochParticipation", o15)
+		}
+		if b.PreviousEpochParticipation, err = st.ExtendUint8(b.PreviousEpochParticipation, num); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "PreviousEpochParticipation", o15)
+		}
+		for ii := 0; ii < num; ii++ {
+			b.PreviousEpochParticipation[ii] = ssz.UnmarshallUint8(buf[ii*1 : (ii+1)*1])
+		}
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	{
+		buf = tail[o16:o21]
+		num, err := ssz.DivideInt2(len(buf), 1, 1099511627776)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "CurrentEpochParticipation", o16)
+		}
+		if b.CurrentEpochParticipation, err = st.ExtendUint8(b.CurrentEpochParticipation, num); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "CurrentEpochParticipation", o16)
+		}
+		for ii := 0; ii < num; ii++ {
+			b.CurrentEpochParticipation[ii] = ssz.UnmarshallUint8(buf[ii*1 : (ii+1)*1])
+		}
+	}
+
+	// Field (21) 'InactivityScores'
+	{
+		buf = tail[o21:]
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "InactivityScores", o21)
+		}
+		if b.InactivityScores, err = st.ExtendUint64(b.InactivityScores, num); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "InactivityScores", o21)
+		}
+		for ii := 0; ii < num; ii++ {
+			b.InactivityScores[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a BeaconState object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (b *BeaconState) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 10325 {
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconState", 10325, size)
+	}
+
+	tail := buf
+	var o7, o9, o11, o12, o15, o16, o21 uint64
+
+	// Field (3) 'Fork'
+	if err = (*Fork)(nil).ValidateSSZ(buf[48:64]); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "Fork", 48)
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if err = (*BeaconBlockHeader)(nil).ValidateSSZ(buf[64:176]); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "LatestBlockHeader", 64)
+	}
+
+	// Offset (7) 'HistoricalRoots'
+	if o7 = ssz.ReadOffset(buf[4272:4276]); o7 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "HistoricalRoots", 4272)
+	}
+
+	if o7 != 10325 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 10325, o7), "BeaconState", "HistoricalRoots", 4272)
+	}
+
+	// Field (8) 'Eth1Data'
+	if err = (*Eth1Data)(nil).ValidateSSZ(buf[4276:4348]); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "Eth1Data", 4276)
+	}
+
+	// Offset (9) 'Eth1DataVotes'
+	if o9 = ssz.ReadOffset(buf[4348:4352]); o9 > size || o7 > o9 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "Eth1DataVotes", 4348)
+	}
+
+	// Offset (11) 'Validators'
+	if o11 = ssz.ReadOffset(buf[4360:4364]); o11 > size || o9 > o11 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "Validators", 4360)
+	}
+
+	// Offset (12) 'Balances'
+	if o12 = ssz.ReadOffset(buf[4364:4368]); o12 > size || o11 > o12 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "Balances", 4364)
+	}
+
+	// Offset (15) 'PreviousEpochParticipation'
+	if o15 = ssz.ReadOffset(buf[6928:6932]); o15 > size || o12 > o15 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "PreviousEpochParticipation", 6928)
+	}
+
+	// Offset (16) 'CurrentEpochParticipation'
+	if o16 = ssz.ReadOffset(buf[6932:6936]); o16 > size || o15 > o16 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "CurrentEpochParticipation", 6932)
+	}
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if err = (*Checkpoint)(nil).ValidateSSZ(buf[6937:6977]); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "PreviousJustifiedCheckpoint", 6937)
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if err = (*Checkpoint)(nil).ValidateSSZ(buf[6977:7017]); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "CurrentJustifiedCheckpoint", 6977)
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if err = (*Checkpoint)(nil).ValidateSSZ(buf[7017:7057]); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "FinalizedCheckpoint", 7017)
+	}
+
+	// Offset (21) 'InactivityScores'
+	if o21 = ssz.ReadOffset(buf[7057:7061]); o21 > size || o16 > o21 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "InactivityScores", 7057)
+	}
+
+	// Field (22) 'CurrentSyncCommitee'
+	if err = (*SyncCommitteeMinimal)(nil).ValidateSSZ(buf[7061:8693]); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "CurrentSyncCommitee", 7061)
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if err = (*SyncCommitteeMinimal)(nil).ValidateSSZ(buf[8693:10325]); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "NextSyncCommittee", 8693)
+	}
+
+	// Field (7) 'HistoricalRoots'
+	{
+		buf = tail[o7:o9]
+		if _, err = ssz.DivideInt2(len(buf), 32, 16777216); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "HistoricalRoots", o7)
+		}
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	{
+		buf = tail[o9:o11]
+		num, err := ssz.DivideInt2(len(buf), 72, 32)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "Eth1DataVotes", o9)
+		}
+
+		for ii := 0; ii < num; ii++ {
+			if err = (*Eth1Data)(nil).ValidateSSZ(buf[ii*72 : (ii+1)*72]); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*72)), "BeaconState", "Eth1DataVotes", o9)
+			}
+		}
+	}
+
+	// Field (11) 'Validators'
+	{
+		buf = tail[o11:o12]
+		num, err := ssz.DivideInt2(len(buf), 121, 1099511627776)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "Validators", o11)
+		}
+
+		for ii := 0; ii < num; ii++ {
+			if err = (*Validator)(nil).ValidateSSZ(buf[ii*121 : (ii+1)*121]); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*121)), "BeaconState", "Validators", o11)
+			}
+		}
+	}
+
+	// Field (12) 'Balances'
+	{
+		buf = tail[o12:o15]
+		if _, err = ssz.DivideInt2(len(buf), 8, 1099511627776); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "Balances", o12)
+		}
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	{
+		buf = tail[o15:o16]
+		if _, err = ssz.DivideInt2(len(buf), 1, 1099511627776); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "PreviousEpochParticipation", o15)
+		}
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	{
+		buf = tail[o16:o21]
+		if _, err = ssz.DivideInt2(len(buf), 1, 1099511627776); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "CurrentEpochParticipation", o16)
+		}
+	}
+
+	// Field (21) 'InactivityScores'
+	{
+		buf = tail[o21:]
+		if _, err = ssz.DivideInt2(len(buf), 8, 1099511627776); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "InactivityScores", o21)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the BeaconState object from a reader with an encoded size of 'size' bytes
+func (b *BeaconState) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, b)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the BeaconState object from a decoder
+func (b *BeaconState) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 10325 {
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconState", 10325, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(10325)
+	if err != nil {
+		return err
+	}
+	var o7, o9, o11, o12, o15, o16, o21 uint64
+
+	// Field (0) 'GenesisTime'
+	b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if cap(b.GenesisValidatorsRoot) == 0 {
+		b.GenesisValidatorsRoot = make([]byte, 0, len(buf[8:40]))
+	}
+	b.GenesisValidatorsRoot = append(b.GenesisValidatorsRoot, buf[8:40]...)
+
+	// Field (2) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[40:48])
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if err = ssz.UnmarshalWithState(b.Fork, buf[48:64], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "Fork", 48)
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if err = ssz.UnmarshalWithState(b.LatestBlockHeader, buf[64:176], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "LatestBlockHeader", 64)
+	}
+
+	// Field (5) 'BlockRoots'
+
+	for ii := 0; ii < 64; ii++ {
+		copy(b.BlockRoots[ii][:], buf[176:2224][ii*32:(ii+1)*32])
+	}
+
+	// Field (6) 'StateRoots'
+	if err = st.Alloc(64, 32); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "StateRoots", 2224)
+	}
+	b.StateRoots = make([][32]byte, 64)
+	for ii := 0; ii < 64; ii++ {
+		copy(b.StateRoots[ii][:], buf[2224:4272][ii*32:(ii+1)*32])
+	}
+
+	// Offset (7) 'HistoricalRoots'
+	if o7 = ssz.ReadOffset(buf[4272:4276]); o7 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "HistoricalRoots", 4272)
+	}
+
+	if o7 != 10325 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 10325, o7), "BeaconState", "HistoricalRoots", 4272)
+	}
+
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = ssz.UnmarshalWithState(b.Eth1Data, buf[4276:4348], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "Eth1Data", 4276)
+	}
+
+	// Offset (9) 'Eth1DataVotes'
+	if o9 = ssz.ReadOffset(buf[4348:4352]); o9 > size || o7 > o9 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "Eth1DataVotes", 4348)
+	}
+
+	// Field (10) 'Eth1DepositIndex'
+	b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[4352:4360])
+
+	// Offset (11) 'Validators'
+	if o11 = ssz.ReadOffset(buf[4360:4364]); o11 > size || o9 > o11 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "Validators", 4360)
+	}
+
+	// Offset (12) 'Balances'
+	if o12 = ssz.ReadOffset(buf[4364:4368]); o12 > size || o11 > o12 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "Balances", 4364)
+	}
+
+	// Field (13) 'RandaoMixes'
+	if err = st.Alloc(64, 24); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "RandaoMixes", 4368)
+	}
+	b.RandaoMixes = make([][]byte, 64)
+	for ii := 0; ii < 64; ii++ {
+		if cap(b.RandaoMixes[ii]) == 0 {
+			b.RandaoMixes[ii] = make([]byte, 0, len(buf[4368:6416][ii*32:(ii+1)*32]))
+		}
+		b.RandaoMixes[ii] = append(b.RandaoMixes[ii], buf[4368:6416][ii*32:(ii+1)*32]...)
+	}
+
+	// Field (14) 'Slashings'
+	if b.Slashings, err = st.ExtendUint64(b.Slashings, 64); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "Slashings", 6416)
+	}
+	for ii := 0; ii < 64; ii++ {
+		b.Slashings[ii] = ssz.UnmarshallUint64(buf[6416:6928][ii*8 : (ii+1)*8])
+	}
+
+	// Offset (15) 'PreviousEpochParticipation'
+	if o15 = ssz.ReadOffset(buf[6928:6932]); o15 > size || o12 > o15 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "PreviousEpochParticipation", 6928)
+	}
+
+	// Offset (16) 'CurrentEpochParticipation'
+	if o16 = ssz.ReadOffset(buf[6932:6936]); o16 > size || o15 > o16 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "CurrentEpochParticipation", 6932)
+	}
+
+	// Field (17) 'JustificationBits'
+	if cap(b.JustificationBits) == 0 {
+		b.JustificationBits = make([]byte, 0, len(buf[6936:6937]))
+	}
+	b.JustificationBits = append(b.JustificationBits, buf[6936:6937]...)
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalWithState(b.PreviousJustifiedCheckpoint, buf[6937:6977], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "PreviousJustifiedCheckpoint", 6937)
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalWithState(b.CurrentJustifiedCheckpoint, buf[6977:7017], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "CurrentJustifiedCheckpoint", 6977)
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalWithState(b.FinalizedCheckpoint, buf[7017:7057], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "FinalizedCheckpoint", 7017)
+	}
+
+	// Offset (21) 'InactivityScores'
+	if o21 = ssz.ReadOffset(buf[7057:7061]); o21 > size || o16 > o21 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconState", "InactivityScores", 7057)
+	}
+
+	// Field (22) 'CurrentSyncCommitee'
+	if b.CurrentSyncCommitee == nil {
+		b.CurrentSyncCommitee = new(SyncCommitteeMinimal)
+	}
+	if err = ssz.UnmarshalWithState(b.CurrentSyncCommitee, buf[7061:8693], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "CurrentSyncCommitee", 7061)
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommitteeMinimal)
+	}
+	if err = ssz.UnmarshalWithState(b.NextSyncCommittee, buf[8693:10325], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconState", "NextSyncCommittee", 8693)
+	}
+
+	// Field (7) 'HistoricalRoots'
+	{
+		num, err := ssz.DivideInt2(int(o9-o7), 32, 16777216)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "HistoricalRoots", o7)
+		}
+		if err = st.Alloc(num, 32); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "HistoricalRoots", o7)
+		}
+		b.HistoricalRoots = make([][32]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(32); err != nil {
+				return ssz.WrapDecodeError(err, "BeaconState", "HistoricalRoots", o7)
+			}
+			copy(b.HistoricalRoots[ii][:], buf)
+		}
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	{
+		num, err := ssz.DivideInt2(int(o11-o9), 72, 32)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "Eth1DataVotes", o9)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Eth1Data{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "Eth1DataVotes", o9)
+		}
+		b.Eth1DataVotes = make([]*Eth1Data, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(72); err != nil {
+				return ssz.WrapDecodeError(err, "BeaconState", "Eth1DataVotes", o9)
+			}
+			if b.Eth1DataVotes[ii] == nil {
+				b.Eth1DataVotes[ii] = new(Eth1Data)
+			}
+			if err = ssz.UnmarshalWithState(b.Eth1DataVotes[ii], buf, st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*72)), "BeaconState", "Eth1DataVotes", o9)
+			}
+		}
+	}
+
+	// Field (11) 'Validators'
+	{
+		num, err := ssz.DivideInt2(int(o12-o11), 121, 1099511627776)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "Validators", o11)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Validator{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "Validators", o11)
+		}
+		b.Validators = make([]*Validator, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(121); err != nil {
+				return ssz.WrapDecodeError(err, "BeaconState", "Validators", o11)
+			}
+			if b.Validators[ii] == nil {
+				b.Validators[ii] = new(Validator)
+			}
+			if err = ssz.UnmarshalWithState(b.Validators[ii], buf, st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*121)), "BeaconState", "Validators", o11)
+			}
+		}
+	}
+
+	// Field (12) 'Balances'
+	{
+		num, err := ssz.DivideInt2(int(o15-o12), 8, 1099511627776)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "Balances", o12)
+		}
+		if b.Balances, err = st.ExtendUint64(b.Balances, num); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "Balances", o12)
+		}
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(8); err != nil {
+				return ssz.WrapDecodeError(err, "BeaconState", "Balances", o12)
+			}
+			b.Balances[ii] = ssz.UnmarshallUint64(buf)
+		}
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	{
+		num, err := ssz.DivideInt2(int(o16-o15), 1, 1099511627776)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "PreviousEpochParticipation", o15)
+		}
+		if b.PreviousEpochParticipation, err = st.ExtendUint8(b.PreviousEpochParticipation, num); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "PreviousEpochParticipation", o15)
+		}
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(1); err != nil {
+				return ssz.WrapDecodeError(err, "BeaconState", "PreviousEpochParticipation", o15)
+			}
+			b.PreviousEpochParticipation[ii] = ssz.UnmarshallUint8(buf)
+		}
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	{
+		num, err := ssz.DivideInt2(int(o21-o16), 1, 1099511627776)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "CurrentEpochParticipation", o16)
+		}
+		if b.CurrentEpochParticipation, err = st.ExtendUint8(b.CurrentEpochParticipation, num); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "CurrentEpochParticipation", o16)
+		}
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(1); err != nil {
+				return ssz.WrapDecodeError(err, "BeaconState", "CurrentEpochParticipation", o16)
+			}
+			b.CurrentEpochParticipation[ii] = ssz.UnmarshallUint8(buf)
+		}
+	}
+
+	// Field (21) 'InactivityScores'
+	{
+		num, err := ssz.DivideInt2(int(size-o21), 8, 1099511627776)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "InactivityScores", o21)
+		}
+		if b.InactivityScores, err = st.ExtendUint64(b.InactivityScores, num); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconState", "InactivityScores", o21)
+		}
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(8); err != nil {
+				return ssz.WrapDecodeError(err, "BeaconState", "InactivityScores", o21)
+			}
+			b.InactivityScores[ii] = ssz.UnmarshallUint64(buf)
+		}
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconState object
+func (b *BeaconState) SizeSSZ() (size int) {
+	size = 10325
+
+	// Field (7) 'HistoricalRoots'
+	size += len(b.HistoricalRoots) * 32
+
+	// Field (9) 'Eth1DataVotes'
+	size += len(b.Eth1DataVotes) * 72
+
+	// Field (11) 'Validators'
+	size += len(b.Validators) * 121
+
+	// Field (12) 'Balances'
+	size += len(b.Balances) * 8
+
+	// Field (15) 'PreviousEpochParticipation'
+	size += len(b.PreviousEpochParticipation) * 1
+
+	// Field (16) 'CurrentEpochParticipation'
+	size += len(b.CurrentEpochParticipation) * 1
+
+	// Field (21) 'InactivityScores'
+	size += len(b.InactivityScores) * 8
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconState object
+func (b *BeaconState) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconState object with a hasher
+func (b *BeaconState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'GenesisTime'
+	hh.PutUint64(b.GenesisTime)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if size := len(b.GenesisValidatorsRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.GenesisValidatorsRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.GenesisValidatorsRoot)
+
+	// Field (2) 'Slot'
+	hh.PutUint64(b.Slot)
+
+	// Field (3) 'Fork'
+	if err = b.Fork.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (5) 'BlockRoots'
+	{
+		subIndx := hh.Index()
+		for _, i := range b.BlockRoots {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (6) 'StateRoots'
+	{
+		if size := len(b.StateRoots); size != 64 {
+			err = ssz.ErrVectorLengthFn("--.StateRoots", size, 64)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.StateRoots {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (7) 'HistoricalRoots'
+	{
+		if size := len(b.HistoricalRoots); size > 16777216 {
+			err = ssz.ErrListTooBigFn("--.HistoricalRoots", size, 16777216)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.HistoricalRoots {
+			hh.Append(i[:])
+		}
+
+		numItems := uint64(len(b.HistoricalRoots))
+		hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
+	}
+
+	// Field (8) 'Eth1Data'
+	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Eth1DataVotes))
+		if num > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = hh.HashElements(len(b.Eth1DataVotes), func(indx int, hh *ssz.Hasher) error {
+			return b.Eth1DataVotes[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 32)
+	}
+
+	// Field (10) 'Eth1DepositIndex'
+	hh.PutUint64(b.Eth1DepositIndex)
+
+	// Field (11) 'Validators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Validators))
+		if num > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = hh.HashElements(len(b.Validators), func(indx int, hh *ssz.Hasher) error {
+			return b.Validators[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+	}
+
+	// Field (12) 'Balances'
+	{
+		if size := len(b.Balances); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("--.Balances", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Balances {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(b.Balances))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	}
+
+	// Field (13) 'RandaoMixes'
+	{
+		if size := len(b.RandaoMixes); size != 64 {
+			err = ssz.ErrVectorLengthFn("--.RandaoMixes", size, 64)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.RandaoMixes {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (14) 'Slashings'
+	{
+		if size := len(b.Slashings); size != 64 {
+			err = ssz.ErrVectorLengthFn("--.Slashings", size, 64)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Slashings {
+			hh.AppendUint64(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	{
+		if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("--.PreviousEpochParticipation", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.PreviousEpochParticipation {
+			hh.AppendUint8(i)
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(b.PreviousEpochParticipation))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 1))
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	{
+		if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("--.CurrentEpochParticipation", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.CurrentEpochParticipation {
+			hh.AppendUint8(i)
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(b.CurrentEpochParticipation))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 1))
+	}
+
+	// Field (17) 'JustificationBits'
+	if size := len(b.JustificationBits); size != 1 {
+		err = ssz.ErrBytesLengthFn("--.JustificationBits", size, 1)
+		return
+	}
+	hh.PutBytes(b.JustificationBits)
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (21) 'InactivityScores'
+	{
+		if size := len(b.InactivityScores); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("--.InactivityScores", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.InactivityScores {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(b.InactivityScores))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	}
+
+	// Field (22) 'CurrentSyncCommitee'
+	if err = b.CurrentSyncCommitee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootParallel ssz hashes the BeaconState object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (b *BeaconState) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return b.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the BeaconState object like HashTreeRootParallel
+// with the Hashers of the pool
+func (b *BeaconState) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'GenesisTime'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(b.GenesisTime)
+			return
+		},
+		// Field (1) 'GenesisValidatorsRoot'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(b.GenesisValidatorsRoot); size != 32 {
+				err = ssz.ErrBytesLengthFn("--.GenesisValidatorsRoot", size, 32)
+				return
+			}
+			hh.PutBytes(b.GenesisValidatorsRoot)
+			return
+		},
+		// Field (2) 'Slot'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(b.Slot)
+			return
+		},
+		// Field (3) 'Fork'
+		func(hh *ssz.Hasher) (err error) {
+			if err = b.Fork.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (4) 'LatestBlockHeader'
+		func(hh *ssz.Hasher) (err error) {
+			if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (5) 'BlockRoots'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				subIndx := hh.Index()
+				for _, i := range b.BlockRoots {
+					hh.Append(i[:])
+				}
+				hh.Merkleize(subIndx)
+			}
+			return
+		},
+		// Field (6) 'StateRoots'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				if size := len(b.StateRoots); size != 64 {
+					err = ssz.ErrVectorLengthFn("--.StateRoots", size, 64)
+					return
+				}
+				subIndx := hh.Index()
+				for _, i := range b.StateRoots {
+					hh.Append(i[:])
+				}
+				hh.Merkleize(subIndx)
+			}
+			return
+		},
+		// Field (7) 'HistoricalRoots'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				if size := len(b.HistoricalRoots); size > 16777216 {
+					err = ssz.ErrListTooBigFn("--.HistoricalRoots", size, 16777216)
+					return
+				}
+				subIndx := hh.Index()
+				for _, i := range b.HistoricalRoots {
+					hh.Append(i[:])
+				}
+
+				numItems := uint64(len(b.HistoricalRoots))
+				hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
+			}
+			return
+		},
+		// Field (8) 'Eth1Data'
+		func(hh *ssz.Hasher) (err error) {
+			if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (9) 'Eth1DataVotes'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				subIndx := hh.Index()
+				num := uint64(len(b.Eth1DataVotes))
+				if num > 32 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				if err = hh.HashElements(len(b.Eth1DataVotes), func(indx int, hh *ssz.Hasher) error {
+					return b.Eth1DataVotes[indx].HashTreeRootWith(hh)
+				}); err != nil {
+					return
+				}
+				hh.MerkleizeWithMixin(subIndx, num, 32)
+			}
+			return
+		},
+		// Field (10) 'Eth1DepositIndex'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(b.Eth1DepositIndex)
+			return
+		},
+		// Field (11) 'Validators'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				subIndx := hh.Index()
+				num := uint64(len(b.Validators))
+				if num > 1099511627776 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				if err = hh.HashElements(len(b.Validators), func(indx int, hh *ssz.Hasher) error {
+					return b.Validators[indx].HashTreeRootWith(hh)
+				}); err != nil {
+					return
+				}
+				hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+			}
+			return
+		},
+		// Field (12) 'Balances'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				if size := len(b.Balances); size > 1099511627776 {
+					err = ssz.ErrListTooBigFn("--.Balances", size, 1099511627776)
+					return
+				}
+				subIndx := hh.Index()
+				for _, i := range b.Balances {
+					hh.AppendUint64(i)
+				}
+				hh.FillUpTo32()
+
+				numItems := uint64(len(b.Balances))
+				hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+			}
+			return
+		},
+		// Field (13) 'RandaoMixes'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				if size := len(b.RandaoMixes); size != 64 {
+					err = ssz.ErrVectorLengthFn("--.RandaoMixes", size, 64)
+					return
+				}
+				subIndx := hh.Index()
+				for _, i := range b.RandaoMixes {
+					if len(i) != 32 {
+						err = ssz.ErrBytesLength
+						return
+					}
+					hh.Append(i)
+				}
+				hh.Merkleize(subIndx)
+			}
+			return
+		},
+		// Field (14) 'Slashings'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				if size := len(b.Slashings); size != 64 {
+					err = ssz.ErrVectorLengthFn("--.Slashings", size, 64)
+					return
+				}
+				subIndx := hh.Index()
+				for _, i := range b.Slashings {
+					hh.AppendUint64(i)
+				}
+				hh.Merkleize(subIndx)
+			}
+			return
+		},
+		// Field (15) 'PreviousEpochParticipation'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
+					err = ssz.ErrListTooBigFn("--.PreviousEpochParticipation", size, 1099511627776)
+					return
+				}
+				subIndx := hh.Index()
+				for _, i := range b.PreviousEpochParticipation {
+					hh.AppendUint8(i)
+				}
+				hh.FillUpTo32()
+
+				numItems := uint64(len(b.PreviousEpochParticipation))
+				hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 1))
+			}
+			return
+		},
+		// Field (16) 'CurrentEpochParticipation'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
+					err = ssz.ErrListTooBigFn("--.CurrentEpochParticipation", size, 1099511627776)
+					return
+				}
+				subIndx := hh.Index()
+				for _, i := range b.CurrentEpochParticipation {
+					hh.AppendUint8(i)
+				}
+				hh.FillUpTo32()
+
+				numItems := uint64(len(b.CurrentEpochParticipation))
+				hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 1))
+			}
+			return
+		},
+		// Field (17) 'JustificationBits'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(b.JustificationBits); size != 1 {
+				err = ssz.ErrBytesLengthFn("--.JustificationBits", size, 1)
+				return
+			}
+			hh.PutBytes(b.JustificationBits)
+			return
+		},
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		func(hh *ssz.Hasher) (err error) {
+			if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		func(hh *ssz.Hasher) (err error) {
+			if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (20) 'FinalizedCheckpoint'
+		func(hh *ssz.Hasher) (err error) {
+			if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (21) 'InactivityScores'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				if size := len(b.InactivityScores); size > 1099511627776 {
+					err = ssz.ErrListTooBigFn("--.InactivityScores", size, 1099511627776)
+					return
+				}
+				subIndx := hh.Index()
+				for _, i := range b.InactivityScores {
+					hh.AppendUint64(i)
+				}
+				hh.FillUpTo32()
+
+				numItems := uint64(len(b.InactivityScores))
+				hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+			}
+			return
+		},
+		// Field (22) 'CurrentSyncCommitee'
+		func(hh *ssz.Hasher) (err error) {
+			if err = b.CurrentSyncCommitee.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (23) 'NextSyncCommittee'
+		func(hh *ssz.Hasher) (err error) {
+			if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+	})
+}
+
+// BeaconStateView is a lazy view over the SSZ encoding of a BeaconState object
+type BeaconStateView struct {
+	v ssz.View
+}
+
+// NewBeaconStateView creates a view over the SSZ encoding of a BeaconState object
+func NewBeaconStateView(buf []byte) BeaconStateView {
+	return BeaconStateViewOf(ssz.NewView(buf))
+}
+
+// BeaconStateViewOf returns the view of the BeaconState object encoded in v
+func BeaconStateViewOf(v ssz.View) BeaconStateView {
+	return BeaconStateView{v.Container(10325, 4272)}
+}
+
+// Raw returns the untyped view of the BeaconState object
+func (v BeaconStateView) Raw() ssz.View {
+	return v.v
+}
+
+// BeaconStateListView is a lazy view over a list of BeaconState objects
+type BeaconStateListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th BeaconState object of the list
+func (l BeaconStateListView) At(i int) BeaconStateView {
+	return BeaconStateViewOf(l.ListView.At(i))
+}
+
+// GenesisTime returns the GenesisTime field of the BeaconState object
+func (v BeaconStateView) GenesisTime() (uint64, error) {
+	return v.v.Field(0, 8).Uint64()
+}
+
+// GenesisValidatorsRoot returns the GenesisValidatorsRoot field of the BeaconState object
+func (v BeaconStateView) GenesisValidatorsRoot() ([]byte, error) {
+	return v.v.Field(8, 40).Bytes()
+}
+
+// Slot returns the Slot field of the BeaconState object
+func (v BeaconStateView) Slot() (uint64, error) {
+	return v.v.Field(40, 48).Uint64()
+}
+
+// Fork returns the Fork field of the BeaconState object
+func (v BeaconStateView) Fork() ForkView {
+	return ForkViewOf(v.v.Field(48, 64))
+}
+
+// LatestBlockHeader returns the LatestBlockHeader field of the BeaconState object
+func (v BeaconStateView) LatestBlockHeader() BeaconBlockHeaderView {
+	return BeaconBlockHeaderViewOf(v.v.Field(64, 176))
+}
+
+// BlockRoots returns the BlockRoots field of the BeaconState object
+func (v BeaconStateView) BlockRoots() ssz.ListView {
+	return v.v.Field(176, 2224).List(32, 64)
+}
+
+// StateRoots returns the StateRoots field of the BeaconState object
+func (v BeaconStateView) StateRoots() ssz.ListView {
+	return v.v.Field(2224, 4272).List(32, 64)
+}
+
+// HistoricalRoots returns the HistoricalRoots field of the BeaconState object
+func (v BeaconStateView) HistoricalRoots() ssz.ListView {
+	return v.v.DynamicField(4272, 4348).List(32, 16777216)
+}
+
+// Eth1Data returns the Eth1Data field of the BeaconState object
+func (v BeaconStateView) Eth1Data() Eth1DataView {
+	return Eth1DataViewOf(v.v.Field(4276, 4348))
+}
+
+// Eth1DataVotes returns the Eth1DataVotes field of the BeaconState object
+func (v BeaconStateView) Eth1DataVotes() Eth1DataListView {
+	return Eth1DataListView{v.v.DynamicField(4348, 4360).List(72, 32)}
+}
+
+// Eth1DepositIndex returns the Eth1DepositIndex field of the BeaconState object
+func (v BeaconStateView) Eth1DepositIndex() (uint64, error) {
+	return v.v.Field(4352, 4360).Uint64()
+}
+
+// Validators returns the Validators field of the BeaconState object
+func (v BeaconStateView) Validators() ValidatorListView {
+	return ValidatorListView{v.v.DynamicField(4360, 4364).List(121, 1099511627776)}
+}
+
+// Balances returns the Balances field of the BeaconState object
+func (v BeaconStateView) Balances() ssz.ListView {
+	return v.v.DynamicField(4364, 6928).List(8, 1099511627776)
+}
+
+// RandaoMixes returns the RandaoMixes field of the BeaconState object
+func (v BeaconStateView) RandaoMixes() ssz.ListView {
+	return v.v.Field(4368, 6416).List(32, 64)
+}
+
+// Slashings returns the Slashings field of the BeaconState object
+func (v BeaconStateView) Slashings() ssz.ListView {
+	return v.v.Field(6416, 6928).List(8, 64)
+}
+
+// PreviousEpochParticipation returns the PreviousEpochParticipation field of the BeaconState object
+func (v BeaconStateView) PreviousEpochParticipation() ssz.ListView {
+	return v.v.DynamicField(6928, 6932).List(1, 1099511627776)
+}
+
+// CurrentEpochParticipation returns the CurrentEpochParticipation field of the BeaconState object
+func (v BeaconStateView) CurrentEpochParticipation() ssz.ListView {
+	return v.v.DynamicField(6932, 7057).List(1, 1099511627776)
+}
+
+// JustificationBits returns the JustificationBits field of the BeaconState object
+func (v BeaconStateView) JustificationBits() ([]byte, error) {
+	return v.v.Field(6936, 6937).Bytes()
+}
+
+// PreviousJustifiedCheckpoint returns the PreviousJustifiedCheckpoint field of the BeaconState object
+func (v BeaconStateView) PreviousJustifiedCheckpoint() CheckpointView {
+	return CheckpointViewOf(v.v.Field(6937, 6977))
+}
+
+// CurrentJustifiedCheckpoint returns the CurrentJustifiedCheckpoint field of the BeaconState object
+func (v BeaconStateView) CurrentJustifiedCheckpoint() CheckpointView {
+	return CheckpointViewOf(v.v.Field(6977, 7017))
+}
+
+// FinalizedCheckpoint returns the FinalizedCheckpoint field of the BeaconState object
+func (v BeaconStateView) FinalizedCheckpoint() CheckpointView {
+	return CheckpointViewOf(v.v.Field(7017, 7057))
+}
+
+// InactivityScores returns the InactivityScores field of the BeaconState object
+func (v BeaconStateView) InactivityScores() ssz.ListView {
+	return v.v.DynamicField(7057, 0).List(8, 1099511627776)
+}
+
+// CurrentSyncCommitee returns the CurrentSyncCommitee field of the BeaconState object
+func (v BeaconStateView) CurrentSyncCommitee() SyncCommitteeMinimalView {
+	return SyncCommitteeMinimalViewOf(v.v.Field(7061, 8693))
+}
+
+// NextSyncCommittee returns the NextSyncCommittee field of the BeaconState object
+func (v BeaconStateView) NextSyncCommittee() SyncCommitteeMinimalView {
+	return SyncCommitteeMinimalViewOf(v.v.Field(8693, 10325))
+}
+
+// Copy returns a deep copy of the BeaconState object
+func (b *BeaconState) Copy() *BeaconState {
+	if b == nil {
+		return nil
+	}
+	cpy := new(BeaconState)
+	*cpy = *b
+
+	// Field 'GenesisValidatorsRoot'
+	cpy.GenesisValidatorsRoot = append(cpy.GenesisValidatorsRoot[:0:0], cpy.GenesisValidatorsRoot...)
+
+	// Field 'Fork'
+	cpy.Fork = cpy.Fork.Copy()
+
+	// Field 'LatestBlockHeader'
+	cpy.LatestBlockHeader = cpy.LatestBlockHeader.Copy()
+
+	// Field 'StateRoots'
+	cpy.StateRoots = append(cpy.StateRoots[:0:0], cpy.StateRoots...)
+
+	// Field 'HistoricalRoots'
+	cpy.HistoricalRoots = append(cpy.HistoricalRoots[:0:0], cpy.HistoricalRoots...)
+
+	// Field 'Eth1Data'
+	cpy.Eth1Data = cpy.Eth1Data.Copy()
+
+	// Field 'Eth1DataVotes'
+	cpy.Eth1DataVotes = append(cpy.Eth1DataVotes[:0:0], cpy.Eth1DataVotes...)
+	for i0 := range cpy.Eth1DataVotes {
+		cpy.Eth1DataVotes[i0] = cpy.Eth1DataVotes[i0].Copy()
+	}
+
+	// Field 'Validators'
+	cpy.Validators = append(cpy.Validators[:0:0], cpy.Validators...)
+	for i0 := range cpy.Validators {
+		cpy.Validators[i0] = cpy.Validators[i0].Copy()
+	}
+
+	// Field 'Balances'
+	cpy.Balances = append(cpy.Balances[:0:0], cpy.Balances...)
+
+	// Field 'RandaoMixes'
+	cpy.RandaoMixes = append(cpy.RandaoMixes[:0:0], cpy.RandaoMixes...)
+	for i0 := range cpy.RandaoMixes {
+		cpy.RandaoMixes[i0] = append(cpy.RandaoMixes[i0][:0:0], cpy.RandaoMixes[i0]...)
+	}
+
+	// Field 'Slashings'
+	cpy.Slashings = append(cpy.Slashings[:0:0], cpy.Slashings...)
+
+	// Field 'PreviousEpochParticipation'
+	cpy.PreviousEpochParticipation = append(cpy.PreviousEpochParticipation[:0:0], cpy.PreviousEpochParticipation...)
+
+	// Field 'CurrentEpochParticipation'
+	cpy.CurrentEpochParticipation = append(cpy.CurrentEpochParticipation[:0:0], cpy.CurrentEpochParticipation...)
+
+	// Field 'JustificationBits'
+	cpy.JustificationBits = append(cpy.JustificationBits[:0:0], cpy.JustificationBits...)
+
+	// Field 'PreviousJustifiedCheckpoint'
+	cpy.PreviousJustifiedCheckpoint = cpy.PreviousJustifiedCheckpoint.Copy()
+
+	// Field 'CurrentJustifiedCheckpoint'
+	cpy.CurrentJustifiedCheckpoint = cpy.CurrentJustifiedCheckpoint.Copy()
+
+	// Field 'FinalizedCheckpoint'
+	cpy.FinalizedCheckpoint = cpy.FinalizedCheckpoint.Copy()
+
+	// Field 'InactivityScores'
+	cpy.InactivityScores = append(cpy.InactivityScores[:0:0], cpy.InactivityScores...)
+
+	// Field 'CurrentSyncCommitee'
+	cpy.CurrentSyncCommitee = cpy.CurrentSyncCommitee.Copy()
+
+	// Field 'NextSyncCommittee'
+	cpy.NextSyncCommittee = cpy.NextSyncCommittee.Copy()
+
+	return cpy
+}
+
+// EqualSSZ returns true if the BeaconState objects have the same SSZ encoding
+func (b *BeaconState) EqualSSZ(other *BeaconState) bool {
+	if b == nil {
+		b = new(BeaconState)
+	}
+	if other == nil {
+		other = new(BeaconState)
+	}
+
+	// Field 'GenesisTime'
+	if b.GenesisTime != other.GenesisTime {
+		return false
+	}
+
+	// Field 'GenesisValidatorsRoot'
+	if !bytes.Equal(b.GenesisValidatorsRoot, other.GenesisValidatorsRoot) {
+		return false
+	}
+
+	// Field 'Slot'
+	if b.Slot != other.Slot {
+		return false
+	}
+
+	// Field 'Fork'
+	if !b.Fork.EqualSSZ(other.Fork) {
+		return false
+	}
+
+	// Field 'LatestBlockHeader'
+	if !b.LatestBlockHeader.EqualSSZ(other.LatestBlockHeader) {
+		return false
+	}
+
+	// Field 'BlockRoots'
+	if b.BlockRoots != other.BlockRoots {
+		return false
+	}
+
+	// Field 'StateRoots'
+	if len(b.StateRoots) != len(other.StateRoots) {
+		return false
+	} else {
+		for i0 := range b.StateRoots {
+			if b.StateRoots[i0] != other.StateRoots[i0] {
+				return false
+			}
+		}
+	}
+
+	// Field 'HistoricalRoots'
+	if len(b.HistoricalRoots) != len(other.HistoricalRoots) {
+		return false
+	} else {
+		for i0 := range b.HistoricalRoots {
+			if b.HistoricalRoots[i0] != other.HistoricalRoots[i0] {
+				return false
+			}
+		}
+	}
+
+	// Field 'Eth1Data'
+	if !b.Eth1Data.EqualSSZ(other.Eth1Data) {
+		return false
+	}
+
+	// Field 'Eth1DataVotes'
+	if len(b.Eth1DataVotes) != len(other.Eth1DataVotes) {
+		return false
+	} else {
+		for i0 := range b.Eth1DataVotes {
+			if !b.Eth1DataVotes[i0].EqualSSZ(other.Eth1DataVotes[i0]) {
+				return false
+			}
+		}
+	}
+
+	// Field 'Eth1DepositIndex'
+	if b.Eth1DepositIndex != other.Eth1DepositIndex {
+		return false
+	}
+
+	// Field 'Validators'
+	if len(b.Validators) != len(other.Validators) {
+		return false
+	} else {
+		for i0 := range b.Validators {
+			if !b.Validators[i0].EqualSSZ(other.Validators[i0]) {
+				return false
+			}
+		}
+	}
+
+	// Field 'Balances'
+	if len(b.Balances) != len(other.Balances) {
+		return false
+	} else {
+		for i0 := range b.Balances {
+			if b.Balances[i0] != other.Balances[i0] {
+				return false
+			}
+		}
+	}
+
+	// Field 'RandaoMixes'
+	if len(b.RandaoMixes) != len(other.RandaoMixes) {
+		return false
+	} else {
+		for i0 := range b.RandaoMixes {
+			if !bytes.Equal(b.RandaoMixes[i0], other.RandaoMixes[i0]) {
+				return false
+			}
+		}
+	}
+
+	// Field 'Slashings'
+	if len(b.Slashings) != len(other.Slashings) {
+		return false
+	} else {
+		for i0 := range b.Slashings {
+			if b.Slashings[i0] != other.Slashings[i0] {
+				return false
+			}
+		}
+	}
+
+	// Field 'PreviousEpochParticipation'
+	if len(b.PreviousEpochParticipation) != len(other.PreviousEpochParticipation) {
+		return false
+	} else {
+		for i0 := range b.PreviousEpochParticipation {
+			if b.PreviousEpochParticipation[i0] != other.PreviousEpochParticipation[i0] {
+				return false
+			}
+		}
+	}
+
+	// Field 'CurrentEpochParticipation'
+	if len(b.CurrentEpochParticipation) != len(other.CurrentEpochParticipation) {
+		return false
+	} else {
+		for i0 := range b.CurrentEpochParticipation {
+			if b.CurrentEpochParticipation[i0] != other.CurrentEpochParticipation[i0] {
+				return false
+			}
+		}
+	}
+
+	// Field 'JustificationBits'
+	if !bytes.Equal(b.JustificationBits, other.JustificationBits) {
+		return false
+	}
+
+	// Field 'PreviousJustifiedCheckpoint'
+	if !b.PreviousJustifiedCheckpoint.EqualSSZ(other.PreviousJustifiedCheckpoint) {
+		return false
+	}
+
+	// Field 'CurrentJustifiedCheckpoint'
+	if !b.CurrentJustifiedCheckpoint.EqualSSZ(other.CurrentJustifiedCheckpoint) {
+		return false
+	}
+
+	// Field 'FinalizedCheckpoint'
+	if !b.FinalizedCheckpoint.EqualSSZ(other.FinalizedCheckpoint) {
+		return false
+	}
+
+	// Field 'InactivityScores'
+	if len(b.InactivityScores) != len(other.InactivityScores) {
+		return false
+	} else {
+		for i0 := range b.InactivityScores {
+			if b.InactivityScores[i0] != other.InactivityScores[i0] {
+				return false
+			}
+		}
+	}
+
+	// Field 'CurrentSyncCommitee'
+	if !b.CurrentSyncCommitee.EqualSSZ(other.CurrentSyncCommitee) {
+		return false
+	}
+
+	// Field 'NextSyncCommittee'
+	if !b.NextSyncCommittee.EqualSSZ(other.NextSyncCommittee) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the BeaconState objects
+func (b *BeaconState) DiffSSZ(other *BeaconState) []ssz.FieldDiff {
+	if b == nil {
+		b = new(BeaconState)
+	}
+	if other == nil {
+		other = new(BeaconState)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'GenesisTime'
+	if b.GenesisTime != other.GenesisTime {
+		diffs = append(diffs, ssz.FieldDiff{Path: "GenesisTime", A: b.GenesisTime, B: other.GenesisTime})
+	}
+
+	// Field 'GenesisValidatorsRoot'
+	if !bytes.Equal(b.GenesisValidatorsRoot, other.GenesisValidatorsRoot) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "GenesisValidatorsRoot", A: b.GenesisValidatorsRoot, B: other.GenesisValidatorsRoot})
+	}
+
+	// Field 'Slot'
+	if b.Slot != other.Slot {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Slot", A: b.Slot, B: other.Slot})
+	}
+
+	// Field 'Fork'
+	diffs = ssz.AppendDiffs(diffs, "Fork", b.Fork.DiffSSZ(other.Fork))
+
+	// Field 'LatestBlockHeader'
+	diffs = ssz.AppendDiffs(diffs, "LatestBlockHeader", b.LatestBlockHeader.DiffSSZ(other.LatestBlockHeader))
+
+	// Field 'BlockRoots'
+	if b.BlockRoots != other.BlockRoots {
+		diffs = append(diffs, ssz.FieldDiff{Path: "BlockRoots", A: b.BlockRoots, B: other.BlockRoots})
+	}
+
+	// Field 'StateRoots'
+	if len(b.StateRoots) != len(other.StateRoots) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "StateRoots", A: b.StateRoots, B: other.StateRoots})
+	} else {
+		for i0 := range b.StateRoots {
+			if b.StateRoots[i0] != other.StateRoots[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("StateRoots", i0), A: b.StateRoots[i0], B: other.StateRoots[i0]})
+			}
+		}
+	}
+
+	// Field 'HistoricalRoots'
+	if len(b.HistoricalRoots) != len(other.HistoricalRoots) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "HistoricalRoots", A: b.HistoricalRoots, B: other.HistoricalRoots})
+	} else {
+		for i0 := range b.HistoricalRoots {
+			if b.HistoricalRoots[i0] != other.HistoricalRoots[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("HistoricalRoots", i0), A: b.HistoricalRoots[i0], B: other.HistoricalRoots[i0]})
+			}
+		}
+	}
+
+	// Field 'Eth1Data'
+	diffs = ssz.AppendDiffs(diffs, "Eth1Data", b.Eth1Data.DiffSSZ(other.Eth1Data))
+
+	// Field 'Eth1DataVotes'
+	if len(b.Eth1DataVotes) != len(other.Eth1DataVotes) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Eth1DataVotes", A: b.Eth1DataVotes, B: other.Eth1DataVotes})
+	} else {
+		for i0 := range b.Eth1DataVotes {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("Eth1DataVotes", i0), b.Eth1DataVotes[i0].DiffSSZ(other.Eth1DataVotes[i0]))
+		}
+	}
+
+	// Field 'Eth1DepositIndex'
+	if b.Eth1DepositIndex != other.Eth1DepositIndex {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Eth1DepositIndex", A: b.Eth1DepositIndex, B: other.Eth1DepositIndex})
+	}
+
+	// Field 'Validators'
+	if len(b.Validators) != len(other.Validators) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Validators", A: b.Validators, B: other.Validators})
+	} else {
+		for i0 := range b.Validators {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("Validators", i0), b.Validators[i0].DiffSSZ(other.Validators[i0]))
+		}
+	}
+
+	// Field 'Balances'
+	if len(b.Balances) != len(other.Balances) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Balances", A: b.Balances, B: other.Balances})
+	} else {
+		for i0 := range b.Balances {
+			if b.Balances[i0] != other.Balances[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("Balances", i0), A: b.Balances[i0], B: other.Balances[i0]})
+			}
+		}
+	}
+
+	// Field 'RandaoMixes'
+	if len(b.RandaoMixes) != len(other.RandaoMixes) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "RandaoMixes", A: b.RandaoMixes, B: other.RandaoMixes})
+	} else {
+		for i0 := range b.RandaoMixes {
+			if !bytes.Equal(b.RandaoMixes[i0], other.RandaoMixes[i0]) {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("RandaoMixes", i0), A: b.RandaoMixes[i0], B: other.RandaoMixes[i0]})
+			}
+		}
+	}
+
+	// Field 'Slashings'
+	if len(b.Slashings) != len(other.Slashings) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Slashings", A: b.Slashings, B: other.Slashings})
+	} else {
+		for i0 := range b.Slashings {
+			if b.Slashings[i0] != other.Slashings[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("Slashings", i0), A: b.Slashings[i0], B: other.Slashings[i0]})
+			}
+		}
+	}
+
+	// Field 'PreviousEpochParticipation'
+	if len(b.PreviousEpochParticipation) != len(other.PreviousEpochParticipation) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "PreviousEpochParticipation", A: b.PreviousEpochParticipation, B: other.PreviousEpochParticipation})
+	} else {
+		for i0 := range b.PreviousEpochParticipation {
+			if b.PreviousEpochParticipation[i0] != other.PreviousEpochParticipation[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("PreviousEpochParticipation", i0), A: b.PreviousEpochParticipation[i0], B: other.PreviousEpochParticipation[i0]})
+			}
+		}
+	}
+
+	// Field 'CurrentEpochParticipation'
+	if len(b.CurrentEpochParticipation) != len(other.CurrentEpochParticipation) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "CurrentEpochParticipation", A: b.CurrentEpochParticipation, B: other.CurrentEpochParticipation})
+	} else {
+		for i0 := range b.CurrentEpochParticipation {
+			if b.CurrentEpochParticipation[i0] != other.CurrentEpochParticipation[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("CurrentEpochParticipation", i0), A: b.CurrentEpochParticipation[i0], B: other.CurrentEpochParticipation[i0]})
+			}
+		}
+	}
+
+	// Field 'JustificationBits'
+	if !bytes.Equal(b.JustificationBits, other.JustificationBits) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "JustificationBits", A: b.JustificationBits, B: other.JustificationBits})
+	}
+
+	// Field 'PreviousJustifiedCheckpoint'
+	diffs = ssz.AppendDiffs(diffs, "PreviousJustifiedCheckpoint", b.PreviousJustifiedCheckpoint.DiffSSZ(other.PreviousJustifiedCheckpoint))
+
+	// Field 'CurrentJustifiedCheckpoint'
+	diffs = ssz.AppendDiffs(diffs, "CurrentJustifiedCheckpoint", b.CurrentJustifiedCheckpoint.DiffSSZ(other.CurrentJustifiedCheckpoint))
+
+	// Field 'FinalizedCheckpoint'
+	diffs = ssz.AppendDiffs(diffs, "FinalizedCheckpoint", b.FinalizedCheckpoint.DiffSSZ(other.FinalizedCheckpoint))
+
+	// Field 'InactivityScores'
+	if len(b.InactivityScores) != len(other.InactivityScores) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "InactivityScores", A: b.InactivityScores, B: other.InactivityScores})
+	} else {
+		for i0 := range b.InactivityScores {
+			if b.InactivityScores[i0] != other.InactivityScores[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("InactivityScores", i0), A: b.InactivityScores[i0], B: other.InactivityScores[i0]})
+			}
+		}
+	}
+
+	// Field 'CurrentSyncCommitee'
+	diffs = ssz.AppendDiffs(diffs, "CurrentSyncCommitee", b.CurrentSyncCommitee.DiffSSZ(other.CurrentSyncCommitee))
+
+	// Field 'NextSyncCommittee'
+	diffs = ssz.AppendDiffs(diffs, "NextSyncCommittee", b.NextSyncCommittee.DiffSSZ(other.NextSyncCommittee))
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the BeaconState object following the Beacon API conventions
+func (b *BeaconState) MarshalJSON() ([]byte, error) {
+	if b == nil {
+		b = new(BeaconState)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'GenesisTime'
+	dst = append(dst, "\"genesis_time\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(b.GenesisTime))
+
+	// Field (1) 'GenesisValidatorsRoot'
+	dst = append(dst, ",\"genesis_validators_root\":"...)
+	dst = ssz.MarshalJSONBytes(dst, b.GenesisValidatorsRoot)
+
+	// Field (2) 'Slot'
+	dst = append(dst, ",\"slot\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(b.Slot))
+
+	// Field (3) 'Fork'
+	dst = append(dst, ",\"fork\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, b.Fork); err != nil {
+		return nil, err
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	dst = append(dst, ",\"latest_block_header\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, b.LatestBlockHeader); err != nil {
+		return nil, err
+	}
+
+	// Field (5) 'BlockRoots'
+	dst = append(dst, ",\"block_roots\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.BlockRoots {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONBytes(dst, b.BlockRoots[i0][:])
+	}
+	dst = append(dst, ']')
+
+	// Field (6) 'StateRoots'
+	dst = append(dst, ",\"state_roots\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.StateRoots {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONBytes(dst, b.StateRoots[i0][:])
+	}
+	dst = append(dst, ']')
+
+	// Field (7) 'HistoricalRoots'
+	dst = append(dst, ",\"historical_roots\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.HistoricalRoots {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONBytes(dst, b.HistoricalRoots[i0][:])
+	}
+	dst = append(dst, ']')
+
+	// Field (8) 'Eth1Data'
+	dst = append(dst, ",\"eth1_data\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, b.Eth1Data); err != nil {
+		return nil, err
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	dst = append(dst, ",\"eth1_data_votes\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.Eth1DataVotes {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		if dst, err = ssz.MarshalJSONObject(dst, b.Eth1DataVotes[i0]); err != nil {
+			return nil, err
+		}
+	}
+	dst = append(dst, ']')
+
+	// Field (10) 'Eth1DepositIndex'
+	dst = append(dst, ",\"eth1_deposit_index\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(b.Eth1DepositIndex))
+
+	// Field (11) 'Validators'
+	dst = append(dst, ",\"validators\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.Validators {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		if dst, err = ssz.MarshalJSONObject(dst, b.Validators[i0]); err != nil {
+			return nil, err
+		}
+	}
+	dst = append(dst, ']')
+
+	// Field (12) 'Balances'
+	dst = append(dst, ",\"balances\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.Balances {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONUint64(dst, uint64(b.Balances[i0]))
+	}
+	dst = append(dst, ']')
+
+	// Field (13) 'RandaoMixes'
+	dst = append(dst, ",\"randao_mixes\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.RandaoMixes {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONBytes(dst, b.RandaoMixes[i0])
+	}
+	dst = append(dst, ']')
+
+	// Field (14) 'Slashings'
+	dst = append(dst, ",\"slashings\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.Slashings {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONUint64(dst, uint64(b.Slashings[i0]))
+	}
+	dst = append(dst, ']')
+
+	// Field (15) 'PreviousEpochParticipation'
+	dst = append(dst, ",\"previous_epoch_participation\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.PreviousEpochParticipation {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONUint64(dst, uint64(b.PreviousEpochParticipation[i0]))
+	}
+	dst = append(dst, ']')
+
+	// Field (16) 'CurrentEpochParticipation'
+	dst = append(dst, ",\"current_epoch_participation\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.CurrentEpochParticipation {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONUint64(dst, uint64(b.CurrentEpochParticipation[i0]))
+	}
+	dst = append(dst, ']')
+
+	// Field (17) 'JustificationBits'
+	dst = append(dst, ",\"justification_bits\":"...)
+	dst = ssz.MarshalJSONBytes(dst, b.JustificationBits)
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	dst = append(dst, ",\"previous_justified_checkpoint\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, b.PreviousJustifiedCheckpoint); err != nil {
+		return nil, err
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	dst = append(dst, ",\"current_justified_checkpoint\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, b.CurrentJustifiedCheckpoint); err != nil {
+		return nil, err
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	dst = append(dst, ",\"finalized_checkpoint\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, b.FinalizedCheckpoint); err != nil {
+		return nil, err
+	}
+
+	// Field (21) 'InactivityScores'
+	dst = append(dst, ",\"inactivity_scores\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.InactivityScores {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONUint64(dst, uint64(b.InactivityScores[i0]))
+	}
+	dst = append(dst, ']')
+
+	// Field (22) 'CurrentSyncCommitee'
+	dst = append(dst, ",\"current_sync_committee\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, b.CurrentSyncCommitee); err != nil {
+		return nil, err
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	dst = append(dst, ",\"next_sync_committee\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, b.NextSyncCommittee); err != nil {
+		return nil, err
+	}
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the BeaconState object following the Beacon API conventions
+func (b *BeaconState) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'GenesisTime'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["genesis_time"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "GenesisTime")
+		}
+		b.GenesisTime = uint64(val)
+	}
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if b.GenesisValidatorsRoot, err = ssz.UnmarshalJSONBytes(fields["genesis_validators_root"], 32, 32); err != nil {
+		return ssz.WrapJSONError(err, "GenesisValidatorsRoot")
+	}
+
+	// Field (2) 'Slot'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["slot"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Slot")
+		}
+		b.Slot = uint64(val)
+	}
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["fork"], b.Fork); err != nil {
+		return ssz.WrapJSONError(err, "Fork")
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["latest_block_header"], b.LatestBlockHeader); err != nil {
+		return ssz.WrapJSONError(err, "LatestBlockHeader")
+	}
+
+	// Field (5) 'BlockRoots'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["block_roots"], 64, 64)
+		if err != nil {
+			return ssz.WrapJSONError(err, "BlockRoots")
+		}
+
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONBytes(items0[i0], 32, 32)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("BlockRoots", i0))
+				}
+				copy(b.BlockRoots[i0][:], val)
+			}
+		}
+	}
+
+	// Field (6) 'StateRoots'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["state_roots"], 64, 64)
+		if err != nil {
+			return ssz.WrapJSONError(err, "StateRoots")
+		}
+		b.StateRoots = make([][32]byte, len(items0))
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONBytes(items0[i0], 32, 32)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("StateRoots", i0))
+				}
+				copy(b.StateRoots[i0][:], val)
+			}
+		}
+	}
+
+	// Field (7) 'HistoricalRoots'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["historical_roots"], 0, 16777216)
+		if err != nil {
+			return ssz.WrapJSONError(err, "HistoricalRoots")
+		}
+		b.HistoricalRoots = make([][32]byte, len(items0))
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONBytes(items0[i0], 32, 32)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("HistoricalRoots", i0))
+				}
+				copy(b.HistoricalRoots[i0][:], val)
+			}
+		}
+	}
+
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["eth1_data"], b.Eth1Data); err != nil {
+		return ssz.WrapJSONError(err, "Eth1Data")
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["eth1_data_votes"], 0, 32)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Eth1DataVotes")
+		}
+		b.Eth1DataVotes = make([]*Eth1Data, len(items0))
+		for i0 := range items0 {
+			if b.Eth1DataVotes[i0] == nil {
+				b.Eth1DataVotes[i0] = new(Eth1Data)
+			}
+			if err = ssz.UnmarshalJSONObject(items0[i0], b.Eth1DataVotes[i0]); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("Eth1DataVotes", i0))
+			}
+		}
+	}
+
+	// Field (10) 'Eth1DepositIndex'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["eth1_deposit_index"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Eth1DepositIndex")
+		}
+		b.Eth1DepositIndex = uint64(val)
+	}
+
+	// Field (11) 'Validators'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["validators"], 0, 1099511627776)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Validators")
+		}
+		b.Validators = make([]*Validator, len(items0))
+		for i0 := range items0 {
+			if b.Validators[i0] == nil {
+				b.Validators[i0] = new(Validator)
+			}
+			if err = ssz.UnmarshalJSONObject(items0[i0], b.Validators[i0]); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("Validators", i0))
+			}
+		}
+	}
+
+	// Field (12) 'Balances'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["balances"], 0, 1099511627776)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Balances")
+		}
+		b.Balances = make([]uint64, len(items0))
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONUint64(items0[i0], 8)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("Balances", i0))
+				}
+				b.Balances[i0] = uint64(val)
+			}
+		}
+	}
+
+	// Field (13) 'RandaoMixes'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["randao_mixes"], 64, 64)
+		if err != nil {
+			return ssz.WrapJSONError(err, "RandaoMixes")
+		}
+		b.RandaoMixes = make([][]byte, len(items0))
+		for i0 := range items0 {
+			if b.RandaoMixes[i0], err = ssz.UnmarshalJSONBytes(items0[i0], 32, 32); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("RandaoMixes", i0))
+			}
+		}
+	}
+
+	// Field (14) 'Slashings'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["slashings"], 64, 64)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Slashings")
+		}
+		b.Slashings = make([]uint64, len(items0))
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONUint64(items0[i0], 8)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("Slashings", i0))
+				}
+				b.Slashings[i0] = uint64(val)
+			}
+		}
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["previous_epoch_participation"], 0, 1099511627776)
+		if err != nil {
+			return ssz.WrapJSONError(err, "PreviousEpochParticipation")
+		}
+		b.PreviousEpochParticipation = make([]uint8, len(items0))
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONUint64(items0[i0], 1)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("PreviousEpochParticipation", i0))
+				}
+				b.PreviousEpochParticipation[i0] = uint8(val)
+			}
+		}
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["current_epoch_participation"], 0, 1099511627776)
+		if err != nil {
+			return ssz.WrapJSONError(err, "CurrentEpochParticipation")
+		}
+		b.CurrentEpochParticipation = make([]uint8, len(items0))
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONUint64(items0[i0], 1)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("CurrentEpochParticipation", i0))
+				}
+				b.CurrentEpochParticipation[i0] = uint8(val)
+			}
+		}
+	}
+
+	// Field (17) 'JustificationBits'
+	if b.JustificationBits, err = ssz.UnmarshalJSONBytes(fields["justification_bits"], 1, 1); err != nil {
+		return ssz.WrapJSONError(err, "JustificationBits")
+	}
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["previous_justified_checkpoint"], b.PreviousJustifiedCheckpoint); err != nil {
+		return ssz.WrapJSONError(err, "PreviousJustifiedCheckpoint")
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["current_justified_checkpoint"], b.CurrentJustifiedCheckpoint); err != nil {
+		return ssz.WrapJSONError(err, "CurrentJustifiedCheckpoint")
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["finalized_checkpoint"], b.FinalizedCheckpoint); err != nil {
+		return ssz.WrapJSONError(err, "FinalizedCheckpoint")
+	}
+
+	// Field (21) 'InactivityScores'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["inactivity_scores"], 0, 1099511627776)
+		if err != nil {
+			return ssz.WrapJSONError(err, "InactivityScores")
+		}
+		b.InactivityScores = make([]uint64, len(items0))
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONUint64(items0[i0], 8)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("InactivityScores", i0))
+				}
+				b.InactivityScores[i0] = uint64(val)
+			}
+		}
+	}
+
+	// Field (22) 'CurrentSyncCommitee'
+	if b.CurrentSyncCommitee == nil {
+		b.CurrentSyncCommitee = new(SyncCommitteeMinimal)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["current_sync_committee"], b.CurrentSyncCommitee); err != nil {
+		return ssz.WrapJSONError(err, "CurrentSyncCommitee")
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommitteeMinimal)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["next_sync_committee"], b.NextSyncCommittee); err != nil {
+		return ssz.WrapJSONError(err, "NextSyncCommittee")
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the BeaconBlock object
+func (b *BeaconBlock) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlock object to a target array
+func (b *BeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(84)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.ParentRoot...)
+
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.StateRoot...)
+
+	// Offset (4) 'Body'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.Body == nil {
+		b.Body = new(BeaconBlockBody)
+	}
+	offset += b.Body.SizeSSZ()
+
+	// Field (4) 'Body'
+	if dst, err = b.Body.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the BeaconBlock object to a writer
+func (b *BeaconBlock) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, b)
+}
+
+// MarshalSSZToEncoder ssz marshals the BeaconBlock object to an encoder
+func (b *BeaconBlock) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(84)
+
+	// Field (0) 'Slot'
+	enc.EncodeUint64(b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	enc.EncodeUint64(b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+		return
+	}
+	enc.EncodeBytes(b.ParentRoot)
+
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
+		return
+	}
+	enc.EncodeBytes(b.StateRoot)
+
+	// Offset (4) 'Body'
+	enc.EncodeOffset(offset)
+	if b.Body == nil {
+		b.Body = new(BeaconBlockBody)
+	}
+	offset += b.Body.SizeSSZ()
+
+	// Field (4) 'Body'
+	if err = enc.EncodeObject(b.Body); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlock object
+func (b *BeaconBlock) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the BeaconBlock object within the limits of the decode state
+func (b *BeaconBlock) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlock", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 84 {
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconBlock", 84, size)
+	}
+
+	tail := buf
+	var o4 uint64
+
+	// Field (0) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ProposerIndex'
+	b.ProposerIndex = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'ParentRoot'
+	if cap(b.ParentRoot) == 0 {
+		b.ParentRoot = make([]byte, 0, len(buf[16:48]))
+	}
+	b.ParentRoot = append(b.ParentRoot, buf[16:48]...)
+
+	// Field (3) 'StateRoot'
+	if cap(b.StateRoot) == 0 {
+		b.StateRoot = make([]byte, 0, len(buf[48:80]))
+	}
+	b.StateRoot = append(b.StateRoot, buf[48:80]...)
+
+	// Offset (4) 'Body'
+	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlock", "Body", 80)
+	}
+
+	if o4 != 84 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 84, o4), "BeaconBlock", "Body", 80)
+	}
+
+	// Field (4) 'Body'
+	{
+		buf = tail[o4:]
+		if b.Body == nil {
+			b.Body = new(BeaconBlockBody)
+		}
+		if err = ssz.UnmarshalWithState(b.Body, buf, st); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlock", "Body", o4)
+		}
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a BeaconBlock object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (b *BeaconBlock) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 84 {
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconBlock", 84, size)
+	}
+
+	tail := buf
+	var o4 uint64
+
+	// Offset (4) 'Body'
+	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlock", "Body", 80)
+	}
+
+	if o4 != 84 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 84, o4), "BeaconBlock", "Body", 80)
+	}
+
+	// Field (4) 'Body'
+	{
+		buf = tail[o4:]
+		if err = (*BeaconBlockBody)(nil).ValidateSSZ(buf); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlock", "Body", o4)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the BeaconBlock object from a reader with an encoded size of 'size' bytes
+func (b *BeaconBlock) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, b)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the BeaconBlock object from a decoder
+func (b *BeaconBlock) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 84 {
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconBlock", 84, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlock", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(84)
+	if err != nil {
+		return err
+	}
+	var o4 uint64
+
+	// Field (0) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ProposerIndex'
+	b.ProposerIndex = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'ParentRoot'
+	if cap(b.ParentRoot) == 0 {
+		b.ParentRoot = make([]byte, 0, len(buf[16:48]))
+	}
+	b.ParentRoot = append(b.ParentRoot, buf[16:48]...)
+
+	// Field (3) 'StateRoot'
+	if cap(b.StateRoot) == 0 {
+		b.StateRoot = make([]byte, 0, len(buf[48:80]))
+	}
+	b.StateRoot = append(b.StateRoot, buf[48:80]...)
+
+	// Offset (4) 'Body'
+	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlock", "Body", 80)
+	}
+
+	if o4 != 84 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 84, o4), "BeaconBlock", "Body", 80)
+	}
+
+	// Field (4) 'Body'
+	{
+		if b.Body == nil {
+			b.Body = new(BeaconBlockBody)
+		}
+		if err = dec.DecodeObject(b.Body, size-o4); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlock", "Body", o4)
+		}
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlock object
+func (b *BeaconBlock) SizeSSZ() (size int) {
+	size = 84
+
+	// Field (4) 'Body'
+	if b.Body == nil {
+		b.Body = new(BeaconBlockBody)
+	}
+	size += b.Body.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconBlock object
+func (b *BeaconBlock) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlock object with a hasher
+func (b *BeaconBlock) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	hh.PutUint64(b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.ParentRoot)
+
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.StateRoot)
+
+	// Field (4) 'Body'
+	if err = b.Body.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootParallel ssz hashes the BeaconBlock object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (b *BeaconBlock) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return b.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the BeaconBlock object like HashTreeRootParallel
+// with the Hashers of the pool
+func (b *BeaconBlock) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Slot'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(b.Slot)
+			return
+		},
+		// Field (1) 'ProposerIndex'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(b.ProposerIndex)
+			return
+		},
+		// Field (2) 'ParentRoot'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(b.ParentRoot); size != 32 {
+				err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+				return
+			}
+			hh.PutBytes(b.ParentRoot)
+			return
+		},
+		// Field (3) 'StateRoot'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(b.StateRoot); size != 32 {
+				err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
+				return
+			}
+			hh.PutBytes(b.StateRoot)
+			return
+		},
+		// Field (4) 'Body'
+		func(hh *ssz.Hasher) (err error) {
+			if err = b.Body.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+	})
+}
+
+// BeaconBlockView is a lazy view over the SSZ encoding of a BeaconBlock object
+type BeaconBlockView struct {
+	v ssz.View
+}
+
+// NewBeaconBlockView creates a view over the SSZ encoding of a BeaconBlock object
+func NewBeaconBlockView(buf []byte) BeaconBlockView {
+	return BeaconBlockViewOf(ssz.NewView(buf))
+}
+
+// BeaconBlockViewOf returns the view of the BeaconBlock object encoded in v
+func BeaconBlockViewOf(v ssz.View) BeaconBlockView {
+	return BeaconBlockView{v.Container(84, 80)}
+}
+
+// Raw returns the untyped view of the BeaconBlock object
+func (v BeaconBlockView) Raw() ssz.View {
+	return v.v
+}
+
+// BeaconBlockListView is a lazy view over a list of BeaconBlock objects
+type BeaconBlockListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th BeaconBlock object of the list
+func (l BeaconBlockListView) At(i int) BeaconBlockView {
+	return BeaconBlockViewOf(l.ListView.At(i))
+}
+
+// Slot returns the Slot field of the BeaconBlock object
+func (v BeaconBlockView) Slot() (uint64, error) {
+	return v.v.Field(0, 8).Uint64()
+}
+
+// ProposerIndex returns the ProposerIndex field of the BeaconBlock object
+func (v BeaconBlockView) ProposerIndex() (uint64, error) {
+	return v.v.Field(8, 16).Uint64()
+}
+
+// ParentRoot returns the ParentRoot field of the BeaconBlock object
+func (v BeaconBlockView) ParentRoot() ([]byte, error) {
+	return v.v.Field(16, 48).Bytes()
+}
+
+// StateRoot returns the StateRoot field of the BeaconBlock object
+func (v BeaconBlockView) StateRoot() ([]byte, error) {
+	return v.v.Field(48, 80).Bytes()
+}
+
+// Body returns the Body field of the BeaconBlock object
+func (v BeaconBlockView) Body() BeaconBlockBodyView {
+	return BeaconBlockBodyViewOf(v.v.DynamicField(80, 0))
+}
+
+// Copy returns a deep copy of the BeaconBlock object
+func (b *BeaconBlock) Copy() *BeaconBlock {
+	if b == nil {
+		return nil
+	}
+	cpy := new(BeaconBlock)
+	*cpy = *b
+
+	// Field 'ParentRoot'
+	cpy.ParentRoot = append(cpy.ParentRoot[:0:0], cpy.ParentRoot...)
+
+	// Field 'StateRoot'
+	cpy.StateRoot = append(cpy.StateRoot[:0:0], cpy.StateRoot...)
+
+	// Field 'Body'
+	cpy.Body = cpy.Body.Copy()
+
+	return cpy
+}
+
+// EqualSSZ returns true if the BeaconBlock objects have the same SSZ encoding
+func (b *BeaconBlock) EqualSSZ(other *BeaconBlock) bool {
+	if b == nil {
+		b = new(BeaconBlock)
+	}
+	if other == nil {
+		other = new(BeaconBlock)
+	}
+
+	// Field 'Slot'
+	if b.Slot != other.Slot {
+		return false
+	}
+
+	// Field 'ProposerIndex'
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+
+	// Field 'ParentRoot'
+	if !bytes.Equal(b.ParentRoot, other.ParentRoot) {
+		return false
+	}
+
+	// Field 'StateRoot'
+	if !bytes.Equal(b.StateRoot, other.StateRoot) {
+		return false
+	}
+
+	// Field 'Body'
+	if !b.Body.EqualSSZ(other.Body) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the BeaconBlock objects
+func (b *BeaconBlock) DiffSSZ(other *BeaconBlock) []ssz.FieldDiff {
+	if b == nil {
+		b = new(BeaconBlock)
+	}
+	if other == nil {
+		other = new(BeaconBlock)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Slot'
+	if b.Slot != other.Slot {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Slot", A: b.Slot, B: other.Slot})
+	}
+
+	// Field 'ProposerIndex'
+	if b.ProposerIndex != other.ProposerIndex {
+		diffs = append(diffs, ssz.FieldDiff{Path: "ProposerIndex", A: b.ProposerIndex, B: other.ProposerIndex})
+	}
+
+	// Field 'ParentRoot'
+	if !bytes.Equal(b.ParentRoot, other.ParentRoot) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "ParentRoot", A: b.ParentRoot, B: other.ParentRoot})
+	}
+
+	// Field 'StateRoot'
+	if !bytes.Equal(b.StateRoot, other.StateRoot) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "StateRoot", A: b.StateRoot, B: other.StateRoot})
+	}
+
+	// Field 'Body'
+	diffs = ssz.AppendDiffs(diffs, "Body", b.Body.DiffSSZ(other.Body))
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the BeaconBlock object following the Beacon API conventions
+func (b *BeaconBlock) MarshalJSON() ([]byte, error) {
+	if b == nil {
+		b = new(BeaconBlock)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'Slot'
+	dst = append(dst, "\"slot\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(b.Slot))
+
+	// Field (1) 'ProposerIndex'
+	dst = append(dst, ",\"proposer_index\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(b.ProposerIndex))
+
+	// Field (2) 'ParentRoot'
+	dst = append(dst, ",\"parent_root\":"...)
+	dst = ssz.MarshalJSONBytes(dst, b.ParentRoot)
+
+	// Field (3) 'StateRoot'
+	dst = append(dst, ",\"state_root\":"...)
+	dst = ssz.MarshalJSONBytes(dst, b.StateRoot)
+
+	// Field (4) 'Body'
+	dst = append(dst, ",\"body\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, b.Body); err != nil {
+		return nil, err
+	}
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the BeaconBlock object following the Beacon API conventions
+func (b *BeaconBlock) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Slot'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["slot"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Slot")
+		}
+		b.Slot = uint64(val)
+	}
+
+	// Field (1) 'ProposerIndex'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["proposer_index"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "ProposerIndex")
+		}
+		b.ProposerIndex = uint64(val)
+	}
+
+	// Field (2) 'ParentRoot'
+	if b.ParentRoot, err = ssz.UnmarshalJSONBytes(fields["parent_root"], 32, 32); err != nil {
+		return ssz.WrapJSONError(err, "ParentRoot")
+	}
+
+	// Field (3) 'StateRoot'
+	if b.StateRoot, err = ssz.UnmarshalJSONBytes(fields["state_root"], 32, 32); err != nil {
+		return ssz.WrapJSONError(err, "StateRoot")
+	}
+
+	// Field (4) 'Body'
+	if b.Body == nil {
+		b.Body = new(BeaconBlockBody)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["body"], b.Body); err != nil {
+		return ssz.WrapJSONError(err, "Body")
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the SignedBeaconBlock object
+func (s *SignedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBeaconBlock object to a target array
+func (s *SignedBeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(100)
+
+	// Offset (0) 'Block'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.Block == nil {
+		s.Block = new(BeaconBlock)
+	}
+	offset += s.Block.SizeSSZ()
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	// Field (0) 'Block'
+	if dst, err = s.Block.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the SignedBeaconBlock object to a writer
+func (s *SignedBeaconBlock) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, s)
+}
+
+// MarshalSSZToEncoder ssz marshals the SignedBeaconBlock object to an encoder
+func (s *SignedBeaconBlock) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(100)
+
+	// Offset (0) 'Block'
+	enc.EncodeOffset(offset)
+	if s.Block == nil {
+		s.Block = new(BeaconBlock)
+	}
+	offset += s.Block.SizeSSZ()
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	enc.EncodeBytes(s.Signature)
+
+	// Field (0) 'Block'
+	if err = enc.EncodeObject(s.Block); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBeaconBlock object
+func (s *SignedBeaconBlock) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the SignedBeaconBlock object within the limits of the decode state
+func (s *SignedBeaconBlock) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "SignedBeaconBlock", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 100 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SignedBeaconBlock", 100, size)
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Block'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "SignedBeaconBlock", "Block", 0)
+	}
+
+	if o0 != 100 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 100, o0), "SignedBeaconBlock", "Block", 0)
+	}
+
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[4:100]))
+	}
+	s.Signature = append(s.Signature, buf[4:100]...)
+
+	// Field (0) 'Block'
+	{
+		buf = tail[o0:]
+		if s.Block == nil {
+			s.Block = new(BeaconBlock)
+		}
+		if err = ssz.UnmarshalWithState(s.Block, buf, st); err != nil {
+			return ssz.WrapDecodeError(err, "SignedBeaconBlock", "Block", o0)
+		}
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a SignedBeaconBlock object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (s *SignedBeaconBlock) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 100 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SignedBeaconBlock", 100, size)
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Block'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "SignedBeaconBlock", "Block", 0)
+	}
+
+	if o0 != 100 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 100, o0), "SignedBeaconBlock", "Block", 0)
+	}
+
+	// Field (0) 'Block'
+	{
+		buf = tail[o0:]
+		if err = (*BeaconBlock)(nil).ValidateSSZ(buf); err != nil {
+			return ssz.WrapDecodeError(err, "SignedBeaconBlock", "Block", o0)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the SignedBeaconBlock object from a reader with an encoded size of 'size' bytes
+func (s *SignedBeaconBlock) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, s)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the SignedBeaconBlock object from a decoder
+func (s *SignedBeaconBlock) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 100 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SignedBeaconBlock", 100, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "SignedBeaconBlock", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(100)
+	if err != nil {
+		return err
+	}
+	var o0 uint64
+
+	// Offset (0) 'Block'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "SignedBeaconBlock", "Block", 0)
+	}
+
+	if o0 != 100 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 100, o0), "SignedBeaconBlock", "Block", 0)
+	}
+
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[4:100]))
+	}
+	s.Signature = append(s.Signature, buf[4:100]...)
+
+	// Field (0) 'Block'
+	{
+		if s.Block == nil {
+			s.Block = new(BeaconBlock)
+		}
+		if err = dec.DecodeObject(s.Block, size-o0); err != nil {
+			return ssz.WrapDecodeError(err, "SignedBeaconBlock", "Block", o0)
+		}
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlock object
+func (s *SignedBeaconBlock) SizeSSZ() (size int) {
+	size = 100
+
+	// Field (0) 'Block'
+	if s.Block == nil {
+		s.Block = new(BeaconBlock)
+	}
+	size += s.Block.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBeaconBlock object
+func (s *SignedBeaconBlock) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBeaconBlock object with a hasher
+func (s *SignedBeaconBlock) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Block'
+	if err = s.Block.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootParallel ssz hashes the SignedBeaconBlock object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (s *SignedBeaconBlock) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return s.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the SignedBeaconBlock object like HashTreeRootParallel
+// with the Hashers of the pool
+func (s *SignedBeaconBlock) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Block'
+		func(hh *ssz.Hasher) (err error) {
+			if err = s.Block.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (1) 'Signature'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(s.Signature); size != 96 {
+				err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+				return
+			}
+			hh.PutBytes(s.Signature)
+			return
+		},
+	})
+}
+
+// SignedBeaconBlockView is a lazy view over the SSZ encoding of a SignedBeaconBlock object
+type SignedBeaconBlockView struct {
+	v ssz.View
+}
+
+// NewSignedBeaconBlockView creates a view over the SSZ encoding of a SignedBeaconBlock object
+func NewSignedBeaconBlockView(buf []byte) SignedBeaconBlockView {
+	return SignedBeaconBlockViewOf(ssz.NewView(buf))
+}
+
+// SignedBeaconBlockViewOf returns the view of the SignedBeaconBlock object encoded in v
+func SignedBeaconBlockViewOf(v ssz.View) SignedBeaconBlockView {
+	return SignedBeaconBlockView{v.Container(100, 0)}
+}
+
+// Raw returns the untyped view of the SignedBeaconBlock object
+func (v SignedBeaconBlockView) Raw() ssz.View {
+	return v.v
+}
+
+// SignedBeaconBlockListView is a lazy view over a list of SignedBeaconBlock objects
+type SignedBeaconBlockListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th SignedBeaconBlock object of the list
+func (l SignedBeaconBlockListView) At(i int) SignedBeaconBlockView {
+	return SignedBeaconBlockViewOf(l.ListView.At(i))
+}
+
+// Block returns the Block field of the SignedBeaconBlock object
+func (v SignedBeaconBlockView) Block() BeaconBlockView {
+	return BeaconBlockViewOf(v.v.DynamicField(0, 0))
+}
+
+// Signature returns the Signature field of the SignedBeaconBlock object
+func (v SignedBeaconBlockView) Signature() ([]byte, error) {
+	return v.v.Field(4, 100).Bytes()
+}
+
+// Copy returns a deep copy of the SignedBeaconBlock object
+func (s *SignedBeaconBlock) Copy() *SignedBeaconBlock {
+	if s == nil {
+		return nil
+	}
+	cpy := new(SignedBeaconBlock)
+	*cpy = *s
+
+	// Field 'Block'
+	cpy.Block = cpy.Block.Copy()
+
+	// Field 'Signature'
+	cpy.Signature = append(cpy.Signature[:0:0], cpy.Signature...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the SignedBeaconBlock objects have the same SSZ encoding
+func (s *SignedBeaconBlock) EqualSSZ(other *SignedBeaconBlock) bool {
+	if s == nil {
+		s = new(SignedBeaconBlock)
+	}
+	if other == nil {
+		other = new(SignedBeaconBlock)
+	}
+
+	// Field 'Block'
+	if !s.Block.EqualSSZ(other.Block) {
+		return false
+	}
+
+	// Field 'Signature'
+	if !bytes.Equal(s.Signature, other.Signature) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the SignedBeaconBlock objects
+func (s *SignedBeaconBlock) DiffSSZ(other *SignedBeaconBlock) []ssz.FieldDiff {
+	if s == nil {
+		s = new(SignedBeaconBlock)
+	}
+	if other == nil {
+		other = new(SignedBeaconBlock)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Block'
+	diffs = ssz.AppendDiffs(diffs, "Block", s.Block.DiffSSZ(other.Block))
+
+	// Field 'Signature'
+	if !bytes.Equal(s.Signature, other.Signature) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Signature", A: s.Signature, B: other.Signature})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the SignedBeaconBlock object following the Beacon API conventions
+func (s *SignedBeaconBlock) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		s = new(SignedBeaconBlock)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'Block'
+	dst = append(dst, "\"message\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, s.Block); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Signature'
+	dst = append(dst, ",\"signature\":"...)
+	dst = ssz.MarshalJSONBytes(dst, s.Signature)
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the SignedBeaconBlock object following the Beacon API conventions
+func (s *SignedBeaconBlock) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Block'
+	if s.Block == nil {
+		s.Block = new(BeaconBlock)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["message"], s.Block); err != nil {
+		return ssz.WrapJSONError(err, "Block")
+	}
+
+	// Field (1) 'Signature'
+	if s.Signature, err = ssz.UnmarshalJSONBytes(fields["signature"], 96, 96); err != nil {
+		return ssz.WrapJSONError(err, "Signature")
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Transfer object
+func (t *Transfer) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(t)
+}
+
+// MarshalSSZTo ssz marshals the Transfer object to a target array
+func (t *Transfer) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Sender'
+	dst = ssz.MarshalUint64(dst, t.Sender)
+
+	// Field (1) 'Recipient'
+	dst = ssz.MarshalUint64(dst, t.Recipient)
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalUint64(dst, t.Amount)
+
+	// Field (3) 'Fee'
+	dst = ssz.MarshalUint64(dst, t.Fee)
+
+	// Field (4) 'Slot'
+	dst = ssz.MarshalUint64(dst, t.Slot)
+
+	// Field (5) 'Pubkey'
+	if size := len(t.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, t.Pubkey...)
+
+	// Field (6) 'Signature'
+	if size := len(t.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	dst = append(dst, t.Signature...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Transfer object to a writer
+func (t *Transfer) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, t)
+}
+
+// MarshalSSZToEncoder ssz marshals the Transfer object to an encoder
+func (t *Transfer) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	// Field (0) 'Sender'
+	enc.EncodeUint64(t.Sender)
+
+	// Field (1) 'Recipient'
+	enc.EncodeUint64(t.Recipient)
+
+	// Field (2) 'Amount'
+	enc.EncodeUint64(t.Amount)
+
+	// Field (3) 'Fee'
+	enc.EncodeUint64(t.Fee)
+
+	// Field (4) 'Slot'
+	enc.EncodeUint64(t.Slot)
+
+	// Field (5) 'Pubkey'
+	if size := len(t.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	enc.EncodeBytes(t.Pubkey)
+
+	// Field (6) 'Signature'
+	if size := len(t.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	enc.EncodeBytes(t.Signature)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Transfer object
+func (t *Transfer) UnmarshalSSZ(buf []byte) error {
+	return t.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the Transfer object within the limits of the decode state
+func (t *Transfer) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "Transfer", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 184 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Transfer", 184, size)
+	}
+
+	// Field (0) 'Sender'
+	t.Sender = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Recipient'
+	t.Recipient = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'Amount'
+	t.Amount = ssz.UnmarshallUint64(buf[16:24])
+
+	// Field (3) 'Fee'
+	t.Fee = ssz.UnmarshallUint64(buf[24:32])
+
+	// Field (4) 'Slot'
+	t.Slot = ssz.UnmarshallUint64(buf[32:40])
+
+	// Field (5) 'Pubkey'
+	if cap(t.Pubkey) == 0 {
+		t.Pubkey = make([]byte, 0, len(buf[40:88]))
+	}
+	t.Pubkey = append(t.Pubkey, buf[40:88]...)
+
+	// Field (6) 'Signature'
+	if cap(t.Signature) == 0 {
+		t.Signature = make([]byte, 0, len(buf[88:184]))
+	}
+	t.Signature = append(t.Signature, buf[88:184]...)
+
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a Transfer object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (t *Transfer) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 184 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Transfer", 184, size)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the Transfer object from a reader with an encoded size of 'size' bytes
+func (t *Transfer) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, t)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the Transfer object from a decoder
+func (t *Transfer) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 184 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Transfer", 184, dec.Size())
+	}
+	buf, err := dec.Read(184)
+	if err != nil {
+		return err
+	}
+	return t.UnmarshalSSZWithState(buf, dec.State())
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Transfer object
+func (t *Transfer) SizeSSZ() (size int) {
+	size = 184
+	return
+}
+
+// HashTreeRoot ssz hashes the Transfer object
+func (t *Transfer) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(t)
+}
+
+// HashTreeRootWith ssz hashes the Transfer object with a hasher
+func (t *Transfer) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Sender'
+	hh.PutUint64(t.Sender)
+
+	// Field (1) 'Recipient'
+	hh.PutUint64(t.Recipient)
+
+	// Field (2) 'Amount'
+	hh.PutUint64(t.Amount)
+
+	// Field (3) 'Fee'
+	hh.PutUint64(t.Fee)
+
+	// Field (4) 'Slot'
+	hh.PutUint64(t.Slot)
+
+	// Field (5) 'Pubkey'
+	if size := len(t.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	hh.PutBytes(t.Pubkey)
+
+	// Field (6) 'Signature'
+	if size := len(t.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(t.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootParallel ssz hashes the Transfer object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (t *Transfer) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return t.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the Transfer object like HashTreeRootParallel
+// with the Hashers of the pool
+func (t *Transfer) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Sender'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(t.Sender)
+			return
+		},
+		// Field (1) 'Recipient'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(t.Recipient)
+			return
+		},
+		// Field (2) 'Amount'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(t.Amount)
+			return
+		},
+		// Field (3) 'Fee'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(t.Fee)
+			return
+		},
+		// Field (4) 'Slot'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(t.Slot)
+			return
+		},
+		// Field (5) 'Pubkey'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(t.Pubkey); size != 48 {
+				err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+				return
+			}
+			hh.PutBytes(t.Pubkey)
+			return
+		},
+		// Field (6) 'Signature'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(t.Signature); size != 96 {
+				err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+				return
+			}
+			hh.PutBytes(t.Signature)
+			return
+		},
+	})
+}
+
+// TransferView is a lazy view over the SSZ encoding of a Transfer object
+type TransferView struct {
+	v ssz.View
+}
+
+// NewTransferView creates a view over the SSZ encoding of a Transfer object
+func NewTransferView(buf []byte) TransferView {
+	return TransferViewOf(ssz.NewView(buf))
+}
+
+// TransferViewOf returns the view of the Transfer object encoded in v
+func TransferViewOf(v ssz.View) TransferView {
+	return TransferView{v.Container(184, -1)}
+}
+
+// Raw returns the untyped view of the Transfer object
+func (v TransferView) Raw() ssz.View {
+	return v.v
+}
+
+// TransferListView is a lazy view over a list of Transfer objects
+type TransferListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th Transfer object of the list
+func (l TransferListView) At(i int) TransferView {
+	return TransferViewOf(l.ListView.At(i))
+}
+
+// Sender returns the Sender field of the Transfer object
+func (v TransferView) Sender() (uint64, error) {
+	return v.v.Field(0, 8).Uint64()
+}
+
+// Recipient returns the Recipient field of the Transfer object
+func (v TransferView) Recipient() (uint64, error) {
+	return v.v.Field(8, 16).Uint64()
+}
+
+// Amount returns the Amount field of the Transfer object
+func (v TransferView) Amount() (uint64, error) {
+	return v.v.Field(16, 24).Uint64()
+}
+
+// Fee returns the Fee field of the Transfer object
+func (v TransferView) Fee() (uint64, error) {
+	return v.v.Field(24, 32).Uint64()
+}
+
+// Slot returns the Slot field of the Transfer object
+func (v TransferView) Slot() (uint64, error) {
+	return v.v.Field(32, 40).Uint64()
+}
+
+// Pubkey returns the Pubkey field of the Transfer object
+func (v TransferView) Pubkey() ([]byte, error) {
+	return v.v.Field(40, 88).Bytes()
+}
+
+// Signature returns the Signature field of the Transfer object
+func (v TransferView) Signature() ([]byte, error) {
+	return v.v.Field(88, 184).Bytes()
+}
+
+// Copy returns a deep copy of the Transfer object
+func (t *Transfer) Copy() *Transfer {
+	if t == nil {
+		return nil
+	}
+	cpy := new(Transfer)
+	*cpy = *t
+
+	// Field 'Pubkey'
+	cpy.Pubkey = append(cpy.Pubkey[:0:0], cpy.Pubkey...)
+
+	// Field 'Signature'
+	cpy.Signature = append(cpy.Signature[:0:0], cpy.Signature...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the Transfer objects have the same SSZ encoding
+func (t *Transfer) EqualSSZ(other *Transfer) bool {
+	if t == nil {
+		t = new(Transfer)
+	}
+	if other == nil {
+		other = new(Transfer)
+	}
+
+	// Field 'Sender'
+	if t.Sender != other.Sender {
+		return false
+	}
+
+	// Field 'Recipient'
+	if t.Recipient != other.Recipient {
+		return false
+	}
+
+	// Field 'Amount'
+	if t.Amount != other.Amount {
+		return false
+	}
+
+	// Field 'Fee'
+	if t.Fee != other.Fee {
+		return false
+	}
+
+	// Field 'Slot'
+	if t.Slot != other.Slot {
+		return false
+	}
+
+	// Field 'Pubkey'
+	if !bytes.Equal(t.Pubkey, other.Pubkey) {
+		return false
+	}
+
+	// Field 'Signature'
+	if !bytes.Equal(t.Signature, other.Signature) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the Transfer objects
+func (t *Transfer) DiffSSZ(other *Transfer) []ssz.FieldDiff {
+	if t == nil {
+		t = new(Transfer)
+	}
+	if other == nil {
+		other = new(Transfer)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Sender'
+	if t.Sender != other.Sender {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Sender", A: t.Sender, B: other.Sender})
+	}
+
+	// Field 'Recipient'
+	if t.Recipient != other.Recipient {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Recipient", A: t.Recipient, B: other.Recipient})
+	}
+
+	// Field 'Amount'
+	if t.Amount != other.Amount {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Amount", A: t.Amount, B: other.Amount})
+	}
+
+	// Field 'Fee'
+	if t.Fee != other.Fee {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Fee", A: t.Fee, B: other.Fee})
+	}
+
+	// Field 'Slot'
+	if t.Slot != other.Slot {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Slot", A: t.Slot, B: other.Slot})
+	}
+
+	// Field 'Pubkey'
+	if !bytes.Equal(t.Pubkey, other.Pubkey) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Pubkey", A: t.Pubkey, B: other.Pubkey})
+	}
+
+	// Field 'Signature'
+	if !bytes.Equal(t.Signature, other.Signature) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Signature", A: t.Signature, B: other.Signature})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the Transfer object following the Beacon API conventions
+func (t *Transfer) MarshalJSON() ([]byte, error) {
+	if t == nil {
+		t = new(Transfer)
+	}
+	dst := []byte{'{'}
+
+	// Field (0) 'Sender'
+	dst = append(dst, "\"sender\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(t.Sender))
+
+	// Field (1) 'Recipient'
+	dst = append(dst, ",\"recipient\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(t.Recipient))
+
+	// Field (2) 'Amount'
+	dst = append(dst, ",\"amount\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(t.Amount))
+
+	// Field (3) 'Fee'
+	dst = append(dst, ",\"fee\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(t.Fee))
+
+	// Field (4) 'Slot'
+	dst = append(dst, ",\"slot\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(t.Slot))
+
+	// Field (5) 'Pubkey'
+	dst = append(dst, ",\"pubkey\":"...)
+	dst = ssz.MarshalJSONBytes(dst, t.Pubkey)
+
+	// Field (6) 'Signature'
+	dst = append(dst, ",\"signature\":"...)
+	dst = ssz.MarshalJSONBytes(dst, t.Signature)
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the Transfer object following the Beacon API conventions
+func (t *Transfer) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Sender'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["sender"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Sender")
+		}
+		t.Sender = uint64(val)
+	}
+
+	// Field (1) 'Recipient'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["recipient"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Recipient")
+		}
+		t.Recipient = uint64(val)
+	}
+
+	// Field (2) 'Amount'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["amount"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Amount")
+		}
+		t.Amount = uint64(val)
+	}
+
+	// Field (3) 'Fee'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["fee"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Fee")
+		}
+		t.Fee = uint64(val)
+	}
+
+	// Field (4) 'Slot'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["slot"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Slot")
+		}
+		t.Slot = uint64(val)
+	}
+
+	// Field (5) 'Pubkey'
+	if t.Pubkey, err = ssz.UnmarshalJSONBytes(fields["pubkey"], 48, 48); err != nil {
+		return ssz.WrapJSONError(err, "Pubkey")
+	}
+
+	// Field (6) 'Signature'
+	if t.Signature, err = ssz.UnmarshalJSONBytes(fields["signature"], 96, 96); err != nil {
+		return ssz.WrapJSONError(err, "Signature")
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockBody object
+func (b *BeaconBlockBody) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockBody object to a target array
+func (b *BeaconBlockBody) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(444)
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
+	}
+	dst = append(dst, b.RandaoReveal...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	dst = append(dst, b.Graffiti[:]...)
+
+	// Offset (3) 'ProposerSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ProposerSlashings) * 416
+
+	// Offset (4) 'AttesterSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Offset (5) 'Attestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Offset (6) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1240
+
+	// Offset (7) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.VoluntaryExits) * 112
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregate)
+	}
+	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'ProposerSlashings'
+	if size := len(b.ProposerSlashings); size > 16 {
+		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if size := len(b.AttesterSlashings); size > 2 {
+		err = ssz.ErrListTooBigFn("--.AttesterSlashings", size, 2)
+		return
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if size := len(b.Attestations); size > 128 {
+		err = ssz.ErrListTooBigFn("--.Attestations", size, 128)
+		return
+	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if size := len(b.Deposits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.Deposits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if size := len(b.VoluntaryExits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the BeaconBlockBody object to a writer
+func (b *BeaconBlockBody) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, b)
+}
+
+// MarshalSSZToEncoder ssz marshals the BeaconBlockBody object to an encoder
+func (b *BeaconBlockBody) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(444)
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
+	}
+	enc.EncodeBytes(b.RandaoReveal)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = enc.EncodeObject(b.Eth1Data); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	enc.EncodeBytes(b.Graffiti[:])
+
+	// Offset (3) 'ProposerSlashings'
+	enc.EncodeOffset(offset)
+	offset += len(b.ProposerSlashings) * 416
+
+	// Offset (4) 'AttesterSlashings'
+	enc.EncodeOffset(offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Offset (5) 'Attestations'
+	enc.EncodeOffset(offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Offset (6) 'Deposits'
+	enc.EncodeOffset(offset)
+	offset += len(b.Deposits) * 1240
+
+	// Offset (7) 'VoluntaryExits'
+	enc.EncodeOffset(offset)
+	offset += len(b.VoluntaryExits) * 112
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregate)
+	}
+	if err = enc.EncodeObject(b.SyncAggregate); err != nil {
+		return
+	}
+
+	// Field (3) 'ProposerSlashings'
+	if size := len(b.ProposerSlashings); size > 16 {
+		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if err = enc.EncodeObject(b.ProposerSlashings[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if size := len(b.AttesterSlashings); size > 2 {
+		err = ssz.ErrListTooBigFn("--.AttesterSlashings", size, 2)
+		return
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			enc.EncodeOffset(offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if err = enc.EncodeObject(b.AttesterSlashings[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if size := len(b.Attestations); size > 128 {
+		err = ssz.ErrListTooBigFn("--.Attestations", size, 128)
+		return
+	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			enc.EncodeOffset(offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if err = enc.EncodeObject(b.Attestations[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if size := len(b.Deposits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.Deposits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if err = enc.EncodeObject(b.Deposits[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if size := len(b.VoluntaryExits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if err = enc.EncodeObject(b.VoluntaryExits[ii]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockBody object
+func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the BeaconBlockBody object within the limits of the decode state
+func (b *BeaconBlockBody) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockBody", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 444 {
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconBlockBody", 444, size)
+	}
+
+	tail := buf
+	var o3, o4, o5, o6, o7 uint64
+
+	// Field (0) 'RandaoReveal'
+	if cap(b.RandaoReveal) == 0 {
+		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+	}
+	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = ssz.UnmarshalWithState(b.Eth1Data, buf[96:168], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockBody", "Eth1Data", 96)
+	}
+
+	// Field (2) 'Graffiti'
+	copy(b.Graffiti[:], buf[168:200])
+
+	// Offset (3) 'ProposerSlashings'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBody", "ProposerSlashings", 200)
+	}
+
+	if o3 != 444 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 444, o3), "BeaconBlockBody", "ProposerSlashings", 200)
+	}
+
+	// Offset (4) 'AttesterSlashings'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBody", "AttesterSlashings", 204)
+	}
+
+	// Offset (5) 'Attestations'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBody", "Attestations", 208)
+	}
+
+	// Offset (6) 'Deposits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBody", "Deposits", 212)
+	}
+
+	// Offset (7) 'VoluntaryExits'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBody", "VoluntaryExits", 216)
+	}
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregate)
+	}
+	if err = ssz.UnmarshalWithState(b.SyncAggregate, buf[220:444], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockBody", "SyncAggregate", 220)
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		buf = tail[o3:o4]
+		num, err := ssz.DivideInt2(len(buf), 416, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "ProposerSlashings", o3)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(ProposerSlashing{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "ProposerSlashings", o3)
+		}
+		b.ProposerSlashings = make([]*ProposerSlashing, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ProposerSlashings[ii] == nil {
+				b.ProposerSlashings[ii] = new(ProposerSlashing)
+			}
+			if err = ssz.UnmarshalWithState(b.ProposerSlashings[ii], buf[ii*416:(ii+1)*416], st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*416)), "BeaconBlockBody", "ProposerSlashings", o3)
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DecodeDynamicLength(buf, 2)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "AttesterSlashings", o4)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(AttesterSlashing{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "AttesterSlashings", o4)
+		}
+		b.AttesterSlashings = make([]*AttesterSlashing, num)
+		err = st.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.AttesterSlashings[indx] == nil {
+				b.AttesterSlashings[indx] = new(AttesterSlashing)
+			}
+			if err = ssz.UnmarshalWithState(b.AttesterSlashings[indx], buf, st); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "AttesterSlashings", o4)
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		buf = tail[o5:o6]
+		num, err := ssz.DecodeDynamicLength(buf, 128)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "Attestations", o5)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Attestation{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "Attestations", o5)
+		}
+		b.Attestations = make([]*Attestation, num)
+		err = st.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.Attestations[indx] == nil {
+				b.Attestations[indx] = new(Attestation)
+			}
+			if err = ssz.UnmarshalWithState(b.Attestations[indx], buf, st); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "Attestations", o5)
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		buf = tail[o6:o7]
+		num, err := ssz.DivideInt2(len(buf), 1240, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "Deposits", o6)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Deposit{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "Deposits", o6)
+		}
+		b.Deposits = make([]*Deposit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Deposits[ii] == nil {
+				b.Deposits[ii] = new(Deposit)
+			}
+			if err = ssz.UnmarshalWithState(b.Deposits[ii], buf[ii*1240:(ii+1)*1240], st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*1240)), "BeaconBlockBody", "Deposits", o6)
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		buf = tail[o7:]
+		num, err := ssz.DivideInt2(len(buf), 112, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "VoluntaryExits", o7)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(SignedVoluntaryExit{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "VoluntaryExits", o7)
+		}
+		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.VoluntaryExits[ii] == nil {
+				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+			}
+			if err = ssz.UnmarshalWithState(b.VoluntaryExits[ii], buf[ii*112:(ii+1)*112], st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*112)), "BeaconBlockBody", "VoluntaryExits", o7)
+			}
+		}
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a BeaconBlockBody object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (b *BeaconBlockBody) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 444 {
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconBlockBody", 444, size)
+	}
+
+	tail := buf
+	var o3, o4, o5, o6, o7 uint64
+
+	// Field (1) 'Eth1Data'
+	if err = (*Eth1Data)(nil).ValidateSSZ(buf[96:168]); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockBody", "Eth1Data", 96)
+	}
+
+	// Offset (3) 'ProposerSlashings'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBody", "ProposerSlashings", 200)
+	}
+
+	if o3 != 444 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 444, o3), "BeaconBlockBody", "ProposerSlashings", 200)
+	}
+
+	// Offset (4) 'AttesterSlashings'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBody", "AttesterSlashings", 204)
+	}
+
+	// Offset (5) 'Attestations'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBody", "Attestations", 208)
+	}
+
+	// Offset (6) 'Deposits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBody", "Deposits", 212)
+	}
+
+	// Offset (7) 'VoluntaryExits'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBody", "VoluntaryExits", 216)
+	}
+
+	// Field (8) 'SyncAggregate'
+	if err = (*SyncAggregate)(nil).ValidateSSZ(buf[220:444]); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockBody", "SyncAggregate", 220)
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		buf = tail[o3:o4]
+		num, err := ssz.DivideInt2(len(buf), 416, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "ProposerSlashings", o3)
+		}
+
+		for ii := 0; ii < num; ii++ {
+			if err = (*ProposerSlashing)(nil).ValidateSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*416)), "BeaconBlockBody", "ProposerSlashings", o3)
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DecodeDynamicLength(buf, 2)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "AttesterSlashings", o4)
+		}
+
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if err = (*AttesterSlashing)(nil).ValidateSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "AttesterSlashings", o4)
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		buf = tail[o5:o6]
+		num, err := ssz.DecodeDynamicLength(buf, 128)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "Attestations", o5)
+		}
+
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if err = (*Attestation)(nil).ValidateSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "Attestations", o5)
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		buf = tail[o6:o7]
+		num, err := ssz.DivideInt2(len(buf), 1240, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "Deposits", o6)
+		}
+
+		for ii := 0; ii < num; ii++ {
+			if err = (*Deposit)(nil).ValidateSSZ(buf[ii*1240 : (ii+1)*1240]); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*1240)), "BeaconBlockBody", "Deposits", o6)
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		buf = tail[o7:]
+		num, err := ssz.DivideInt2(len(buf), 112, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "VoluntaryExits", o7)
+		}
+
+		for ii := 0; ii < num; ii++ {
+			if err = (*SignedVoluntaryExit)(nil).ValidateSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*112)), "BeaconBlockBody", "VoluntaryExits", o7)
+			}
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the BeaconBlockBody object from a reader with an encoded size of 'size' bytes
+func (b *BeaconBlockBody) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, b)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the BeaconBlockBody object from a decoder
+func (b *BeaconBlockBody) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 444 {
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconBlockBody", 444, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockBody", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(444)
+	if err != nil {
+		return err
+	}
+	var o3, o4, o5, o6, o7 uint64
+
+	// Field (0) 'RandaoReveal'
+	if cap(b.RandaoReveal) == 0 {
+		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+	}
+	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = ssz.UnmarshalWithState(b.Eth1Data, buf[96:168], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockBody", "Eth1Data", 96)
+	}
+
+	// Field (2) 'Graffiti'
+	copy(b.Graffiti[:], buf[168:200])
+
+	// Offset (3) 'ProposerSlashings'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBody", "ProposerSlashings", 200)
+	}
+
+	if o3 != 444 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 444, o3), "BeaconBlockBody", "ProposerSlashings", 200)
+	}
+
+	// Offset (4) 'AttesterSlashings'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBody", "AttesterSlashings", 204)
+	}
+
+	// Offset (5) 'Attestations'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBody", "Attestations", 208)
+	}
+
+	// Offset (6) 'Deposits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBody", "Deposits", 212)
+	}
+
+	// Offset (7) 'VoluntaryExits'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBody", "VoluntaryExits", 216)
+	}
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregate)
+	}
+	if err = ssz.UnmarshalWithState(b.SyncAggregate, buf[220:444], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockBody", "SyncAggregate", 220)
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		num, err := ssz.DivideInt2(int(o4-o3), 416, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "ProposerSlashings", o3)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(ProposerSlashing{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "ProposerSlashings", o3)
+		}
+		b.ProposerSlashings = make([]*ProposerSlashing, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(416); err != nil {
+				return ssz.WrapDecodeError(err, "BeaconBlockBody", "ProposerSlashings", o3)
+			}
+			if b.ProposerSlashings[ii] == nil {
+				b.ProposerSlashings[ii] = new(ProposerSlashing)
+			}
+			if err = ssz.UnmarshalWithState(b.ProposerSlashings[ii], buf, st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*416)), "BeaconBlockBody", "ProposerSlashings", o3)
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		sizes, err := dec.ReadDynamicOffsets(o5-o4, 2)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "AttesterSlashings", o4)
+		}
+		num := len(sizes)
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(AttesterSlashing{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "AttesterSlashings", o4)
+		}
+		b.AttesterSlashings = make([]*AttesterSlashing, num)
+		if err = st.Enter(); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "AttesterSlashings", o4)
+		}
+		pos := uint64(4 * num)
+		for indx := 0; indx < num; indx++ {
+			{
+				if b.AttesterSlashings[indx] == nil {
+					b.AttesterSlashings[indx] = new(AttesterSlashing)
+				}
+				if err = dec.DecodeObject(b.AttesterSlashings[indx], sizes[indx]); err != nil {
+					return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, indx, pos), "BeaconBlockBody", "AttesterSlashings", o4)
+				}
+			}
+			pos += sizes[indx]
+		}
+		st.Leave()
+	}
+
+	// Field (5) 'Attestations'
+	{
+		sizes, err := dec.ReadDynamicOffsets(o6-o5, 128)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "Attestations", o5)
+		}
+		num := len(sizes)
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Attestation{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "Attestations", o5)
+		}
+		b.Attestations = make([]*Attestation, num)
+		if err = st.Enter(); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "Attestations", o5)
+		}
+		pos := uint64(4 * num)
+		for indx := 0; indx < num; indx++ {
+			{
+				if b.Attestations[indx] == nil {
+					b.Attestations[indx] = new(Attestation)
+				}
+				if err = dec.DecodeObject(b.Attestations[indx], sizes[indx]); err != nil {
+					return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, indx, pos), "BeaconBlockBody", "Attestations", o5)
+				}
+			}
+			pos += sizes[indx]
+		}
+		st.Leave()
+	}
+
+	// Field (6) 'Deposits'
+	{
+		num, err := ssz.DivideInt2(int(o7-o6), 1240, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "Deposits", o6)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Deposit{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "Deposits", o6)
+		}
+		b.Deposits = make([]*Deposit, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(1240); err != nil {
+				return ssz.WrapDecodeError(err, "BeaconBlockBody", "Deposits", o6)
+			}
+			if b.Deposits[ii] == nil {
+				b.Deposits[ii] = new(Deposit)
+			}
+			if err = ssz.UnmarshalWithState(b.Deposits[ii], buf, st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*1240)), "BeaconBlockBody", "Deposits", o6)
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		num, err := ssz.DivideInt2(int(size-o7), 112, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "VoluntaryExits", o7)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(SignedVoluntaryExit{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBody", "VoluntaryExits", o7)
+		}
+		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(112); err != nil {
+				return ssz.WrapDecodeError(err, "BeaconBlockBody", "VoluntaryExits", o7)
+			}
+			if b.VoluntaryExits[ii] == nil {
+				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+			}
+			if err = ssz.UnmarshalWithState(b.VoluntaryExits[ii], buf, st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*112)), "BeaconBlockBody", "VoluntaryExits", o7)
+			}
+		}
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBody object
+func (b *BeaconBlockBody) SizeSSZ() (size int) {
+	size = 444
+
+	// Field (3) 'ProposerSlashings'
+	size += len(b.ProposerSlashings) * 416
+
+	// Field (4) 'AttesterSlashings'
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		size += 4
+		size += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Field (5) 'Attestations'
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		size += 4
+		size += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Field (6) 'Deposits'
+	size += len(b.Deposits) * 1240
+
+	// Field (7) 'VoluntaryExits'
+	size += len(b.VoluntaryExits) * 112
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockBody object
+func (b *BeaconBlockBody) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockBody object with a hasher
+func (b *BeaconBlockBody) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
+	}
+	hh.PutBytes(b.RandaoReveal)
+
+	// Field (1) 'Eth1Data'
+	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	hh.PutBytes(b.Graffiti[:])
+
+	// Field (3) 'ProposerSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ProposerSlashings))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = hh.HashElements(len(b.ProposerSlashings), func(indx int, hh *ssz.Hasher) error {
+			return b.ProposerSlashings[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.AttesterSlashings))
+		if num > 2 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = hh.HashElements(len(b.AttesterSlashings), func(indx int, hh *ssz.Hasher) error {
+			return b.AttesterSlashings[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 2)
+	}
+
+	// Field (5) 'Attestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Attestations))
+		if num > 128 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = hh.HashElements(len(b.Attestations), func(indx int, hh *ssz.Hasher) error {
+			return b.Attestations[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 128)
+	}
+
+	// Field (6) 'Deposits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Deposits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = hh.HashElements(len(b.Deposits), func(indx int, hh *ssz.Hasher) error {
+			return b.Deposits[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.VoluntaryExits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = hh.HashElements(len(b.VoluntaryExits), func(indx int, hh *ssz.Hasher) error {
+			return b.VoluntaryExits[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (8) 'SyncAggregate'
+	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootParallel ssz hashes the BeaconBlockBody object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (b *BeaconBlockBody) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return b.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the BeaconBlockBody object like HashTreeRootParallel
+// with the Hashers of the pool
+func (b *BeaconBlockBody) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'RandaoReveal'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(b.RandaoReveal); size != 96 {
+				err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+				return
+			}
+			hh.PutBytes(b.RandaoReveal)
+			return
+		},
+		// Field (1) 'Eth1Data'
+		func(hh *ssz.Hasher) (err error) {
+			if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (2) 'Graffiti'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutBytes(b.Graffiti[:])
+			return
+		},
+		// Field (3) 'ProposerSlashings'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				subIndx := hh.Index()
+				num := uint64(len(b.ProposerSlashings))
+				if num > 16 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				if err = hh.HashElements(len(b.ProposerSlashings), func(indx int, hh *ssz.Hasher) error {
+					return b.ProposerSlashings[indx].HashTreeRootWith(hh)
+				}); err != nil {
+					return
+				}
+				hh.MerkleizeWithMixin(subIndx, num, 16)
+			}
+			return
+		},
+		// Field (4) 'AttesterSlashings'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				subIndx := hh.Index()
+				num := uint64(len(b.AttesterSlashings))
+				if num > 2 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				if err = hh.HashElements(len(b.AttesterSlashings), func(indx int, hh *ssz.Hasher) error {
+					return b.AttesterSlashings[indx].HashTreeRootWith(hh)
+				}); err != nil {
+					return
+				}
+				hh.MerkleizeWithMixin(subIndx, num, 2)
+			}
+			return
+		},
+		// Field (5) 'Attestations'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				subIndx := hh.Index()
+				num := uint64(len(b.Attestations))
+				if num > 128 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				if err = hh.HashElements(len(b.Attestations), func(indx int, hh *ssz.Hasher) error {
+					return b.Attestations[indx].HashTreeRootWith(hh)
+				}); err != nil {
+					return
+				}
+				hh.MerkleizeWithMixin(subIndx, num, 128)
+			}
+			return
+		},
+		// Field (6) 'Deposits'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				subIndx := hh.Index()
+				num := uint64(len(b.Deposits))
+				if num > 16 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				if err = hh.HashElements(len(b.Deposits), func(indx int, hh *ssz.Hasher) error {
+					return b.Deposits[indx].HashTreeRootWith(hh)
+				}); err != nil {
+					return
+				}
+				hh.MerkleizeWithMixin(subIndx, num, 16)
+			}
+			return
+		},
+		// Field (7) 'VoluntaryExits'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				subIndx := hh.Index()
+				num := uint64(len(b.VoluntaryExits))
+				if num > 16 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				if err = hh.HashElements(len(b.VoluntaryExits), func(indx int, hh *ssz.Hasher) error {
+					return b.VoluntaryExits[indx].HashTreeRootWith(hh)
+				}); err != nil {
+					return
+				}
+				hh.MerkleizeWithMixin(subIndx, num, 16)
+			}
+			return
+		},
+		// Field (8) 'SyncAggregate'
+		func(hh *ssz.Hasher) (err error) {
+			if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+	})
+}
+
+// BeaconBlockBodyView is a lazy view over the SSZ encoding of a BeaconBlockBody object
+type BeaconBlockBodyView struct {
+	v ssz.View
+}
+
+// NewBeaconBlockBodyView creates a view over the SSZ encoding of a BeaconBlockBody object
+func NewBeaconBlockBodyView(buf []byte) BeaconBlockBodyView {
+	return BeaconBlockBodyViewOf(ssz.NewView(buf))
+}
+
+// BeaconBlockBodyViewOf returns the view of the BeaconBlockBody object encoded in v
+func BeaconBlockBodyViewOf(v ssz.View) BeaconBlockBodyView {
+	return BeaconBlockBodyView{v.Container(444, 200)}
+}
+
+// Raw returns the untyped view of the BeaconBlockBody object
+func (v BeaconBlockBodyView) Raw() ssz.View {
+	return v.v
+}
+
+// BeaconBlockBodyListView is a lazy view over a list of BeaconBlockBody objects
+type BeaconBlockBodyListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th BeaconBlockBody object of the list
+func (l BeaconBlockBodyListView) At(i int) BeaconBlockBodyView {
+	return BeaconBlockBodyViewOf(l.ListView.At(i))
+}
+
+// RandaoReveal returns the RandaoReveal field of the BeaconBlockBody object
+func (v BeaconBlockBodyView) RandaoReveal() ([]byte, error) {
+	return v.v.Field(0, 96).Bytes()
+}
+
+// Eth1Data returns the Eth1Data field of the BeaconBlockBody object
+func (v BeaconBlockBodyView) Eth1Data() Eth1DataView {
+	return Eth1DataViewOf(v.v.Field(96, 168))
+}
+
+// Graffiti returns the Graffiti field of the BeaconBlockBody object
+func (v BeaconBlockBodyView) Graffiti() ([]byte, error) {
+	return v.v.Field(168, 200).Bytes()
+}
+
+// ProposerSlashings returns the ProposerSlashings field of the BeaconBlockBody object
+func (v BeaconBlockBodyView) ProposerSlashings() ProposerSlashingListView {
+	return ProposerSlashingListView{v.v.DynamicField(200, 204).List(416, 16)}
+}
+
+// AttesterSlashings returns the AttesterSlashings field of the BeaconBlockBody object
+func (v BeaconBlockBodyView) AttesterSlashings() AttesterSlashingListView {
+	return AttesterSlashingListView{v.v.DynamicField(204, 208).List(0, 2)}
+}
+
+// Attestations returns the Attestations field of the BeaconBlockBody object
+func (v BeaconBlockBodyView) Attestations() AttestationListView {
+	return AttestationListView{v.v.DynamicField(208, 212).List(0, 128)}
+}
+
+// Deposits returns the Deposits field of the BeaconBlockBody object
+func (v BeaconBlockBodyView) Deposits() DepositListView {
+	return DepositListView{v.v.DynamicField(212, 216).List(1240, 16)}
+}
+
+// VoluntaryExits returns the VoluntaryExits field of the BeaconBlockBody object
+func (v BeaconBlockBodyView) VoluntaryExits() SignedVoluntaryExitListView {
+	return SignedVoluntaryExitListView{v.v.DynamicField(216, 0).List(112, 16)}
+}
+
+// SyncAggregate returns the SyncAggregate field of the BeaconBlockBody object
+func (v BeaconBlockBodyView) SyncAggregate() SyncAggregateView {
+	return SyncAggregateViewOf(v.v.Field(220, 444))
+}
+
+// Copy returns a deep copy of the BeaconBlockBody object
+func (b *BeaconBlockBody) Copy() *BeaconBlockBody {
+	if b == nil {
+		return nil
+	}
+	cpy := new(BeaconBlockBody)
+	*cpy = *b
+
+	// Field 'RandaoReveal'
+	cpy.RandaoReveal = append(cpy.RandaoReveal[:0:0], cpy.RandaoReveal...)
+
+	// Field 'Eth1Data'
+	cpy.Eth1Data = cpy.Eth1Data.Copy()
+
+	// Field 'ProposerSlashings'
+	cpy.ProposerSlashings = append(cpy.ProposerSlashings[:0:0], cpy.ProposerSlashings...)
+	for i0 := range cpy.ProposerSlashings {
+		cpy.ProposerSlashings[i0] = cpy.ProposerSlashings[i0].Copy()
+	}
+
+	// Field 'AttesterSlashings'
+	cpy.AttesterSlashings = append(cpy.AttesterSlashings[:0:0], cpy.AttesterSlashings...)
+	for i0 := range cpy.AttesterSlashings {
+		cpy.AttesterSlashings[i0] = cpy.AttesterSlashings[i0].Copy()
+	}
+
+	// Field 'Attestations'
+	cpy.Attestations = append(cpy.Attestations[:0:0], cpy.Attestations...)
+	for i0 := range cpy.Attestations {
+		cpy.Attestations[i0] = cpy.Attestations[i0].Copy()
+	}
+
+	// Field 'Deposits'
+	cpy.Deposits = append(cpy.Deposits[:0:0], cpy.Deposits...)
+	for i0 := range cpy.Deposits {
+		cpy.Deposits[i0] = cpy.Deposits[i0].Copy()
+	}
+
+	// Field 'VoluntaryExits'
+	cpy.VoluntaryExits = append(cpy.VoluntaryExits[:0:0], cpy.VoluntaryExits...)
+	for i0 := range cpy.VoluntaryExits {
+		cpy.VoluntaryExits[i0] = cpy.VoluntaryExits[i0].Copy()
+	}
+
+	// Field 'SyncAggregate'
+	cpy.SyncAggregate = cpy.SyncAggregate.Copy()
+
+	return cpy
+}
+
+// EqualSSZ returns true if the BeaconBlockBody objects have the same SSZ encoding
+func (b *BeaconBlockBody) EqualSSZ(other *BeaconBlockBody) bool {
+	if b == nil {
+		b = new(BeaconBlockBody)
+	}
+	if other == nil {
+		other = new(BeaconBlockBody)
+	}
+
+	// Field 'RandaoReveal'
+	if !bytes.Equal(b.RandaoReveal, other.RandaoReveal) {
+		return false
+	}
+
+	// Field 'Eth1Data'
+	if !b.Eth1Data.EqualSSZ(other.Eth1Data) {
+		return false
+	}
+
+	// Field 'Graffiti'
+	if b.Graffiti != other.Graffiti {
+		return false
+	}
+
+	// Field 'ProposerSlashings'
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		return false
+	} else {
+		for i0 := range b.ProposerSlashings {
+			if !b.ProposerSlashings[i0].EqualSSZ(other.ProposerSlashings[i0]) {
+				return false
+			}
+		}
+	}
+
+	// Field 'AttesterSlashings'
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		return false
+	} else {
+		for i0 := range b.AttesterSlashings {
+			if !b.AttesterSlashings[i0].EqualSSZ(other.AttesterSlashings[i0]) {
+				return false
+			}
+		}
+	}
+
+	// Field 'Attestations'
+	if len(b.Attestations) != len(other.Attestations) {
+		return false
+	} else {
+		for i0 := range b.Attestations {
+			if !b.Attestations[i0].EqualSSZ(other.Attestations[i0]) {
+				return false
+			}
+		}
+	}
+
+	// Field 'Deposits'
+	if len(b.Deposits) != len(other.Deposits) {
+		return false
+	} else {
+		for i0 := range b.Deposits {
+			if !b.Deposits[i0].EqualSSZ(other.Deposits[i0]) {
+				return false
+			}
+		}
+	}
+
+	// Field 'VoluntaryExits'
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		return false
+	} else {
+		for i0 := range b.VoluntaryExits {
+			if !b.VoluntaryExits[i0].EqualSSZ(other.VoluntaryExits[i0]) {
+				return false
+			}
+		}
+	}
+
+	// Field 'SyncAggregate'
+	if !b.SyncAggregate.EqualSSZ(other.SyncAggregate) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the BeaconBlockBody objects
+func (b *BeaconBlockBody) DiffSSZ(other *BeaconBlockBody) []ssz.FieldDiff {
+	if b == nil {
+		b = new(BeaconBlockBody)
+	}
+	if other == nil {
+		other = new(BeaconBlockBody)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'RandaoReveal'
+	if !bytes.Equal(b.RandaoReveal, other.RandaoReveal) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "RandaoReveal", A: b.RandaoReveal, B: other.RandaoReveal})
+	}
+
+	// Field 'Eth1Data'
+	diffs = ssz.AppendDiffs(diffs, "Eth1Data", b.Eth1Data.DiffSSZ(other.Eth1Data))
+
+	// Field 'Graffiti'
+	if b.Graffiti != other.Graffiti {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Graffiti", A: b.Graffiti, B: other.Graffiti})
+	}
+
+	// Field 'ProposerSlashings'
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "ProposerSlashings", A: b.ProposerSlashings, B: other.ProposerSlashings})
+	} else {
+		for i0 := range b.ProposerSlashings {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("ProposerSlashings", i0), b.ProposerSlashings[i0].DiffSSZ(other.ProposerSlashings[i0]))
+		}
+	}
+
+	// Field 'AttesterSlashings'
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "AttesterSlashings", A: b.AttesterSlashings, B: other.AttesterSlashings})
+	} else {
+		for i0 := range b.AttesterSlashings {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("AttesterSlashings", i0), b.AttesterSlashings[i0].DiffSSZ(other.AttesterSlashings[i0]))
+		}
+	}
+
+	// Field 'Attestations'
+	if len(b.Attestations) != len(other.Attestations) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Attestations", A: b.Attestations, B: other.Attestations})
+	} else {
+		for i0 := range b.Attestations {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("Attestations", i0), b.Attestations[i0].DiffSSZ(other.Attestations[i0]))
+		}
+	}
+
+	// Field 'Deposits'
+	if len(b.Deposits) != len(other.Deposits) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Deposits", A: b.Deposits, B: other.Deposits})
+	} else {
+		for i0 := range b.Deposits {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("Deposits", i0), b.Deposits[i0].DiffSSZ(other.Deposits[i0]))
+		}
+	}
+
+	// Field 'VoluntaryExits'
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "VoluntaryExits", A: b.VoluntaryExits, B: other.VoluntaryExits})
+	} else {
+		for i0 := range b.VoluntaryExits {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("VoluntaryExits", i0), b.VoluntaryExits[i0].DiffSSZ(other.VoluntaryExits[i0]))
+		}
+	}
+
+	// Field 'SyncAggregate'
+	diffs = ssz.AppendDiffs(diffs, "SyncAggregate", b.SyncAggregate.DiffSSZ(other.SyncAggregate))
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the BeaconBlockBody object following the Beacon API conventions
+func (b *BeaconBlockBody) MarshalJSON() ([]byte, error) {
+	if b == nil {
+		b = new(BeaconBlockBody)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'RandaoReveal'
+	dst = append(dst, "\"randao_reveal\":"...)
+	dst = ssz.MarshalJSONBytes(dst, b.RandaoReveal)
+
+	// Field (1) 'Eth1Data'
+	dst = append(dst, ",\"eth1_data\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, b.Eth1Data); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Graffiti'
+	dst = append(dst, ",\"graffiti\":"...)
+	dst = ssz.MarshalJSONBytes(dst, b.Graffiti[:])
+
+	// Field (3) 'ProposerSlashings'
+	dst = append(dst, ",\"proposer_slashings\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.ProposerSlashings {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		if dst, err = ssz.MarshalJSONObject(dst, b.ProposerSlashings[i0]); err != nil {
+			return nil, err
+		}
+	}
+	dst = append(dst, ']')
+
+	// Field (4) 'AttesterSlashings'
+	dst = append(dst, ",\"attester_slashings\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.AttesterSlashings {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		if dst, err = ssz.MarshalJSONObject(dst, b.AttesterSlashings[i0]); err != nil {
+			return nil, err
+		}
+	}
+	dst = append(dst, ']')
+
+	// Field (5) 'Attestations'
+	dst = append(dst, ",\"attestations\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.Attestations {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		if dst, err = ssz.MarshalJSONObject(dst, b.Attestations[i0]); err != nil {
+			return nil, err
+		}
+	}
+	dst = append(dst, ']')
+
+	// Field (6) 'Deposits'
+	dst = append(dst, ",\"deposits\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.Deposits {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		if dst, err = ssz.MarshalJSONObject(dst, b.Deposits[i0]); err != nil {
+			return nil, err
+		}
+	}
+	dst = append(dst, ']')
+
+	// Field (7) 'VoluntaryExits'
+	dst = append(dst, ",\"voluntary_exits\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.VoluntaryExits {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		if dst, err = ssz.MarshalJSONObject(dst, b.VoluntaryExits[i0]); err != nil {
+			return nil, err
+		}
+	}
+	dst = append(dst, ']')
+
+	// Field (8) 'SyncAggregate'
+	dst = append(dst, ",\"sync_aggregate\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, b.SyncAggregate); err != nil {
+		return nil, err
+	}
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the BeaconBlockBody object following the Beacon API conventions
+func (b *BeaconBlockBody) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'RandaoReveal'
+	if b.RandaoReveal, err = ssz.UnmarshalJSONBytes(fields["randao_reveal"], 96, 96); err != nil {
+		return ssz.WrapJSONError(err, "RandaoReveal")
+	}
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["eth1_data"], b.Eth1Data); err != nil {
+		return ssz.WrapJSONError(err, "Eth1Data")
+	}
+
+	// Field (2) 'Graffiti'
+	{
+		val, err := ssz.UnmarshalJSONBytes(fields["graffiti"], 32, 32)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Graffiti")
+		}
+		copy(b.Graffiti[:], val)
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["proposer_slashings"], 0, 16)
+		if err != nil {
+			return ssz.WrapJSONError(err, "ProposerSlashings")
+		}
+		b.ProposerSlashings = make([]*ProposerSlashing, len(items0))
+		for i0 := range items0 {
+			if b.ProposerSlashings[i0] == nil {
+				b.ProposerSlashings[i0] = new(ProposerSlashing)
+			}
+			if err = ssz.UnmarshalJSONObject(items0[i0], b.ProposerSlashings[i0]); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("ProposerSlashings", i0))
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["attester_slashings"], 0, 2)
+		if err != nil {
+			return ssz.WrapJSONError(err, "AttesterSlashings")
+		}
+		b.AttesterSlashings = make([]*AttesterSlashing, len(items0))
+		for i0 := range items0 {
+			if b.AttesterSlashings[i0] == nil {
+				b.AttesterSlashings[i0] = new(AttesterSlashing)
+			}
+			if err = ssz.UnmarshalJSONObject(items0[i0], b.AttesterSlashings[i0]); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("AttesterSlashings", i0))
+			}
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["attestations"], 0, 128)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Attestations")
+		}
+		b.Attestations = make([]*Attestation, len(items0))
+		for i0 := range items0 {
+			if b.Attestations[i0] == nil {
+				b.Attestations[i0] = new(Attestation)
+			}
+			if err = ssz.UnmarshalJSONObject(items0[i0], b.Attestations[i0]); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("Attestations", i0))
+			}
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["deposits"], 0, 16)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Deposits")
+		}
+		b.Deposits = make([]*Deposit, len(items0))
+		for i0 := range items0 {
+			if b.Deposits[i0] == nil {
+				b.Deposits[i0] = new(Deposit)
+			}
+			if err = ssz.UnmarshalJSONObject(items0[i0], b.Deposits[i0]); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("Deposits", i0))
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["voluntary_exits"], 0, 16)
+		if err != nil {
+			return ssz.WrapJSONError(err, "VoluntaryExits")
+		}
+		b.VoluntaryExits = make([]*SignedVoluntaryExit, len(items0))
+		for i0 := range items0 {
+			if b.VoluntaryExits[i0] == nil {
+				b.VoluntaryExits[i0] = new(SignedVoluntaryExit)
+			}
+			if err = ssz.UnmarshalJSONObject(items0[i0], b.VoluntaryExits[i0]); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("VoluntaryExits", i0))
+			}
+		}
+	}
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregate)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["sync_aggregate"], b.SyncAggregate); err != nil {
+		return ssz.WrapJSONError(err, "SyncAggregate")
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBeaconBlockHeader object to a target array
+func (s *SignedBeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		s.Header = new(BeaconBlockHeader)
+	}
+	if dst, err = s.Header.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the SignedBeaconBlockHeader object to a writer
+func (s *SignedBeaconBlockHeader) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, s)
+}
+
+// MarshalSSZToEncoder ssz marshals the SignedBeaconBlockHeader object to an encoder
+func (s *SignedBeaconBlockHeader) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		s.Header = new(BeaconBlockHeader)
+	}
+	if err = enc.EncodeObject(s.Header); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	enc.EncodeBytes(s.Signature)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the SignedBeaconBlockHeader object within the limits of the decode state
+func (s *SignedBeaconBlockHeader) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "SignedBeaconBlockHeader", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 208 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SignedBeaconBlockHeader", 208, size)
+	}
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		s.Header = new(BeaconBlockHeader)
+	}
+	if err = ssz.UnmarshalWithState(s.Header, buf[0:112], st); err != nil {
+		return ssz.WrapDecodeError(err, "SignedBeaconBlockHeader", "Header", 0)
+	}
+
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[112:208]))
+	}
+	s.Signature = append(s.Signature, buf[112:208]...)
+
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a SignedBeaconBlockHeader object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (s *SignedBeaconBlockHeader) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 208 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SignedBeaconBlockHeader", 208, size)
+	}
+
+	// Field (0) 'Header'
+	if err = (*BeaconBlockHeader)(nil).ValidateSSZ(buf[0:112]); err != nil {
+		return ssz.WrapDecodeError(err, "SignedBeaconBlockHeader", "Header", 0)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the SignedBeaconBlockHeader object from a reader with an encoded size of 'size' bytes
+func (s *SignedBeaconBlockHeader) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, s)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the SignedBeaconBlockHeader object from a decoder
+func (s *SignedBeaconBlockHeader) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 208 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SignedBeaconBlockHeader", 208, dec.Size())
+	}
+	buf, err := dec.Read(208)
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalSSZWithState(buf, dec.State())
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) SizeSSZ() (size int) {
+	size = 208
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBeaconBlockHeader object with a hasher
+func (s *SignedBeaconBlockHeader) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Header'
+	if err = s.Header.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootParallel ssz hashes the SignedBeaconBlockHeader object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (s *SignedBeaconBlockHeader) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return s.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the SignedBeaconBlockHeader object like HashTreeRootParallel
+// with the Hashers of the pool
+func (s *SignedBeaconBlockHeader) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Header'
+		func(hh *ssz.Hasher) (err error) {
+			if err = s.Header.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (1) 'Signature'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(s.Signature); size != 96 {
+				err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+				return
+			}
+			hh.PutBytes(s.Signature)
+			return
+		},
+	})
+}
+
+// SignedBeaconBlockHeaderView is a lazy view over the SSZ encoding of a SignedBeaconBlockHeader object
+type SignedBeaconBlockHeaderView struct {
+	v ssz.View
+}
+
+// NewSignedBeaconBlockHeaderView creates a view over the SSZ encoding of a SignedBeaconBlockHeader object
+func NewSignedBeaconBlockHeaderView(buf []byte) SignedBeaconBlockHeaderView {
+	return SignedBeaconBlockHeaderViewOf(ssz.NewView(buf))
+}
+
+// SignedBeaconBlockHeaderViewOf returns the view of the SignedBeaconBlockHeader object encoded in v
+func SignedBeaconBlockHeaderViewOf(v ssz.View) SignedBeaconBlockHeaderView {
+	return SignedBeaconBlockHeaderView{v.Container(208, -1)}
+}
+
+// Raw returns the untyped view of the SignedBeaconBlockHeader object
+func (v SignedBeaconBlockHeaderView) Raw() ssz.View {
+	return v.v
+}
+
+// SignedBeaconBlockHeaderListView is a lazy view over a list of SignedBeaconBlockHeader objects
+type SignedBeaconBlockHeaderListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th SignedBeaconBlockHeader object of the list
+func (l SignedBeaconBlockHeaderListView) At(i int) SignedBeaconBlockHeaderView {
+	return SignedBeaconBlockHeaderViewOf(l.ListView.At(i))
+}
+
+// Header returns the Header field of the SignedBeaconBlockHeader object
+func (v SignedBeaconBlockHeaderView) Header() BeaconBlockHeaderView {
+	return BeaconBlockHeaderViewOf(v.v.Field(0, 112))
+}
+
+// Signature returns the Signature field of the SignedBeaconBlockHeader object
+func (v SignedBeaconBlockHeaderView) Signature() ([]byte, error) {
+	return v.v.Field(112, 208).Bytes()
+}
+
+// Copy returns a deep copy of the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) Copy() *SignedBeaconBlockHeader {
+	if s == nil {
+		return nil
+	}
+	cpy := new(SignedBeaconBlockHeader)
+	*cpy = *s
+
+	// Field 'Header'
+	cpy.Header = cpy.Header.Copy()
+
+	// Field 'Signature'
+	cpy.Signature = append(cpy.Signature[:0:0], cpy.Signature...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the SignedBeaconBlockHeader objects have the same SSZ encoding
+func (s *SignedBeaconBlockHeader) EqualSSZ(other *SignedBeaconBlockHeader) bool {
+	if s == nil {
+		s = new(SignedBeaconBlockHeader)
+	}
+	if other == nil {
+		other = new(SignedBeaconBlockHeader)
+	}
+
+	// Field 'Header'
+	if !s.Header.EqualSSZ(other.Header) {
+		return false
+	}
+
+	// Field 'Signature'
+	if !bytes.Equal(s.Signature, other.Signature) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the SignedBeaconBlockHeader objects
+func (s *SignedBeaconBlockHeader) DiffSSZ(other *SignedBeaconBlockHeader) []ssz.FieldDiff {
+	if s == nil {
+		s = new(SignedBeaconBlockHeader)
+	}
+	if other == nil {
+		other = new(SignedBeaconBlockHeader)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Header'
+	diffs = ssz.AppendDiffs(diffs, "Header", s.Header.DiffSSZ(other.Header))
+
+	// Field 'Signature'
+	if !bytes.Equal(s.Signature, other.Signature) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Signature", A: s.Signature, B: other.Signature})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the SignedBeaconBlockHeader object following the Beacon API conventions
+func (s *SignedBeaconBlockHeader) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		s = new(SignedBeaconBlockHeader)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'Header'
+	dst = append(dst, "\"message\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, s.Header); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Signature'
+	dst = append(dst, ",\"signature\":"...)
+	dst = ssz.MarshalJSONBytes(dst, s.Signature)
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the SignedBeaconBlockHeader object following the Beacon API conventions
+func (s *SignedBeaconBlockHeader) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		s.Header = new(BeaconBlockHeader)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["message"], s.Header); err != nil {
+		return ssz.WrapJSONError(err, "Header")
+	}
+
+	// Field (1) 'Signature'
+	if s.Signature, err = ssz.UnmarshalJSONBytes(fields["signature"], 96, 96); err != nil {
+		return ssz.WrapJSONError(err, "Signature")
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockHeader object
+func (b *BeaconBlockHeader) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockHeader object to a target array
+func (b *BeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.ParentRoot...)
+
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.StateRoot...)
+
+	// Field (4) 'BodyRoot'
+	if size := len(b.BodyRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.BodyRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.BodyRoot...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the BeaconBlockHeader object to a writer
+func (b *BeaconBlockHeader) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, b)
+}
+
+// MarshalSSZToEncoder ssz marshals the BeaconBlockHeader object to an encoder
+func (b *BeaconBlockHeader) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	// Field (0) 'Slot'
+	enc.EncodeUint64(b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	enc.EncodeUint64(b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+		return
+	}
+	enc.EncodeBytes(b.ParentRoot)
+
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
+		return
+	}
+	enc.EncodeBytes(b.StateRoot)
+
+	// Field (4) 'BodyRoot'
+	if size := len(b.BodyRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.BodyRoot", size, 32)
+		return
+	}
+	enc.EncodeBytes(b.BodyRoot)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockHeader object
+func (b *BeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the BeaconBlockHeader object within the limits of the decode state
+func (b *BeaconBlockHeader) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockHeader", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 112 {
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconBlockHeader", 112, size)
+	}
+
+	// Field (0) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ProposerIndex'
+	b.ProposerIndex = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'ParentRoot'
+	if cap(b.ParentRoot) == 0 {
+		b.ParentRoot = make([]byte, 0, len(buf[16:48]))
+	}
+	b.ParentRoot = append(b.ParentRoot, buf[16:48]...)
+
+	// Field (3) 'StateRoot'
+	if cap(b.StateRoot) == 0 {
+		b.StateRoot = make([]byte, 0, len(buf[48:80]))
+	}
+	b.StateRoot = append(b.StateRoot, buf[48:80]...)
+
+	// Field (4) 'BodyRoot'
+	if cap(b.BodyRoot) == 0 {
+		b.BodyRoot = make([]byte, 0, len(buf[80:112]))
+	}
+	b.BodyRoot = append(b.BodyRoot, buf[80:112]...)
+
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a BeaconBlockHeader object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (b *BeaconBlockHeader) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 112 {
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconBlockHeader", 112, size)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the BeaconBlockHeader object from a reader with an encoded size of 'size' bytes
+func (b *BeaconBlockHeader) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, b)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the BeaconBlockHeader object from a decoder
+func (b *BeaconBlockHeader) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 112 {
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconBlockHeader", 112, dec.Size())
+	}
+	buf, err := dec.Read(112)
+	if err != nil {
+		return err
+	}
+	return b.UnmarshalSSZWithState(buf, dec.State())
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockHeader object
+func (b *BeaconBlockHeader) SizeSSZ() (size int) {
+	size = 112
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockHeader object
+func (b *BeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockHeader object with a hasher
+func (b *BeaconBlockHeader) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	hh.PutUint64(b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.ParentRoot)
+
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.StateRoot)
+
+	// Field (4) 'BodyRoot'
+	if size := len(b.BodyRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.BodyRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.BodyRoot)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootParallel ssz hashes the BeaconBlockHeader object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (b *BeaconBlockHeader) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return b.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the BeaconBlockHeader object like HashTreeRootParallel
+// with the Hashers of the pool
+func (b *BeaconBlockHeader) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Slot'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(b.Slot)
+			return
+		},
+		// Field (1) 'ProposerIndex'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(b.ProposerIndex)
+			return
+		},
+		// Field (2) 'ParentRoot'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(b.ParentRoot); size != 32 {
+				err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+				return
+			}
+			hh.PutBytes(b.ParentRoot)
+			return
+		},
+		// Field (3) 'StateRoot'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(b.StateRoot); size != 32 {
+				err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
+				return
+			}
+			hh.PutBytes(b.StateRoot)
+			return
+		},
+		// Field (4) 'BodyRoot'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(b.BodyRoot); size != 32 {
+				err = ssz.ErrBytesLengthFn("--.BodyRoot", size, 32)
+				return
+			}
+			hh.PutBytes(b.BodyRoot)
+			return
+		},
+	})
+}
+
+// BeaconBlockHeaderView is a lazy view over the SSZ encoding of a BeaconBlockHeader object
+type BeaconBlockHeaderView struct {
+	v ssz.View
+}
+
+// NewBeaconBlockHeaderView creates a view over the SSZ encoding of a BeaconBlockHeader object
+func NewBeaconBlockHeaderView(buf []byte) BeaconBlockHeaderView {
+	return BeaconBlockHeaderViewOf(ssz.NewView(buf))
+}
+
+// BeaconBlockHeaderViewOf returns the view of the BeaconBlockHeader object encoded in v
+func BeaconBlockHeaderViewOf(v ssz.View) BeaconBlockHeaderView {
+	return BeaconBlockHeaderView{v.Container(112, -1)}
+}
+
+// Raw returns the untyped view of the BeaconBlockHeader object
+func (v BeaconBlockHeaderView) Raw() ssz.View {
+	return v.v
+}
+
+// BeaconBlockHeaderListView is a lazy view over a list of BeaconBlockHeader objects
+type BeaconBlockHeaderListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th BeaconBlockHeader object of the list
+func (l BeaconBlockHeaderListView) At(i int) BeaconBlockHeaderView {
+	return BeaconBlockHeaderViewOf(l.ListView.At(i))
+}
+
+// Slot returns the Slot field of the BeaconBlockHeader object
+func (v BeaconBlockHeaderView) Slot() (uint64, error) {
+	return v.v.Field(0, 8).Uint64()
+}
+
+// ProposerIndex returns the ProposerIndex field of the BeaconBlockHeader object
+func (v BeaconBlockHeaderView) ProposerIndex() (uint64, error) {
+	return v.v.Field(8, 16).Uint64()
+}
+
+// ParentRoot returns the ParentRoot field of the BeaconBlockHeader object
+func (v BeaconBlockHeaderView) ParentRoot() ([]byte, error) {
+	return v.v.Field(16, 48).Bytes()
+}
+
+// StateRoot returns the StateRoot field of the BeaconBlockHeader object
+func (v BeaconBlockHeaderView) StateRoot() ([]byte, error) {
+	return v.v.Field(48, 80).Bytes()
+}
+
+// BodyRoot returns the BodyRoot field of the BeaconBlockHeader object
+func (v BeaconBlockHeaderView) BodyRoot() ([]byte, error) {
+	return v.v.Field(80, 112).Bytes()
+}
+
+// Copy returns a deep copy of the BeaconBlockHeader object
+func (b *BeaconBlockHeader) Copy() *BeaconBlockHeader {
+	if b == nil {
+		return nil
+	}
+	cpy := new(BeaconBlockHeader)
+	*cpy = *b
+
+	// Field 'ParentRoot'
+	cpy.ParentRoot = append(cpy.ParentRoot[:0:0], cpy.ParentRoot...)
+
+	// Field 'StateRoot'
+	cpy.StateRoot = append(cpy.StateRoot[:0:0], cpy.StateRoot...)
+
+	// Field 'BodyRoot'
+	cpy.BodyRoot = append(cpy.BodyRoot[:0:0], cpy.BodyRoot...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the BeaconBlockHeader objects have the same SSZ encoding
+func (b *BeaconBlockHeader) EqualSSZ(other *BeaconBlockHeader) bool {
+	if b == nil {
+		b = new(BeaconBlockHeader)
+	}
+	if other == nil {
+		other = new(BeaconBlockHeader)
+	}
+
+	// Field 'Slot'
+	if b.Slot != other.Slot {
+		return false
+	}
+
+	// Field 'ProposerIndex'
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+
+	// Field 'ParentRoot'
+	if !bytes.Equal(b.ParentRoot, other.ParentRoot) {
+		return false
+	}
+
+	// Field 'StateRoot'
+	if !bytes.Equal(b.StateRoot, other.StateRoot) {
+		return false
+	}
+
+	// Field 'BodyRoot'
+	if !bytes.Equal(b.BodyRoot, other.BodyRoot) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the BeaconBlockHeader objects
+func (b *BeaconBlockHeader) DiffSSZ(other *BeaconBlockHeader) []ssz.FieldDiff {
+	if b == nil {
+		b = new(BeaconBlockHeader)
+	}
+	if other == nil {
+		other = new(BeaconBlockHeader)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Slot'
+	if b.Slot != other.Slot {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Slot", A: b.Slot, B: other.Slot})
+	}
+
+	// Field 'ProposerIndex'
+	if b.ProposerIndex != other.ProposerIndex {
+		diffs = append(diffs, ssz.FieldDiff{Path: "ProposerIndex", A: b.ProposerIndex, B: other.ProposerIndex})
+	}
+
+	// Field 'ParentRoot'
+	if !bytes.Equal(b.ParentRoot, other.ParentRoot) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "ParentRoot", A: b.ParentRoot, B: other.ParentRoot})
+	}
+
+	// Field 'StateRoot'
+	if !bytes.Equal(b.StateRoot, other.StateRoot) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "StateRoot", A: b.StateRoot, B: other.StateRoot})
+	}
+
+	// Field 'BodyRoot'
+	if !bytes.Equal(b.BodyRoot, other.BodyRoot) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "BodyRoot", A: b.BodyRoot, B: other.BodyRoot})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the BeaconBlockHeader object following the Beacon API conventions
+func (b *BeaconBlockHeader) MarshalJSON() ([]byte, error) {
+	if b == nil {
+		b = new(BeaconBlockHeader)
+	}
+	dst := []byte{'{'}
+
+	// Field (0) 'Slot'
+	dst = append(dst, "\"slot\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(b.Slot))
+
+	// Field (1) 'ProposerIndex'
+	dst = append(dst, ",\"proposer_index\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(b.ProposerIndex))
+
+	// Field (2) 'ParentRoot'
+	dst = append(dst, ",\"parent_root\":"...)
+	dst = ssz.MarshalJSONBytes(dst, b.ParentRoot)
+
+	// Field (3) 'StateRoot'
+	dst = append(dst, ",\"state_root\":"...)
+	dst = ssz.MarshalJSONBytes(dst, b.StateRoot)
+
+	// Field (4) 'BodyRoot'
+	dst = append(dst, ",\"body_root\":"...)
+	dst = ssz.MarshalJSONBytes(dst, b.BodyRoot)
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the BeaconBlockHeader object following the Beacon API conventions
+func (b *BeaconBlockHeader) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Slot'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["slot"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Slot")
+		}
+		b.Slot = uint64(val)
+	}
+
+	// Field (1) 'ProposerIndex'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["proposer_index"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "ProposerIndex")
+		}
+		b.ProposerIndex = uint64(val)
+	}
+
+	// Field (2) 'ParentRoot'
+	if b.ParentRoot, err = ssz.UnmarshalJSONBytes(fields["parent_root"], 32, 32); err != nil {
+		return ssz.WrapJSONError(err, "ParentRoot")
+	}
+
+	// Field (3) 'StateRoot'
+	if b.StateRoot, err = ssz.UnmarshalJSONBytes(fields["state_root"], 32, 32); err != nil {
+		return ssz.WrapJSONError(err, "StateRoot")
+	}
+
+	// Field (4) 'BodyRoot'
+	if b.BodyRoot, err = ssz.UnmarshalJSONBytes(fields["body_root"], 32, 32); err != nil {
+		return ssz.WrapJSONError(err, "BodyRoot")
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the ErrorResponse object
+func (e *ErrorResponse) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ErrorResponse object to a target array
+func (e *ErrorResponse) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Message'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += e.Message.SizeSSZ()
+
+	// Field (0) 'Message'
+	if dst, err = e.Message.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ErrorResponse object to a writer
+func (e *ErrorResponse) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, e)
+}
+
+// MarshalSSZToEncoder ssz marshals the ErrorResponse object to an encoder
+func (e *ErrorResponse) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(4)
+
+	// Offset (0) 'Message'
+	enc.EncodeOffset(offset)
+	offset += e.Message.SizeSSZ()
+
+	// Field (0) 'Message'
+	if err = enc.EncodeObject(&e.Message); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ErrorResponse object
+func (e *ErrorResponse) UnmarshalSSZ(buf []byte) error {
+	return e.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the ErrorResponse object within the limits of the decode state
+func (e *ErrorResponse) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "ErrorResponse", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ErrorResponse", 4, size)
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Message'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ErrorResponse", "Message", 0)
+	}
+
+	if o0 != 4 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 4, o0), "ErrorResponse", "Message", 0)
+	}
+
+	// Field (0) 'Message'
+	{
+		buf = tail[o0:]
+		if err = ssz.UnmarshalWithState(&e.Message, buf, st); err != nil {
+			return ssz.WrapDecodeError(err, "ErrorResponse", "Message", o0)
+		}
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a ErrorResponse object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (e *ErrorResponse) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ErrorResponse", 4, size)
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Message'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ErrorResponse", "Message", 0)
+	}
+
+	if o0 != 4 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 4, o0), "ErrorResponse", "Message", 0)
+	}
+
+	// Field (0) 'Message'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateObject((*external.DynamicBytes)(nil), buf); err != nil {
+			return ssz.WrapDecodeError(err, "ErrorResponse", "Message", o0)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the ErrorResponse object from a reader with an encoded size of 'size' bytes
+func (e *ErrorResponse) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, e)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the ErrorResponse object from a decoder
+func (e *ErrorResponse) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 4 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ErrorResponse", 4, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "ErrorResponse", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(4)
+	if err != nil {
+		return err
+	}
+	var o0 uint64
+
+	// Offset (0) 'Message'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ErrorResponse", "Message", 0)
+	}
+
+	if o0 != 4 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 4, o0), "ErrorResponse", "Message", 0)
+	}
+
+	// Field (0) 'Message'
+	{
+		if err = dec.DecodeObject(&e.Message, size-o0); err != nil {
+			return ssz.WrapDecodeError(err, "ErrorResponse", "Message", o0)
+		}
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ErrorResponse object
+func (e *ErrorResponse) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'Message'
+	size += e.Message.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ErrorResponse object
+func (e *ErrorResponse) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the ErrorResponse object with a hasher
+func (e *ErrorResponse) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if err = e.Message.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootParallel ssz hashes the ErrorResponse object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (e *ErrorResponse) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return e.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the ErrorResponse object like HashTreeRootParallel
+// with the Hashers of the pool
+func (e *ErrorResponse) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Message'
+		func(hh *ssz.Hasher) (err error) {
+			if err = e.Message.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+	})
+}
+
+// ErrorResponseView is a lazy view over the SSZ encoding of a ErrorResponse object
+type ErrorResponseView struct {
+	v ssz.View
+}
+
+// NewErrorResponseView creates a view over the SSZ encoding of a ErrorResponse object
+func NewErrorResponseView(buf []byte) ErrorResponseView {
+	return ErrorResponseViewOf(ssz.NewView(buf))
+}
+
+// ErrorResponseViewOf returns the view of the ErrorResponse object encoded in v
+func ErrorResponseViewOf(v ssz.View) ErrorResponseView {
+	return ErrorResponseView{v.Container(4, 0)}
+}
+
+// Raw returns the untyped view of the ErrorResponse object
+func (v ErrorResponseView) Raw() ssz.View {
+	return v.v
+}
+
+// ErrorResponseListView is a lazy view over a list of ErrorResponse objects
+type ErrorResponseListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th ErrorResponse object of the list
+func (l ErrorResponseListView) At(i int) ErrorResponseView {
+	return ErrorResponseViewOf(l.ListView.At(i))
+}
+
+// Message returns the Message field of the ErrorResponse object
+func (v ErrorResponseView) Message() ssz.View {
+	return v.v.DynamicField(0, 0)
+}
+
+// Copy returns a deep copy of the ErrorResponse object
+func (e *ErrorResponse) Copy() *ErrorResponse {
+	if e == nil {
+		return nil
+	}
+	cpy := new(ErrorResponse)
+	*cpy = *e
+
+	// Field 'Message'
+	cpy.Message = *ssz.CopyObject(&cpy.Message).(*external.DynamicBytes)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the ErrorResponse objects have the same SSZ encoding
+func (e *ErrorResponse) EqualSSZ(other *ErrorResponse) bool {
+	if e == nil {
+		e = new(ErrorResponse)
+	}
+	if other == nil {
+		other = new(ErrorResponse)
+	}
+
+	// Field 'Message'
+	if !ssz.EqualObject(&e.Message, &other.Message) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the ErrorResponse objects
+func (e *ErrorResponse) DiffSSZ(other *ErrorResponse) []ssz.FieldDiff {
+	if e == nil {
+		e = new(ErrorResponse)
+	}
+	if other == nil {
+		other = new(ErrorResponse)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Message'
+	diffs = ssz.AppendDiffs(diffs, "Message", ssz.DiffObject(&e.Message, &other.Message))
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the ErrorResponse object following the Beacon API conventions
+func (e *ErrorResponse) MarshalJSON() ([]byte, error) {
+	if e == nil {
+		e = new(ErrorResponse)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'Message'
+	dst = append(dst, "\"Message\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, &e.Message); err != nil {
+		return nil, err
+	}
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the ErrorResponse object following the Beacon API conventions
+func (e *ErrorResponse) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Message'
+	if err = ssz.UnmarshalJSONObject(fields["Message"], &e.Message); err != nil {
+		return ssz.WrapJSONError(err, "Message")
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Dummy object
+func (d *Dummy) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(d)
+}
+
+// MarshalSSZTo ssz marshals the Dummy object to a target array
+func (d *Dummy) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Dummy object to a writer
+func (d *Dummy) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, d)
+}
+
+// MarshalSSZToEncoder ssz marshals the Dummy object to an encoder
+func (d *Dummy) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Dummy object
+func (d *Dummy) UnmarshalSSZ(buf []byte) error {
+	return d.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the Dummy object within the limits of the decode state
+func (d *Dummy) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "Dummy", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 0 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Dummy", 0, size)
+	}
+
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a Dummy object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (d *Dummy) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 0 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Dummy", 0, size)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the Dummy object from a reader with an encoded size of 'size' bytes
+func (d *Dummy) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, d)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the Dummy object from a decoder
+func (d *Dummy) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 0 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Dummy", 0, dec.Size())
+	}
+	buf, err := dec.Read(0)
+	if err != nil {
+		return err
+	}
+	return d.UnmarshalSSZWithState(buf, dec.State())
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Dummy object
+func (d *Dummy) SizeSSZ() (size int) {
+	size = 0
+	return
+}
+
+// HashTreeRoot ssz hashes the Dummy object
+func (d *Dummy) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(d)
+}
+
+// HashTreeRootWith ssz hashes the Dummy object with a hasher
+func (d *Dummy) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootParallel ssz hashes the Dummy object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (d *Dummy) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return d.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the Dummy object like HashTreeRootParallel
+// with the Hashers of the pool
+func (d *Dummy) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{})
+}
+
+// DummyView is a lazy view over the SSZ encoding of a Dummy object
+type DummyView struct {
+	v ssz.View
+}
+
+// NewDummyView creates a view over the SSZ encoding of a Dummy object
+func NewDummyView(buf []byte) DummyView {
+	return DummyViewOf(ssz.NewView(buf))
+}
+
+// DummyViewOf returns the view of the Dummy object encoded in v
+func DummyViewOf(v ssz.View) DummyView {
+	return DummyView{v.Container(0, -1)}
+}
+
+// Raw returns the untyped view of the Dummy object
+func (v DummyView) Raw() ssz.View {
+	return v.v
+}
+
+// DummyListView is a lazy view over a list of Dummy objects
+type DummyListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th Dummy object of the list
+func (l DummyListView) At(i int) DummyView {
+	return DummyViewOf(l.ListView.At(i))
+}
+
+// Copy returns a deep copy of the Dummy object
+func (d *Dummy) Copy() *Dummy {
+	if d == nil {
+		return nil
+	}
+	cpy := new(Dummy)
+	*cpy = *d
+	return cpy
+}
+
+// EqualSSZ returns true if the Dummy objects have the same SSZ encoding
+func (d *Dummy) EqualSSZ(other *Dummy) bool {
+	if d == nil {
+		d = new(Dummy)
+	}
+	if other == nil {
+		other = new(Dummy)
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the Dummy objects
+func (d *Dummy) DiffSSZ(other *Dummy) []ssz.FieldDiff {
+	if d == nil {
+		d = new(Dummy)
+	}
+	if other == nil {
+		other = new(Dummy)
+	}
+	var diffs []ssz.FieldDiff
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the Dummy object following the Beacon API conventions
+func (d *Dummy) MarshalJSON() ([]byte, error) {
+	if d == nil {
+		d = new(Dummy)
+	}
+	dst := []byte{'{'}
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the Dummy object following the Beacon API conventions
+func (d *Dummy) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the SyncCommittee object
+func (s *SyncCommittee) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SyncCommittee object to a target array
+func (s *SyncCommittee) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'PubKeys'
+	if size := len(s.PubKeys); size != 1024 {
+		err = ssz.ErrVectorLengthFn("--.PubKeys", size, 1024)
+		return
+	}
+	for ii := 0; ii < 1024; ii++ {
+		if size := len(s.PubKeys[ii]); size != 48 {
+			err = ssz.ErrBytesLengthFn("--.PubKeys[ii]", size, 48)
+			return
+		}
+		dst = append(dst, s.PubKeys[ii]...)
+	}
+
+	// Field (1) 'PubKeyAggregates'
+	for ii := 0; ii < 16; ii++ {
+		dst = append(dst, s.PubKeyAggregates[ii][:]...)
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the SyncCommittee object to a writer
+func (s *SyncCommittee) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, s)
+}
+
+// MarshalSSZToEncoder ssz marshals the SyncCommittee object to an encoder
+func (s *SyncCommittee) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	// Field (0) 'PubKeys'
+	if size := len(s.PubKeys); size != 1024 {
+		err = ssz.ErrVectorLengthFn("--.PubKeys", size, 1024)
+		return
+	}
+	for ii := 0; ii < 1024; ii++ {
+		if size := len(s.PubKeys[ii]); size != 48 {
+			err = ssz.ErrBytesLengthFn("--.PubKeys[ii]", size, 48)
+			return
+		}
+		enc.EncodeBytes(s.PubKeys[ii])
+	}
+
+	// Field (1) 'PubKeyAggregates'
+	for ii := 0; ii < 16; ii++ {
+		enc.EncodeBytes(s.PubKeyAggregates[ii][:])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SyncCommittee object
+func (s *SyncCommittee) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the SyncCommittee object within the limits of the decode state
+func (s *SyncCommittee) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "SyncCommittee", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 49920 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SyncCommittee", 49920, size)
+	}
+
+	// Field (0) 'PubKeys'
+	if err = st.Alloc(1024, 24); err != nil {
+		return ssz.WrapDecodeError(err, "SyncCommittee", "PubKeys", 0)
+	}
+	s.PubKeys = make([][]byte, 1024)
+	for ii := 0; ii < 1024; ii++ {
+		if cap(s.PubKeys[ii]) == 0 {
+			s.PubKeys[ii] = make([]byte, 0, len(buf[0:49152][ii*48:(ii+1)*48]))
+		}
+		s.PubKeys[ii] = append(s.PubKeys[ii], buf[0:49152][ii*48:(ii+1)*48]...)
+	}
+
+	// Field (1) 'PubKeyAggregates'
+
+	for ii := 0; ii < 16; ii++ {
+		copy(s.PubKeyAggregates[ii][:], buf[49152:49920][ii*48:(ii+1)*48])
+	}
+
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a SyncCommittee object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (s *SyncCommittee) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 49920 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SyncCommittee", 49920, size)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the SyncCommittee object from a reader with an encoded size of 'size' bytes
+func (s *SyncCommittee) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, s)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the SyncCommittee object from a decoder
+func (s *SyncCommittee) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 49920 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SyncCommittee", 49920, dec.Size())
+	}
+	buf, err := dec.Read(49920)
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalSSZWithState(buf, dec.State())
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SyncCommittee object
+func (s *SyncCommittee) SizeSSZ() (size int) {
+	size = 49920
+	return
+}
+
+// HashTreeRoot ssz hashes the SyncCommittee object
+func (s *SyncCommittee) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SyncCommittee object with a hasher
+func (s *SyncCommittee) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'PubKeys'
+	{
+		if size := len(s.PubKeys); size != 1024 {
+			err = ssz.ErrVectorLengthFn("--.PubKeys", size, 1024)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range s.PubKeys {
+			if len(i) != 48 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (1) 'PubKeyAggregates'
+	{
+		subIndx := hh.Index()
+		for _, i := range s.PubKeyAggregates {
+			hh.PutBytes(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootParallel ssz hashes the SyncCommittee object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (s *SyncCommittee) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return s.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the SyncCommittee object like HashTreeRootParallel
+// with the Hashers of the pool
+func (s *SyncCommittee) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'PubKeys'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				if size := len(s.PubKeys); size != 1024 {
+					err = ssz.ErrVectorLengthFn("--.PubKeys", size, 1024)
+					return
+				}
+				subIndx := hh.Index()
+				for _, i := range s.PubKeys {
+					if len(i) != 48 {
+						err = ssz.ErrBytesLength
+						return
+					}
+					hh.PutBytes(i)
+				}
+				hh.Merkleize(subIndx)
+			}
+			return
+		},
+		// Field (1) 'PubKeyAggregates'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				subIndx := hh.Index()
+				for _, i := range s.PubKeyAggregates {
+					hh.PutBytes(i[:])
+				}
+				hh.Merkleize(subIndx)
+			}
+			return
+		},
+	})
+}
+
+// SyncCommitteeView is a lazy view over the SSZ encoding of a SyncCommittee object
+type SyncCommitteeView struct {
+	v ssz.View
+}
+
+// NewSyncCommitteeView creates a view over the SSZ encoding of a SyncCommittee object
+func NewSyncCommitteeView(buf []byte) SyncCommitteeView {
+	return SyncCommitteeViewOf(ssz.NewView(buf))
+}
+
+// SyncCommitteeViewOf returns the view of the SyncCommittee object encoded in v
+func SyncCommitteeViewOf(v ssz.View) SyncCommitteeView {
+	return SyncCommitteeView{v.Container(49920, -1)}
+}
+
+// Raw returns the untyped view of the SyncCommittee object
+func (v SyncCommitteeView) Raw() ssz.View {
+	return v.v
+}
+
+// SyncCommitteeListView is a lazy view over a list of SyncCommittee objects
+type SyncCommitteeListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th SyncCommittee object of the list
+func (l SyncCommitteeListView) At(i int) SyncCommitteeView {
+	return SyncCommitteeViewOf(l.ListView.At(i))
+}
+
+// PubKeys returns the PubKeys field of the SyncCommittee object
+func (v SyncCommitteeView) PubKeys() ssz.ListView {
+	return v.v.Field(0, 49152).List(48, 1024)
+}
+
+// PubKeyAggregates returns the PubKeyAggregates field of the SyncCommittee object
+func (v SyncCommitteeView) PubKeyAggregates() ssz.ListView {
+	return v.v.Field(49152, 49920).List(48, 16)
+}
+
+// Copy returns a deep copy of the SyncCommittee object
+func (s *SyncCommittee) Copy() *SyncCommittee {
+	if s == nil {
+		return nil
+	}
+	cpy := new(SyncCommittee)
+	*cpy = *s
+
+	// Field 'PubKeys'
+	cpy.PubKeys = append(cpy.PubKeys[:0:0], cpy.PubKeys...)
+	for i0 := range cpy.PubKeys {
+		cpy.PubKeys[i0] = append(cpy.PubKeys[i0][:0:0], cpy.PubKeys[i0]...)
+	}
+
+	return cpy
+}
+
+// EqualSSZ returns true if the SyncCommittee objects have the same SSZ encoding
+func (s *SyncCommittee) EqualSSZ(other *SyncCommittee) bool {
+	if s == nil {
+		s = new(SyncCommittee)
+	}
+	if other == nil {
+		other = new(SyncCommittee)
+	}
+
+	// Field 'PubKeys'
+	if len(s.PubKeys) != len(other.PubKeys) {
+		return false
+	} else {
+		for i0 := range s.PubKeys {
+			if !bytes.Equal(s.PubKeys[i0], other.PubKeys[i0]) {
+				return false
+			}
+		}
+	}
+
+	// Field 'PubKeyAggregates'
+	if s.PubKeyAggregates != other.PubKeyAggregates {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the SyncCommittee objects
+func (s *SyncCommittee) DiffSSZ(other *SyncCommittee) []ssz.FieldDiff {
+	if s == nil {
+		s = new(SyncCommittee)
+	}
+	if other == nil {
+		other = new(SyncCommittee)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'PubKeys'
+	if len(s.PubKeys) != len(other.PubKeys) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "PubKeys", A: s.PubKeys, B: other.PubKeys})
+	} else {
+		for i0 := range s.PubKeys {
+			if !bytes.Equal(s.PubKeys[i0], other.PubKeys[i0]) {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("PubKeys", i0), A: s.PubKeys[i0], B: other.PubKeys[i0]})
+			}
+		}
+	}
+
+	// Field 'PubKeyAggregates'
+	if s.PubKeyAggregates != other.PubKeyAggregates {
+		diffs = append(diffs, ssz.FieldDiff{Path: "PubKeyAggregates", A: s.PubKeyAggregates, B: other.PubKeyAggregates})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the SyncCommittee object following the Beacon API conventions
+func (s *SyncCommittee) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		s = new(SyncCommittee)
+	}
+	dst := []byte{'{'}
+
+	// Field (0) 'PubKeys'
+	dst = append(dst, "\"pubkeys\":"...)
+	dst = append(dst, '[')
+	for i0 := range s.PubKeys {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONBytes(dst, s.PubKeys[i0])
+	}
+	dst = append(dst, ']')
+
+	// Field (1) 'PubKeyAggregates'
+	dst = append(dst, ",\"pubkey_aggregates\":"...)
+	dst = append(dst, '[')
+	for i0 := range s.PubKeyAggregates {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONBytes(dst, s.PubKeyAggregates[i0][:])
+	}
+	dst = append(dst, ']')
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the SyncCommittee object following the Beacon API conventions
+func (s *SyncCommittee) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'PubKeys'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["pubkeys"], 1024, 1024)
+		if err != nil {
+			return ssz.WrapJSONError(err, "PubKeys")
+		}
+		s.PubKeys = make([][]byte, len(items0))
+		for i0 := range items0 {
+			if s.PubKeys[i0], err = ssz.UnmarshalJSONBytes(items0[i0], 48, 48); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("PubKeys", i0))
+			}
+		}
+	}
+
+	// Field (1) 'PubKeyAggregates'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["pubkey_aggregates"], 16, 16)
+		if err != nil {
+			return ssz.WrapJSONError(err, "PubKeyAggregates")
+		}
+
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONBytes(items0[i0], 48, 48)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("PubKeyAggregates", i0))
+				}
+				copy(s.PubKeyAggregates[i0][:], val)
+			}
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the SyncAggregate object
+func (s *SyncAggregate) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SyncAggregate object to a target array
+func (s *SyncAggregate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'SyncCommiteeBits'
+	if size := len(s.SyncCommiteeBits); size != 128 {
+		err = ssz.ErrBytesLengthFn("--.SyncCommiteeBits", size, 128)
+		return
+	}
+	dst = append(dst, s.SyncCommiteeBits...)
+
+	// Field (1) 'SyncCommiteeSignature'
+	dst = append(dst, s.SyncCommiteeSignature[:]...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the SyncAggregate object to a writer
+func (s *SyncAggregate) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, s)
+}
+
+// MarshalSSZToEncoder ssz marshals the SyncAggregate object to an encoder
+func (s *SyncAggregate) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	// Field (0) 'SyncCommiteeBits'
+	if size := len(s.SyncCommiteeBits); size != 128 {
+		err = ssz.ErrBytesLengthFn("--.SyncCommiteeBits", size, 128)
+		return
+	}
+	enc.EncodeBytes(s.SyncCommiteeBits)
+
+	// Field (1) 'SyncCommiteeSignature'
+	enc.EncodeBytes(s.SyncCommiteeSignature[:])
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SyncAggregate object
+func (s *SyncAggregate) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the SyncAggregate object within the limits of the decode state
+func (s *SyncAggregate) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "SyncAggregate", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 224 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SyncAggregate", 224, size)
+	}
+
+	// Field (0) 'SyncCommiteeBits'
+	if cap(s.SyncCommiteeBits) == 0 {
+		s.SyncCommiteeBits = make([]byte, 0, len(buf[0:128]))
+	}
+	s.SyncCommiteeBits = append(s.SyncCommiteeBits, buf[0:128]...)
+
+	// Field (1) 'SyncCommiteeSignature'
+	copy(s.SyncCommiteeSignature[:], buf[128:224])
+
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a SyncAggregate object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (s *SyncAggregate) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 224 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SyncAggregate", 224, size)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the SyncAggregate object from a reader with an encoded size of 'size' bytes
+func (s *SyncAggregate) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, s)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the SyncAggregate object from a decoder
+func (s *SyncAggregate) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 224 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SyncAggregate", 224, dec.Size())
+	}
+	buf, err := dec.Read(224)
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalSSZWithState(buf, dec.State())
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SyncAggregate object
+func (s *SyncAggregate) SizeSSZ() (size int) {
+	size = 224
+	return
+}
+
+// HashTreeRoot ssz hashes the SyncAggregate object
+func (s *SyncAggregate) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SyncAggregate object with a hasher
+func (s *SyncAggregate) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SyncCommiteeBits'
+	if size := len(s.SyncCommiteeBits); size != 128 {
+		err = ssz.ErrBytesLengthFn("--.SyncCommiteeBits", size, 128)
+		return
+	}
+	hh.PutBytes(s.SyncCommiteeBits)
+
+	// Field (1) 'SyncCommiteeSignature'
+	hh.PutBytes(s.SyncCommiteeSignature[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootParallel ssz hashes the SyncAggregate object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (s *SyncAggregate) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return s.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the SyncAggregate object like HashTreeRootParallel
+// with the Hashers of the pool
+func (s *SyncAggregate) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'SyncCommiteeBits'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(s.SyncCommiteeBits); size != 128 {
+				err = ssz.ErrBytesLengthFn("--.SyncCommiteeBits", size, 128)
+				return
+			}
+			hh.PutBytes(s.SyncCommiteeBits)
+			return
+		},
+		// Field (1) 'SyncCommiteeSignature'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutBytes(s.SyncCommiteeSignature[:])
+			return
+		},
+	})
+}
+
+// SyncAggregateView is a lazy view over the SSZ encoding of a SyncAggregate object
+type SyncAggregateView struct {
+	v ssz.View
+}
+
+// NewSyncAggregateView creates a view over the SSZ encoding of a SyncAggregate object
+func NewSyncAggregateView(buf []byte) SyncAggregateView {
+	return SyncAggregateViewOf(ssz.NewView(buf))
+}
+
+// SyncAggregateViewOf returns the view of the SyncAggregate object encoded in v
+func SyncAggregateViewOf(v ssz.View) SyncAggregateView {
+	return SyncAggregateView{v.Container(224, -1)}
+}
+
+// Raw returns the untyped view of the SyncAggregate object
+func (v SyncAggregateView) Raw() ssz.View {
+	return v.v
+}
+
+// SyncAggregateListView is a lazy view over a list of SyncAggregate objects
+type SyncAggregateListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th SyncAggregate object of the list
+func (l SyncAggregateListView) At(i int) SyncAggregateView {
+	return SyncAggregateViewOf(l.ListView.At(i))
+}
+
+// SyncCommiteeBits returns the SyncCommiteeBits field of the SyncAggregate object
+func (v SyncAggregateView) SyncCommiteeBits() ([]byte, error) {
+	return v.v.Field(0, 128).Bytes()
+}
+
+// SyncCommiteeSignature returns the SyncCommiteeSignature field of the SyncAggregate object
+func (v SyncAggregateView) SyncCommiteeSignature() ([]byte, error) {
+	return v.v.Field(128, 224).Bytes()
+}
+
+// Copy returns a deep copy of the SyncAggregate object
+func (s *SyncAggregate) Copy() *SyncAggregate {
+	if s == nil {
+		return nil
+	}
+	cpy := new(SyncAggregate)
+	*cpy = *s
+
+	// Field 'SyncCommiteeBits'
+	cpy.SyncCommiteeBits = append(cpy.SyncCommiteeBits[:0:0], cpy.SyncCommiteeBits...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the SyncAggregate objects have the same SSZ encoding
+func (s *SyncAggregate) EqualSSZ(other *SyncAggregate) bool {
+	if s == nil {
+		s = new(SyncAggregate)
+	}
+	if other == nil {
+		other = new(SyncAggregate)
+	}
+
+	// Field 'SyncCommiteeBits'
+	if !bytes.Equal(s.SyncCommiteeBits, other.SyncCommiteeBits) {
+		return false
+	}
+
+	// Field 'SyncCommiteeSignature'
+	if s.SyncCommiteeSignature != other.SyncCommiteeSignature {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the SyncAggregate objects
+func (s *SyncAggregate) DiffSSZ(other *SyncAggregate) []ssz.FieldDiff {
+	if s == nil {
+		s = new(SyncAggregate)
+	}
+	if other == nil {
+		other = new(SyncAggregate)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'SyncCommiteeBits'
+	if !bytes.Equal(s.SyncCommiteeBits, other.SyncCommiteeBits) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "SyncCommiteeBits", A: s.SyncCommiteeBits, B: other.SyncCommiteeBits})
+	}
+
+	// Field 'SyncCommiteeSignature'
+	if s.SyncCommiteeSignature != other.SyncCommiteeSignature {
+		diffs = append(diffs, ssz.FieldDiff{Path: "SyncCommiteeSignature", A: s.SyncCommiteeSignature, B: other.SyncCommiteeSignature})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the SyncAggregate object following the Beacon API conventions
+func (s *SyncAggregate) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		s = new(SyncAggregate)
+	}
+	dst := []byte{'{'}
+
+	// Field (0) 'SyncCommiteeBits'
+	dst = append(dst, "\"sync_committee_bits\":"...)
+	dst = ssz.MarshalJSONBytes(dst, s.SyncCommiteeBits)
+
+	// Field (1) 'SyncCommiteeSignature'
+	dst = append(dst, ",\"sync_committee_signature\":"...)
+	dst = ssz.MarshalJSONBytes(dst, s.SyncCommiteeSignature[:])
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the SyncAggregate object following the Beacon API conventions
+func (s *SyncAggregate) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'SyncCommiteeBits'
+	if s.SyncCommiteeBits, err = ssz.UnmarshalJSONBytes(fields["sync_committee_bits"], 128, 128); err != nil {
+		return ssz.WrapJSONError(err, "SyncCommiteeBits")
+	}
+
+	// Field (1) 'SyncCommiteeSignature'
+	{
+		val, err := ssz.UnmarshalJSONBytes(fields["sync_committee_signature"], 96, 96)
+		if err != nil {
+			return ssz.WrapJSONError(err, "SyncCommiteeSignature")
+		}
+		copy(s.SyncCommiteeSignature[:], val)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the SyncCommitteeMinimal object
+func (s *SyncCommitteeMinimal) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SyncCommitteeMinimal object to a target array
+func (s *SyncCommitteeMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'PubKeys'
+	if size := len(s.PubKeys); size != 32 {
+		err = ssz.ErrVectorLengthFn("--.PubKeys", size, 32)
+		return
+	}
+	for ii := 0; ii < 32; ii++ {
+		if size := len(s.PubKeys[ii]); size != 48 {
+			err = ssz.ErrBytesLengthFn("--.PubKeys[ii]", size, 48)
+			return
+		}
+		dst = append(dst, s.PubKeys[ii]...)
+	}
+
+	// Field (1) 'PubKeyAggregates'
+	for ii := 0; ii < 2; ii++ {
+		dst = append(dst, s.PubKeyAggregates[ii][:]...)
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the SyncCommitteeMinimal object to a writer
+func (s *SyncCommitteeMinimal) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, s)
+}
+
+// MarshalSSZToEncoder ssz marshals the SyncCommitteeMinimal object to an encoder
+func (s *SyncCommitteeMinimal) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	// Field (0) 'PubKeys'
+	if size := len(s.PubKeys); size != 32 {
+		err = ssz.ErrVectorLengthFn("--.PubKeys", size, 32)
+		return
+	}
+	for ii := 0; ii < 32; ii++ {
+		if size := len(s.PubKeys[ii]); size != 48 {
+			err = ssz.ErrBytesLengthFn("--.PubKeys[ii]", size, 48)
+			return
+		}
+		enc.EncodeBytes(s.PubKeys[ii])
+	}
+
+	// Field (1) 'PubKeyAggregates'
+	for ii := 0; ii < 2; ii++ {
+		enc.EncodeBytes(s.PubKeyAggregates[ii][:])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SyncCommitteeMinimal object
+func (s *SyncCommitteeMinimal) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the SyncCommitteeMinimal object within the limits of the decode state
+func (s *SyncCommitteeMinimal) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "SyncCommitteeMinimal", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 1632 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SyncCommitteeMinimal", 1632, size)
+	}
+
+	// Field (0) 'PubKeys'
+	if err = st.Alloc(32, 24); err != nil {
+		return ssz.WrapDecodeError(err, "SyncCommitteeMinimal", "PubKeys", 0)
+	}
+	s.PubKeys = make([][]byte, 32)
+	for ii := 0; ii < 32; ii++ {
+		if cap(s.PubKeys[ii]) == 0 {
+			s.PubKeys[ii] = make([]byte, 0, len(buf[0:1536][ii*48:(ii+1)*48]))
+		}
+		s.PubKeys[ii] = append(s.PubKeys[ii], buf[0:1536][ii*48:(ii+1)*48]...)
+	}
+
+	// Field (1) 'PubKeyAggregates'
+
+	for ii := 0; ii < 2; ii++ {
+		copy(s.PubKeyAggregates[ii][:], buf[1536:1632][ii*48:(ii+1)*48])
+	}
+
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a SyncCommitteeMinimal object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (s *SyncCommitteeMinimal) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 1632 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SyncCommitteeMinimal", 1632, size)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the SyncCommitteeMinimal object from a reader with an encoded size of 'size' bytes
+func (s *SyncCommitteeMinimal) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, s)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the SyncCommitteeMinimal object from a decoder
+func (s *SyncCommitteeMinimal) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 1632 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SyncCommitteeMinimal", 1632, dec.Size())
+	}
+	buf, err := dec.Read(1632)
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalSSZWithState(buf, dec.State())
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SyncCommitteeMinimal object
+func (s *SyncCommitteeMinimal) SizeSSZ() (size int) {
+	size = 1632
+	return
+}
+
+// HashTreeRoot ssz hashes the SyncCommitteeMinimal object
+func (s *SyncCommitteeMinimal) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SyncCommitteeMinimal object with a hasher
+func (s *SyncCommitteeMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'PubKeys'
+	{
+		if size := len(s.PubKeys); size != 32 {
+			err = ssz.ErrVectorLengthFn("--.PubKeys", size, 32)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range s.PubKeys {
+			if len(i) != 48 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (1) 'PubKeyAggregates'
+	{
+		subIndx := hh.Index()
+		for _, i := range s.PubKeyAggregates {
+			hh.PutBytes(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootParallel ssz hashes the SyncCommitteeMinimal object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (s *SyncCommitteeMinimal) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return s.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the SyncCommitteeMinimal object like HashTreeRootParallel
+// with the Hashers of the pool
+func (s *SyncCommitteeMinimal) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'PubKeys'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				if size := len(s.PubKeys); size != 32 {
+					err = ssz.ErrVectorLengthFn("--.PubKeys", size, 32)
+					return
+				}
+				subIndx := hh.Index()
+				for _, i := range s.PubKeys {
+					if len(i) != 48 {
+						err = ssz.ErrBytesLength
+						return
+					}
+					hh.PutBytes(i)
+				}
+				hh.Merkleize(subIndx)
+			}
+			return
+		},
+		// Field (1) 'PubKeyAggregates'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				subIndx := hh.Index()
+				for _, i := range s.PubKeyAggregates {
+					hh.PutBytes(i[:])
+				}
+				hh.Merkleize(subIndx)
+			}
+			return
+		},
+	})
+}
+
+// SyncCommitteeMinimalView is a lazy view over the SSZ encoding of a SyncCommitteeMinimal object
+type SyncCommitteeMinimalView struct {
+	v ssz.View
+}
+
+// NewSyncCommitteeMinimalView creates a view over the SSZ encoding of a SyncCommitteeMinimal object
+func NewSyncCommitteeMinimalView(buf []byte) SyncCommitteeMinimalView {
+	return SyncCommitteeMinimalViewOf(ssz.NewView(buf))
+}
+
+// SyncCommitteeMinimalViewOf returns the view of the SyncCommitteeMinimal object encoded in v
+func SyncCommitteeMinimalViewOf(v ssz.View) SyncCommitteeMinimalView {
+	return SyncCommitteeMinimalView{v.Container(1632, -1)}
+}
+
+// Raw returns the untyped view of the SyncCommitteeMinimal object
+func (v SyncCommitteeMinimalView) Raw() ssz.View {
+	return v.v
+}
+
+// SyncCommitteeMinimalListView is a lazy view over a list of SyncCommitteeMinimal objects
+type SyncCommitteeMinimalListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th SyncCommitteeMinimal object of the list
+func (l SyncCommitteeMinimalListView) At(i int) SyncCommitteeMinimalView {
+	return SyncCommitteeMinimalViewOf(l.ListView.At(i))
+}
+
+// PubKeys returns the PubKeys field of the SyncCommitteeMinimal object
+func (v SyncCommitteeMinimalView) PubKeys() ssz.ListView {
+	return v.v.Field(0, 1536).List(48, 32)
+}
+
+// PubKeyAggregates returns the PubKeyAggregates field of the SyncCommitteeMinimal object
+func (v SyncCommitteeMinimalView) PubKeyAggregates() ssz.ListView {
+	return v.v.Field(1536, 1632).List(48, 2)
+}
+
+// Copy returns a deep copy of the SyncCommitteeMinimal object
+func (s *SyncCommitteeMinimal) Copy() *SyncCommitteeMinimal {
+	if s == nil {
+		return nil
+	}
+	cpy := new(SyncCommitteeMinimal)
+	*cpy = *s
+
+	// Field 'PubKeys'
+	cpy.PubKeys = append(cpy.PubKeys[:0:0], cpy.PubKeys...)
+	for i0 := range cpy.PubKeys {
+		cpy.PubKeys[i0] = append(cpy.PubKeys[i0][:0:0], cpy.PubKeys[i0]...)
+	}
+
+	return cpy
+}
+
+// EqualSSZ returns true if the SyncCommitteeMinimal objects have the same SSZ encoding
+func (s *SyncCommitteeMinimal) EqualSSZ(other *SyncCommitteeMinimal) bool {
+	if s == nil {
+		s = new(SyncCommitteeMinimal)
+	}
+	if other == nil {
+		other = new(SyncCommitteeMinimal)
+	}
+
+	// Field 'PubKeys'
+	if len(s.PubKeys) != len(other.PubKeys) {
+		return false
+	} else {
+		for i0 := range s.PubKeys {
+			if !bytes.Equal(s.PubKeys[i0], other.PubKeys[i0]) {
+				return false
+			}
+		}
+	}
+
+	// Field 'PubKeyAggregates'
+	if s.PubKeyAggregates != other.PubKeyAggregates {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the SyncCommitteeMinimal objects
+func (s *SyncCommitteeMinimal) DiffSSZ(other *SyncCommitteeMinimal) []ssz.FieldDiff {
+	if s == nil {
+		s = new(SyncCommitteeMinimal)
+	}
+	if other == nil {
+		other = new(SyncCommitteeMinimal)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'PubKeys'
+	if len(s.PubKeys) != len(other.PubKeys) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "PubKeys", A: s.PubKeys, B: other.PubKeys})
+	} else {
+		for i0 := range s.PubKeys {
+			if !bytes.Equal(s.PubKeys[i0], other.PubKeys[i0]) {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("PubKeys", i0), A: s.PubKeys[i0], B: other.PubKeys[i0]})
+			}
+		}
+	}
+
+	// Field 'PubKeyAggregates'
+	if s.PubKeyAggregates != other.PubKeyAggregates {
+		diffs = append(diffs, ssz.FieldDiff{Path: "PubKeyAggregates", A: s.PubKeyAggregates, B: other.PubKeyAggregates})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the SyncCommitteeMinimal object following the Beacon API conventions
+func (s *SyncCommitteeMinimal) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		s = new(SyncCommitteeMinimal)
+	}
+	dst := []byte{'{'}
+
+	// Field (0) 'PubKeys'
+	dst = append(dst, "\"pubkeys\":"...)
+	dst = append(dst, '[')
+	for i0 := range s.PubKeys {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONBytes(dst, s.PubKeys[i0])
+	}
+	dst = append(dst, ']')
+
+	// Field (1) 'PubKeyAggregates'
+	dst = append(dst, ",\"pubkey_aggregates\":"...)
+	dst = append(dst, '[')
+	for i0 := range s.PubKeyAggregates {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONBytes(dst, s.PubKeyAggregates[i0][:])
+	}
+	dst = append(dst, ']')
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the SyncCommitteeMinimal object following the Beacon API conventions
+func (s *SyncCommitteeMinimal) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'PubKeys'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["pubkeys"], 32, 32)
+		if err != nil {
+			return ssz.WrapJSONError(err, "PubKeys")
+		}
+		s.PubKeys = make([][]byte, len(items0))
+		for i0 := range items0 {
+			if s.PubKeys[i0], err = ssz.UnmarshalJSONBytes(items0[i0], 48, 48); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("PubKeys", i0))
+			}
+		}
+	}
+
+	// Field (1) 'PubKeyAggregates'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["pubkey_aggregates"], 2, 2)
+		if err != nil {
+			return ssz.WrapJSONError(err, "PubKeyAggregates")
+		}
+
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONBytes(items0[i0], 48, 48)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("PubKeyAggregates", i0))
+				}
+				copy(s.PubKeyAggregates[i0][:], val)
+			}
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the SyncAggregateMinimal object
+func (s *SyncAggregateMinimal) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SyncAggregateMinimal object to a target array
+func (s *SyncAggregateMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'SyncCommiteeBits'
+	if size := len(s.SyncCommiteeBits); size != 4 {
+		err = ssz.ErrBytesLengthFn("--.SyncCommiteeBits", size, 4)
+		return
+	}
+	dst = append(dst, s.SyncCommiteeBits...)
+
+	// Field (1) 'SyncCommiteeSignature'
+	dst = append(dst, s.SyncCommiteeSignature[:]...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the SyncAggregateMinimal object to a writer
+func (s *SyncAggregateMinimal) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, s)
+}
+
+// MarshalSSZToEncoder ssz marshals the SyncAggregateMinimal object to an encoder
+func (s *SyncAggregateMinimal) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	// Field (0) 'SyncCommiteeBits'
+	if size := len(s.SyncCommiteeBits); size != 4 {
+		err = ssz.ErrBytesLengthFn("--.SyncCommiteeBits", size, 4)
+		return
+	}
+	enc.EncodeBytes(s.SyncCommiteeBits)
+
+	// Field (1) 'SyncCommiteeSignature'
+	enc.EncodeBytes(s.SyncCommiteeSignature[:])
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SyncAggregateMinimal object
+func (s *SyncAggregateMinimal) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the SyncAggregateMinimal object within the limits of the decode state
+func (s *SyncAggregateMinimal) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "SyncAggregateMinimal", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 100 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SyncAggregateMinimal", 100, size)
+	}
+
+	// Field (0) 'SyncCommiteeBits'
+	if cap(s.SyncCommiteeBits) == 0 {
+		s.SyncCommiteeBits = make([]byte, 0, len(buf[0:4]))
+	}
+	s.SyncCommiteeBits = append(s.SyncCommiteeBits, buf[0:4]...)
+
+	// Field (1) 'SyncCommiteeSignature'
+	copy(s.SyncCommiteeSignature[:], buf[4:100])
+
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a SyncAggregateMinimal object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (s *SyncAggregateMinimal) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 100 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SyncAggregateMinimal", 100, size)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the SyncAggregateMinimal object from a reader with an encoded size of 'size' bytes
+func (s *SyncAggregateMinimal) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, s)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the SyncAggregateMinimal object from a decoder
+func (s *SyncAggregateMinimal) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 100 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SyncAggregateMinimal", 100, dec.Size())
+	}
+	buf, err := dec.Read(100)
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalSSZWithState(buf, dec.State())
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SyncAggregateMinimal object
+func (s *SyncAggregateMinimal) SizeSSZ() (size int) {
+	size = 100
+	return
+}
+
+// HashTreeRoot ssz hashes the SyncAggregateMinimal object
+func (s *SyncAggregateMinimal) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SyncAggregateMinimal object with a hasher
+func (s *SyncAggregateMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SyncCommiteeBits'
+	if size := len(s.SyncCommiteeBits); size != 4 {
+		err = ssz.ErrBytesLengthFn("--.SyncCommiteeBits", size, 4)
+		return
+	}
+	hh.PutBytes(s.SyncCommiteeBits)
+
+	// Field (1) 'SyncCommiteeSignature'
+	hh.PutBytes(s.SyncCommiteeSignature[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootParallel ssz hashes the SyncAggregateMinimal object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (s *SyncAggregateMinimal) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return s.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the SyncAggregateMinimal object like HashTreeRootParallel
+// with the Hashers of the pool
+func (s *SyncAggregateMinimal) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'SyncCommiteeBits'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(s.SyncCommiteeBits); size != 4 {
+				err = ssz.ErrBytesLengthFn("--.SyncCommiteeBits", size, 4)
+				return
+			}
+			hh.PutBytes(s.SyncCommiteeBits)
+			return
+		},
+		// Field (1) 'SyncCommiteeSignature'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutBytes(s.SyncCommiteeSignature[:])
+			return
+		},
+	})
+}
+
+// SyncAggregateMinimalView is a lazy view over the SSZ encoding of a SyncAggregateMinimal object
+type SyncAggregateMinimalView struct {
+	v ssz.View
+}
+
+// NewSyncAggregateMinimalView creates a view over the SSZ encoding of a SyncAggregateMinimal object
+func NewSyncAggregateMinimalView(buf []byte) SyncAggregateMinimalView {
+	return SyncAggregateMinimalViewOf(ssz.NewView(buf))
+}
+
+// SyncAggregateMinimalViewOf returns the view of the SyncAggregateMinimal object encoded in v
+func SyncAggregateMinimalViewOf(v ssz.View) SyncAggregateMinimalView {
+	return SyncAggregateMinimalView{v.Container(100, -1)}
+}
+
+// Raw returns the untyped view of the SyncAggregateMinimal object
+func (v SyncAggregateMinimalView) Raw() ssz.View {
+	return v.v
+}
+
+// SyncAggregateMinimalListView is a lazy view over a list of SyncAggregateMinimal objects
+type SyncAggregateMinimalListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th SyncAggregateMinimal object of the list
+func (l SyncAggregateMinimalListView) At(i int) SyncAggregateMinimalView {
+	return SyncAggregateMinimalViewOf(l.ListView.At(i))
+}
+
+// SyncCommiteeBits returns the SyncCommiteeBits field of the SyncAggregateMinimal object
+func (v SyncAggregateMinimalView) SyncCommiteeBits() ([]byte, error) {
+	return v.v.Field(0, 4).Bytes()
+}
+
+// SyncCommiteeSignature returns the SyncCommiteeSignature field of the SyncAggregateMinimal object
+func (v SyncAggregateMinimalView) SyncCommiteeSignature() ([]byte, error) {
+	return v.v.Field(4, 100).Bytes()
+}
+
+// Copy returns a deep copy of the SyncAggregateMinimal object
+func (s *SyncAggregateMinimal) Copy() *SyncAggregateMinimal {
+	if s == nil {
+		return nil
+	}
+	cpy := new(SyncAggregateMinimal)
+	*cpy = *s
+
+	// Field 'SyncCommiteeBits'
+	cpy.SyncCommiteeBits = append(cpy.SyncCommiteeBits[:0:0], cpy.SyncCommiteeBits...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the SyncAggregateMinimal objects have the same SSZ encoding
+func (s *SyncAggregateMinimal) EqualSSZ(other *SyncAggregateMinimal) bool {
+	if s == nil {
+		s = new(SyncAggregateMinimal)
+	}
+	if other == nil {
+		other = new(SyncAggregateMinimal)
+	}
+
+	// Field 'SyncCommiteeBits'
+	if !bytes.Equal(s.SyncCommiteeBits, other.SyncCommiteeBits) {
+		return false
+	}
+
+	// Field 'SyncCommiteeSignature'
+	if s.SyncCommiteeSignature != other.SyncCommiteeSignature {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the SyncAggregateMinimal objects
+func (s *SyncAggregateMinimal) DiffSSZ(other *SyncAggregateMinimal) []ssz.FieldDiff {
+	if s == nil {
+		s = new(SyncAggregateMinimal)
+	}
+	if other == nil {
+		other = new(SyncAggregateMinimal)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'SyncCommiteeBits'
+	if !bytes.Equal(s.SyncCommiteeBits, other.SyncCommiteeBits) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "SyncCommiteeBits", A: s.SyncCommiteeBits, B: other.SyncCommiteeBits})
+	}
+
+	// Field 'SyncCommiteeSignature'
+	if s.SyncCommiteeSignature != other.SyncCommiteeSignature {
+		diffs = append(diffs, ssz.FieldDiff{Path: "SyncCommiteeSignature", A: s.SyncCommiteeSignature, B: other.SyncCommiteeSignature})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the SyncAggregateMinimal object following the Beacon API conventions
+func (s *SyncAggregateMinimal) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		s = new(SyncAggregateMinimal)
+	}
+	dst := []byte{'{'}
+
+	// Field (0) 'SyncCommiteeBits'
+	dst = append(dst, "\"sync_committee_bits\":"...)
+	dst = ssz.MarshalJSONBytes(dst, s.SyncCommiteeBits)
+
+	// Field (1) 'SyncCommiteeSignature'
+	dst = append(dst, ",\"sync_committee_signature\":"...)
+	dst = ssz.MarshalJSONBytes(dst, s.SyncCommiteeSignature[:])
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the SyncAggregateMinimal object following the Beacon API conventions
+func (s *SyncAggregateMinimal) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'SyncCommiteeBits'
+	if s.SyncCommiteeBits, err = ssz.UnmarshalJSONBytes(fields["sync_committee_bits"], 4, 4); err != nil {
+		return ssz.WrapJSONError(err, "SyncCommiteeBits")
+	}
+
+	// Field (1) 'SyncCommiteeSignature'
+	{
+		val, err := ssz.UnmarshalJSONBytes(fields["sync_committee_signature"], 96, 96)
+		if err != nil {
+			return ssz.WrapJSONError(err, "SyncCommiteeSignature")
+		}
+		copy(s.SyncCommiteeSignature[:], val)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the SignedBeaconBlockMinimal object
+func (s *SignedBeaconBlockMinimal) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBeaconBlockMinimal object to a target array
+func (s *SignedBeaconBlockMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(100)
+
+	// Offset (0) 'Block'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.Block == nil {
+		s.Block = new(BeaconBlockMinimal)
+	}
+	offset += s.Block.SizeSSZ()
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	// Field (0) 'Block'
+	if dst, err = s.Block.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the SignedBeaconBlockMinimal object to a writer
+func (s *SignedBeaconBlockMinimal) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, s)
+}
+
+// MarshalSSZToEncoder ssz marshals the SignedBeaconBlockMinimal object to an encoder
+func (s *SignedBeaconBlockMinimal) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(100)
+
+	// Offset (0) 'Block'
+	enc.EncodeOffset(offset)
+	if s.Block == nil {
+		s.Block = new(BeaconBlockMinimal)
+	}
+	offset += s.Block.SizeSSZ()
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	enc.EncodeBytes(s.Signature)
+
+	// Field (0) 'Block'
+	if err = enc.EncodeObject(s.Block); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockMinimal object
+func (s *SignedBeaconBlockMinimal) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the SignedBeaconBlockMinimal object within the limits of the decode state
+func (s *SignedBeaconBlockMinimal) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "SignedBeaconBlockMinimal", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 100 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SignedBeaconBlockMinimal", 100, size)
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Block'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "SignedBeaconBlockMinimal", "Block", 0)
+	}
+
+	if o0 != 100 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 100, o0), "SignedBeaconBlockMinimal", "Block", 0)
+	}
+
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[4:100]))
+	}
+	s.Signature = append(s.Signature, buf[4:100]...)
+
+	// Field (0) 'Block'
+	{
+		buf = tail[o0:]
+		if s.Block == nil {
+			s.Block = new(BeaconBlockMinimal)
+		}
+		if err = ssz.UnmarshalWithState(s.Block, buf, st); err != nil {
+			return ssz.WrapDecodeError(err, "SignedBeaconBlockMinimal", "Block", o0)
+		}
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a SignedBeaconBlockMinimal object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (s *SignedBeaconBlockMinimal) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 100 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SignedBeaconBlockMinimal", 100, size)
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Block'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "SignedBeaconBlockMinimal", "Block", 0)
+	}
+
+	if o0 != 100 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 100, o0), "SignedBeaconBlockMinimal", "Block", 0)
+	}
+
+	// Field (0) 'Block'
+	{
+		buf = tail[o0:]
+		if err = (*BeaconBlockMinimal)(nil).ValidateSSZ(buf); err != nil {
+			return ssz.WrapDecodeError(err, "SignedBeaconBlockMinimal", "Block", o0)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the SignedBeaconBlockMinimal object from a reader with an encoded size of 'size' bytes
+func (s *SignedBeaconBlockMinimal) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, s)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the SignedBeaconBlockMinimal object from a decoder
+func (s *SignedBeaconBlockMinimal) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 100 {
+		return ssz.NewDecodeError(ssz.ErrSize, "SignedBeaconBlockMinimal", 100, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "SignedBeaconBlockMinimal", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(100)
+	if err != nil {
+		return err
+	}
+	var o0 uint64
+
+	// Offset (0) 'Block'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "SignedBeaconBlockMinimal", "Block", 0)
+	}
+
+	if o0 != 100 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 100, o0), "SignedBeaconBlockMinimal", "Block", 0)
+	}
+
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[4:100]))
+	}
+	s.Signature = append(s.Signature, buf[4:100]...)
+
+	// Field (0) 'Block'
+	{
+		if s.Block == nil {
+			s.Block = new(BeaconBlockMinimal)
+		}
+		if err = dec.DecodeObject(s.Block, size-o0); err != nil {
+			return ssz.WrapDecodeError(err, "SignedBeaconBlockMinimal", "Block", o0)
+		}
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockMinimal object
+func (s *SignedBeaconBlockMinimal) SizeSSZ() (size int) {
+	size = 100
+
+	// Field (0) 'Block'
+	if s.Block == nil {
+		s.Block = new(BeaconBlockMinimal)
+	}
+	size += s.Block.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBeaconBlockMinimal object
+func (s *SignedBeaconBlockMinimal) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBeaconBlockMinimal object with a hasher
+func (s *SignedBeaconBlockMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Block'
+	if err = s.Block.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootParallel ssz hashes the SignedBeaconBlockMinimal object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (s *SignedBeaconBlockMinimal) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return s.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the SignedBeaconBlockMinimal object like HashTreeRootParallel
+// with the Hashers of the pool
+func (s *SignedBeaconBlockMinimal) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Block'
+		func(hh *ssz.Hasher) (err error) {
+			if err = s.Block.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (1) 'Signature'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(s.Signature); size != 96 {
+				err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+				return
+			}
+			hh.PutBytes(s.Signature)
+			return
+		},
+	})
+}
+
+// SignedBeaconBlockMinimalView is a lazy view over the SSZ encoding of a SignedBeaconBlockMinimal object
+type SignedBeaconBlockMinimalView struct {
+	v ssz.View
+}
+
+// NewSignedBeaconBlockMinimalView creates a view over the SSZ encoding of a SignedBeaconBlockMinimal object
+func NewSignedBeaconBlockMinimalView(buf []byte) SignedBeaconBlockMinimalView {
+	return SignedBeaconBlockMinimalViewOf(ssz.NewView(buf))
+}
+
+// SignedBeaconBlockMinimalViewOf returns the view of the SignedBeaconBlockMinimal object encoded in v
+func SignedBeaconBlockMinimalViewOf(v ssz.View) SignedBeaconBlockMinimalView {
+	return SignedBeaconBlockMinimalView{v.Container(100, 0)}
+}
+
+// Raw returns the untyped view of the SignedBeaconBlockMinimal object
+func (v SignedBeaconBlockMinimalView) Raw() ssz.View {
+	return v.v
+}
+
+// SignedBeaconBlockMinimalListView is a lazy view over a list of SignedBeaconBlockMinimal objects
+type SignedBeaconBlockMinimalListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th SignedBeaconBlockMinimal object of the list
+func (l SignedBeaconBlockMinimalListView) At(i int) SignedBeaconBlockMinimalView {
+	return SignedBeaconBlockMinimalViewOf(l.ListView.At(i))
+}
+
+// Block returns the Block field of the SignedBeaconBlockMinimal object
+func (v SignedBeaconBlockMinimalView) Block() BeaconBlockMinimalView {
+	return BeaconBlockMinimalViewOf(v.v.DynamicField(0, 0))
+}
+
+// Signature returns the Signature field of the SignedBeaconBlockMinimal object
+func (v SignedBeaconBlockMinimalView) Signature() ([]byte, error) {
+	return v.v.Field(4, 100).Bytes()
+}
+
+// Copy returns a deep copy of the SignedBeaconBlockMinimal object
+func (s *SignedBeaconBlockMinimal) Copy() *SignedBeaconBlockMinimal {
+	if s == nil {
+		return nil
+	}
+	cpy := new(SignedBeaconBlockMinimal)
+	*cpy = *s
+
+	// Field 'Block'
+	cpy.Block = cpy.Block.Copy()
+
+	// Field 'Signature'
+	cpy.Signature = append(cpy.Signature[:0:0], cpy.Signature...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the SignedBeaconBlockMinimal objects have the same SSZ encoding
+func (s *SignedBeaconBlockMinimal) EqualSSZ(other *SignedBeaconBlockMinimal) bool {
+	if s == nil {
+		s = new(SignedBeaconBlockMinimal)
+	}
+	if other == nil {
+		other = new(SignedBeaconBlockMinimal)
+	}
+
+	// Field 'Block'
+	if !s.Block.EqualSSZ(other.Block) {
+		return false
+	}
+
+	// Field 'Signature'
+	if !bytes.Equal(s.Signature, other.Signature) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the SignedBeaconBlockMinimal objects
+func (s *SignedBeaconBlockMinimal) DiffSSZ(other *SignedBeaconBlockMinimal) []ssz.FieldDiff {
+	if s == nil {
+		s = new(SignedBeaconBlockMinimal)
+	}
+	if other == nil {
+		other = new(SignedBeaconBlockMinimal)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Block'
+	diffs = ssz.AppendDiffs(diffs, "Block", s.Block.DiffSSZ(other.Block))
+
+	// Field 'Signature'
+	if !bytes.Equal(s.Signature, other.Signature) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Signature", A: s.Signature, B: other.Signature})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the SignedBeaconBlockMinimal object following the Beacon API conventions
+func (s *SignedBeaconBlockMinimal) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		s = new(SignedBeaconBlockMinimal)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'Block'
+	dst = append(dst, "\"message\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, s.Block); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Signature'
+	dst = append(dst, ",\"signature\":"...)
+	dst = ssz.MarshalJSONBytes(dst, s.Signature)
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the SignedBeaconBlockMinimal object following the Beacon API conventions
+func (s *SignedBeaconBlockMinimal) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Block'
+	if s.Block == nil {
+		s.Block = new(BeaconBlockMinimal)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["message"], s.Block); err != nil {
+		return ssz.WrapJSONError(err, "Block")
+	}
+
+	// Field (1) 'Signature'
+	if s.Signature, err = ssz.UnmarshalJSONBytes(fields["signature"], 96, 96); err != nil {
+		return ssz.WrapJSONError(err, "Signature")
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockBodyMinimal object
+func (b *BeaconBlockBodyMinimal) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockBodyMinimal object to a target array
+func (b *BeaconBlockBodyMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(320)
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
+	}
+	dst = append(dst, b.RandaoReveal...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	dst = append(dst, b.Graffiti[:]...)
+
+	// Offset (3) 'ProposerSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ProposerSlashings) * 416
+
+	// Offset (4) 'AttesterSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Offset (5) 'Attestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Offset (6) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1240
+
+	// Offset (7) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.VoluntaryExits) * 112
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregateMinimal)
+	}
+	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'ProposerSlashings'
+	if size := len(b.ProposerSlashings); size > 16 {
+		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if size := len(b.AttesterSlashings); size > 2 {
+		err = ssz.ErrListTooBigFn("--.AttesterSlashings", size, 2)
+		return
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if size := len(b.Attestations); size > 128 {
+		err = ssz.ErrListTooBigFn("--.Attestations", size, 128)
+		return
+	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if size := len(b.Deposits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.Deposits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if size := len(b.VoluntaryExits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the BeaconBlockBodyMinimal object to a writer
+func (b *BeaconBlockBodyMinimal) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, b)
+}
+
+// MarshalSSZToEncoder ssz marshals the BeaconBlockBodyMinimal object to an encoder
+func (b *BeaconBlockBodyMinimal) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(320)
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
+	}
+	enc.EncodeBytes(b.RandaoReveal)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = enc.EncodeObject(b.Eth1Data); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	enc.EncodeBytes(b.Graffiti[:])
+
+	// Offset (3) 'ProposerSlashings'
+	enc.EncodeOffset(offset)
+	offset += len(b.ProposerSlashings) * 416
+
+	// Offset (4) 'AttesterSlashings'
+	enc.EncodeOffset(offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Offset (5) 'Attestations'
+	enc.EncodeOffset(offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Offset (6) 'Deposits'
+	enc.EncodeOffset(offset)
+	offset += len(b.Deposits) * 1240
+
+	// Offset (7) 'VoluntaryExits'
+	enc.EncodeOffset(offset)
+	offset += len(b.VoluntaryExits) * 112
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregateMinimal)
+	}
+	if err = enc.EncodeObject(b.SyncAggregate); err != nil {
+		return
+	}
+
+	// Field (3) 'ProposerSlashings'
+	if size := len(b.ProposerSlashings); size > 16 {
+		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if err = enc.EncodeObject(b.ProposerSlashings[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if size := len(b.AttesterSlashings); size > 2 {
+		err = ssz.ErrListTooBigFn("--.AttesterSlashings", size, 2)
+		return
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			enc.EncodeOffset(offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if err = enc.EncodeObject(b.AttesterSlashings[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if size := len(b.Attestations); size > 128 {
+		err = ssz.ErrListTooBigFn("--.Attestations", size, 128)
+		return
+	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			enc.EncodeOffset(offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if err = enc.EncodeObject(b.Attestations[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if size := len(b.Deposits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.Deposits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if err = enc.EncodeObject(b.Deposits[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if size := len(b.VoluntaryExits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if err = enc.EncodeObject(b.VoluntaryExits[ii]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyMinimal object
+func (b *BeaconBlockBodyMinimal) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the BeaconBlockBodyMinimal object within the limits of the decode state
+func (b *BeaconBlockBodyMinimal) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 320 {
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconBlockBodyMinimal", 320, size)
+	}
+
+	tail := buf
+	var o3, o4, o5, o6, o7 uint64
+
+	// Field (0) 'RandaoReveal'
+	if cap(b.RandaoReveal) == 0 {
+		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+	}
+	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = ssz.UnmarshalWithState(b.Eth1Data, buf[96:168], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "Eth1Data", 96)
+	}
+
+	// Field (2) 'Graffiti'
+	copy(b.Graffiti[:], buf[168:200])
+
+	// Offset (3) 'ProposerSlashings'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBodyMinimal", "ProposerSlashings", 200)
+	}
+
+	if o3 != 320 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 320, o3), "BeaconBlockBodyMinimal", "ProposerSlashings", 200)
+	}
+
+	// Offset (4) 'AttesterSlashings'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBodyMinimal", "AttesterSlashings", 204)
+	}
+
+	// Offset (5) 'Attestations'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBodyMinimal", "Attestations", 208)
+	}
+
+	// Offset (6) 'Deposits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBodyMinimal", "Deposits", 212)
+	}
+
+	// Offset (7) 'VoluntaryExits'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBodyMinimal", "VoluntaryExits", 216)
+	}
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregateMinimal)
+	}
+	if err = ssz.UnmarshalWithState(b.SyncAggregate, buf[220:320], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "SyncAggregate", 220)
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		buf = tail[o3:o4]
+		num, err := ssz.DivideInt2(len(buf), 416, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "ProposerSlashings", o3)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(ProposerSlashing{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "ProposerSlashings", o3)
+		}
+		b.ProposerSlashings = make([]*ProposerSlashing, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ProposerSlashings[ii] == nil {
+				b.ProposerSlashings[ii] = new(ProposerSlashing)
+			}
+			if err = ssz.UnmarshalWithState(b.ProposerSlashings[ii], buf[ii*416:(ii+1)*416], st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*416)), "BeaconBlockBodyMinimal", "ProposerSlashings", o3)
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DecodeDynamicLength(buf, 2)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "AttesterSlashings", o4)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(AttesterSlashing{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "AttesterSlashings", o4)
+		}
+		b.AttesterSlashings = make([]*AttesterSlashing, num)
+		err = st.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.AttesterSlashings[indx] == nil {
+				b.AttesterSlashings[indx] = new(AttesterSlashing)
+			}
+			if err = ssz.UnmarshalWithState(b.AttesterSlashings[indx], buf, st); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "AttesterSlashings", o4)
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		buf = tail[o5:o6]
+		num, err := ssz.DecodeDynamicLength(buf, 128)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "Attestations", o5)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Attestation{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "Attestations", o5)
+		}
+		b.Attestations = make([]*Attestation, num)
+		err = st.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.Attestations[indx] == nil {
+				b.Attestations[indx] = new(Attestation)
+			}
+			if err = ssz.UnmarshalWithState(b.Attestations[indx], buf, st); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "Attestations", o5)
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		buf = tail[o6:o7]
+		num, err := ssz.DivideInt2(len(buf), 1240, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "Deposits", o6)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Deposit{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "Deposits", o6)
+		}
+		b.Deposits = make([]*Deposit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Deposits[ii] == nil {
+				b.Deposits[ii] = new(Deposit)
+			}
+			if err = ssz.UnmarshalWithState(b.Deposits[ii], buf[ii*1240:(ii+1)*1240], st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*1240)), "BeaconBlockBodyMinimal", "Deposits", o6)
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		buf = tail[o7:]
+		num, err := ssz.DivideInt2(len(buf), 112, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "VoluntaryExits", o7)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(SignedVoluntaryExit{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "VoluntaryExits", o7)
+		}
+		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.VoluntaryExits[ii] == nil {
+				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+			}
+			if err = ssz.UnmarshalWithState(b.VoluntaryExits[ii], buf[ii*112:(ii+1)*112], st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*112)), "BeaconBlockBodyMinimal", "VoluntaryExits", o7)
+			}
+		}
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a BeaconBlockBodyMinimal object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (b *BeaconBlockBodyMinimal) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 320 {
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconBlockBodyMinimal", 320, size)
+	}
+
+	tail := buf
+	var o3, o4, o5, o6, o7 uint64
+
+	// Field (1) 'Eth1Data'
+	if err = (*Eth1Data)(nil).ValidateSSZ(buf[96:168]); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "Eth1Data", 96)
+	}
+
+	// Offset (3) 'ProposerSlashings'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBodyMinimal", "ProposerSlashings", 200)
+	}
+
+	if o3 != 320 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 320, o3), "BeaconBlockBodyMinimal", "ProposerSlashings", 200)
+	}
+
+	// Offset (4) 'AttesterSlashings'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBodyMinimal", "AttesterSlashings", 204)
+	}
+
+	// Offset (5) 'Attestations'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBodyMinimal", "Attestations", 208)
+	}
+
+	// Offset (6) 'Deposits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBodyMinimal", "Deposits", 212)
+	}
+
+	// Offset (7) 'VoluntaryExits'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBodyMinimal", "VoluntaryExits", 216)
+	}
+
+	// Field (8) 'SyncAggregate'
+	if err = (*SyncAggregateMinimal)(nil).ValidateSSZ(buf[220:320]); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "SyncAggregate", 220)
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		buf = tail[o3:o4]
+		num, err := ssz.DivideInt2(len(buf), 416, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "ProposerSlashings", o3)
+		}
+
+		for ii := 0; ii < num; ii++ {
+			if err = (*ProposerSlashing)(nil).ValidateSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*416)), "BeaconBlockBodyMinimal", "ProposerSlashings", o3)
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DecodeDynamicLength(buf, 2)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "AttesterSlashings", o4)
+		}
+
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if err = (*AttesterSlashing)(nil).ValidateSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "AttesterSlashings", o4)
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		buf = tail[o5:o6]
+		num, err := ssz.DecodeDynamicLength(buf, 128)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "Attestations", o5)
+		}
 
-// UnmarshalSSZ ssz unmarshals the SyncAggregate object
-func (s *SyncAggregate) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 224 {
-		return ssz.ErrSize
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if err = (*Attestation)(nil).ValidateSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "Attestations", o5)
+		}
 	}
 
-	// Field (0) 'SyncCommiteeBits'
-	if cap(s.SyncCommiteeBits) == 0 {
-		s.SyncCommiteeBits = make([]byte, 0, len(buf[0:128]))
+	// Field (6) 'Deposits'
+	{
+		buf = tail[o6:o7]
+		num, err := ssz.DivideInt2(len(buf), 1240, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "Deposits", o6)
+		}
+
+		for ii := 0; ii < num; ii++ {
+			if err = (*Deposit)(nil).ValidateSSZ(buf[ii*1240 : (ii+1)*1240]); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*1240)), "BeaconBlockBodyMinimal", "Deposits", o6)
+			}
+		}
 	}
-	s.SyncCommiteeBits = append(s.SyncCommiteeBits, buf[0:128]...)
 
-	// Field (1) 'SyncCommiteeSignature'
-	copy(s.SyncCommiteeSignature[:], buf[128:224])
+	// Field (7) 'VoluntaryExits'
+	{
+		buf = tail[o7:]
+		num, err := ssz.DivideInt2(len(buf), 112, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "VoluntaryExits", o7)
+		}
 
+		for ii := 0; ii < num; ii++ {
+			if err = (*SignedVoluntaryExit)(nil).ValidateSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*112)), "BeaconBlockBodyMinimal", "VoluntaryExits", o7)
+			}
+		}
+	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SyncAggregate object
-func (s *SyncAggregate) SizeSSZ() (size int) {
-	size = 224
-	return
-}
-
-// HashTreeRoot ssz hashes the SyncAggregate object
-func (s *SyncAggregate) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+// UnmarshalSSZFromReader ssz unmarshals the BeaconBlockBodyMinimal object from a reader with an encoded size of 'size' bytes
+func (b *BeaconBlockBodyMinimal) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, b)
 }
 
-// HashTreeRootWith ssz hashes the SyncAggregate object with a hasher
-func (s *SyncAggregate) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+// UnmarshalSSZFromDecoder ssz unmarshals the BeaconBlockBodyMinimal object from a decoder
+func (b *BeaconBlockBodyMinimal) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 320 {
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconBlockBodyMinimal", 320, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(320)
+	if err != nil {
+		return err
+	}
+	var o3, o4, o5, o6, o7 uint64
 
-	// Field (0) 'SyncCommiteeBits'
-	if size := len(s.SyncCommiteeBits); size != 128 {
-		err = ssz.ErrBytesLengthFn("--.SyncCommiteeBits", size, 128)
-		return
+	// Field (0) 'RandaoReveal'
+	if cap(b.RandaoReveal) == 0 {
+		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
 	}
-	hh.PutBytes(s.SyncCommiteeBits)
+	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
 
-	// Field (1) 'SyncCommiteeSignature'
-	hh.PutBytes(s.SyncCommiteeSignature[:])
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = ssz.UnmarshalWithState(b.Eth1Data, buf[96:168], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "Eth1Data", 96)
+	}
 
-	hh.Merkleize(indx)
-	return
-}
+	// Field (2) 'Graffiti'
+	copy(b.Graffiti[:], buf[168:200])
 
-// MarshalSSZ ssz marshals the SyncCommitteeMinimal object
-func (s *SyncCommitteeMinimal) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
-}
+	// Offset (3) 'ProposerSlashings'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBodyMinimal", "ProposerSlashings", 200)
+	}
 
-// MarshalSSZTo ssz marshals the SyncCommitteeMinimal object to a target array
-func (s *SyncCommitteeMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+	if o3 != 320 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 320, o3), "BeaconBlockBodyMinimal", "ProposerSlashings", 200)
+	}
 
-	// Field (0) 'PubKeys'
-	if size := len(s.PubKeys); size != 32 {
-		err = ssz.ErrVectorLengthFn("--.PubKeys", size, 32)
-		return
+	// Offset (4) 'AttesterSlashings'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBodyMinimal", "AttesterSlashings", 204)
 	}
-	for ii := 0; ii < 32; ii++ {
-		if size := len(s.PubKeys[ii]); size != 48 {
-			err = ssz.ErrBytesLengthFn("--.PubKeys[ii]", size, 48)
-			return
-		}
-		dst = append(dst, s.PubKeys[ii]...)
+
+	// Offset (5) 'Attestations'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBodyMinimal", "Attestations", 208)
 	}
 
-	// Field (1) 'PubKeyAggregates'
-	for ii := 0; ii < 2; ii++ {
-		dst = append(dst, s.PubKeyAggregates[ii][:]...)
+	// Offset (6) 'Deposits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBodyMinimal", "Deposits", 212)
 	}
 
-	return
-}
+	// Offset (7) 'VoluntaryExits'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockBodyMinimal", "VoluntaryExits", 216)
+	}
 
-// UnmarshalSSZ ssz unmarshals the SyncCommitteeMinimal object
-func (s *SyncCommitteeMinimal) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 1632 {
-		return ssz.ErrSize
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregateMinimal)
+	}
+	if err = ssz.UnmarshalWithState(b.SyncAggregate, buf[220:320], st); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "SyncAggregate", 220)
 	}
 
-	// Field (0) 'PubKeys'
-	s.PubKeys = make([][]byte, 32)
-	for ii := 0; ii < 32; ii++ {
-		if cap(s.PubKeys[ii]) == 0 {
-			s.PubKeys[ii] = make([]byte, 0, len(buf[0:1536][ii*48:(ii+1)*48]))
+	// Field (3) 'ProposerSlashings'
+	{
+		num, err := ssz.DivideInt2(int(o4-o3), 416, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "ProposerSlashings", o3)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(ProposerSlashing{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "ProposerSlashings", o3)
+		}
+		b.ProposerSlashings = make([]*ProposerSlashing, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(416); err != nil {
+				return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "ProposerSlashings", o3)
+			}
+			if b.ProposerSlashings[ii] == nil {
+				b.ProposerSlashings[ii] = new(ProposerSlashing)
+			}
+			if err = ssz.UnmarshalWithState(b.ProposerSlashings[ii], buf, st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*416)), "BeaconBlockBodyMinimal", "ProposerSlashings", o3)
+			}
 		}
-		s.PubKeys[ii] = append(s.PubKeys[ii], buf[0:1536][ii*48:(ii+1)*48]...)
 	}
 
-	// Field (1) 'PubKeyAggregates'
-
-	for ii := 0; ii < 2; ii++ {
-		copy(s.PubKeyAggregates[ii][:], buf[1536:1632][ii*48:(ii+1)*48])
+	// Field (4) 'AttesterSlashings'
+	{
+		sizes, err := dec.ReadDynamicOffsets(o5-o4, 2)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "AttesterSlashings", o4)
+		}
+		num := len(sizes)
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(AttesterSlashing{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "AttesterSlashings", o4)
+		}
+		b.AttesterSlashings = make([]*AttesterSlashing, num)
+		if err = st.Enter(); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "AttesterSlashings", o4)
+		}
+		pos := uint64(4 * num)
+		for indx := 0; indx < num; indx++ {
+			{
+				if b.AttesterSlashings[indx] == nil {
+					b.AttesterSlashings[indx] = new(AttesterSlashing)
+				}
+				if err = dec.DecodeObject(b.AttesterSlashings[indx], sizes[indx]); err != nil {
+					return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, indx, pos), "BeaconBlockBodyMinimal", "AttesterSlashings", o4)
+				}
+			}
+			pos += sizes[indx]
+		}
+		st.Leave()
 	}
 
-	return err
-}
-
-// SizeSSZ returns the ssz encoded size in bytes for the SyncCommitteeMinimal object
-func (s *SyncCommitteeMinimal) SizeSSZ() (size int) {
-	size = 1632
-	return
-}
-
-// HashTreeRoot ssz hashes the SyncCommitteeMinimal object
-func (s *SyncCommitteeMinimal) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
-}
-
-// HashTreeRootWith ssz hashes the SyncCommitteeMinimal object with a hasher
-func (s *SyncCommitteeMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
-
-	// Field (0) 'PubKeys'
+	// Field (5) 'Attestations'
 	{
-		if size := len(s.PubKeys); size != 32 {
-			err = ssz.ErrVectorLengthFn("--.PubKeys", size, 32)
-			return
+		sizes, err := dec.ReadDynamicOffsets(o6-o5, 128)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "Attestations", o5)
 		}
-		subIndx := hh.Index()
-		for _, i := range s.PubKeys {
-			if len(i) != 48 {
-				err = ssz.ErrBytesLength
-				return
+		num := len(sizes)
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Attestation{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "Attestations", o5)
+		}
+		b.Attestations = make([]*Attestation, num)
+		if err = st.Enter(); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "Attestations", o5)
+		}
+		pos := uint64(4 * num)
+		for indx := 0; indx < num; indx++ {
+			{
+				if b.Attestations[indx] == nil {
+					b.Attestations[indx] = new(Attestation)
+				}
+				if err = dec.DecodeObject(b.Attestations[indx], sizes[indx]); err != nil {
+					return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, indx, pos), "BeaconBlockBodyMinimal", "Attestations", o5)
+				}
 			}
-			hh.PutBytes(i)
+			pos += sizes[indx]
 		}
-		hh.Merkleize(subIndx)
+		st.Leave()
 	}
 
-	// Field (1) 'PubKeyAggregates'
+	// Field (6) 'Deposits'
 	{
-		subIndx := hh.Index()
-		for _, i := range s.PubKeyAggregates {
-			hh.PutBytes(i[:])
+		num, err := ssz.DivideInt2(int(o7-o6), 1240, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "Deposits", o6)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Deposit{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "Deposits", o6)
+		}
+		b.Deposits = make([]*Deposit, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(1240); err != nil {
+				return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "Deposits", o6)
+			}
+			if b.Deposits[ii] == nil {
+				b.Deposits[ii] = new(Deposit)
+			}
+			if err = ssz.UnmarshalWithState(b.Deposits[ii], buf, st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*1240)), "BeaconBlockBodyMinimal", "Deposits", o6)
+			}
 		}
-		hh.Merkleize(subIndx)
 	}
 
-	hh.Merkleize(indx)
-	return
-}
-
-// MarshalSSZ ssz marshals the SyncAggregateMinimal object
-func (s *SyncAggregateMinimal) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
-}
-
-// MarshalSSZTo ssz marshals the SyncAggregateMinimal object to a target array
-func (s *SyncAggregateMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-
-	// Field (0) 'SyncCommiteeBits'
-	if size := len(s.SyncCommiteeBits); size != 4 {
-		err = ssz.ErrBytesLengthFn("--.SyncCommiteeBits", size, 4)
-		return
+	// Field (7) 'VoluntaryExits'
+	{
+		num, err := ssz.DivideInt2(int(size-o7), 112, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "VoluntaryExits", o7)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(SignedVoluntaryExit{}))); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "VoluntaryExits", o7)
+		}
+		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(112); err != nil {
+				return ssz.WrapDecodeError(err, "BeaconBlockBodyMinimal", "VoluntaryExits", o7)
+			}
+			if b.VoluntaryExits[ii] == nil {
+				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+			}
+			if err = ssz.UnmarshalWithState(b.VoluntaryExits[ii], buf, st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*112)), "BeaconBlockBodyMinimal", "VoluntaryExits", o7)
+			}
+		}
 	}
-	dst = append(dst, s.SyncCommiteeBits...)
+	return nil
+}
 
-	// Field (1) 'SyncCommiteeSignature'
-	dst = append(dst, s.SyncCommiteeSignature[:]...)
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyMinimal object
+func (b *BeaconBlockBodyMinimal) SizeSSZ() (size int) {
+	size = 320
 
-	return
-}
+	// Field (3) 'ProposerSlashings'
+	size += len(b.ProposerSlashings) * 416
 
-// UnmarshalSSZ ssz unmarshals the SyncAggregateMinimal object
-func (s *SyncAggregateMinimal) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 100 {
-		return ssz.ErrSize
+	// Field (4) 'AttesterSlashings'
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		size += 4
+		size += b.AttesterSlashings[ii].SizeSSZ()
 	}
 
-	// Field (0) 'SyncCommiteeBits'
-	if cap(s.SyncCommiteeBits) == 0 {
-		s.SyncCommiteeBits = make([]byte, 0, len(buf[0:4]))
+	// Field (5) 'Attestations'
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		size += 4
+		size += b.Attestations[ii].SizeSSZ()
 	}
-	s.SyncCommiteeBits = append(s.SyncCommiteeBits, buf[0:4]...)
 
-	// Field (1) 'SyncCommiteeSignature'
-	copy(s.SyncCommiteeSignature[:], buf[4:100])
+	// Field (6) 'Deposits'
+	size += len(b.Deposits) * 1240
 
-	return err
-}
+	// Field (7) 'VoluntaryExits'
+	size += len(b.VoluntaryExits) * 112
 
-// SizeSSZ returns the ssz encoded size in bytes for the SyncAggregateMinimal object
-func (s *SyncAggregateMinimal) SizeSSZ() (size int) {
-	size = 100
 	return
 }
 
-// HashTreeRoot ssz hashes the SyncAggregateMinimal object
-func (s *SyncAggregateMinimal) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+// HashTreeRoot ssz hashes the BeaconBlockBodyMinimal object
+func (b *BeaconBlockBodyMinimal) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the SyncAggregateMinimal object with a hasher
-func (s *SyncAggregateMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the BeaconBlockBodyMinimal object with a hasher
+func (b *BeaconBlockBodyMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'SyncCommiteeBits'
-	if size := len(s.SyncCommiteeBits); size != 4 {
-		err = ssz.ErrBytesLengthFn("--.SyncCommiteeBits", size, 4)
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
 		return
 	}
-	hh.PutBytes(s.SyncCommiteeBits)
+	hh.PutBytes(b.RandaoReveal)
 
-	// Field (1) 'SyncCommiteeSignature'
-	hh.PutBytes(s.SyncCommiteeSignature[:])
+	// Field (1) 'Eth1Data'
+	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
 
-	hh.Merkleize(indx)
-	return
-}
+	// Field (2) 'Graffiti'
+	hh.PutBytes(b.Graffiti[:])
 
-// MarshalSSZ ssz marshals the SignedBeaconBlockMinimal object
-func (s *SignedBeaconBlockMinimal) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
-}
+	// Field (3) 'ProposerSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ProposerSlashings))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = hh.HashElements(len(b.ProposerSlashings), func(indx int, hh *ssz.Hasher) error {
+			return b.ProposerSlashings[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
 
-// MarshalSSZTo ssz marshals the SignedBeaconBlockMinimal object to a target array
-func (s *SignedBeaconBlockMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(100)
+	// Field (4) 'AttesterSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.AttesterSlashings))
+		if num > 2 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = hh.HashElements(len(b.AttesterSlashings), func(indx int, hh *ssz.Hasher) error {
+			return b.AttesterSlashings[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 2)
+	}
 
-	// Offset (0) 'Block'
-	dst = ssz.WriteOffset(dst, offset)
-	if s.Block == nil {
-		s.Block = new(BeaconBlockMinimal)
+	// Field (5) 'Attestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Attestations))
+		if num > 128 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = hh.HashElements(len(b.Attestations), func(indx int, hh *ssz.Hasher) error {
+			return b.Attestations[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 128)
 	}
-	offset += s.Block.SizeSSZ()
 
-	// Field (1) 'Signature'
-	if size := len(s.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
-		return
+	// Field (6) 'Deposits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Deposits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = hh.HashElements(len(b.Deposits), func(indx int, hh *ssz.Hasher) error {
+			return b.Deposits[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
-	dst = append(dst, s.Signature...)
 
-	// Field (0) 'Block'
-	if dst, err = s.Block.MarshalSSZTo(dst); err != nil {
+	// Field (7) 'VoluntaryExits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.VoluntaryExits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = hh.HashElements(len(b.VoluntaryExits), func(indx int, hh *ssz.Hasher) error {
+			return b.VoluntaryExits[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (8) 'SyncAggregate'
+	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
+	hh.Merkleize(indx)
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockMinimal object
-func (s *SignedBeaconBlockMinimal) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 100 {
-		return ssz.ErrSize
-	}
+// HashTreeRootParallel ssz hashes the BeaconBlockBodyMinimal object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (b *BeaconBlockBodyMinimal) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return b.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
 
-	tail := buf
-	var o0 uint64
+// HashTreeRootParallelWithPool ssz hashes the BeaconBlockBodyMinimal object like HashTreeRootParallel
+// with the Hashers of the pool
+func (b *BeaconBlockBodyMinimal) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'RandaoReveal'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(b.RandaoReveal); size != 96 {
+				err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+				return
+			}
+			hh.PutBytes(b.RandaoReveal)
+			return
+		},
+		// Field (1) 'Eth1Data'
+		func(hh *ssz.Hasher) (err error) {
+			if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+		// Field (2) 'Graffiti'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutBytes(b.Graffiti[:])
+			return
+		},
+		// Field (3) 'ProposerSlashings'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				subIndx := hh.Index()
+				num := uint64(len(b.ProposerSlashings))
+				if num > 16 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				if err = hh.HashElements(len(b.ProposerSlashings), func(indx int, hh *ssz.Hasher) error {
+					return b.ProposerSlashings[indx].HashTreeRootWith(hh)
+				}); err != nil {
+					return
+				}
+				hh.MerkleizeWithMixin(subIndx, num, 16)
+			}
+			return
+		},
+		// Field (4) 'AttesterSlashings'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				subIndx := hh.Index()
+				num := uint64(len(b.AttesterSlashings))
+				if num > 2 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				if err = hh.HashElements(len(b.AttesterSlashings), func(indx int, hh *ssz.Hasher) error {
+					return b.AttesterSlashings[indx].HashTreeRootWith(hh)
+				}); err != nil {
+					return
+				}
+				hh.MerkleizeWithMixin(subIndx, num, 2)
+			}
+			return
+		},
+		// Field (5) 'Attestations'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				subIndx := hh.Index()
+				num := uint64(len(b.Attestations))
+				if num > 128 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				if err = hh.HashElements(len(b.Attestations), func(indx int, hh *ssz.Hasher) error {
+					return b.Attestations[indx].HashTreeRootWith(hh)
+				}); err != nil {
+					return
+				}
+				hh.MerkleizeWithMixin(subIndx, num, 128)
+			}
+			return
+		},
+		// Field (6) 'Deposits'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				subIndx := hh.Index()
+				num := uint64(len(b.Deposits))
+				if num > 16 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				if err = hh.HashElements(len(b.Deposits), func(indx int, hh *ssz.Hasher) error {
+					return b.Deposits[indx].HashTreeRootWith(hh)
+				}); err != nil {
+					return
+				}
+				hh.MerkleizeWithMixin(subIndx, num, 16)
+			}
+			return
+		},
+		// Field (7) 'VoluntaryExits'
+		func(hh *ssz.Hasher) (err error) {
+			{
+				subIndx := hh.Index()
+				num := uint64(len(b.VoluntaryExits))
+				if num > 16 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				if err = hh.HashElements(len(b.VoluntaryExits), func(indx int, hh *ssz.Hasher) error {
+					return b.VoluntaryExits[indx].HashTreeRootWith(hh)
+				}); err != nil {
+					return
+				}
+				hh.MerkleizeWithMixin(subIndx, num, 16)
+			}
+			return
+		},
+		// Field (8) 'SyncAggregate'
+		func(hh *ssz.Hasher) (err error) {
+			if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+	})
+}
 
-	// Offset (0) 'Block'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
-	}
+// BeaconBlockBodyMinimalView is a lazy view over the SSZ encoding of a BeaconBlockBodyMinimal object
+type BeaconBlockBodyMinimalView struct {
+	v ssz.View
+}
 
-	if o0 < 100 {
-		return ssz.ErrInvalidVariableOffset
-	}
+// NewBeaconBlockBodyMinimalView creates a view over the SSZ encoding of a BeaconBlockBodyMinimal object
+func NewBeaconBlockBodyMinimalView(buf []byte) BeaconBlockBodyMinimalView {
+	return BeaconBlockBodyMinimalViewOf(ssz.NewView(buf))
+}
 
-	// Field (1) 'Signature'
-	if cap(s.Signature) == 0 {
-		s.Signature = make([]byte, 0, len(buf[4:100]))
-	}
-	s.Signature = append(s.Signature, buf[4:100]...)
+// BeaconBlockBodyMinimalViewOf returns the view of the BeaconBlockBodyMinimal object encoded in v
+func BeaconBlockBodyMinimalViewOf(v ssz.View) BeaconBlockBodyMinimalView {
+	return BeaconBlockBodyMinimalView{v.Container(320, 200)}
+}
 
-	// Field (0) 'Block'
-	{
-		buf = tail[o0:]
-		if s.Block == nil {
-			s.Block = new(BeaconBlockMinimal)
-		}
-		if err = s.Block.UnmarshalSSZ(buf); err != nil {
-			return err
-		}
-	}
-	return err
+// Raw returns the untyped view of the BeaconBlockBodyMinimal object
+func (v BeaconBlockBodyMinimalView) Raw() ssz.View {
+	return v.v
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockMinimal object
-func (s *SignedBeaconBlockMinimal) SizeSSZ() (size int) {
-	size = 100
+// BeaconBlockBodyMinimalListView is a lazy view over a list of BeaconBlockBodyMinimal objects
+type BeaconBlockBodyMinimalListView struct {
+	ssz.ListView
+}
 
-	// Field (0) 'Block'
-	if s.Block == nil {
-		s.Block = new(BeaconBlockMinimal)
-	}
-	size += s.Block.SizeSSZ()
+// At returns the view of the i-th BeaconBlockBodyMinimal object of the list
+func (l BeaconBlockBodyMinimalListView) At(i int) BeaconBlockBodyMinimalView {
+	return BeaconBlockBodyMinimalViewOf(l.ListView.At(i))
+}
 
-	return
+// RandaoReveal returns the RandaoReveal field of the BeaconBlockBodyMinimal object
+func (v BeaconBlockBodyMinimalView) RandaoReveal() ([]byte, error) {
+	return v.v.Field(0, 96).Bytes()
 }
 
-// HashTreeRoot ssz hashes the SignedBeaconBlockMinimal object
-func (s *SignedBeaconBlockMinimal) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+// Eth1Data returns the Eth1Data field of the BeaconBlockBodyMinimal object
+func (v BeaconBlockBodyMinimalView) Eth1Data() Eth1DataView {
+	return Eth1DataViewOf(v.v.Field(96, 168))
 }
 
-// HashTreeRootWith ssz hashes the SignedBeaconBlockMinimal object with a hasher
-func (s *SignedBeaconBlockMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+// Graffiti returns the Graffiti field of the BeaconBlockBodyMinimal object
+func (v BeaconBlockBodyMinimalView) Graffiti() ([]byte, error) {
+	return v.v.Field(168, 200).Bytes()
+}
 
-	// Field (0) 'Block'
-	if err = s.Block.HashTreeRootWith(hh); err != nil {
-		return
-	}
+// ProposerSlashings returns the ProposerSlashings field of the BeaconBlockBodyMinimal object
+func (v BeaconBlockBodyMinimalView) ProposerSlashings() ProposerSlashingListView {
+	return ProposerSlashingListView{v.v.DynamicField(200, 204).List(416, 16)}
+}
 
-	// Field (1) 'Signature'
-	if size := len(s.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
-		return
-	}
-	hh.PutBytes(s.Signature)
+// AttesterSlashings returns the AttesterSlashings field of the BeaconBlockBodyMinimal object
+func (v BeaconBlockBodyMinimalView) AttesterSlashings() AttesterSlashingListView {
+	return AttesterSlashingListView{v.v.DynamicField(204, 208).List(0, 2)}
+}
 
-	hh.Merkleize(indx)
-	return
+// Attestations returns the Attestations field of the BeaconBlockBodyMinimal object
+func (v BeaconBlockBodyMinimalView) Attestations() AttestationListView {
+	return AttestationListView{v.v.DynamicField(208, 212).List(0, 128)}
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockBodyMinimal object
-func (b *BeaconBlockBodyMinimal) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+// Deposits returns the Deposits field of the BeaconBlockBodyMinimal object
+func (v BeaconBlockBodyMinimalView) Deposits() DepositListView {
+	return DepositListView{v.v.DynamicField(212, 216).List(1240, 16)}
 }
 
-// MarshalSSZTo ssz marshals the BeaconBlockBodyMinimal object to a target array
-func (b *BeaconBlockBodyMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(320)
+// VoluntaryExits returns the VoluntaryExits field of the BeaconBlockBodyMinimal object
+func (v BeaconBlockBodyMinimalView) VoluntaryExits() SignedVoluntaryExitListView {
+	return SignedVoluntaryExitListView{v.v.DynamicField(216, 0).List(112, 16)}
+}
 
-	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
-		return
-	}
-	dst = append(dst, b.RandaoReveal...)
+// SyncAggregate returns the SyncAggregate field of the BeaconBlockBodyMinimal object
+func (v BeaconBlockBodyMinimalView) SyncAggregate() SyncAggregateMinimalView {
+	return SyncAggregateMinimalViewOf(v.v.Field(220, 320))
+}
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
-		return
+// Copy returns a deep copy of the BeaconBlockBodyMinimal object
+func (b *BeaconBlockBodyMinimal) Copy() *BeaconBlockBodyMinimal {
+	if b == nil {
+		return nil
 	}
+	cpy := new(BeaconBlockBodyMinimal)
+	*cpy = *b
 
-	// Field (2) 'Graffiti'
-	dst = append(dst, b.Graffiti[:]...)
+	// Field 'RandaoReveal'
+	cpy.RandaoReveal = append(cpy.RandaoReveal[:0:0], cpy.RandaoReveal...)
 
-	// Offset (3) 'ProposerSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.ProposerSlashings) * 416
+	// Field 'Eth1Data'
+	cpy.Eth1Data = cpy.Eth1Data.Copy()
 
-	// Offset (4) 'AttesterSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		offset += 4
-		offset += b.AttesterSlashings[ii].SizeSSZ()
+	// Field 'ProposerSlashings'
+	cpy.ProposerSlashings = append(cpy.ProposerSlashings[:0:0], cpy.ProposerSlashings...)
+	for i0 := range cpy.ProposerSlashings {
+		cpy.ProposerSlashings[i0] = cpy.ProposerSlashings[i0].Copy()
+	}
+
+	// Field 'AttesterSlashings'
+	cpy.AttesterSlashings = append(cpy.AttesterSlashings[:0:0], cpy.AttesterSlashings...)
+	for i0 := range cpy.AttesterSlashings {
+		cpy.AttesterSlashings[i0] = cpy.AttesterSlashings[i0].Copy()
 	}
 
-	// Offset (5) 'Attestations'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		offset += 4
-		offset += b.Attestations[ii].SizeSSZ()
+	// Field 'Attestations'
+	cpy.Attestations = append(cpy.Attestations[:0:0], cpy.Attestations...)
+	for i0 := range cpy.Attestations {
+		cpy.Attestations[i0] = cpy.Attestations[i0].Copy()
 	}
 
-	// Offset (6) 'Deposits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Deposits) * 1240
+	// Field 'Deposits'
+	cpy.Deposits = append(cpy.Deposits[:0:0], cpy.Deposits...)
+	for i0 := range cpy.Deposits {
+		cpy.Deposits[i0] = cpy.Deposits[i0].Copy()
+	}
 
-	// Offset (7) 'VoluntaryExits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.VoluntaryExits) * 112
+	// Field 'VoluntaryExits'
+	cpy.VoluntaryExits = append(cpy.VoluntaryExits[:0:0], cpy.VoluntaryExits...)
+	for i0 := range cpy.VoluntaryExits {
+		cpy.VoluntaryExits[i0] = cpy.VoluntaryExits[i0].Copy()
+	}
 
-	// Field (8) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(SyncAggregateMinimal)
+	// Field 'SyncAggregate'
+	cpy.SyncAggregate = cpy.SyncAggregate.Copy()
+
+	return cpy
+}
+
+// EqualSSZ returns true if the BeaconBlockBodyMinimal objects have the same SSZ encoding
+func (b *BeaconBlockBodyMinimal) EqualSSZ(other *BeaconBlockBodyMinimal) bool {
+	if b == nil {
+		b = new(BeaconBlockBodyMinimal)
 	}
-	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
-		return
+	if other == nil {
+		other = new(BeaconBlockBodyMinimal)
 	}
 
-	// Field (3) 'ProposerSlashings'
-	if size := len(b.ProposerSlashings); size > 16 {
-		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
-		return
+	// Field 'RandaoReveal'
+	if !bytes.Equal(b.RandaoReveal, other.RandaoReveal) {
+		return false
 	}
-	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
-		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+
+	// Field 'Eth1Data'
+	if !b.Eth1Data.EqualSSZ(other.Eth1Data) {
+		return false
 	}
 
-	// Field (4) 'AttesterSlashings'
-	if size := len(b.AttesterSlashings); size > 2 {
-		err = ssz.ErrListTooBigFn("--.AttesterSlashings", size, 2)
-		return
+	// Field 'Graffiti'
+	if b.Graffiti != other.Graffiti {
+		return false
 	}
-	{
-		offset = 4 * len(b.AttesterSlashings)
-		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.AttesterSlashings[ii].SizeSSZ()
+
+	// Field 'ProposerSlashings'
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		return false
+	} else {
+		for i0 := range b.ProposerSlashings {
+			if !b.ProposerSlashings[i0].EqualSSZ(other.ProposerSlashings[i0]) {
+				return false
+			}
 		}
 	}
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
+
+	// Field 'AttesterSlashings'
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		return false
+	} else {
+		for i0 := range b.AttesterSlashings {
+			if !b.AttesterSlashings[i0].EqualSSZ(other.AttesterSlashings[i0]) {
+				return false
+			}
 		}
 	}
 
-	// Field (5) 'Attestations'
-	if size := len(b.Attestations); size > 128 {
-		err = ssz.ErrListTooBigFn("--.Attestations", size, 128)
-		return
-	}
-	{
-		offset = 4 * len(b.Attestations)
-		for ii := 0; ii < len(b.Attestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.Attestations[ii].SizeSSZ()
+	// Field 'Attestations'
+	if len(b.Attestations) != len(other.Attestations) {
+		return false
+	} else {
+		for i0 := range b.Attestations {
+			if !b.Attestations[i0].EqualSSZ(other.Attestations[i0]) {
+				return false
+			}
 		}
 	}
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
-			return
+
+	// Field 'Deposits'
+	if len(b.Deposits) != len(other.Deposits) {
+		return false
+	} else {
+		for i0 := range b.Deposits {
+			if !b.Deposits[i0].EqualSSZ(other.Deposits[i0]) {
+				return false
+			}
 		}
 	}
 
-	// Field (6) 'Deposits'
-	if size := len(b.Deposits); size > 16 {
-		err = ssz.ErrListTooBigFn("--.Deposits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.Deposits); ii++ {
-		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
-			return
+	// Field 'VoluntaryExits'
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		return false
+	} else {
+		for i0 := range b.VoluntaryExits {
+			if !b.VoluntaryExits[i0].EqualSSZ(other.VoluntaryExits[i0]) {
+				return false
+			}
 		}
 	}
 
-	// Field (7) 'VoluntaryExits'
-	if size := len(b.VoluntaryExits); size > 16 {
-		err = ssz.ErrListTooBigFn("--.VoluntaryExits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
-		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+	// Field 'SyncAggregate'
+	if !b.SyncAggregate.EqualSSZ(other.SyncAggregate) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyMinimal object
-func (b *BeaconBlockBodyMinimal) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 320 {
-		return ssz.ErrSize
+// DiffSSZ returns the fields with a different SSZ encoding in the BeaconBlockBodyMinimal objects
+func (b *BeaconBlockBodyMinimal) DiffSSZ(other *BeaconBlockBodyMinimal) []ssz.FieldDiff {
+	if b == nil {
+		b = new(BeaconBlockBodyMinimal)
 	}
-
-	tail := buf
-	var o3, o4, o5, o6, o7 uint64
-
-	// Field (0) 'RandaoReveal'
-	if cap(b.RandaoReveal) == 0 {
-		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+	if other == nil {
+		other = new(BeaconBlockBodyMinimal)
 	}
-	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+	var diffs []ssz.FieldDiff
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
-		return err
+	// Field 'RandaoReveal'
+	if !bytes.Equal(b.RandaoReveal, other.RandaoReveal) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "RandaoReveal", A: b.RandaoReveal, B: other.RandaoReveal})
 	}
 
-	// Field (2) 'Graffiti'
-	copy(b.Graffiti[:], buf[168:200])
+	// Field 'Eth1Data'
+	diffs = ssz.AppendDiffs(diffs, "Eth1Data", b.Eth1Data.DiffSSZ(other.Eth1Data))
 
-	// Offset (3) 'ProposerSlashings'
-	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
-		return ssz.ErrOffset
+	// Field 'Graffiti'
+	if b.Graffiti != other.Graffiti {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Graffiti", A: b.Graffiti, B: other.Graffiti})
 	}
 
-	if o3 < 320 {
-		return ssz.ErrInvalidVariableOffset
+	// Field 'ProposerSlashings'
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "ProposerSlashings", A: b.ProposerSlashings, B: other.ProposerSlashings})
+	} else {
+		for i0 := range b.ProposerSlashings {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("ProposerSlashings", i0), b.ProposerSlashings[i0].DiffSSZ(other.ProposerSlashings[i0]))
+		}
 	}
 
-	// Offset (4) 'AttesterSlashings'
-	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
-		return ssz.ErrOffset
+	// Field 'AttesterSlashings'
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "AttesterSlashings", A: b.AttesterSlashings, B: other.AttesterSlashings})
+	} else {
+		for i0 := range b.AttesterSlashings {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("AttesterSlashings", i0), b.AttesterSlashings[i0].DiffSSZ(other.AttesterSlashings[i0]))
+		}
 	}
 
-	// Offset (5) 'Attestations'
-	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
-		return ssz.ErrOffset
+	// Field 'Attestations'
+	if len(b.Attestations) != len(other.Attestations) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Attestations", A: b.Attestations, B: other.Attestations})
+	} else {
+		for i0 := range b.Attestations {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("Attestations", i0), b.Attestations[i0].DiffSSZ(other.Attestations[i0]))
+		}
 	}
 
-	// Offset (6) 'Deposits'
-	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
-		return ssz.ErrOffset
+	// Field 'Deposits'
+	if len(b.Deposits) != len(other.Deposits) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Deposits", A: b.Deposits, B: other.Deposits})
+	} else {
+		for i0 := range b.Deposits {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("Deposits", i0), b.Deposits[i0].DiffSSZ(other.Deposits[i0]))
+		}
 	}
 
-	// Offset (7) 'VoluntaryExits'
-	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
-		return ssz.ErrOffset
+	// Field 'VoluntaryExits'
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "VoluntaryExits", A: b.VoluntaryExits, B: other.VoluntaryExits})
+	} else {
+		for i0 := range b.VoluntaryExits {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("VoluntaryExits", i0), b.VoluntaryExits[i0].DiffSSZ(other.VoluntaryExits[i0]))
+		}
 	}
 
-	// Field (8) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(SyncAggregateMinimal)
+	// Field 'SyncAggregate'
+	diffs = ssz.AppendDiffs(diffs, "SyncAggregate", b.SyncAggregate.DiffSSZ(other.SyncAggregate))
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the BeaconBlockBodyMinimal object following the Beacon API conventions
+func (b *BeaconBlockBodyMinimal) MarshalJSON() ([]byte, error) {
+	if b == nil {
+		b = new(BeaconBlockBodyMinimal)
 	}
-	if err = b.SyncAggregate.UnmarshalSSZ(buf[220:320]); err != nil {
-		return err
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'RandaoReveal'
+	dst = append(dst, "\"randao_reveal\":"...)
+	dst = ssz.MarshalJSONBytes(dst, b.RandaoReveal)
+
+	// Field (1) 'Eth1Data'
+	dst = append(dst, ",\"eth1_data\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, b.Eth1Data); err != nil {
+		return nil, err
 	}
 
+	// Field (2) 'Graffiti'
+	dst = append(dst, ",\"graffiti\":"...)
+	dst = ssz.MarshalJSONBytes(dst, b.Graffiti[:])
+
 	// Field (3) 'ProposerSlashings'
-	{
-		buf = tail[o3:o4]
-		num, err := ssz.DivideInt2(len(buf), 416, 16)
-		if err != nil {
-			return err
+	dst = append(dst, ",\"proposer_slashings\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.ProposerSlashings {
+		if i0 != 0 {
+			dst = append(dst, ',')
 		}
-		b.ProposerSlashings = make([]*ProposerSlashing, num)
-		for ii := 0; ii < num; ii++ {
-			if b.ProposerSlashings[ii] == nil {
-				b.ProposerSlashings[ii] = new(ProposerSlashing)
-			}
-			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
-				return err
-			}
+		if dst, err = ssz.MarshalJSONObject(dst, b.ProposerSlashings[i0]); err != nil {
+			return nil, err
 		}
 	}
+	dst = append(dst, ']')
 
 	// Field (4) 'AttesterSlashings'
-	{
-		buf = tail[o4:o5]
-		num, err := ssz.DecodeDynamicLength(buf, 2)
-		if err != nil {
-			return err
+	dst = append(dst, ",\"attester_slashings\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.AttesterSlashings {
+		if i0 != 0 {
+			dst = append(dst, ',')
 		}
-		b.AttesterSlashings = make([]*AttesterSlashing, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.AttesterSlashings[indx] == nil {
-				b.AttesterSlashings[indx] = new(AttesterSlashing)
-			}
-			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
-			return err
+		if dst, err = ssz.MarshalJSONObject(dst, b.AttesterSlashings[i0]); err != nil {
+			return nil, err
 		}
 	}
+	dst = append(dst, ']')
 
 	// Field (5) 'Attestations'
-	{
-		buf = tail[o5:o6]
-		num, err := ssz.DecodeDynamicLength(buf, 128)
-		if err != nil {
-			return err
+	dst = append(dst, ",\"attestations\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.Attestations {
+		if i0 != 0 {
+			dst = append(dst, ',')
 		}
-		b.Attestations = make([]*Attestation, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.Attestations[indx] == nil {
-				b.Attestations[indx] = new(Attestation)
-			}
-			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
-			return err
+		if dst, err = ssz.MarshalJSONObject(dst, b.Attestations[i0]); err != nil {
+			return nil, err
 		}
 	}
+	dst = append(dst, ']')
 
 	// Field (6) 'Deposits'
-	{
-		buf = tail[o6:o7]
-		num, err := ssz.DivideInt2(len(buf), 1240, 16)
-		if err != nil {
-			return err
+	dst = append(dst, ",\"deposits\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.Deposits {
+		if i0 != 0 {
+			dst = append(dst, ',')
 		}
-		b.Deposits = make([]*Deposit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Deposits[ii] == nil {
-				b.Deposits[ii] = new(Deposit)
-			}
-			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1240 : (ii+1)*1240]); err != nil {
-				return err
-			}
+		if dst, err = ssz.MarshalJSONObject(dst, b.Deposits[i0]); err != nil {
+			return nil, err
 		}
 	}
+	dst = append(dst, ']')
 
 	// Field (7) 'VoluntaryExits'
-	{
-		buf = tail[o7:]
-		num, err := ssz.DivideInt2(len(buf), 112, 16)
-		if err != nil {
-			return err
+	dst = append(dst, ",\"voluntary_exits\":"...)
+	dst = append(dst, '[')
+	for i0 := range b.VoluntaryExits {
+		if i0 != 0 {
+			dst = append(dst, ',')
 		}
-		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.VoluntaryExits[ii] == nil {
-				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
-			}
-			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
-				return err
-			}
+		if dst, err = ssz.MarshalJSONObject(dst, b.VoluntaryExits[i0]); err != nil {
+			return nil, err
 		}
 	}
-	return err
-}
-
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyMinimal object
-func (b *BeaconBlockBodyMinimal) SizeSSZ() (size int) {
-	size = 320
-
-	// Field (3) 'ProposerSlashings'
-	size += len(b.ProposerSlashings) * 416
-
-	// Field (4) 'AttesterSlashings'
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		size += 4
-		size += b.AttesterSlashings[ii].SizeSSZ()
-	}
+	dst = append(dst, ']')
 
-	// Field (5) 'Attestations'
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		size += 4
-		size += b.Attestations[ii].SizeSSZ()
+	// Field (8) 'SyncAggregate'
+	dst = append(dst, ",\"sync_aggregate\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, b.SyncAggregate); err != nil {
+		return nil, err
 	}
 
-	// Field (6) 'Deposits'
-	size += len(b.Deposits) * 1240
-
-	// Field (7) 'VoluntaryExits'
-	size += len(b.VoluntaryExits) * 112
-
-	return
-}
-
-// HashTreeRoot ssz hashes the BeaconBlockBodyMinimal object
-func (b *BeaconBlockBodyMinimal) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+	dst = append(dst, '}')
+	return dst, nil
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlockBodyMinimal object with a hasher
-func (b *BeaconBlockBodyMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+// UnmarshalJSON decodes the JSON encoding of the BeaconBlockBodyMinimal object following the Beacon API conventions
+func (b *BeaconBlockBodyMinimal) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
 
 	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
-		return
+	if b.RandaoReveal, err = ssz.UnmarshalJSONBytes(fields["randao_reveal"], 96, 96); err != nil {
+		return ssz.WrapJSONError(err, "RandaoReveal")
 	}
-	hh.PutBytes(b.RandaoReveal)
 
 	// Field (1) 'Eth1Data'
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
-		return
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["eth1_data"], b.Eth1Data); err != nil {
+		return ssz.WrapJSONError(err, "Eth1Data")
 	}
 
 	// Field (2) 'Graffiti'
-	hh.PutBytes(b.Graffiti[:])
+	{
+		val, err := ssz.UnmarshalJSONBytes(fields["graffiti"], 32, 32)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Graffiti")
+		}
+		copy(b.Graffiti[:], val)
+	}
 
 	// Field (3) 'ProposerSlashings'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.ProposerSlashings))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
+		items0, err := ssz.UnmarshalJSONList(fields["proposer_slashings"], 0, 16)
+		if err != nil {
+			return ssz.WrapJSONError(err, "ProposerSlashings")
 		}
-		for _, elem := range b.ProposerSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
+		b.ProposerSlashings = make([]*ProposerSlashing, len(items0))
+		for i0 := range items0 {
+			if b.ProposerSlashings[i0] == nil {
+				b.ProposerSlashings[i0] = new(ProposerSlashing)
+			}
+			if err = ssz.UnmarshalJSONObject(items0[i0], b.ProposerSlashings[i0]); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("ProposerSlashings", i0))
 			}
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
 
 	// Field (4) 'AttesterSlashings'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.AttesterSlashings))
-		if num > 2 {
-			err = ssz.ErrIncorrectListSize
-			return
+		items0, err := ssz.UnmarshalJSONList(fields["attester_slashings"], 0, 2)
+		if err != nil {
+			return ssz.WrapJSONError(err, "AttesterSlashings")
 		}
-		for _, elem := range b.AttesterSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
+		b.AttesterSlashings = make([]*AttesterSlashing, len(items0))
+		for i0 := range items0 {
+			if b.AttesterSlashings[i0] == nil {
+				b.AttesterSlashings[i0] = new(AttesterSlashing)
+			}
+			if err = ssz.UnmarshalJSONObject(items0[i0], b.AttesterSlashings[i0]); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("AttesterSlashings", i0))
 			}
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 2)
 	}
 
 	// Field (5) 'Attestations'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Attestations))
-		if num > 128 {
-			err = ssz.ErrIncorrectListSize
-			return
+		items0, err := ssz.UnmarshalJSONList(fields["attestations"], 0, 128)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Attestations")
 		}
-		for _, elem := range b.Attestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
+		b.Attestations = make([]*Attestation, len(items0))
+		for i0 := range items0 {
+			if b.Attestations[i0] == nil {
+				b.Attestations[i0] = new(Attestation)
+			}
+			if err = ssz.UnmarshalJSONObject(items0[i0], b.Attestations[i0]); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("Attestations", i0))
 			}
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 128)
 	}
 
 	// Field (6) 'Deposits'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Deposits))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
+		items0, err := ssz.UnmarshalJSONList(fields["deposits"], 0, 16)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Deposits")
 		}
-		for _, elem := range b.Deposits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
+		b.Deposits = make([]*Deposit, len(items0))
+		for i0 := range items0 {
+			if b.Deposits[i0] == nil {
+				b.Deposits[i0] = new(Deposit)
+			}
+			if err = ssz.UnmarshalJSONObject(items0[i0], b.Deposits[i0]); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("Deposits", i0))
 			}
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
 
 	// Field (7) 'VoluntaryExits'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.VoluntaryExits))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
+		items0, err := ssz.UnmarshalJSONList(fields["voluntary_exits"], 0, 16)
+		if err != nil {
+			return ssz.WrapJSONError(err, "VoluntaryExits")
 		}
-		for _, elem := range b.VoluntaryExits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
+		b.VoluntaryExits = make([]*SignedVoluntaryExit, len(items0))
+		for i0 := range items0 {
+			if b.VoluntaryExits[i0] == nil {
+				b.VoluntaryExits[i0] = new(SignedVoluntaryExit)
+			}
+			if err = ssz.UnmarshalJSONObject(items0[i0], b.VoluntaryExits[i0]); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("VoluntaryExits", i0))
 			}
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
 
 	// Field (8) 'SyncAggregate'
-	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
-		return
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregateMinimal)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["sync_aggregate"], b.SyncAggregate); err != nil {
+		return ssz.WrapJSONError(err, "SyncAggregate")
 	}
 
-	hh.Merkleize(indx)
-	return
+	return nil
 }
 
 // MarshalSSZ ssz marshals the BeaconBlockMinimal object
@@ -4757,12 +18424,65 @@ func (b *BeaconBlockMinimal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the BeaconBlockMinimal object to a writer
+func (b *BeaconBlockMinimal) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, b)
+}
+
+// MarshalSSZToEncoder ssz marshals the BeaconBlockMinimal object to an encoder
+func (b *BeaconBlockMinimal) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(84)
+
+	// Field (0) 'Slot'
+	enc.EncodeUint64(b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	enc.EncodeUint64(b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+		return
+	}
+	enc.EncodeBytes(b.ParentRoot)
+
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
+		return
+	}
+	enc.EncodeBytes(b.StateRoot)
+
+	// Offset (4) 'Body'
+	enc.EncodeOffset(offset)
+	if b.Body == nil {
+		b.Body = new(BeaconBlockBodyMinimal)
+	}
+	offset += b.Body.SizeSSZ()
+
+	// Field (4) 'Body'
+	if err = enc.EncodeObject(b.Body); err != nil {
+		return
+	}
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the BeaconBlockMinimal object
 func (b *BeaconBlockMinimal) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the BeaconBlockMinimal object within the limits of the decode state
+func (b *BeaconBlockMinimal) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
 	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockMinimal", "", 0)
+	}
+	defer st.Leave()
 	size := uint64(len(buf))
 	if size < 84 {
-		return ssz.ErrSize
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconBlockMinimal", 84, size)
 	}
 
 	tail := buf
@@ -4788,11 +18508,11 @@ func (b *BeaconBlockMinimal) UnmarshalSSZ(buf []byte) error {
 
 	// Offset (4) 'Body'
 	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
-		return ssz.ErrOffset
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockMinimal", "Body", 80)
 	}
 
-	if o4 < 84 {
-		return ssz.ErrInvalidVariableOffset
+	if o4 != 84 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 84, o4), "BeaconBlockMinimal", "Body", 80)
 	}
 
 	// Field (4) 'Body'
@@ -4801,13 +18521,105 @@ func (b *BeaconBlockMinimal) UnmarshalSSZ(buf []byte) error {
 		if b.Body == nil {
 			b.Body = new(BeaconBlockBodyMinimal)
 		}
-		if err = b.Body.UnmarshalSSZ(buf); err != nil {
-			return err
+		if err = ssz.UnmarshalWithState(b.Body, buf, st); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockMinimal", "Body", o4)
+		}
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a BeaconBlockMinimal object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (b *BeaconBlockMinimal) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 84 {
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconBlockMinimal", 84, size)
+	}
+
+	tail := buf
+	var o4 uint64
+
+	// Offset (4) 'Body'
+	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockMinimal", "Body", 80)
+	}
+
+	if o4 != 84 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 84, o4), "BeaconBlockMinimal", "Body", 80)
+	}
+
+	// Field (4) 'Body'
+	{
+		buf = tail[o4:]
+		if err = (*BeaconBlockBodyMinimal)(nil).ValidateSSZ(buf); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockMinimal", "Body", o4)
 		}
 	}
 	return err
 }
 
+// UnmarshalSSZFromReader ssz unmarshals the BeaconBlockMinimal object from a reader with an encoded size of 'size' bytes
+func (b *BeaconBlockMinimal) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, b)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the BeaconBlockMinimal object from a decoder
+func (b *BeaconBlockMinimal) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 84 {
+		return ssz.NewDecodeError(ssz.ErrSize, "BeaconBlockMinimal", 84, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "BeaconBlockMinimal", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(84)
+	if err != nil {
+		return err
+	}
+	var o4 uint64
+
+	// Field (0) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ProposerIndex'
+	b.ProposerIndex = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'ParentRoot'
+	if cap(b.ParentRoot) == 0 {
+		b.ParentRoot = make([]byte, 0, len(buf[16:48]))
+	}
+	b.ParentRoot = append(b.ParentRoot, buf[16:48]...)
+
+	// Field (3) 'StateRoot'
+	if cap(b.StateRoot) == 0 {
+		b.StateRoot = make([]byte, 0, len(buf[48:80]))
+	}
+	b.StateRoot = append(b.StateRoot, buf[48:80]...)
+
+	// Offset (4) 'Body'
+	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "BeaconBlockMinimal", "Body", 80)
+	}
+
+	if o4 != 84 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 84, o4), "BeaconBlockMinimal", "Body", 80)
+	}
+
+	// Field (4) 'Body'
+	{
+		if b.Body == nil {
+			b.Body = new(BeaconBlockBodyMinimal)
+		}
+		if err = dec.DecodeObject(b.Body, size-o4); err != nil {
+			return ssz.WrapDecodeError(err, "BeaconBlockMinimal", "Body", o4)
+		}
+	}
+	return nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockMinimal object
 func (b *BeaconBlockMinimal) SizeSSZ() (size int) {
 	size = 84
@@ -4858,3 +18670,280 @@ func (b *BeaconBlockMinimal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	hh.Merkleize(indx)
 	return
 }
+
+// HashTreeRootParallel ssz hashes the BeaconBlockMinimal object computing
+// the roots of the fields concurrently with up to 'workers' goroutines
+func (b *BeaconBlockMinimal) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+	return b.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+}
+
+// HashTreeRootParallelWithPool ssz hashes the BeaconBlockMinimal object like HashTreeRootParallel
+// with the Hashers of the pool
+func (b *BeaconBlockMinimal) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+	return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+		// Field (0) 'Slot'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(b.Slot)
+			return
+		},
+		// Field (1) 'ProposerIndex'
+		func(hh *ssz.Hasher) (err error) {
+			hh.PutUint64(b.ProposerIndex)
+			return
+		},
+		// Field (2) 'ParentRoot'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(b.ParentRoot); size != 32 {
+				err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+				return
+			}
+			hh.PutBytes(b.ParentRoot)
+			return
+		},
+		// Field (3) 'StateRoot'
+		func(hh *ssz.Hasher) (err error) {
+			if size := len(b.StateRoot); size != 32 {
+				err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
+				return
+			}
+			hh.PutBytes(b.StateRoot)
+			return
+		},
+		// Field (4) 'Body'
+		func(hh *ssz.Hasher) (err error) {
+			if err = b.Body.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			return
+		},
+	})
+}
+
+// BeaconBlockMinimalView is a lazy view over the SSZ encoding of a BeaconBlockMinimal object
+type BeaconBlockMinimalView struct {
+	v ssz.View
+}
+
+// NewBeaconBlockMinimalView creates a view over the SSZ encoding of a BeaconBlockMinimal object
+func NewBeaconBlockMinimalView(buf []byte) BeaconBlockMinimalView {
+	return BeaconBlockMinimalViewOf(ssz.NewView(buf))
+}
+
+// BeaconBlockMinimalViewOf returns the view of the BeaconBlockMinimal object encoded in v
+func BeaconBlockMinimalViewOf(v ssz.View) BeaconBlockMinimalView {
+	return BeaconBlockMinimalView{v.Container(84, 80)}
+}
+
+// Raw returns the untyped view of the BeaconBlockMinimal object
+func (v BeaconBlockMinimalView) Raw() ssz.View {
+	return v.v
+}
+
+// BeaconBlockMinimalListView is a lazy view over a list of BeaconBlockMinimal objects
+type BeaconBlockMinimalListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th BeaconBlockMinimal object of the list
+func (l BeaconBlockMinimalListView) At(i int) BeaconBlockMinimalView {
+	return BeaconBlockMinimalViewOf(l.ListView.At(i))
+}
+
+// Slot returns the Slot field of the BeaconBlockMinimal object
+func (v BeaconBlockMinimalView) Slot() (uint64, error) {
+	return v.v.Field(0, 8).Uint64()
+}
+
+// ProposerIndex returns the ProposerIndex field of the BeaconBlockMinimal object
+func (v BeaconBlockMinimalView) ProposerIndex() (uint64, error) {
+	return v.v.Field(8, 16).Uint64()
+}
+
+// ParentRoot returns the ParentRoot field of the BeaconBlockMinimal object
+func (v BeaconBlockMinimalView) ParentRoot() ([]byte, error) {
+	return v.v.Field(16, 48).Bytes()
+}
+
+// StateRoot returns the StateRoot field of the BeaconBlockMinimal object
+func (v BeaconBlockMinimalView) StateRoot() ([]byte, error) {
+	return v.v.Field(48, 80).Bytes()
+}
+
+// Body returns the Body field of the BeaconBlockMinimal object
+func (v BeaconBlockMinimalView) Body() BeaconBlockBodyMinimalView {
+	return BeaconBlockBodyMinimalViewOf(v.v.DynamicField(80, 0))
+}
+
+// Copy returns a deep copy of the BeaconBlockMinimal object
+func (b *BeaconBlockMinimal) Copy() *BeaconBlockMinimal {
+	if b == nil {
+		return nil
+	}
+	cpy := new(BeaconBlockMinimal)
+	*cpy = *b
+
+	// Field 'ParentRoot'
+	cpy.ParentRoot = append(cpy.ParentRoot[:0:0], cpy.ParentRoot...)
+
+	// Field 'StateRoot'
+	cpy.StateRoot = append(cpy.StateRoot[:0:0], cpy.StateRoot...)
+
+	// Field 'Body'
+	cpy.Body = cpy.Body.Copy()
+
+	return cpy
+}
+
+// EqualSSZ returns true if the BeaconBlockMinimal objects have the same SSZ encoding
+func (b *BeaconBlockMinimal) EqualSSZ(other *BeaconBlockMinimal) bool {
+	if b == nil {
+		b = new(BeaconBlockMinimal)
+	}
+	if other == nil {
+		other = new(BeaconBlockMinimal)
+	}
+
+	// Field 'Slot'
+	if b.Slot != other.Slot {
+		return false
+	}
+
+	// Field 'ProposerIndex'
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+
+	// Field 'ParentRoot'
+	if !bytes.Equal(b.ParentRoot, other.ParentRoot) {
+		return false
+	}
+
+	// Field 'StateRoot'
+	if !bytes.Equal(b.StateRoot, other.StateRoot) {
+		return false
+	}
+
+	// Field 'Body'
+	if !b.Body.EqualSSZ(other.Body) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the BeaconBlockMinimal objects
+func (b *BeaconBlockMinimal) DiffSSZ(other *BeaconBlockMinimal) []ssz.FieldDiff {
+	if b == nil {
+		b = new(BeaconBlockMinimal)
+	}
+	if other == nil {
+		other = new(BeaconBlockMinimal)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Slot'
+	if b.Slot != other.Slot {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Slot", A: b.Slot, B: other.Slot})
+	}
+
+	// Field 'ProposerIndex'
+	if b.ProposerIndex != other.ProposerIndex {
+		diffs = append(diffs, ssz.FieldDiff{Path: "ProposerIndex", A: b.ProposerIndex, B: other.ProposerIndex})
+	}
+
+	// Field 'ParentRoot'
+	if !bytes.Equal(b.ParentRoot, other.ParentRoot) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "ParentRoot", A: b.ParentRoot, B: other.ParentRoot})
+	}
+
+	// Field 'StateRoot'
+	if !bytes.Equal(b.StateRoot, other.StateRoot) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "StateRoot", A: b.StateRoot, B: other.StateRoot})
+	}
+
+	// Field 'Body'
+	diffs = ssz.AppendDiffs(diffs, "Body", b.Body.DiffSSZ(other.Body))
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the BeaconBlockMinimal object following the Beacon API conventions
+func (b *BeaconBlockMinimal) MarshalJSON() ([]byte, error) {
+	if b == nil {
+		b = new(BeaconBlockMinimal)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'Slot'
+	dst = append(dst, "\"slot\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(b.Slot))
+
+	// Field (1) 'ProposerIndex'
+	dst = append(dst, ",\"proposer_index\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(b.ProposerIndex))
+
+	// Field (2) 'ParentRoot'
+	dst = append(dst, ",\"parent_root\":"...)
+	dst = ssz.MarshalJSONBytes(dst, b.ParentRoot)
+
+	// Field (3) 'StateRoot'
+	dst = append(dst, ",\"state_root\":"...)
+	dst = ssz.MarshalJSONBytes(dst, b.StateRoot)
+
+	// Field (4) 'Body'
+	dst = append(dst, ",\"body\":"...)
+	if dst, err = ssz.MarshalJSONObject(dst, b.Body); err != nil {
+		return nil, err
+	}
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the BeaconBlockMinimal object following the Beacon API conventions
+func (b *BeaconBlockMinimal) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Slot'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["slot"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Slot")
+		}
+		b.Slot = uint64(val)
+	}
+
+	// Field (1) 'ProposerIndex'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["proposer_index"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "ProposerIndex")
+		}
+		b.ProposerIndex = uint64(val)
+	}
+
+	// Field (2) 'ParentRoot'
+	if b.ParentRoot, err = ssz.UnmarshalJSONBytes(fields["parent_root"], 32, 32); err != nil {
+		return ssz.WrapJSONError(err, "ParentRoot")
+	}
+
+	// Field (3) 'StateRoot'
+	if b.StateRoot, err = ssz.UnmarshalJSONBytes(fields["state_root"], 32, 32); err != nil {
+		return ssz.WrapJSONError(err, "StateRoot")
+	}
+
+	// Field (4) 'Body'
+	if b.Body == nil {
+		b.Body = new(BeaconBlockBodyMinimal)
+	}
+	if err = ssz.UnmarshalJSONObject(fields["body"], b.Body); err != nil {
+		return ssz.WrapJSONError(err, "Body")
+	}
+
+	return nil
+}
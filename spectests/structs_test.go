@@ -2,15 +2,21 @@ package spectests
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha512"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/snappy"
 	ssz "github.com/prysmaticlabs/fastssz"
@@ -511,3 +517,724 @@ func readValidGenericSSZ(t *testing.T, path string, obj interface{}) *output {
 	}
 	return &output{root: root, ssz: serialized}
 }
+
+func TestMarshalSSZToWriter(t *testing.T) {
+	for name, codec := range codecs {
+		for i := 0; i < 10; i++ {
+			obj := codec("")
+			f := fuzz.NewWithSeed(int64(i))
+			f.Fuzz(obj)
+
+			dst, err := obj.MarshalSSZTo(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			if err := ssz.MarshalSSZToWriter(&buf, obj); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if !bytes.Equal(dst, buf.Bytes()) {
+				t.Fatalf("%s: writer encoding does not match MarshalSSZTo", name)
+			}
+		}
+	}
+}
+
+// fuzzValid fills obj with random values and fixes up the bitlists so
+// that they fit their limit and carry the length bit.
+func fuzzValid(obj interface{}, seed int64) {
+	fuzz.NewWithSeed(seed).Fuzz(obj)
+	fixBitlists(reflect.ValueOf(obj), "", rand.New(rand.NewSource(seed)))
+}
+
+func fixBitlists(v reflect.Value, tag reflect.StructTag, r *rand.Rand) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			fixBitlists(v.Elem(), "", r)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			fixBitlists(v.Field(i), v.Type().Field(i).Tag, r)
+		}
+	case reflect.Slice:
+		if tag.Get("ssz") != "bitlist" {
+			for i := 0; i < v.Len(); i++ {
+				fixBitlists(v.Index(i), "", r)
+			}
+			return
+		}
+		max, err := strconv.Atoi(tag.Get("ssz-max"))
+		if err != nil {
+			panic(err)
+		}
+		if v.Len() == 0 || v.Len() > max/8 {
+			n := 1 + r.Intn(max/8)
+			v.Set(reflect.MakeSlice(v.Type(), n, n))
+			r.Read(v.Bytes())
+		}
+		// the last byte of a bitlist holds the length bit
+		v.Index(v.Len() - 1).SetUint(uint64(1 + r.Intn(255)))
+	}
+}
+
+func TestUnmarshalSSZFromReader(t *testing.T) {
+	for name, codec := range codecs {
+		for i := 0; i < 5; i++ {
+			obj := codec("")
+			fuzzValid(obj, int64(i))
+
+			dst, err := obj.MarshalSSZTo(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			obj2 := codec("")
+			if err := obj2.UnmarshalSSZ(dst); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			obj3 := codec("")
+			if err := ssz.UnmarshalSSZFromReader(bytes.NewReader(dst), len(dst), obj3); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if !deepEqual(obj2, obj3) {
+				t.Fatalf("%s: reader decoding does not match the object", name)
+			}
+
+			// corrupt the input and check that both decoders accept the same inputs
+			for j := 0; j < 20; j++ {
+				buf := make([]byte, len(dst))
+				copy(buf, dst)
+
+				pos := rand.Intn(len(buf))
+				rand.Read(buf[pos:min(pos+4, len(buf))])
+				if j%2 == 0 {
+					buf = buf[:rand.Intn(len(buf))]
+				}
+
+				obj4, obj5 := codec(""), codec("")
+				err1 := obj4.UnmarshalSSZ(buf)
+				err2 := ssz.UnmarshalSSZFromReader(bytes.NewReader(buf), len(buf), obj5)
+				if (err1 == nil) != (err2 == nil) {
+					t.Fatalf("%s: in-memory error '%v' and reader error '%v' do not match", name, err1, err2)
+				}
+				if err1 == nil && !deepEqual(obj4, obj5) {
+					t.Fatalf("%s: reader decoding does not match the in-memory decoding", name)
+				}
+			}
+		}
+	}
+}
+
+func TestDecodeErrorPath(t *testing.T) {
+	obj := new(BeaconBlock)
+	fuzz.NewWithSeed(1).Fuzz(obj)
+
+	atts := make([]*Attestation, 2)
+	for i := range atts {
+		atts[i] = new(Attestation)
+		fuzz.NewWithSeed(int64(i)).Fuzz(atts[i])
+		atts[i].AggregationBits = []byte{0x1}
+	}
+	obj.Body.Attestations = atts
+
+	dst, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the body starts after the fixed part of the block and the offsets
+	// of its lists are after the randao reveal, eth1 data and graffiti
+	body := uint64(84)
+	proposerSlashings := body + 200
+	list := body + ssz.ReadOffset(dst[proposerSlashings+8:])
+	bits := list + ssz.ReadOffset(dst[list+4:]) + 228
+
+	decodeErr := func(buf []byte) *ssz.DecodeError {
+		t.Helper()
+
+		var derr *ssz.DecodeError
+		if err := new(BeaconBlock).UnmarshalSSZ(buf); !errors.As(err, &derr) {
+			t.Fatalf("expected a decode error but found %v", err)
+		}
+		derr2 := new(ssz.DecodeError)
+		err := ssz.UnmarshalSSZFromReader(bytes.NewReader(buf), len(buf), new(BeaconBlock))
+		if !errors.As(err, &derr2) || derr2.Path != derr.Path {
+			t.Fatalf("reader error '%v' does not match '%v'", err, derr)
+		}
+		return derr
+	}
+
+	// invalid bitlist of the second attestation
+	buf := append([]byte{}, dst...)
+	buf[bits] = 0
+
+	derr := decodeErr(buf)
+	if derr.Path != "BeaconBlock.Body.Attestations[1].AggregationBits" {
+		t.Fatalf("bad path %s", derr.Path)
+	}
+	if derr.Offset != bits {
+		t.Fatalf("bad offset, expected %d but found %d", bits, derr.Offset)
+	}
+
+	// first offset of the body does not point to the end of its fixed part
+	fixed := ssz.ReadOffset(dst[proposerSlashings:])
+
+	buf = append([]byte{}, dst...)
+	buf[proposerSlashings]++
+
+	derr = decodeErr(buf)
+	if !errors.Is(derr, ssz.ErrInvalidVariableOffset) {
+		t.Fatalf("expected invalid offset error but found %v", derr)
+	}
+	if derr.Path != "BeaconBlock.Body.ProposerSlashings" || derr.Offset != proposerSlashings {
+		t.Fatalf("bad location %s at %d", derr.Path, derr.Offset)
+	}
+	if derr.Expected != fixed || derr.Actual != fixed+1 {
+		t.Fatalf("bad values, expected %d and %d but found %d and %d", fixed, fixed+1, derr.Expected, derr.Actual)
+	}
+}
+
+func TestView(t *testing.T) {
+	obj := new(BeaconState)
+	fuzz.NewWithSeed(1).Fuzz(obj)
+	obj.Validators = make([]*Validator, 3)
+	for i := range obj.Validators {
+		obj.Validators[i] = new(Validator)
+		fuzz.NewWithSeed(int64(i)).Fuzz(obj.Validators[i])
+	}
+
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	view := NewBeaconStateView(buf)
+
+	check := func(a, b interface{}, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(a, b) {
+			t.Fatalf("expected %v but found %v", a, b)
+		}
+	}
+
+	slot, err := view.Slot()
+	check(obj.Slot, slot, err)
+
+	epoch, err := view.Fork().Epoch()
+	check(obj.Fork.Epoch, epoch, err)
+
+	num, err := view.Validators().Len()
+	check(len(obj.Validators), num, err)
+
+	for i, val := range obj.Validators {
+		balance, err := view.Validators().At(i).EffectiveBalance()
+		check(val.EffectiveBalance, balance, err)
+
+		pubkey, err := view.Validators().At(i).Pubkey()
+		check(val.Pubkey, pubkey, err)
+	}
+
+	num, err = view.HistoricalRoots().Len()
+	check(len(obj.HistoricalRoots), num, err)
+
+	if len(obj.Eth1DataVotes) != 0 {
+		root, err := view.Eth1DataVotes().At(0).BlockHash()
+		check(obj.Eth1DataVotes[0].BlockHash, root, err)
+	}
+
+	bits, err := view.JustificationBits()
+	check(obj.JustificationBits, bits, err)
+
+	// the views can be unmarshalled
+	sync := new(SyncCommitteeMinimal)
+	if err := view.NextSyncCommittee().Raw().Unmarshal(sync); err != nil {
+		t.Fatal(err)
+	}
+	if !deepEqual(obj.NextSyncCommittee, sync) {
+		t.Fatal("bad sync committee")
+	}
+
+	if _, err := view.Validators().At(3).Slashed(); !errors.Is(err, ssz.ErrViewIndex) {
+		t.Fatalf("expected index error but found %v", err)
+	}
+
+	// invalid offsets are found when the field is accessed
+	validators := 8 + 32 + 8 + 16 + 112 + 64*32*2 + 4 + 72 + 4 + 8
+	ssz.MarshalUint32(buf[:validators], uint32(len(buf)+1))
+
+	if _, err := NewBeaconStateView(buf).Slot(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewBeaconStateView(buf).Validators().Len(); !errors.Is(err, ssz.ErrOffset) {
+		t.Fatalf("expected offset error but found %v", err)
+	}
+	if _, err := NewBeaconStateView(buf[:100]).Slot(); !errors.Is(err, ssz.ErrSize) {
+		t.Fatalf("expected size error but found %v", err)
+	}
+}
+
+func TestValidateSSZ(t *testing.T) {
+	seed := time.Now().UnixNano()
+	t.Logf("random seed %d", seed)
+	r := rand.New(rand.NewSource(seed))
+
+	for name, codec := range codecs {
+		for i := 0; i < 5; i++ {
+			obj := codec("")
+			fuzzValid(obj, int64(i))
+
+			dst, err := obj.MarshalSSZTo(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := obj.(ssz.Validator).ValidateSSZ(dst); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+
+			// corrupt the input and check that the validation
+			// and the decoding accept the same inputs
+			for j := 0; j < 50; j++ {
+				buf := make([]byte, len(dst))
+				copy(buf, dst)
+
+				pos := r.Intn(len(buf))
+				r.Read(buf[pos:min(pos+4, len(buf))])
+				if j%2 == 0 {
+					buf = buf[:r.Intn(len(buf))]
+				}
+
+				err1 := codec("").UnmarshalSSZ(buf)
+				err2 := codec("").(ssz.Validator).ValidateSSZ(buf)
+				if fmt.Sprint(err1) != fmt.Sprint(err2) {
+					t.Fatalf("%s: decode error '%v' and validate error '%v' do not match", name, err1, err2)
+				}
+			}
+		}
+	}
+}
+
+func TestValidateSSZAllocs(t *testing.T) {
+	obj := new(BeaconState)
+	fuzz.NewWithSeed(1).Fuzz(obj)
+
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	allocs := testing.AllocsPerRun(10, func() {
+		if err := new(BeaconState).ValidateSSZ(buf); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Fatalf("expected no allocations but found %f", allocs)
+	}
+}
+
+func TestSSZSnappy(t *testing.T) {
+	for name, codec := range codecs {
+		obj := codec("")
+		fuzzValid(obj, 1)
+
+		dst, err := obj.MarshalSSZTo(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf, err := ssz.MarshalSSZSnappy(obj)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		// it is the snappy block compression of the encoding
+		raw, err := snappy.Decode(nil, buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(raw, dst) {
+			t.Fatalf("%s: bad ssz_snappy encoding", name)
+		}
+		if size, err := ssz.DecodedLenSSZSnappy(buf); err != nil || size != len(dst) {
+			t.Fatalf("%s: bad uncompressed size %d", name, size)
+		}
+
+		obj2, obj3 := codec(""), codec("")
+		if err := ssz.UnmarshalSSZSnappy(buf, obj2, len(dst)); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if err := obj3.UnmarshalSSZ(dst); err != nil {
+			t.Fatal(err)
+		}
+		if !deepEqual(obj2, obj3) {
+			t.Fatalf("%s: bad ssz_snappy decoding", name)
+		}
+
+		// the limit is checked before decompressing the input
+		err = ssz.UnmarshalSSZSnappy(buf, codec(""), len(dst)-1)
+		if !errors.Is(err, ssz.ErrSnappyLength) {
+			t.Fatalf("%s: expected length error but found %v", name, err)
+		}
+	}
+}
+
+func TestCopy(t *testing.T) {
+	for name, codec := range codecs {
+		for i := 0; i < 5; i++ {
+			obj := codec("")
+			fuzz.NewWithSeed(int64(i)).Fuzz(obj)
+
+			cpy := reflect.ValueOf(obj).MethodByName("Copy").Call(nil)[0].Interface()
+			if !reflect.DeepEqual(obj, cpy) {
+				t.Fatalf("%s: the copy is not equal", name)
+			}
+			if path := sharedMemory(reflect.ValueOf(obj), reflect.ValueOf(cpy), name); path != "" {
+				t.Fatalf("%s: the copy shares memory with the object", path)
+			}
+		}
+	}
+
+	// nil and empty slices are preserved
+	obj := &BeaconBlockBody{RandaoReveal: []byte{}, Deposits: []*Deposit{}}
+	cpy := obj.Copy()
+	if cpy.RandaoReveal == nil || cpy.Deposits == nil || cpy.Attestations != nil || cpy.Eth1Data != nil {
+		t.Fatal("nil and empty slices are not preserved")
+	}
+	if (*BeaconBlockBody)(nil).Copy() != nil {
+		t.Fatal("the copy of nil is not nil")
+	}
+}
+
+// sharedMemory returns the path of the first slice or pointer that is shared by a and b
+func sharedMemory(a, b reflect.Value, path string) string {
+	switch a.Kind() {
+	case reflect.Ptr:
+		if a.IsNil() {
+			return ""
+		}
+		if a.Pointer() == b.Pointer() {
+			return path
+		}
+		return sharedMemory(a.Elem(), b.Elem(), path)
+
+	case reflect.Slice:
+		if a.Len() == 0 {
+			return ""
+		}
+		if a.Pointer() == b.Pointer() {
+			return path
+		}
+		for i := 0; i < a.Len(); i++ {
+			if p := sharedMemory(a.Index(i), b.Index(i), path+"["+strconv.Itoa(i)+"]"); p != "" {
+				return p
+			}
+		}
+
+	case reflect.Array:
+		for i := 0; i < a.Len(); i++ {
+			if p := sharedMemory(a.Index(i), b.Index(i), path+"["+strconv.Itoa(i)+"]"); p != "" {
+				return p
+			}
+		}
+
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if p := sharedMemory(a.Field(i), b.Field(i), path+"."+a.Type().Field(i).Name); p != "" {
+				return p
+			}
+		}
+	}
+	return ""
+}
+
+func TestEqualSSZ(t *testing.T) {
+	for name, codec := range codecs {
+		obj := codec("")
+		fuzzValid(obj, 1)
+
+		// the decoded object is equal even if nil
+		// and empty values are not decoded the same
+		dst, err := obj.MarshalSSZTo(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		obj2 := codec("")
+		if err := obj2.UnmarshalSSZ(dst); err != nil {
+			t.Fatal(err)
+		}
+		if !ssz.EqualObject(obj, obj2) {
+			t.Fatalf("%s: objects are not equal", name)
+		}
+		if diffs := ssz.DiffObject(obj, obj2); len(diffs) != 0 {
+			t.Fatalf("%s: unexpected differences %v", name, diffs)
+		}
+	}
+
+	// nil and empty values are equal
+	if !(&BeaconBlockBody{}).EqualSSZ(&BeaconBlockBody{Eth1Data: &Eth1Data{}, Attestations: []*Attestation{}}) {
+		t.Fatal("nil and empty values are not equal")
+	}
+
+	obj := new(BeaconBlockBody)
+	fuzz.NewWithSeed(1).Fuzz(obj)
+	obj.Attestations = []*Attestation{{}, {AggregationBits: []byte{0x1}}}
+
+	obj2 := obj.Copy()
+	obj2.Attestations[1].AggregationBits[0] = 0x3
+	obj2.Graffiti[0]++
+	if obj.EqualSSZ(obj2) {
+		t.Fatal("objects are equal")
+	}
+	diffs := obj.DiffSSZ(obj2)
+	if len(diffs) != 2 || diffs[0].Path != "Graffiti" || diffs[1].Path != "Attestations[1].AggregationBits" {
+		t.Fatalf("bad differences %v", diffs)
+	}
+	if !bytes.Equal(diffs[1].B.([]byte), obj2.Attestations[1].AggregationBits) {
+		t.Fatal("bad value")
+	}
+}
+
+func TestJSON(t *testing.T) {
+	for name, codec := range codecs {
+		for i := 0; i < 5; i++ {
+			obj := codec("")
+			fuzzValid(obj, int64(i))
+
+			expected, err := obj.MarshalSSZ()
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(obj)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			obj2 := codec("")
+			if err := json.Unmarshal(data, obj2); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			found, err := obj2.MarshalSSZ()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(expected, found) {
+				t.Fatalf("%s: the JSON encoding does not round trip", name)
+			}
+		}
+	}
+}
+
+func TestJSONBeaconAPI(t *testing.T) {
+	data := `{
+		"aggregation_bits": "0x0b",
+		"data": {
+			"slot": "18446744073709551615",
+			"index": "1",
+			"beacon_block_root": "0x0000000000000000000000000000000000000000000000000000000000000002",
+			"source": {"epoch": "3", "root": "0x0000000000000000000000000000000000000000000000000000000000000004"},
+			"target": {"epoch": "5", "root": "0x0000000000000000000000000000000000000000000000000000000000000006"}
+		},
+		"signature": "0x` + strings.Repeat("07", 96) + `"
+	}`
+
+	var obj Attestation
+	if err := json.Unmarshal([]byte(data), &obj); err != nil {
+		t.Fatal(err)
+	}
+	if obj.Data.Slot != 1<<64-1 || obj.Data.Target.Epoch != 5 || obj.Data.Source.Root[31] != 4 || obj.Signature.Data[95] != 7 {
+		t.Fatal("bad decoding")
+	}
+	if !bytes.Equal(obj.AggregationBits, []byte{0x0b}) {
+		t.Fatal("bad bitlist")
+	}
+
+	found, err := json.Marshal(&obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected bytes.Buffer
+	if err := json.Compact(&expected, []byte(data)); err != nil {
+		t.Fatal(err)
+	}
+	if expected.String() != string(found) {
+		t.Fatalf("bad encoding %s", found)
+	}
+
+	// the sizes are checked with the ssz limits
+	cases := []struct {
+		field, value, path string
+		err                error
+	}{
+		{`"aggregation_bits"`, `"0x` + strings.Repeat("ff", 257) + `01"`, "AggregationBits", ssz.ErrBytesLength},
+		{`"root"`, `"0x00"`, "Data.Source.Root", ssz.ErrBytesLength},
+		{`"slot"`, `"18446744073709551616"`, "Data.Slot", ssz.ErrJSONUint},
+		{`"index"`, `1`, "Data.Index", nil},
+		{`"signature"`, `null`, "Signature", ssz.ErrMissingField},
+	}
+	for _, c := range cases {
+		// replace the first value of the field
+		indx := strings.Index(data, c.field) + len(c.field) + 2
+		end := indx + strings.IndexAny(data[indx:], ",\n}")
+		input := data[:indx] + c.value + data[end:]
+
+		var obj Attestation
+		err := json.Unmarshal([]byte(input), &obj)
+		var jsonErr *ssz.JSONError
+		if !errors.As(err, &jsonErr) || jsonErr.Path != c.path {
+			t.Fatalf("expected error at %s but found %v", c.path, err)
+		}
+		if c.err != nil && !errors.Is(err, c.err) {
+			t.Fatalf("expected %v but found %v", c.err, err)
+		}
+	}
+}
+
+func TestYAMLSpec(t *testing.T) {
+	for name, codec := range codecs {
+		for i := 0; i < 5; i++ {
+			obj := codec("")
+			fuzzValid(obj, int64(i))
+
+			expected, err := obj.MarshalSSZ()
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := ssz.MarshalYAMLSpec(obj)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			obj2 := codec("")
+			if err := ssz.UnmarshalYAMLSpec(data, obj2); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			found, err := obj2.MarshalSSZ()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(expected, found) {
+				t.Fatalf("%s: the yaml encoding does not round trip", name)
+			}
+		}
+	}
+}
+
+func TestHashTreeRootParallel(t *testing.T) {
+	// a low threshold splits the small fuzzed lists between the workers
+	pool := ssz.NewHasherPool(ssz.HasherOptions{Workers: 4, Threshold: 2})
+
+	for name, codec := range codecs {
+		for i := 0; i < 5; i++ {
+			obj := codec("")
+			fuzz.NewWithSeed(int64(i)).Fuzz(obj)
+
+			expected, err := obj.HashTreeRoot()
+			if err != nil {
+				t.Fatal(err)
+			}
+			found, err := ssz.HashWithHasherPool(obj, pool)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if expected != found {
+				t.Fatalf("%s: bad parallel root, expected %x but found %x", name, expected, found)
+			}
+		}
+	}
+
+	// a state with a registry larger than the default threshold
+	state := new(BeaconState)
+	fuzz.NewWithSeed(1).Fuzz(state)
+	state.Validators = make([]*Validator, 5000)
+	state.Balances = make([]uint64, 5000)
+	for i := range state.Validators {
+		state.Validators[i] = &Validator{Pubkey: make([]byte, 48), WithdrawalCredentials: make([]byte, 32), EffectiveBalance: uint64(i)}
+		state.Balances[i] = uint64(i)
+	}
+	expected, err := state.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	found, err := ssz.HashWithHasherPool(state, ssz.NewHasherPool(ssz.HasherOptions{Workers: 3}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected != found {
+		t.Fatalf("bad parallel state root, expected %x but found %x", expected, found)
+	}
+}
+
+// sha512Hash hashes the chunks with SHA-512/256 and it is safe for concurrent use
+type sha512Hash struct{}
+
+func (sha512Hash) Hash(a, b [32]byte) [32]byte {
+	var buf [64]byte
+	copy(buf[:32], a[:])
+	copy(buf[32:], b[:])
+	return sha512.Sum512_256(buf[:])
+}
+
+func (s sha512Hash) HashPairs(digests, chunks [][32]byte) error {
+	for i := 0; i < len(chunks)/2; i++ {
+		digests[i] = s.Hash(chunks[2*i], chunks[2*i+1])
+	}
+	return nil
+}
+
+func TestHashTreeRootParallelFields(t *testing.T) {
+	type parallelHasher interface {
+		HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error)
+		HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error)
+	}
+	pool := ssz.NewHasherPool(ssz.HasherOptions{HashFunction: sha512Hash{}, Workers: 2, Threshold: 2})
+
+	for name, codec := range codecs {
+		for i := 0; i < 5; i++ {
+			obj := codec("")
+			fuzz.NewWithSeed(int64(i)).Fuzz(obj)
+
+			expected, err := obj.HashTreeRoot()
+			if err != nil {
+				t.Fatal(err)
+			}
+			found, err := obj.(parallelHasher).HashTreeRootParallel(context.Background(), 4)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if expected != found {
+				t.Fatalf("%s: bad parallel root, expected %x but found %x", name, expected, found)
+			}
+
+			// the fields are hashed with the hash function of the pool
+			expected, err = ssz.HashWithHasherPool(obj, pool)
+			if err != nil {
+				t.Fatal(err)
+			}
+			found, err = obj.(parallelHasher).HashTreeRootParallelWithPool(context.Background(), pool, 4)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if expected != found {
+				t.Fatalf("%s: bad parallel root with pool, expected %x but found %x", name, expected, found)
+			}
+		}
+	}
+
+	state := new(BeaconState)
+	fuzz.NewWithSeed(1).Fuzz(state)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := state.HashTreeRootParallel(ctx, 4); err != context.Canceled {
+		t.Fatalf("expected the context error but found %v", err)
+	}
+
+	// the error of the first invalid field is returned like in the serial path
+	state.GenesisValidatorsRoot = []byte{0x1}
+	state.Eth1DataVotes = make([]*Eth1Data, 33)
+	_, expected := state.HashTreeRoot()
+	for i := 0; i < 10; i++ {
+		if _, err := state.HashTreeRootParallel(context.Background(), 8); err == nil || err.Error() != expected.Error() {
+			t.Fatalf("expected error %v but found %v", expected, err)
+		}
+	}
+}
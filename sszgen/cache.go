@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+)
+
+// cachedHashTreeRootTmpl is the template of the hash functions of the containers
+// with a ssz.HashCache field. Only the fields marked as dirty are hashed again.
+const cachedHashTreeRootTmpl = `// HashTreeRoot ssz hashes the {{.name}} object
+	func (:: *{{.name}}) HashTreeRoot() ([32]byte, error) {
+		return ssz.HashWithDefaultHasher(::)
+	}
+
+	// HashTreeRootWith ssz hashes the {{.name}} object with a hasher. The roots of the fields
+	// are cached, the fields changed since the last call must be marked with the Mark*Dirty
+	// methods or set with the setters.
+	func (:: *{{.name}}) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+		{{.hashTreeRoot}}
+		return
+	}
+
+	{{.setters}}`
+
+// isHashCache returns true if the field is a ssz.HashCache
+func isHashCache(expr ast.Expr) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	return ok && sel.Sel.Name == "HashCache"
+}
+
+// hashTreeRootCached returns the body of HashTreeRootWith for a container with a cache
+// and the setters of its fields
+func (v *Value) hashTreeRootCached() (string, string) {
+	fields, setters := []string{}, []string{}
+	for indx, f := range v.o {
+		fields = append(fields, fmt.Sprintf("// Field (%d) '%s'\n%s", indx, f.name, f.hashTreeRootCachedField(v.cache, indx)))
+
+		tmpl := `// Set{{.name}} sets the field '{{.name}}' and marks it as changed
+		func (:: *{{.obj}}) Set{{.name}}(val {{.typ}}) {
+			::.{{.name}} = val
+			::.{{.cache}}.MarkDirty({{.indx}})
+		}
+
+		{{if .chunks}}// Mark{{.name}}Dirty marks the field '{{.name}}' as changed. 'indices' are the
+		// changed elements, all of them if there are none.
+		func (:: *{{.obj}}) Mark{{.name}}Dirty(indices ...int) {
+			::.{{.cache}}.MarkDirty({{.indx}}, indices...)
+		}{{else}}// Mark{{.name}}Dirty marks the field '{{.name}}' as changed
+		func (:: *{{.obj}}) Mark{{.name}}Dirty() {
+			::.{{.cache}}.MarkDirty({{.indx}})
+		}{{end}}`
+		_, _, chunks := f.cachedChunks("")
+		setters = append(setters, execTmpl(tmpl, map[string]interface{}{
+			"obj":    v.name,
+			"name":   f.name,
+			"typ":    f.goExpr,
+			"cache":  v.cache,
+			"indx":   indx,
+			"chunks": chunks,
+		}))
+	}
+
+	tmpl := `::.{{.cache}}.Prepare(hh, {{.num}})
+
+	{{.fields}}
+
+	::.{{.cache}}.Root(hh)`
+	body := execTmpl(tmpl, map[string]interface{}{
+		"cache":  v.cache,
+		"num":    len(v.o),
+		"fields": strings.Join(fields, "\n\n"),
+	})
+	return body, strings.Join(setters, "\n\n")
+}
+
+// hashTreeRootCachedField returns the code that updates the cached root of the field. The
+// lists and vectors of containers, uints and roots cache their chunks, the other fields
+// only cache their root.
+func (v *Value) hashTreeRootCachedField(cache string, indx int) string {
+	name := "::." + v.name
+	if perChunk, elem, ok := v.cachedChunks(name + "[indx]"); ok {
+		tmpl := `{{.validate}}if err = ::.{{.cache}}.{{.method}}(hh, {{.indx}}, len({{.name}}), {{.perChunk}}, {{if .limit}}{{.limit}}, {{end}}func(indx int, hh *ssz.Hasher) error {
+			{{.elem}}
+		}); err != nil {
+			return
+		}`
+		method, limit := "Vector", uint64(0)
+		if v.t == TypeList {
+			method = "List"
+			limit = (v.m*v.e.fixedSizeForChunks() + 31) / 32
+		}
+		return execTmpl(tmpl, map[string]interface{}{
+			"validate": v.validate(),
+			"cache":    cache,
+			"method":   method,
+			"indx":     indx,
+			"name":     name,
+			"perChunk": perChunk,
+			"limit":    limit,
+			"elem":     elem,
+		})
+	}
+
+	tmpl := `if err = ::.{{.cache}}.Field(hh, {{.indx}}, func(hh *ssz.Hasher) (err error) {
+		{{.hashTreeRoot}}
+		return
+	}); err != nil {
+		return
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"cache":        cache,
+		"indx":         indx,
+		"hashTreeRoot": v.hashTreeRoot("", false),
+	})
+}
+
+// cachedChunks returns the number of elements per chunk of a list or vector whose chunks
+// are cached and the code that appends the element 'elem' to the hasher
+func (v *Value) cachedChunks(elem string) (int, string, bool) {
+	if v.t != TypeList && v.t != TypeVector {
+		return 0, "", false
+	}
+	switch e := v.e; {
+	case e.t == TypeContainer || e.t == TypeReference:
+		if e.noPtr {
+			elem = "&" + elem
+		}
+		return 1, fmt.Sprintf("return %s.HashTreeRootWith(hh)", elem), true
+
+	case e.t == TypeUint && (e.s == 1 || e.s == 8):
+		if e.obj != "" {
+			// alias of the uint
+			elem = fmt.Sprintf("uint%d(%s)", e.s*8, elem)
+		}
+		return 32 / int(e.s), fmt.Sprintf("hh.Append%s(%s)\nreturn nil", uintVToName(e), elem), true
+
+	case e.t == TypeBytes && e.isFixed() && e.s == 32:
+		if e.c {
+			return 1, fmt.Sprintf("hh.Append(%s[:])\nreturn nil", elem), true
+		}
+		tmpl := `if len({{.elem}}) != 32 {
+			return ssz.ErrBytesLength
+		}
+		hh.Append({{.elem}})
+		return nil`
+		return 1, execTmpl(tmpl, map[string]interface{}{"elem": elem}), true
+	}
+	return 0, "", false
+}
+
+// fixedSizeForChunks returns the size of the element in the chunks, containers use one chunk
+func (v *Value) fixedSizeForChunks() uint64 {
+	if v.t == TypeContainer || v.t == TypeReference {
+		return 32
+	}
+	return v.s
+}
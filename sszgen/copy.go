@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// copy creates a function that returns a deep copy of the container. The
+// fields are copied by value first and then every field that references
+// memory (slices and pointers) is replaced with a copy of it.
+func (e *env) copy(name string, v *Value) string {
+	if v.t != TypeContainer {
+		return ""
+	}
+
+	tmpl := `// Copy returns a deep copy of the {{.name}} object
+	func (:: *{{.name}}) Copy() *{{.name}} {
+		if :: == nil {
+			return nil
+		}
+		cpy := new({{.name}})
+		*cpy = *::
+		{{ if .copy }}
+		{{.copy}}
+
+		{{ end }}{{ if .cache }}// the cache is not shared with the copy
+		cpy.{{.cache}} = ::.{{.cache}}.Copy()
+
+		{{ end }}return cpy
+	}`
+
+	fields := []string{}
+	for _, f := range v.o {
+		if code := f.copyValue("cpy."+f.name, 0); code != "" {
+			fields = append(fields, fmt.Sprintf("// Field '%s'\n%s", f.name, code))
+		}
+	}
+	return appendObjSignature(execTmpl(tmpl, map[string]interface{}{
+		"name":  name,
+		"copy":  strings.Join(fields, "\n\n"),
+		"cache": v.cache,
+	}), v)
+}
+
+// copyValue returns the code that replaces the value in 'dst', which holds a shallow
+// copy of the original value, with a deep copy. Nil slices and pointers are kept nil
+// and empty slices are kept empty. 'depth' is the nesting level of lists.
+func (v *Value) copyValue(dst string, depth int) string {
+	switch v.t {
+	case TypeBool:
+		return ""
+
+	case TypeUint:
+		if !v.isWideUint() || v.c || v.noPtr {
+			return ""
+		}
+		// *uint256.Int
+		return copyPointer(dst)
+
+	case TypeBytes, TypeBitList:
+		if v.c {
+			return ""
+		}
+		return copySlice(dst)
+
+	case TypeVector, TypeList, TypeProgressiveList:
+		if v.c {
+			// arrays are copied by value
+			return ""
+		}
+		indx := fmt.Sprintf("i%d", depth)
+		item := v.e.copyValue(fmt.Sprintf("%s[%s]", dst, indx), depth+1)
+		if item == "" {
+			return copySlice(dst)
+		}
+		return fmt.Sprintf("%s\nfor %s := range %s {\n%s\n}", copySlice(dst), indx, dst, item)
+
+	case TypeContainer, TypeReference:
+		obj := v.objRef()
+		if v.t == TypeContainer && v.ref == "" {
+			if v.noPtr {
+				return fmt.Sprintf("%s = *%s.Copy()", dst, dst)
+			}
+			return fmt.Sprintf("%s = %s.Copy()", dst, dst)
+		}
+		// types from other packages may not have a Copy function
+		if v.noPtr {
+			return fmt.Sprintf("%s = *ssz.CopyObject(&%s).(*%s)", dst, dst, obj)
+		}
+		return fmt.Sprintf("%s = ssz.CopyObject(%s).(*%s)", dst, dst, obj)
+
+	case TypeOptional:
+		if v.e.t == TypeContainer || v.e.t == TypeReference {
+			return v.e.copyValue(dst, depth)
+		}
+		return copyPointer(dst)
+
+	case TypeUnion:
+		str := fmt.Sprintf("switch val := %s.(type) {\n", dst)
+		for _, opt := range v.o {
+			if opt == nil {
+				continue
+			}
+			// the types of a union are pointers to containers
+			if opt.t == TypeContainer && opt.ref == "" {
+				str += fmt.Sprintf("case *%s:\n%s = val.Copy()\n", opt.obj, dst)
+			} else {
+				str += fmt.Sprintf("case *%s:\n%s = ssz.CopyObject(val).(*%s)\n", opt.objRef(), dst, opt.objRef())
+			}
+		}
+		return str + "}"
+
+	default:
+		panic(fmt.Errorf("copy not implemented for type %s", v.t.String()))
+	}
+}
+
+// copySlice copies the slice in 'dst', a nil slice stays nil
+func copySlice(dst string) string {
+	return fmt.Sprintf("%s = append(%s[:0:0], %s...)", dst, dst, dst)
+}
+
+// copyPointer copies the value referenced by the pointer in 'dst'
+func copyPointer(dst string) string {
+	return fmt.Sprintf("if %s != nil {\nval := *%s\n%s = &val\n}", dst, dst, dst)
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// equal creates the EqualSSZ and DiffSSZ functions of the container. Two objects
+// are equal if they have the same encoding, so nil lists are equal to empty lists
+// and nil containers are equal to empty containers.
+func (e *env) equal(name string, v *Value) string {
+	if v.t != TypeContainer {
+		return ""
+	}
+
+	tmpl := `// EqualSSZ returns true if the {{.name}} objects have the same SSZ encoding
+	func (:: *{{.name}}) EqualSSZ(other *{{.name}}) bool {
+		if :: == nil {
+			:: = new({{.name}})
+		}
+		if other == nil {
+			other = new({{.name}})
+		}
+
+		{{.equal}}
+
+		return true
+	}
+
+	// DiffSSZ returns the fields with a different SSZ encoding in the {{.name}} objects
+	func (:: *{{.name}}) DiffSSZ(other *{{.name}}) []ssz.FieldDiff {
+		if :: == nil {
+			:: = new({{.name}})
+		}
+		if other == nil {
+			other = new({{.name}})
+		}
+		var diffs []ssz.FieldDiff
+
+		{{.diff}}
+
+		return diffs
+	}`
+
+	equal, diff := []string{}, []string{}
+	for _, f := range v.o {
+		a, b, path := "::."+f.name, "other."+f.name, strconv.Quote(f.name)
+		equal = append(equal, fmt.Sprintf("// Field '%s'\n%s", f.name, f.equalSSZ(a, b, path, false, 0)))
+		diff = append(diff, fmt.Sprintf("// Field '%s'\n%s", f.name, f.equalSSZ(a, b, path, true, 0)))
+	}
+	return appendObjSignature(execTmpl(tmpl, map[string]interface{}{
+		"name":  name,
+		"equal": strings.Join(equal, "\n\n"),
+		"diff":  strings.Join(diff, "\n\n"),
+	}), v)
+}
+
+// equalSSZ returns the code that compares the values 'a' and 'b'. If 'diff' is set the
+// differences are appended to the 'diffs' variable with the path of the field, which is
+// the expression 'path', otherwise the code returns false if the values are not equal.
+// 'depth' is the nesting level of lists.
+func (v *Value) equalSSZ(a, b, path string, diff bool, depth int) string {
+	fail := "return false"
+	if diff {
+		fail = fmt.Sprintf("diffs = append(diffs, ssz.FieldDiff{Path: %s, A: %s, B: %s})", path, a, b)
+	}
+	// nested compares a field with DiffSSZ when diff is set, otherwise with EqualSSZ
+	nested := func(equal, diffs string) string {
+		if diff {
+			return fmt.Sprintf("diffs = ssz.AppendDiffs(diffs, %s, %s)", path, diffs)
+		}
+		return fmt.Sprintf("if !%s {\nreturn false\n}", equal)
+	}
+
+	switch v.t {
+	case TypeBool:
+		return fmt.Sprintf("if %s != %s {\n%s\n}", a, b, fail)
+
+	case TypeUint:
+		if !v.isWideUint() || v.c || v.noPtr {
+			return fmt.Sprintf("if %s != %s {\n%s\n}", a, b, fail)
+		}
+		// nil pointers are encoded as zero
+		tmpl := `{
+			var va, vb {{.obj}}
+			if {{.a}} != nil {
+				va = *{{.a}}
+			}
+			if {{.b}} != nil {
+				vb = *{{.b}}
+			}
+			if va != vb {
+				{{.fail}}
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"obj":  v.objRef(),
+			"a":    a,
+			"b":    b,
+			"fail": fail,
+		})
+
+	case TypeBytes, TypeBitList:
+		if v.c {
+			return fmt.Sprintf("if %s != %s {\n%s\n}", a, b, fail)
+		}
+		return fmt.Sprintf("if !bytes.Equal(%s, %s) {\n%s\n}", a, b, fail)
+
+	case TypeVector, TypeList, TypeProgressiveList:
+		if v.c {
+			// arrays are comparable
+			return fmt.Sprintf("if %s != %s {\n%s\n}", a, b, fail)
+		}
+		indx := fmt.Sprintf("i%d", depth)
+		item := v.e.equalSSZ(
+			fmt.Sprintf("%s[%s]", a, indx),
+			fmt.Sprintf("%s[%s]", b, indx),
+			fmt.Sprintf("ssz.IndexPath(%s, %s)", path, indx),
+			diff,
+			depth+1,
+		)
+		tmpl := `if len({{.a}}) != len({{.b}}) {
+			{{.fail}}
+		} else {
+			for {{.indx}} := range {{.a}} {
+				{{.item}}
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"a":    a,
+			"b":    b,
+			"fail": fail,
+			"indx": indx,
+			"item": item,
+		})
+
+	case TypeContainer, TypeReference:
+		if v.noPtr {
+			a, b = "&"+a, "&"+b
+		}
+		if v.t == TypeContainer && v.ref == "" {
+			return nested(fmt.Sprintf("%s.EqualSSZ(%s)", a, b), fmt.Sprintf("%s.DiffSSZ(%s)", a, b))
+		}
+		// types from other packages may not have the EqualSSZ and DiffSSZ functions
+		return nested(fmt.Sprintf("ssz.EqualObject(%s, %s)", a, b), fmt.Sprintf("ssz.DiffObject(%s, %s)", a, b))
+
+	case TypeOptional:
+		// the presence of the value is part of the encoding
+		var value string
+		if v.e.t == TypeContainer || v.e.t == TypeReference {
+			value = v.e.equalSSZ(a, b, path, diff, depth)
+		} else {
+			value = fmt.Sprintf("if *%s != *%s {\n%s\n}", a, b, fail)
+		}
+		return fmt.Sprintf("if (%s == nil) != (%s == nil) {\n%s\n} else if %s != nil {\n%s\n}", a, b, fail, a, value)
+
+	case TypeUnion:
+		// the selected types must match
+		str := fmt.Sprintf("switch va := %s.(type) {\ncase nil:\nif %s != nil {\n%s\n}\n", a, b, fail)
+		for _, opt := range v.o {
+			if opt == nil {
+				continue
+			}
+			str += fmt.Sprintf("case *%s:\nif vb, ok := %s.(*%s); !ok {\n%s\n} else {\n%s\n}\n", opt.objRef(), b, opt.objRef(), fail, opt.equalSSZ("va", "vb", path, diff, depth))
+		}
+		return str + "}"
+
+	default:
+		panic(fmt.Errorf("equal not implemented for type %s", v.t.String()))
+	}
+}
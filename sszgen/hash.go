@@ -22,10 +22,50 @@ func (e *env) hashTreeRoot(name string, v *Value) string {
 		"name":         name,
 		"hashTreeRoot": v.hashTreeRootContainer(true),
 	}
+	if v.stable != 0 || v.profile != nil {
+		// stable containers and profiles mix in the active fields
+		data["hashTreeRoot"] = v.hashTreeRootStable()
+	}
+	if v.cache != "" {
+		tmpl = cachedHashTreeRootTmpl
+		data["cache"] = v.cache
+		data["hashTreeRoot"], data["setters"] = v.hashTreeRootCached()
+	}
 	str := execTmpl(tmpl, data)
+	if e.parallel && v.stable == 0 && v.profile == nil {
+		str += "\n\n" + v.hashTreeRootParallel(name)
+	}
 	return appendObjSignature(str, v)
 }
 
+// hashTreeRootParallel creates the HashTreeRootParallel function of the container
+// which hashes each field with its own Hasher and merkleizes the roots afterwards
+func (v *Value) hashTreeRootParallel(name string) string {
+	tmpl := `// HashTreeRootParallel ssz hashes the {{.name}} object computing
+	// the roots of the fields concurrently with up to 'workers' goroutines
+	func (:: *{{.name}}) HashTreeRootParallel(ctx context.Context, workers int) ([32]byte, error) {
+		return ::.HashTreeRootParallelWithPool(ctx, &ssz.DefaultHasherPool, workers)
+	}
+
+	// HashTreeRootParallelWithPool ssz hashes the {{.name}} object like HashTreeRootParallel
+	// with the Hashers of the pool
+	func (:: *{{.name}}) HashTreeRootParallelWithPool(ctx context.Context, pool *ssz.HasherPool, workers int) ([32]byte, error) {
+		return ssz.HashFieldsParallel(ctx, pool, workers, []func(hh *ssz.Hasher) error{
+			{{.fields}}
+		})
+	}`
+
+	fields := []string{}
+	for indx, i := range v.o {
+		str := fmt.Sprintf("// Field (%d) '%s'\nfunc(hh *ssz.Hasher) (err error) {\n%s\nreturn\n},", indx, i.name, i.hashTreeRoot("", false))
+		fields = append(fields, str)
+	}
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":   name,
+		"fields": strings.Join(fields, "\n"),
+	})
+}
+
 func (v *Value) hashRoots(isList bool, elem Type) string {
 	subName := "i"
 	if v.e.c {
@@ -54,6 +94,10 @@ func (v *Value) hashRoots(isList bool, elem Type) string {
 			appendFn = "Append"
 			elemSize = 32
 		}
+	} else if v.e.isWideUint() && v.e.c {
+		// [][32]byte or [][16]byte little endian uints
+		appendFn = "Append"
+		elemSize = v.e.s
 	} else {
 		// []uint64
 		appendFn = "Append" + uintVToName(v.e)
@@ -90,20 +134,24 @@ func (v *Value) hashRoots(isList bool, elem Type) string {
 		merkleize = "hh.Merkleize(subIndx)"
 	}
 
+	appendItem := fmt.Sprintf("%shh.%s(%s)", inner, appendFn, subName)
+	if v.e.isWideUint() && !v.e.c && !v.e.noPtr {
+		// []*uint256.Int, nil items are hashed as zero like they are encoded
+		appendItem = v.e.hashWideUintPtr("i", appendFn)
+	}
+
 	tmpl := `{
 		{{.outer}}subIndx := hh.Index()
 		for _, i := range ::.{{.name}} {
-			{{.inner}}hh.{{.appendFn}}({{.subName}})
+			{{.appendItem}}
 		}
 		{{.merkleize}}
 	}`
 	return execTmpl(tmpl, map[string]interface{}{
-		"outer":     v.validate(),
-		"inner":     inner,
-		"name":      v.name,
-		"subName":   subName,
-		"appendFn":  appendFn,
-		"merkleize": merkleize,
+		"outer":      v.validate(),
+		"name":       v.name,
+		"appendItem": appendItem,
+		"merkleize":  merkleize,
 	})
 }
 
@@ -160,6 +208,16 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 		}
 
 	case TypeUint:
+		if v.isWideUint() {
+			if v.c {
+				// little endian byte array
+				return fmt.Sprintf("hh.PutBytes(%s[:])", name)
+			}
+			if !v.noPtr {
+				return v.hashWideUintPtr(name, "Put"+uintVToName(v))
+			}
+			return fmt.Sprintf("hh.Put%s(%s)", uintVToName(v), name)
+		}
 		if v.ref != "" || v.obj != "" {
 			// alias to Uint64
 			name = fmt.Sprintf("uint64(%s)", name)
@@ -182,9 +240,67 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 	case TypeBool:
 		return fmt.Sprintf("hh.PutBool(%s)", name)
 
+	case TypeOptional:
+		// the root of None is zero
+		tmpl := `{
+			subIndx := hh.Index()
+			if {{.name}} == nil {
+				hh.MerkleizeWithSelector(subIndx, 0)
+			} else {
+				{{.htr}}
+				hh.MerkleizeWithSelector(subIndx, 1)
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": name,
+			"htr":  v.hashTreeRootOptionalValue(),
+		})
+
+	case TypeUnion:
+		// the root of None is zero
+		tmpl := `{
+			subIndx := hh.Index()
+			switch opt := {{.name}}.(type) {
+			{{ range .types }}{{ if .Obj }}case *{{.Obj}}:
+				if err = opt.HashTreeRootWith(hh); err != nil {
+					return
+				}
+				hh.MerkleizeWithSelector(subIndx, {{.Selector}})
+			{{ else }}case nil:
+				hh.MerkleizeWithSelector(subIndx, 0)
+			{{ end }}{{ end }}default:
+				err = ssz.ErrUnionType
+				return
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":  name,
+			"types": v.unionTypes(),
+		})
+
 	case TypeVector:
 		return v.hashRoots(false, v.e.t)
 
+	case TypeProgressiveList:
+		tmpl := `{
+			subIndx := hh.Index()
+			num := uint64(len({{.name}}))
+			{{.htrCall}}
+			{{if .basic}}hh.FillUpTo32()
+			{{end}}hh.MerkleizeProgressiveWithMixin(subIndx, num)
+		}`
+		var htrCall string
+		if v.e.t == TypeUint {
+			htrCall = fmt.Sprintf("for _, elem := range %s {\nhh.Append%s(elem)\n}", name, uintVToName(v.e))
+		} else {
+			htrCall = hashElements(name)
+		}
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":    name,
+			"basic":   v.e.t == TypeUint,
+			"htrCall": htrCall,
+		})
+
 	case TypeList:
 		if v.e.isFixed() {
 			if v.e.t == TypeUint || v.e.t == TypeBytes {
@@ -199,21 +315,16 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 				err = ssz.ErrIncorrectListSize
 				return
 			}
-			for _, elem := range {{.name}} {
-{{.htrCall}}
-			}
+			{{.htrCall}}
 			hh.MerkleizeWithMixin(subIndx, num, {{.num}})
 		}`
 		var htrCall string
 		if v.e.t == TypeBytes {
 			eName := "elem"
 			// ByteLists should be represented as Value with TypeBytes and .m set instead of .s (isFixed == true)
-			htrCall = v.e.hashTreeRoot(eName, true)
+			htrCall = fmt.Sprintf("for _, elem := range %s {\n%s\n}", name, v.e.hashTreeRoot(eName, true))
 		} else {
-			htrCall = execTmpl(`if err = elem.HashTreeRootWith(hh); err != nil {
-	return
-}`,
-				map[string]interface{}{"name": name})
+			htrCall = hashElements(name)
 		}
 		return execTmpl(tmpl, map[string]interface{}{
 			"name":    name,
@@ -226,6 +337,29 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 	}
 }
 
+// hashElements hashes the elements of the list 'name' with HashElements, which
+// splits large lists between the workers of the Hasher
+func hashElements(name string) string {
+	tmpl := `if err = hh.HashElements(len({{.name}}), func(indx int, hh *ssz.Hasher) error {
+		return {{.name}}[indx].HashTreeRootWith(hh)
+	}); err != nil {
+		return
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"name": name,
+	})
+}
+
+// hashTreeRootOptionalValue hashes the value of an optional type
+func (v *Value) hashTreeRootOptionalValue() string {
+	v.e.name = v.name
+	if v.e.t == TypeContainer || v.e.t == TypeReference {
+		return fmt.Sprintf("if err = ::.%s.HashTreeRootWith(hh); err != nil {\nreturn\n}", v.name)
+	}
+	fn, name := v.e.optionalBasic()
+	return fmt.Sprintf("hh.Put%s(%s)", fn, name)
+}
+
 func (v *Value) hashTreeRootContainer(start bool) string {
 	if !start {
 		return fmt.Sprintf("if err = ::.%s.HashTreeRootWith(hh); err != nil {\n return\n}", v.name)
@@ -253,3 +387,19 @@ func (v *Value) hashTreeRootContainer(start bool) string {
 		"fields": strings.Join(out, "\n"),
 	})
 }
+
+// hashWideUintPtr returns the code that hashes the pointer to a wide uint 'name' with
+// the method 'method' of the hasher. A nil pointer is hashed as zero, like the
+// encoding writes it.
+func (v *Value) hashWideUintPtr(name, method string) string {
+	tmpl := `if {{.name}} == nil {
+		hh.{{.method}}([{{.words}}]uint64{})
+	} else {
+		hh.{{.method}}(*{{.name}})
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":   name,
+		"method": method,
+		"words":  v.s / 8,
+	})
+}
@@ -0,0 +1,430 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonKeyTag returns the key of the field in the JSON encoding, the
+// name in the 'json' tag if there is one and the field name otherwise.
+func jsonKeyTag(name, tags string) string {
+	if tag, ok := getTags(tags, "json"); ok {
+		if key := strings.Split(tag, ",")[0]; key != "" && key != "-" {
+			return key
+		}
+	}
+	return name
+}
+
+// json creates the MarshalJSON and UnmarshalJSON functions of the container. The
+// encoding follows the conventions of the Beacon API: uints are quoted decimal
+// strings, bytes and bitlists are 0x prefixed hex strings and lists are arrays.
+func (e *env) json(name string, v *Value) string {
+	if v.t != TypeContainer {
+		return ""
+	}
+
+	tmpl := `// MarshalJSON returns the JSON encoding of the {{.name}} object following the Beacon API conventions
+	func (:: *{{.name}}) MarshalJSON() ([]byte, error) {
+		if :: == nil {
+			:: = new({{.name}})
+		}
+		{{ if .marshalErr }}var err error
+		{{ end }}dst := []byte{'{'}
+
+		{{.marshal}}
+
+		dst = append(dst, '}')
+		return dst, nil
+	}
+
+	// UnmarshalJSON decodes the JSON encoding of the {{.name}} object following the Beacon API conventions
+	func (:: *{{.name}}) UnmarshalJSON(data []byte) error {
+		var fields map[string]json.RawMessage
+		err := json.Unmarshal(data, &fields)
+		if err != nil {
+			return err
+		}
+		{{ if .cache }}::.{{.cache}}.Reset()
+		{{ end }}
+		{{.unmarshal}}
+
+		return nil
+	}`
+
+	marshal, unmarshal := []string{}, []string{}
+	for indx, f := range v.o {
+		key := strconv.Quote(f.jsonKey) + ":"
+		if indx != 0 {
+			key = "," + key
+		}
+		marshal = append(marshal, fmt.Sprintf("// Field (%d) '%s'\ndst = append(dst, %s...)\n%s", indx, f.name, strconv.Quote(key), f.marshalJSON("::."+f.name, 0)))
+
+		raw := fmt.Sprintf("fields[%s]", strconv.Quote(f.jsonKey))
+		unmarshal = append(unmarshal, fmt.Sprintf("// Field (%d) '%s'\n%s", indx, f.name, f.unmarshalJSON("::."+f.name, raw, strconv.Quote(f.name), 0)))
+	}
+	marshalStr, unmarshalStr := strings.Join(marshal, "\n\n"), strings.Join(unmarshal, "\n\n")
+
+	return appendObjSignature(execTmpl(tmpl, map[string]interface{}{
+		"name":       name,
+		"marshal":    marshalStr,
+		"unmarshal":  unmarshalStr,
+		"marshalErr": strings.Contains(marshalStr, "err = "),
+		"cache":      v.cache,
+	}), v)
+}
+
+// marshalJSON returns the code that appends the JSON encoding of
+// the value 'val' to dst. 'depth' is the nesting level of lists.
+func (v *Value) marshalJSON(val string, depth int) string {
+	switch v.t {
+	case TypeBool:
+		if v.obj != "" {
+			val = fmt.Sprintf("bool(%s)", val)
+		}
+		return fmt.Sprintf("dst = ssz.MarshalJSONBool(dst, %s)", val)
+
+	case TypeUint:
+		if !v.isWideUint() {
+			return fmt.Sprintf("dst = ssz.MarshalJSONUint64(dst, uint64(%s))", val)
+		}
+		if v.c {
+			// little endian byte array
+			return fmt.Sprintf("dst = ssz.MarshalJSONUint(dst, %s[:])", val)
+		}
+		if v.noPtr {
+			return fmt.Sprintf("dst = ssz.MarshalJSONUint(dst, ssz.Marshal%s(nil, %s))", uintVToName(v), val)
+		}
+		// nil pointers are encoded as zero
+		tmpl := `if {{.val}} == nil {
+			dst = ssz.MarshalJSONUint(dst, nil)
+		} else {
+			dst = ssz.MarshalJSONUint(dst, ssz.Marshal{{.uint}}(nil, *{{.val}}))
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"val":  val,
+			"uint": uintVToName(v),
+		})
+
+	case TypeBytes, TypeBitList:
+		if v.c {
+			val += "[:]"
+		}
+		return fmt.Sprintf("dst = ssz.MarshalJSONBytes(dst, %s)", val)
+
+	case TypeVector, TypeList, TypeProgressiveList:
+		if v.isProgressiveBytes() {
+			return fmt.Sprintf("dst = ssz.MarshalJSONBytes(dst, %s)", val)
+		}
+		indx := fmt.Sprintf("i%d", depth)
+		tmpl := `dst = append(dst, '[')
+		for {{.indx}} := range {{.val}} {
+			if {{.indx}} != 0 {
+				dst = append(dst, ',')
+			}
+			{{.item}}
+		}
+		dst = append(dst, ']')`
+		return execTmpl(tmpl, map[string]interface{}{
+			"val":  val,
+			"indx": indx,
+			"item": v.e.marshalJSON(fmt.Sprintf("%s[%s]", val, indx), depth+1),
+		})
+
+	case TypeContainer, TypeReference:
+		if v.noPtr {
+			val = "&" + val
+		}
+		return fmt.Sprintf("if dst, err = ssz.MarshalJSONObject(dst, %s); err != nil {\nreturn nil, err\n}", val)
+
+	case TypeOptional:
+		value := "*" + val
+		if v.e.t == TypeContainer || v.e.t == TypeReference || v.e.isWideUint() {
+			value = val
+		}
+		tmpl := `if {{.val}} == nil {
+			dst = append(dst, "null"...)
+		} else {
+			{{.marshal}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"val":     val,
+			"marshal": v.e.marshalJSON(value, depth),
+		})
+
+	case TypeUnion:
+		// the union is an object with the selector and the value of the selected type
+		str := fmt.Sprintf("switch val := %s.(type) {\n", val)
+		for _, typ := range v.unionTypes() {
+			if typ.Obj == "" {
+				str += fmt.Sprintf("case nil:\ndst = append(dst, %s...)\n", strconv.Quote(`{"selector":"0","value":null}`))
+				continue
+			}
+			tmpl := `case *{{.obj}}:
+			dst = append(dst, {{.prefix}}...)
+			if dst, err = ssz.MarshalJSONObject(dst, val); err != nil {
+				return nil, err
+			}
+			dst = append(dst, '}')
+			`
+			str += execTmpl(tmpl, map[string]interface{}{
+				"obj":    typ.Obj,
+				"prefix": strconv.Quote(fmt.Sprintf(`{"selector":"%d","value":`, typ.Selector)),
+			})
+		}
+		return str + "default:\nreturn nil, ssz.ErrUnionType\n}"
+
+	default:
+		panic(fmt.Errorf("marshal json not implemented for type %s", v.t.String()))
+	}
+}
+
+// unmarshalJSON returns the code that decodes the JSON value 'raw' into 'dst'. The
+// sizes are checked with the same limits as the SSZ encoding. The errors are wrapped
+// with the path of the value, which is the expression 'path'. 'depth' is the nesting
+// level of lists.
+func (v *Value) unmarshalJSON(dst, raw, path string, depth int) string {
+	fail := fmt.Sprintf("return ssz.WrapJSONError(err, %s)", path)
+
+	switch v.t {
+	case TypeBool:
+		if v.obj == "" {
+			return fmt.Sprintf("if %s, err = ssz.UnmarshalJSONBool(%s); err != nil {\n%s\n}", dst, raw, fail)
+		}
+		tmpl := `{
+			val, err := ssz.UnmarshalJSONBool({{.raw}})
+			if err != nil {
+				{{.fail}}
+			}
+			{{.dst}} = {{.obj}}(val)
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"dst":  dst,
+			"raw":  raw,
+			"fail": fail,
+			"obj":  v.objRef(),
+		})
+
+	case TypeUint:
+		if v.isWideUint() {
+			var assign string
+			if v.c {
+				// little endian byte array
+				assign = fmt.Sprintf("copy(%s[:], val)", dst)
+			} else if v.noPtr {
+				assign = fmt.Sprintf("%s = ssz.Unmarshall%s(val)", dst, uintVToName(v))
+			} else {
+				assign = fmt.Sprintf("%s = new(%s)\n*%s = ssz.Unmarshall%s(val)", dst, v.objRef(), dst, uintVToName(v))
+			}
+			tmpl := `{
+				val, err := ssz.UnmarshalJSONUint({{.raw}}, {{.size}})
+				if err != nil {
+					{{.fail}}
+				}
+				{{.assign}}
+			}`
+			return execTmpl(tmpl, map[string]interface{}{
+				"raw":    raw,
+				"size":   v.s,
+				"fail":   fail,
+				"assign": assign,
+			})
+		}
+		tmpl := `{
+			val, err := ssz.UnmarshalJSONUint64({{.raw}}, {{.size}})
+			if err != nil {
+				{{.fail}}
+			}
+			{{.dst}} = {{.obj}}(val)
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"dst":  dst,
+			"raw":  raw,
+			"size": v.s,
+			"fail": fail,
+			"obj":  v.goType(),
+		})
+
+	case TypeBytes:
+		min, max := uint64(0), v.m
+		if v.isFixed() {
+			min, max = v.s, v.s
+		}
+		if !v.c {
+			return fmt.Sprintf("if %s, err = ssz.UnmarshalJSONBytes(%s, %d, %d); err != nil {\n%s\n}", dst, raw, min, max, fail)
+		}
+		tmpl := `{
+			val, err := ssz.UnmarshalJSONBytes({{.raw}}, {{.size}}, {{.size}})
+			if err != nil {
+				{{.fail}}
+			}
+			copy({{.dst}}[:], val)
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"dst":  dst,
+			"raw":  raw,
+			"size": v.s,
+			"fail": fail,
+		})
+
+	case TypeBitList:
+		return fmt.Sprintf("if %s, err = ssz.UnmarshalJSONBitlist(%s, %d); err != nil {\n%s\n}", dst, raw, v.m, fail)
+
+	case TypeVector, TypeList, TypeProgressiveList:
+		if v.isProgressiveBytes() {
+			return fmt.Sprintf("if %s, err = ssz.UnmarshalJSONBytes(%s, 0, math.MaxUint64); err != nil {\n%s\n}", dst, raw, fail)
+		}
+		var min, max string
+		switch v.t {
+		case TypeVector:
+			min, max = strconv.Itoa(int(v.s)), strconv.Itoa(int(v.s))
+		case TypeList:
+			min, max = "0", strconv.Itoa(int(v.m))
+		default:
+			min, max = "0", "math.MaxUint64"
+		}
+		items, indx := fmt.Sprintf("items%d", depth), fmt.Sprintf("i%d", depth)
+
+		create := ""
+		if !v.c {
+			create = fmt.Sprintf("%s = make(%s, len(%s))", dst, v.goType(), items)
+		}
+		tmpl := `{
+			{{.items}}, err := ssz.UnmarshalJSONList({{.raw}}, {{.min}}, {{.max}})
+			if err != nil {
+				{{.fail}}
+			}
+			{{.create}}
+			for {{.indx}} := range {{.items}} {
+				{{.item}}
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"items":  items,
+			"indx":   indx,
+			"raw":    raw,
+			"min":    min,
+			"max":    max,
+			"fail":   fail,
+			"create": create,
+			"item": v.e.unmarshalJSON(
+				fmt.Sprintf("%s[%s]", dst, indx),
+				fmt.Sprintf("%s[%s]", items, indx),
+				fmt.Sprintf("ssz.IndexPath(%s, %s)", path, indx),
+				depth+1,
+			),
+		})
+
+	case TypeContainer, TypeReference:
+		if v.noPtr {
+			return fmt.Sprintf("if err = ssz.UnmarshalJSONObject(%s, &%s); err != nil {\n%s\n}", raw, dst, fail)
+		}
+		tmpl := `if {{.dst}} == nil {
+			{{.dst}} = new({{.obj}})
+		}
+		if err = ssz.UnmarshalJSONObject({{.raw}}, {{.dst}}); err != nil {
+			{{.fail}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"dst":  dst,
+			"raw":  raw,
+			"obj":  v.objRef(),
+			"fail": fail,
+		})
+
+	case TypeOptional:
+		var value string
+		if v.e.t == TypeContainer || v.e.t == TypeReference || v.e.isWideUint() {
+			value = v.e.unmarshalJSON(dst, raw, path, depth)
+		} else {
+			value = fmt.Sprintf("%s = new(%s)\n%s", dst, v.e.goType(), v.e.unmarshalJSON("*"+dst, raw, path, depth))
+		}
+		tmpl := `if ssz.IsJSONNull({{.raw}}) {
+			{{.dst}} = nil
+		} else {
+			{{.value}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"dst":   dst,
+			"raw":   raw,
+			"value": value,
+		})
+
+	case TypeUnion:
+		tmpl := `{
+			selector, value, err := ssz.UnmarshalJSONUnion({{.raw}})
+			if err != nil {
+				{{.fail}}
+			}
+			switch selector {
+			{{ range .types }}{{ if .Obj }}case {{.Selector}}:
+				val := new({{.Obj}})
+				if err = ssz.UnmarshalJSONObject(value, val); err != nil {
+					{{$.fail}}
+				}
+				{{$.dst}} = val
+			{{ else }}case 0:
+				if !ssz.IsJSONNull(value) {
+					err = ssz.ErrUnionType
+					{{$.fail}}
+				}
+				{{$.dst}} = nil
+			{{ end }}{{ end }}default:
+				err = ssz.ErrUnionSelector
+				{{.fail}}
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"dst":   dst,
+			"raw":   raw,
+			"fail":  fail,
+			"types": v.unionTypes(),
+		})
+
+	default:
+		panic(fmt.Errorf("unmarshal json not implemented for type %s", v.t.String()))
+	}
+}
+
+// isProgressiveBytes returns true if the value is a progressive list of bytes
+func (v *Value) isProgressiveBytes() bool {
+	return v.t == TypeProgressiveList && v.e.t == TypeUint && v.e.s == 1 && v.e.obj == ""
+}
+
+// goType returns the Go type of the value
+func (v *Value) goType() string {
+	if v.obj != "" && v.t != TypeContainer && v.t != TypeReference {
+		// alias
+		if v.isWideUint() && !v.c && !v.noPtr {
+			return "*" + v.objRef()
+		}
+		return v.objRef()
+	}
+	switch v.t {
+	case TypeBool:
+		return "bool"
+	case TypeUint:
+		if v.isWideUint() {
+			return fmt.Sprintf("[%d]byte", v.s)
+		}
+		return fmt.Sprintf("uint%d", v.s*8)
+	case TypeBytes, TypeBitList:
+		if v.c {
+			return fmt.Sprintf("[%d]byte", v.s)
+		}
+		return "[]byte"
+	case TypeVector, TypeList, TypeProgressiveList:
+		if v.c {
+			return fmt.Sprintf("[%d]%s", v.s, v.e.goType())
+		}
+		return "[]" + v.e.goType()
+	case TypeContainer, TypeReference:
+		if v.noPtr {
+			return v.objRef()
+		}
+		return "*" + v.objRef()
+	default:
+		panic(fmt.Errorf("go type not implemented for type %s", v.t.String()))
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"go/format"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -37,6 +38,7 @@ func main() {
 	var output string
 	var include string
 	var experimental bool
+	var opts options
 	var excludeObjs string
 
 	flag.StringVar(&source, "path", "", "")
@@ -45,6 +47,10 @@ func main() {
 	flag.StringVar(&output, "output", "", "")
 	flag.StringVar(&include, "include", "", "")
 	flag.BoolVar(&experimental, "experimental", false, "")
+	flag.BoolVar(&opts.noCopy, "nocopy", false, "Generate UnmarshalSSZNoCopy functions that reference the input in the byte fields")
+	flag.BoolVar(&opts.views, "views", false, "Generate lazy views over the SSZ encoding of the containers")
+	flag.BoolVar(&opts.json, "json", false, "Generate MarshalJSON and UnmarshalJSON functions that follow the Beacon API conventions")
+	flag.BoolVar(&opts.parallel, "parallel", false, "Generate HashTreeRootParallel functions that hash the fields of the containers concurrently")
 
 	flag.Parse()
 
@@ -55,7 +61,7 @@ func main() {
 		excludeTypeNames[name] = true
 	}
 
-	if err := encode(source, targets, output, includeList, excludeTypeNames, experimental); err != nil {
+	if err := encode(source, targets, output, includeList, excludeTypeNames, experimental, opts); err != nil {
 		fmt.Printf("[ERR]: %v\n", err)
 		os.Exit(1)
 	}
@@ -95,7 +101,7 @@ func decodeList(input string) []string {
 // using the Value object.
 // 3. Use the IR to print the encoding functions
 
-func encode(source string, targets []generationTarget, output string, includePaths []string, excludeTypeNames map[string]bool, experimental bool) error {
+func encode(source string, targets []generationTarget, output string, includePaths []string, excludeTypeNames map[string]bool, experimental bool, opts options) error {
 	files, err := parseInput(source) // 1.
 	if err != nil {
 		return err
@@ -127,6 +133,7 @@ func encode(source string, targets []generationTarget, output string, includePat
 		packName:         packName,
 		targets:          targets,
 		excludeTypeNames: excludeTypeNames,
+		options:          opts,
 	}
 
 	if err := e.generateIR(); err != nil { // 2.
@@ -212,7 +219,7 @@ type Value struct {
 	s uint64
 	// type of the value
 	t Type
-	// array of values for a container
+	// array of values for a container or the types of a union (nil for None)
 	o []*Value
 	// type of item for an array
 	e *Value
@@ -229,6 +236,23 @@ type Value struct {
 	opts []string
 	// isFixed allows us to explicitly mark fixed at parse time
 	fixed bool
+	// stable is the capacity N of a StableContainer[N] (EIP-7495)
+	stable uint64
+	// profile is the StableContainer a Profile is based on (EIP-7495)
+	profile *Value
+	// noCopy decodes the byte fields referencing the input instead of copying it
+	noCopy bool
+	// validateOnly checks the encoding of the value without decoding it (ValidateSSZ)
+	validateOnly bool
+	// jsonKey is the key of the field in the JSON encoding
+	jsonKey string
+	// cache is the name of the ssz.HashCache field of a container that caches the roots
+	cache string
+	// goExpr is the Go type of the field as written in the source
+	goExpr string
+	// errWrap are the wrappers of the errors returned by the decoding code of
+	// the value, from the innermost (see returnErr)
+	errWrap []string
 }
 
 func (v *Value) isListElem() bool {
@@ -244,19 +268,59 @@ func (v *Value) objRef() string {
 	return v.ref + "." + v.obj
 }
 
+// isWideUint returns true if the value is a uint128 or a uint256
+func (v *Value) isWideUint() bool {
+	return v.t == TypeUint && v.s > 8
+}
+
 func (v *Value) copy() *Value {
 	vv := new(Value)
 	*vv = *v
 	vv.o = make([]*Value, len(v.o))
 	for indx := range v.o {
-		vv.o[indx] = v.o[indx].copy()
+		if v.o[indx] != nil {
+			vv.o[indx] = v.o[indx].copy()
+		}
 	}
 	if v.e != nil {
 		vv.e = v.e.copy()
 	}
+	if v.profile != nil {
+		vv.profile = v.profile.copy()
+	}
+	return vv
+}
+
+// withNoCopy returns a copy of the value that decodes the byte fields referencing the input
+func (v *Value) withNoCopy() *Value {
+	vv := v.copy()
+	vv.walk(func(v *Value) { v.noCopy = true })
 	return vv
 }
 
+// withValidateOnly returns a copy of the value that only checks the encoding without decoding it
+func (v *Value) withValidateOnly() *Value {
+	vv := v.copy()
+	vv.walk(func(v *Value) { v.validateOnly = true })
+	return vv
+}
+
+// walk calls fn for the value and all the values nested in it
+func (v *Value) walk(fn func(v *Value)) {
+	fn(v)
+	for _, o := range v.o {
+		if o != nil {
+			o.walk(fn)
+		}
+	}
+	if v.e != nil {
+		v.e.walk(fn)
+	}
+	if v.profile != nil {
+		v.profile.walk(fn)
+	}
+}
+
 // Type is a SSZ type
 type Type int
 
@@ -279,6 +343,12 @@ const (
 	TypeContainer
 	// TypeReference is a SSZ reference
 	TypeReference
+	// TypeUnion is a SSZ union
+	TypeUnion
+	// TypeOptional is a SSZ optional (EIP-6475)
+	TypeOptional
+	// TypeProgressiveList is a SSZ list without limit (EIP-7916)
+	TypeProgressiveList
 )
 
 func (t Type) String() string {
@@ -301,6 +371,12 @@ func (t Type) String() string {
 		return "container"
 	case TypeReference:
 		return "reference"
+	case TypeUnion:
+		return "union"
+	case TypeOptional:
+		return "optional"
+	case TypeProgressiveList:
+		return "progressive-list"
 	default:
 		panic("not found")
 	}
@@ -326,6 +402,20 @@ type env struct {
 	imports []*astImport
 	// excludeTypeNames is a map of type names to leave out of output
 	excludeTypeNames map[string]bool
+	// options of the generated code
+	options
+}
+
+// options are the optional functions and types generated for the objects
+type options struct {
+	// noCopy generates the UnmarshalSSZNoCopy functions
+	noCopy bool
+	// views generates the lazy views over the SSZ encoding
+	views bool
+	// json generates the MarshalJSON and UnmarshalJSON functions
+	json bool
+	// parallel generates the HashTreeRootParallel functions
+	parallel bool
 }
 
 const encodingPrefix = "_encoding.go"
@@ -411,6 +501,10 @@ func (e *env) print(order []string, experimental bool) (string, bool, error) {
 		{{ .Size }}
 		{{ .HashTreeRoot }}
 		{{ .GetTree }}
+		{{ .View }}
+		{{ .Copy }}
+		{{ .Equal }}
+		{{ .JSON }}
 	{{ end }}
 	`
 
@@ -420,7 +514,7 @@ func (e *env) print(order []string, experimental bool) (string, bool, error) {
 	}
 
 	type Obj struct {
-		Size, Marshal, Unmarshal, HashTreeRoot, GetTree string
+		Size, Marshal, Unmarshal, HashTreeRoot, GetTree, View, Copy, Equal, JSON string
 	}
 
 	objs := []*Obj{}
@@ -455,6 +549,14 @@ func (e *env) print(order []string, experimental bool) (string, bool, error) {
 			Marshal:   e.marshal(name, obj),
 			Unmarshal: e.unmarshal(name, obj),
 			Size:      e.size(name, obj),
+			Copy:      e.copy(name, obj),
+			Equal:     e.equal(name, obj),
+		}
+		if e.views {
+			o.View = e.view(name, obj)
+		}
+		if e.options.json {
+			o.JSON = e.json(name, obj)
 		}
 		if len(obj.opts) == 1 && obj.opts[0] == "no-htr" {
 			o.HashTreeRoot = ""
@@ -535,8 +637,14 @@ func detectImports(v *Value) []string {
 			}
 		case TypeContainer:
 			ref = i.ref
-		case TypeList, TypeVector:
+		case TypeList, TypeVector, TypeOptional, TypeProgressiveList:
 			ref = i.e.ref
+		case TypeUnion:
+			for _, opt := range i.o {
+				if opt != nil && opt.ref != "" {
+					refs = append(refs, opt.ref)
+				}
+			}
 		default:
 			ref = i.ref
 		}
@@ -925,6 +1033,18 @@ func (e *env) parseASTStructType(name string, typ *ast.StructType) (*Value, erro
 			continue
 		}
 		name := f.Names[0].Name
+		if name == "_" && f.Tag != nil {
+			// blank field with the tags of the struct
+			if err := e.parseStructTags(v, f.Tag.Value); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if isHashCache(f.Type) {
+			// field that caches the roots of the container
+			v.cache = name
+			continue
+		}
 		if !isExportedField(name) && !hasGenTag(f) {
 			continue
 		}
@@ -945,12 +1065,43 @@ func (e *env) parseASTStructType(name string, typ *ast.StructType) (*Value, erro
 			continue
 		}
 		elem.name = name
+		elem.jsonKey = jsonKeyTag(name, tags)
+		elem.goExpr = types.ExprString(f.Type)
 		v.o = append(v.o, elem)
 	}
 
+	if err := v.validateStable(); err != nil {
+		return nil, err
+	}
+	if v.cache != "" && (v.stable != 0 || v.profile != nil) {
+		return nil, fmt.Errorf("stable container %s cannot cache the roots", v.name)
+	}
 	return v, nil
 }
 
+// parseStructTags parses the tags of the blank field of a struct that describe the kind of
+// container (i.e. '_ struct{} `ssz-stable-container:"8"`' or '_ struct{} `ssz-profile:"Shape"`')
+func (e *env) parseStructTags(v *Value, tags string) error {
+	if n, ok := getTags(tags, "ssz-stable-container"); ok {
+		num, err := strconv.ParseUint(n, 10, 64)
+		if err != nil || num == 0 {
+			return fmt.Errorf("stable container %s has an invalid capacity '%s'", v.name, n)
+		}
+		v.stable = num
+	}
+	if name, ok := getTags(tags, "ssz-profile"); ok {
+		base, err := e.encodeItem(name, "")
+		if err != nil {
+			return err
+		}
+		if base.stable == 0 {
+			return fmt.Errorf("profile %s is not based on a stable container", v.name)
+		}
+		v.profile = base
+	}
+	return nil
+}
+
 func hasGenTag(f *ast.Field) bool {
 	return f.Tag != nil && strings.Contains(f.Tag.Value, "ssz-gen")
 }
@@ -961,6 +1112,18 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 		// omit value
 		return nil, nil
 	}
+	if tag, ok := getTags(tags, "ssz-union"); ok {
+		return e.parseUnion(name, tag)
+	}
+	if tag, ok := getTags(tags, "ssz"); ok && tag == "optional" {
+		return e.parseOptional(name, expr)
+	}
+	if tag, ok := getTags(tags, "ssz"); ok && tag == "progressive-list" {
+		return e.parseProgressiveList(name, expr)
+	}
+	if v, ok := parseWideUint(tags, expr); ok {
+		return v, nil
+	}
 
 	switch obj := expr.(type) {
 	case *ast.StarExpr:
@@ -1059,6 +1222,12 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 				}
 			}
 
+			if element, ok := parseWideUint(tags, collectionExpr.Elt); ok {
+				// list or vector of uint128 or uint256 byte arrays
+				collection.e = element
+				continue
+			}
+
 			switch eeType := collectionExpr.Elt.(type) {
 			case *ast.ArrayType:
 				// we expect there to a subsequent dimension when the element type is an ArrayType
@@ -1164,6 +1333,144 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 	}
 }
 
+// parseUnion parses the types of a union from the 'ssz-union' tag (i.e. 'ssz-union:"None,A,pkg.B"').
+// The types are containers referenced by name, only the first one can be None.
+func (e *env) parseUnion(name, tag string) (*Value, error) {
+	v := &Value{t: TypeUnion}
+	for indx, obj := range strings.Split(tag, ",") {
+		if obj == "None" {
+			if indx != 0 {
+				return nil, fmt.Errorf("union %s can only have None as the first type", name)
+			}
+			v.o = append(v.o, nil)
+			continue
+		}
+		var ref string
+		if spl := strings.Split(obj, "."); len(spl) == 2 {
+			ref, obj = spl[0], spl[1]
+		}
+		opt, err := e.encodeItem(obj, "")
+		if err != nil {
+			return nil, err
+		}
+		if opt.t != TypeContainer && opt.t != TypeReference {
+			return nil, fmt.Errorf("union %s type %s is not a container", name, obj)
+		}
+		opt.ref = ref
+		opt.noPtr = false
+		v.o = append(v.o, opt)
+	}
+	if len(v.o) < 2 && v.o[0] == nil {
+		return nil, fmt.Errorf("union %s cannot only have None", name)
+	}
+	if len(v.o) > 128 {
+		return nil, fmt.Errorf("union %s has more than 128 types", name)
+	}
+	return v, nil
+}
+
+// parseOptional parses a pointer field tagged with 'ssz:"optional"'. The
+// optional type is either a container or a basic type.
+func (e *env) parseOptional(name string, expr ast.Expr) (*Value, error) {
+	obj, ok := expr.(*ast.StarExpr)
+	if !ok {
+		return nil, fmt.Errorf("optional %s is not a pointer", name)
+	}
+	elem, err := e.parseASTFieldType(name, "", obj.X)
+	if err != nil {
+		return nil, err
+	}
+	switch elem.t {
+	case TypeContainer, TypeReference, TypeUint, TypeBool:
+	default:
+		return nil, fmt.Errorf("optional %s of type %s not supported", name, elem.t)
+	}
+	elem.noPtr = false
+	return &Value{t: TypeOptional, e: elem}, nil
+}
+
+// parseProgressiveList parses a slice tagged with 'ssz:"progressive-list"'. The
+// list does not have a limit and its elements are uint8, uint64 or containers.
+func (e *env) parseProgressiveList(name string, expr ast.Expr) (*Value, error) {
+	obj, ok := expr.(*ast.ArrayType)
+	if !ok || obj.Len != nil {
+		return nil, fmt.Errorf("progressive list %s is not a slice", name)
+	}
+	if elem, ok := obj.Elt.(*ast.Ident); ok && elem.Name == "byte" {
+		// []byte is a list of uint8
+		return &Value{t: TypeProgressiveList, e: &Value{t: TypeUint, s: 1}}, nil
+	}
+	elem, err := e.parseASTFieldType(name, "", obj.Elt)
+	if err != nil {
+		return nil, err
+	}
+	switch elem.t {
+	case TypeContainer, TypeReference:
+	case TypeUint:
+		if (elem.s != 1 && elem.s != 8) || elem.ref != "" || elem.obj != "" {
+			return nil, fmt.Errorf("progressive list %s of type %s not supported", name, elem.objRef())
+		}
+	default:
+		return nil, fmt.Errorf("progressive list %s of type %s not supported", name, elem.t)
+	}
+	return &Value{t: TypeProgressiveList, e: elem}, nil
+}
+
+// parseWideUint parses uint128 and uint256 values. They are either little endian
+// byte arrays tagged with 'ssz:"uint128"' or 'ssz:"uint256"' or types made of
+// uint64 limbs like holiman/uint256.Int.
+func parseWideUint(tags string, expr ast.Expr) (*Value, bool) {
+	var size uint64
+	if tag, ok := getTags(tags, "ssz"); ok {
+		switch tag {
+		case "uint128":
+			size = 16
+		case "uint256":
+			size = 32
+		}
+	}
+
+	switch obj := expr.(type) {
+	case *ast.ArrayType:
+		// [16]byte or [32]byte
+		if size == 0 {
+			return nil, false
+		}
+		elem, ok := obj.Elt.(*ast.Ident)
+		if !ok || elem.Name != "byte" {
+			return nil, false
+		}
+		arrayLen, ok := obj.Len.(*ast.BasicLit)
+		if !ok || arrayLen.Value != strconv.Itoa(int(size)) {
+			return nil, false
+		}
+		return &Value{t: TypeUint, s: size, c: true}, true
+
+	case *ast.StarExpr, *ast.SelectorExpr:
+		// uint256.Int or *uint256.Int
+		sel, ok := obj.(*ast.SelectorExpr)
+		if star, isPtr := obj.(*ast.StarExpr); isPtr {
+			sel, ok = star.X.(*ast.SelectorExpr)
+		}
+		if !ok {
+			return nil, false
+		}
+		ref, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return nil, false
+		}
+		if size == 0 {
+			if ref.Name != "uint256" || sel.Sel.Name != "Int" {
+				return nil, false
+			}
+			size = 32
+		}
+		_, isPtr := obj.(*ast.StarExpr)
+		return &Value{t: TypeUint, s: size, ref: ref.Name, obj: sel.Sel.Name, noPtr: !isPtr}, true
+	}
+	return nil, false
+}
+
 func findConstValue(files map[string]*ast.File, constName string) (string, bool) {
 	value := ""
 	found := false
@@ -1235,7 +1542,7 @@ func (v *Value) isFixed() bool {
 	case TypeUint, TypeBool:
 		return true
 	// dynamic collection types
-	case TypeList, TypeBitList:
+	case TypeList, TypeBitList, TypeUnion, TypeOptional, TypeProgressiveList:
 		return false
 	case TypeVector:
 		if v.e.t == TypeUndefined {
@@ -1291,6 +1598,10 @@ func uintVToName(v *Value) string {
 		panic("not expected")
 	}
 	switch v.s {
+	case 32:
+		return "Uint256"
+	case 16:
+		return "Uint128"
 	case 8:
 		return "Uint64"
 	case 4:
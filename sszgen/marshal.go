@@ -5,9 +5,11 @@ import (
 	"strings"
 )
 
-// marshal creates a function that encodes the structs in SSZ format. It creates two functions:
+// marshal creates a function that encodes the structs in SSZ format. It creates four functions:
 // 1. MarshalTo(dst []byte) marshals the content to the target array.
 // 2. Marshal() marshals the content to a newly created array.
+// 3. MarshalSSZToWriter(writer io.Writer) streams the content to a writer.
+// 4. MarshalSSZToEncoder(enc *ssz.Encoder) streams the content to an ssz.Encoder.
 func (e *env) marshal(name string, v *Value) string {
 	tmpl := `// MarshalSSZ ssz marshals the {{.name}} object
 	func (:: *{{.name}}) MarshalSSZ() ([]byte, error) {
@@ -20,14 +22,32 @@ func (e *env) marshal(name string, v *Value) string {
 		{{.offset}}
 		{{.marshal}}
 		return
+	}
+
+	// MarshalSSZToWriter ssz marshals the {{.name}} object to a writer
+	func (:: *{{.name}}) MarshalSSZToWriter(writer io.Writer) error {
+		return ssz.MarshalSSZToWriter(writer, ::)
+	}
+
+	// MarshalSSZToEncoder ssz marshals the {{.name}} object to an encoder
+	func (:: *{{.name}}) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+		{{.offset}}
+		{{.encode}}
+		return
 	}`
 
 	data := map[string]interface{}{
 		"name":    name,
 		"marshal": v.marshalContainer(true),
+		"encode":  v.encodeContainer(true),
 		"offset":  "",
 	}
-	if !v.isFixed() {
+	if v.hasActiveFields() {
+		// the fixed part depends on the fields that are present, stable
+		// containers and profiles are marshaled first and then streamed.
+		data["marshal"] = v.marshalStable()
+		data["encode"] = "var buf []byte\nif buf, err = ::.MarshalSSZTo(nil); err != nil {\nreturn\n}\nenc.EncodeBytes(buf)"
+	} else if !v.isFixed() {
 		// offset is the position where the offset starts
 		data["offset"] = fmt.Sprintf("offset := int(%d)\n", v.fixedSize())
 	}
@@ -53,6 +73,13 @@ func (v *Value) marshal() string {
 		})
 
 	case TypeUint:
+		if v.isWideUint() {
+			if v.c {
+				// little endian byte array
+				return fmt.Sprintf("dst = append(dst, ::.%s[:]...)", v.name)
+			}
+			return fmt.Sprintf("%sdst = ssz.Marshal%s(dst, %s)", v.wideUintCheck(), uintVToName(v), v.wideUint())
+		}
 		var name string
 		if v.ref != "" || v.obj != "" {
 			// alias to Uint64
@@ -68,13 +95,41 @@ func (v *Value) marshal() string {
 	case TypeBool:
 		return fmt.Sprintf("dst = ssz.MarshalBool(dst, ::.%s)", v.name)
 
+	case TypeOptional:
+		tmpl := `dst = ssz.MarshalOptional(dst, ::.{{.name}} != nil)
+		if ::.{{.name}} != nil {
+			{{.marshal}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":    v.name,
+			"marshal": v.marshalOptionalValue(),
+		})
+
+	case TypeUnion:
+		tmpl := `switch opt := ::.{{.name}}.(type) {
+		{{ range .types }}{{ if .Obj }}case *{{.Obj}}:
+			dst = append(dst, {{.Selector}})
+			if dst, err = opt.MarshalSSZTo(dst); err != nil {
+				return
+			}
+		{{ else }}case nil:
+			dst = append(dst, 0)
+		{{ end }}{{ end }}default:
+			err = ssz.ErrUnionType
+			return
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":  v.name,
+			"types": v.unionTypes(),
+		})
+
 	case TypeVector:
 		if v.e.isFixed() {
 			return v.marshalVector()
 		}
 		fallthrough
 
-	case TypeList:
+	case TypeList, TypeProgressiveList:
 		return v.marshalList()
 
 	default:
@@ -82,6 +137,76 @@ func (v *Value) marshal() string {
 	}
 }
 
+// marshalOptionalValue marshals the value of an optional type without the prefix
+func (v *Value) marshalOptionalValue() string {
+	v.e.name = v.name
+	if v.e.t == TypeContainer || v.e.t == TypeReference {
+		return fmt.Sprintf("if dst, err = ::.%s.MarshalSSZTo(dst); err != nil {\nreturn\n}", v.name)
+	}
+	fn, name := v.e.optionalBasic()
+	return fmt.Sprintf("dst = ssz.Marshal%s(dst, %s)", fn, name)
+}
+
+// encodeOptionalValue encodes the value of an optional type without the prefix
+func (v *Value) encodeOptionalValue() string {
+	v.e.name = v.name
+	if v.e.t == TypeContainer || v.e.t == TypeReference {
+		return fmt.Sprintf("if err = enc.EncodeObject(::.%s); err != nil {\nreturn\n}", v.name)
+	}
+	fn, name := v.e.optionalBasic()
+	return fmt.Sprintf("enc.Encode%s(%s)", fn, name)
+}
+
+// optionalBasic returns the suffix of the runtime functions and the Go expression of
+// the value of an optional basic type (i.e. 'Uint64' and 'uint64(*::.Slot)')
+func (v *Value) optionalBasic() (string, string) {
+	name := "*::." + v.name
+	if v.t == TypeBool {
+		return "Bool", name
+	}
+	if !v.isWideUint() && (v.ref != "" || v.obj != "") {
+		// alias to a uint
+		name = fmt.Sprintf("%s(%s)", strings.ToLower(uintVToName(v)), name)
+	}
+	return uintVToName(v), name
+}
+
+type unionType struct {
+	Selector int
+	Obj      string
+}
+
+// unionTypes returns the selector and the Go object of each type of
+// a union. The Go object is empty for None.
+func (v *Value) unionTypes() []unionType {
+	types := []unionType{}
+	for indx, opt := range v.o {
+		typ := unionType{Selector: indx}
+		if opt != nil {
+			typ.Obj = opt.objRef()
+		}
+		types = append(types, typ)
+	}
+	return types
+}
+
+// wideUint returns the uint64 limbs of a uint128 or uint256 value
+// that is not represented as a byte array (i.e. uint256.Int)
+func (v *Value) wideUint() string {
+	if v.noPtr {
+		return "::." + v.name
+	}
+	return "*::." + v.name
+}
+
+// wideUintCheck allocates a uint128 or uint256 pointer value if it is nil
+func (v *Value) wideUintCheck() string {
+	if v.noPtr {
+		return ""
+	}
+	return fmt.Sprintf("if ::.%s == nil {\n::.%s = new(%s)\n}\n", v.name, v.name, v.objRef())
+}
+
 func (v *Value) marshalList() string {
 	v.e.name = v.name + "[ii]"
 
@@ -183,3 +308,181 @@ func (v *Value) marshalContainer(start bool) string {
 	}
 	return strings.Join(out, "\n")
 }
+
+// encode mirrors marshal but writes the value into an ssz.Encoder. The fixed
+// part and the offsets are written first and the dynamic parts are streamed afterwards.
+func (v *Value) encode() string {
+	switch v.t {
+	case TypeContainer, TypeReference:
+		return v.encodeContainer(false)
+
+	case TypeBytes:
+		name := v.name
+		if v.c {
+			name += "[:]"
+		}
+		return fmt.Sprintf("%senc.EncodeBytes(::.%s)", v.validate(), name)
+
+	case TypeUint:
+		if v.isWideUint() {
+			if v.c {
+				// little endian byte array
+				return fmt.Sprintf("enc.EncodeBytes(::.%s[:])", v.name)
+			}
+			return fmt.Sprintf("%senc.Encode%s(%s)", v.wideUintCheck(), uintVToName(v), v.wideUint())
+		}
+		var name string
+		if v.ref != "" || v.obj != "" {
+			// alias to Uint64
+			name = fmt.Sprintf("uint64(::.%s)", v.name)
+		} else {
+			name = "::." + v.name
+		}
+		return fmt.Sprintf("enc.Encode%s(%s)", uintVToName(v), name)
+
+	case TypeBitList:
+		return fmt.Sprintf("%senc.EncodeBytes(::.%s)", v.validate(), v.name)
+
+	case TypeBool:
+		return fmt.Sprintf("enc.EncodeBool(::.%s)", v.name)
+
+	case TypeOptional:
+		tmpl := `if ::.{{.name}} != nil {
+			enc.EncodeUint8(1)
+			{{.encode}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":   v.name,
+			"encode": v.encodeOptionalValue(),
+		})
+
+	case TypeUnion:
+		tmpl := `switch opt := ::.{{.name}}.(type) {
+		{{ range .types }}{{ if .Obj }}case *{{.Obj}}:
+			enc.EncodeUint8({{.Selector}})
+			if err = enc.EncodeObject(opt); err != nil {
+				return
+			}
+		{{ else }}case nil:
+			enc.EncodeUint8(0)
+		{{ end }}{{ end }}default:
+			err = ssz.ErrUnionType
+			return
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":  v.name,
+			"types": v.unionTypes(),
+		})
+
+	case TypeVector:
+		if v.e.isFixed() {
+			return v.encodeVector()
+		}
+		fallthrough
+
+	case TypeList, TypeProgressiveList:
+		return v.encodeList()
+
+	default:
+		panic(fmt.Errorf("encode not implemented for type %s", v.t.String()))
+	}
+}
+
+func (v *Value) encodeList() string {
+	v.e.name = v.name + "[ii]"
+
+	// bound check
+	str := v.validate()
+
+	if v.e.isFixed() {
+		tmpl := `for ii := 0; ii < len(::.{{.name}}); ii++ {
+			{{.dynamic}}
+		}`
+		str += execTmpl(tmpl, map[string]interface{}{
+			"name":    v.name,
+			"dynamic": v.e.encode(),
+		})
+		return str
+	}
+
+	// encode a list of dynamic objects:
+	// 1. write offsets for each
+	// 2. stream each element
+
+	tmpl := `{
+		offset = 4 * len(::.{{.name}})
+		for ii := 0; ii < len(::.{{.name}}); ii++ {
+			enc.EncodeOffset(offset)
+			{{.size}}
+		}
+	}
+	for ii := 0; ii < len(::.{{.name}}); ii++ {
+		{{.encode}}
+	}`
+
+	str += execTmpl(tmpl, map[string]interface{}{
+		"name":   v.name,
+		"size":   v.e.size("offset"),
+		"encode": v.e.encode(),
+	})
+	return str
+}
+
+func (v *Value) encodeVector() (str string) {
+	v.e.name = fmt.Sprintf("%s[ii]", v.name)
+
+	tmpl := `{{.validate}}for ii := 0; ii < {{.size}}; ii++ {
+		{{.encode}}
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"validate": v.validate(),
+		"size":     v.s,
+		"encode":   v.e.encode(),
+	})
+}
+
+func (v *Value) encodeContainer(start bool) string {
+	if !start {
+		tmpl := `{{ if .check }}if ::.{{.name}} == nil {
+			::.{{.name}} = new({{.obj}})
+		}
+		{{ end }}if err = enc.EncodeObject({{ if .addr }}&{{ end }}::.{{.name}}); err != nil {
+			return
+		}`
+		// validate only for fixed structs
+		check := v.isFixed()
+		if v.isListElem() {
+			check = false
+		}
+		if v.noPtr {
+			check = false
+		}
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":  v.name,
+			"obj":   v.objRef(),
+			"check": check,
+			"addr":  v.noPtr,
+		})
+	}
+
+	out := []string{}
+	for indx, i := range v.o {
+		var str string
+		if i.isFixed() {
+			// write the content
+			str = fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.encode())
+		} else {
+			// write the offset
+			str = fmt.Sprintf("// Offset (%d) '%s'\nenc.EncodeOffset(offset)\n%s\n", indx, i.name, i.size("offset"))
+		}
+		out = append(out, str)
+	}
+
+	// stream the dynamic parts
+	for indx, i := range v.o {
+		if !i.isFixed() {
+			out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.encode()))
+		}
+	}
+	return strings.Join(out, "\n")
+}
@@ -21,11 +21,15 @@ func (e *env) size(name string, v *Value) string {
 		return
 	}`
 
-	str := execTmpl(tmpl, map[string]interface{}{
+	data := map[string]interface{}{
 		"name":    name,
 		"fixed":   v.fixedSize(),
 		"dynamic": v.sizeContainer("size", true),
-	})
+	}
+	if v.hasActiveFields() {
+		data["fixed"], data["dynamic"] = v.sizeStable("size")
+	}
+	str := execTmpl(tmpl, data)
 	return appendObjSignature(str, v)
 }
 
@@ -89,6 +93,14 @@ func (v *Value) sizeContainer(name string, start bool) string {
 	return strings.Join(out, "\n\n")
 }
 
+// sizeOptionalValue returns the size of the value of an optional type without the prefix
+func (v *Value) sizeOptionalValue() string {
+	if v.e.t == TypeContainer || v.e.t == TypeReference {
+		return fmt.Sprintf("::.%s.SizeSSZ()", v.name)
+	}
+	return strconv.Itoa(int(v.e.fixedSize()))
+}
+
 // 'name' is the name of target variable we assign the size too. We also use this function
 // during marshalling to figure out the size of the offset
 func (v *Value) size(name string) string {
@@ -112,7 +124,24 @@ func (v *Value) size(name string) string {
 	case TypeBytes:
 		return fmt.Sprintf(name+" += len(::.%s)", v.name)
 
-	case TypeList:
+	case TypeOptional:
+		// the prefix and the size of the value if it is present
+		return fmt.Sprintf("if ::.%s != nil {\n%s += 1 + %s\n}", v.name, name, v.sizeOptionalValue())
+
+	case TypeUnion:
+		// the selector and the size of the selected type
+		tmpl := `{{.size}}++
+		switch opt := ::.{{.name}}.(type) {
+		{{ range .types }}{{ if .Obj }}case *{{.Obj}}:
+			{{$.size}} += opt.SizeSSZ()
+		{{ end }}{{ end }}}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":  v.name,
+			"size":  name,
+			"types": v.unionTypes(),
+		})
+
+	case TypeList, TypeProgressiveList:
 		fallthrough
 
 	case TypeVector:
@@ -0,0 +1,431 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StableContainer[N] and Profile[B] (EIP-7495) are containers with optional fields.
+// The fields that are present are serialized like a regular container, prefixed with
+// a bitvector of the fields that are active. The hash tree root merkleizes N slots
+// (one per field of the StableContainer) and mixes in the root of the active fields.
+
+// validateStable validates the fields of a StableContainer or a Profile
+func (v *Value) validateStable() error {
+	if v.stable != 0 {
+		if v.profile != nil {
+			return fmt.Errorf("%s cannot be both a stable container and a profile", v.name)
+		}
+		if len(v.o) == 0 || uint64(len(v.o)) > v.stable {
+			return fmt.Errorf("stable container %s must have between 1 and %d fields", v.name, v.stable)
+		}
+		for _, f := range v.o {
+			if f.t != TypeOptional {
+				return fmt.Errorf("field %s of stable container %s is not optional", f.name, v.name)
+			}
+		}
+	}
+	if v.profile != nil {
+		if len(v.o) == 0 {
+			return fmt.Errorf("profile %s does not have fields", v.name)
+		}
+		last := -1
+		for _, f := range v.o {
+			indx := v.profile.fieldIndex(f.name)
+			if indx == -1 {
+				return fmt.Errorf("field %s of profile %s is not in %s", f.name, v.name, v.profile.name)
+			}
+			if indx <= last {
+				return fmt.Errorf("fields of profile %s are not in the same order as in %s", v.name, v.profile.name)
+			}
+			last = indx
+
+			typ := f
+			if f.t == TypeOptional {
+				typ = f.e
+			}
+			if base := v.profile.o[indx].e; !sameType(typ, base) {
+				return fmt.Errorf("field %s of profile %s does not have the same type as in %s", f.name, v.name, v.profile.name)
+			}
+		}
+	}
+	return nil
+}
+
+// sameType returns true if both values have the same ssz type. The sizes, limits and
+// elements are compared recursively and the containers by the name of their object.
+func sameType(a, b *Value) bool {
+	if a.t != b.t || a.s != b.s || a.m != b.m {
+		return false
+	}
+	if a.t == TypeContainer || a.t == TypeReference {
+		return a.objRef() == b.objRef()
+	}
+	if (a.e == nil) != (b.e == nil) || (a.e != nil && !sameType(a.e, b.e)) {
+		return false
+	}
+	if len(a.o) != len(b.o) {
+		return false
+	}
+	for i := range a.o {
+		if (a.o[i] == nil) != (b.o[i] == nil) || (a.o[i] != nil && !sameType(a.o[i], b.o[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldIndex returns the index of a field of the container or -1 if it does not exist
+func (v *Value) fieldIndex(name string) int {
+	for indx, f := range v.o {
+		if f.name == name {
+			return indx
+		}
+	}
+	return -1
+}
+
+// hasActiveFields returns true if the container is serialized with the active fields
+// bitvector, that is, a StableContainer or a Profile with optional fields.
+func (v *Value) hasActiveFields() bool {
+	if v.stable != 0 {
+		return true
+	}
+	if v.profile != nil {
+		for _, f := range v.o {
+			if f.t == TypeOptional {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// activeFields returns the length of the active fields bitvector of the serialization
+// and the bit that corresponds to each field (-1 for the required fields of a Profile)
+func (v *Value) activeFields() (uint64, []int) {
+	bits := []int{}
+	if v.stable != 0 {
+		for indx := range v.o {
+			bits = append(bits, indx)
+		}
+		return v.stable, bits
+	}
+	num := 0
+	for _, f := range v.o {
+		if f.t == TypeOptional {
+			bits = append(bits, num)
+			num++
+		} else {
+			bits = append(bits, -1)
+		}
+	}
+	return uint64(num), bits
+}
+
+// activeFieldsFixedSize returns the size of the fixed part of the required fields
+func (v *Value) activeFieldsFixedSize() uint64 {
+	var fixed uint64
+	for _, f := range v.o {
+		if f.t == TypeOptional {
+			continue
+		}
+		if f.isFixed() {
+			fixed += f.fixedSize()
+		} else {
+			fixed += bytesPerLengthOffset
+		}
+	}
+	return fixed
+}
+
+// optionalFixedSize returns the size that an optional field adds to the fixed part if it is present
+func (v *Value) optionalFixedSize() uint64 {
+	if v.e.isFixed() {
+		return v.e.fixedSize()
+	}
+	return bytesPerLengthOffset
+}
+
+func isActive(bit int) string {
+	return fmt.Sprintf("active[%d]&%d != 0", bit/8, 1<<(bit%8))
+}
+
+func setActive(bit int) string {
+	return fmt.Sprintf("active[%d] |= %d\n", bit/8, 1<<(bit%8))
+}
+
+func (v *Value) marshalStable() string {
+	numBits, bits := v.activeFields()
+
+	str := fmt.Sprintf("// Active fields\nactive := [%d]byte{}\n", (numBits+7)/8)
+	for indx, f := range v.o {
+		if bits[indx] != -1 {
+			str += fmt.Sprintf("if ::.%s != nil {\n%s}\n", f.name, setActive(bits[indx]))
+		}
+	}
+	str += "dst = append(dst, active[:]...)\n\n"
+
+	// the size of the fixed part depends on the fields that are present
+	str += fmt.Sprintf("offset := int(%d)\n", v.activeFieldsFixedSize())
+	for _, f := range v.o {
+		if f.t == TypeOptional {
+			str += fmt.Sprintf("if ::.%s != nil {\noffset += %d\n}\n", f.name, f.optionalFixedSize())
+		}
+	}
+	out := []string{str}
+
+	for indx, f := range v.o {
+		var str string
+		if f.t != TypeOptional {
+			if f.isFixed() {
+				str = fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, f.name, f.marshal())
+			} else {
+				str = fmt.Sprintf("// Offset (%d) '%s'\ndst = ssz.WriteOffset(dst, offset)\n%s\n", indx, f.name, f.size("offset"))
+			}
+		} else {
+			if f.e.isFixed() {
+				str = fmt.Sprintf("// Field (%d) '%s'\nif ::.%s != nil {\n%s\n}\n", indx, f.name, f.name, f.marshalOptionalValue())
+			} else {
+				str = fmt.Sprintf("// Offset (%d) '%s'\nif ::.%s != nil {\ndst = ssz.WriteOffset(dst, offset)\noffset += %s\n}\n", indx, f.name, f.name, f.sizeOptionalValue())
+			}
+		}
+		out = append(out, str)
+	}
+
+	// write the dynamic parts
+	for indx, f := range v.o {
+		if f.t != TypeOptional {
+			if !f.isFixed() {
+				out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, f.name, f.marshal()))
+			}
+		} else if !f.e.isFixed() {
+			out = append(out, fmt.Sprintf("// Field (%d) '%s'\nif ::.%s != nil {\n%s\n}\n", indx, f.name, f.name, f.marshalOptionalValue()))
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+func (v *Value) unmarshalStable() string {
+	numBits, bits := v.activeFields()
+	numBytes := (numBits + 7) / 8
+
+	offsets := []string{}
+	for indx, f := range v.o {
+		if !f.isFixed() && (f.t != TypeOptional || !f.e.isFixed()) {
+			offsets = append(offsets, fmt.Sprintf("o%d", indx))
+		}
+	}
+
+	tmpl := `size := uint64(len(buf))
+	if size < {{.numBytes}} {
+		return ssz.NewDecodeError(ssz.ErrSize, "{{.name}}", {{.numBytes}}, size)
+	}
+	if err = {{.validateActive}}; err != nil {
+		return ssz.WrapDecodeError(err, "{{.name}}", "", 0)
+	}
+	active := buf[:{{.numBytes}}]
+	buf = buf[{{.numBytes}}:]
+	size -= {{.numBytes}}
+
+	// the size of the fixed part depends on the active fields
+	fixed := uint64({{.fixed}})
+	{{.fixedOptionals}}if size {{.cmp}} fixed {
+		return ssz.NewDecodeError(ssz.ErrSize, "{{.name}}", {{.numBytes}}+fixed, {{.numBytes}}+size)
+	}
+	{{if .offsets}}
+		tail := buf
+		var {{.offsets}} uint64
+		var last uint64
+	{{end}}
+	pos := uint64(0)
+
+	`
+	fixedOptionals := ""
+	for indx, f := range v.o {
+		if f.t == TypeOptional {
+			fixedOptionals += fmt.Sprintf("if %s {\nfixed += %d\n}\n", isActive(bits[indx]), f.optionalFixedSize())
+		}
+	}
+	validateActive := fmt.Sprintf("ssz.ValidateBitvector(buf[:%d], %d)", numBytes, numBits)
+	if num := uint64(len(v.o)); v.stable != 0 && num < numBits {
+		// the fields after the defined ones cannot be active
+		validateActive = fmt.Sprintf("ssz.ValidateActiveFields(buf[:%d], %d, %d)", numBytes, numBits, num)
+	}
+	cmp := "<"
+	if len(offsets) == 0 {
+		cmp = "!="
+	}
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":           v.name,
+		"numBytes":       numBytes,
+		"validateActive": validateActive,
+		"fixed":          v.activeFieldsFixedSize(),
+		"fixedOptionals": fixedOptionals,
+		"cmp":            cmp,
+		"offsets":        strings.Join(offsets, ", "),
+	})
+
+	// read the fixed part and the offsets. The first offset must be
+	// the end of the fixed part and the others cannot go backwards.
+	readOffset := func(f *Value, offset string) string {
+		tmpl := `if {{.offset}} = ssz.ReadOffset(buf[pos:pos+4]); {{.offset}} > size || {{.offset}} < last {
+			{{.returnErrOffset}}
+		}
+		if last == 0 && {{.offset}} != fixed {
+			{{.returnErrFirst}}
+		}
+		last = {{.offset}}
+		pos += 4`
+		return execTmpl(tmpl, map[string]interface{}{
+			"offset":          offset,
+			"returnErrOffset": f.returnErr("ssz.ErrOffset"),
+			"returnErrFirst":  f.returnErr(fmt.Sprintf("ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, \"\", fixed, %s)", offset)),
+		})
+	}
+
+	out := []string{}
+	for indx, f := range v.o {
+		offset := fmt.Sprintf("o%d", indx)
+		f.errWrap = fieldErrWrap(v.name, f.name, fmt.Sprintf("%d+pos", numBytes))
+
+		var res string
+		if f.t != TypeOptional {
+			if f.isFixed() {
+				dst := fmt.Sprintf("buf[pos:pos+%d]", f.fixedSize())
+				res = fmt.Sprintf("// Field (%d) '%s'\n%s\npos += %d\n", indx, f.name, f.unmarshal(dst), f.fixedSize())
+			} else {
+				res = fmt.Sprintf("// Offset (%d) '%s'\n%s\n", indx, f.name, readOffset(f, offset))
+			}
+		} else {
+			var read string
+			if f.e.isFixed() {
+				dst := fmt.Sprintf("buf[pos:pos+%d]", f.e.fixedSize())
+				read = fmt.Sprintf("%s\npos += %d", f.unmarshalOptionalValue(dst), f.e.fixedSize())
+			} else {
+				read = readOffset(f, offset)
+			}
+			if f.validateOnly {
+				res = fmt.Sprintf("// Field (%d) '%s'\nif %s {\n%s\n}\n", indx, f.name, isActive(bits[indx]), read)
+			} else {
+				res = fmt.Sprintf("// Field (%d) '%s'\nif %s {\n%s\n} else {\n::.%s = nil\n}\n", indx, f.name, isActive(bits[indx]), read, f.name)
+			}
+		}
+		out = append(out, res)
+	}
+
+	if len(offsets) != 0 {
+		// without variable fields there is nothing after the fixed part
+		out = append(out, fmt.Sprintf("if last == 0 && size != fixed {\nreturn ssz.NewDecodeError(ssz.ErrSize, %q, %d+fixed, %d+size)\n}\n", v.name, numBytes, numBytes))
+
+		// decode the dynamic parts starting from the end, each of them
+		// ends where the next present dynamic part starts.
+		out = append(out, "end := size\n")
+		for indx := len(v.o) - 1; indx >= 0; indx-- {
+			f := v.o[indx]
+			offset := fmt.Sprintf("o%d", indx)
+
+			if f.t != TypeOptional {
+				if !f.isFixed() {
+					tmpl := `// Field ({{.indx}}) '{{.name}}'
+					{
+						buf = tail[{{.offset}}:end]
+						{{.unmarshal}}
+						end = {{.offset}}
+					}`
+					f.errWrap = fieldErrWrap(v.name, f.name, fmt.Sprintf("%d+%s", numBytes, offset))
+					res := execTmpl(tmpl, map[string]interface{}{
+						"indx":      indx,
+						"name":      f.name,
+						"offset":    offset,
+						"unmarshal": f.unmarshal("buf"),
+					})
+					out = append(out, res)
+				}
+			} else if !f.e.isFixed() {
+				tmpl := `// Field ({{.indx}}) '{{.name}}'
+				if {{.active}} {
+					buf = tail[{{.offset}}:end]
+					{{.unmarshal}}
+					end = {{.offset}}
+				}`
+				f.errWrap = fieldErrWrap(v.name, f.name, fmt.Sprintf("%d+%s", numBytes, offset))
+				res := execTmpl(tmpl, map[string]interface{}{
+					"indx":      indx,
+					"name":      f.name,
+					"active":    isActive(bits[indx]),
+					"offset":    offset,
+					"unmarshal": f.unmarshalOptionalValue("buf"),
+				})
+				out = append(out, res)
+			}
+		}
+	}
+
+	str += strings.Join(out, "\n\n")
+	return str
+}
+
+// sizeStable returns the size of the prefix and the fixed part of
+// the required fields and the code to compute the rest of the size
+func (v *Value) sizeStable(name string) (uint64, string) {
+	numBits, _ := v.activeFields()
+
+	out := []string{}
+	for indx, f := range v.o {
+		if f.t != TypeOptional {
+			if !f.isFixed() {
+				out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s", indx, f.name, f.size(name)))
+			}
+			continue
+		}
+		size := fmt.Sprint(f.e.fixedSize())
+		if !f.e.isFixed() {
+			size = fmt.Sprintf("%d + %s", bytesPerLengthOffset, f.sizeOptionalValue())
+		}
+		out = append(out, fmt.Sprintf("// Field (%d) '%s'\nif ::.%s != nil {\n%s += %s\n}", indx, f.name, f.name, name, size))
+	}
+	return (numBits+7)/8 + v.activeFieldsFixedSize(), strings.Join(out, "\n\n")
+}
+
+func (v *Value) hashTreeRootStable() string {
+	base := v
+	if v.profile != nil {
+		base = v.profile
+	}
+
+	out := []string{}
+	for slot := range base.o {
+		indx := slot
+		if v.profile != nil {
+			indx = v.fieldIndex(base.o[slot].name)
+		}
+		if indx == -1 {
+			// the field is not part of the profile
+			out = append(out, fmt.Sprintf("// Field (%d) '%s'\nhh.PutZero()\n", slot, base.o[slot].name))
+			continue
+		}
+
+		f := v.o[indx]
+		var str string
+		if f.t == TypeOptional {
+			str = fmt.Sprintf("if ::.%s != nil {\n%s%s\n} else {\nhh.PutZero()\n}", f.name, setActive(slot), f.hashTreeRootOptionalValue())
+		} else {
+			str = setActive(slot) + f.hashTreeRoot("", false)
+		}
+		out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", slot, f.name, str))
+	}
+
+	tmpl := `indx := hh.Index()
+	active := [{{.numBytes}}]byte{}
+
+	{{.fields}}
+
+	hh.MerkleizeWithActiveFields(indx, active[:], {{.limit}})`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"numBytes": (base.stable + 7) / 8,
+		"fields":   strings.Join(out, "\n"),
+		"limit":    base.stable,
+	})
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestValidateStableProfileTypes(t *testing.T) {
+	list := func(max, size uint64) *Value {
+		return &Value{t: TypeList, m: max, e: &Value{t: TypeUint, s: size}}
+	}
+	container := func(obj string) *Value {
+		return &Value{t: TypeContainer, obj: obj}
+	}
+	base := &Value{
+		name:   "Base",
+		stable: 4,
+		o: []*Value{
+			{name: "A", t: TypeOptional, e: list(99, 8)},
+			{name: "B", t: TypeOptional, e: container("Label")},
+		},
+	}
+
+	cases := []struct {
+		a, b  *Value
+		valid bool
+	}{
+		{list(99, 8), container("Label"), true},
+		{list(10, 1), container("Label"), false},
+		{list(10, 8), container("Label"), false},
+		{list(99, 1), container("Label"), false},
+		{list(99, 8), container("Other"), false},
+	}
+	for indx, c := range cases {
+		c.a.name, c.b.name = "A", "B"
+		profile := &Value{name: "Profile", profile: base, o: []*Value{c.a, c.b}}
+		if err := profile.validateStable(); (err == nil) != c.valid {
+			t.Fatalf("case %d: expected valid %v but found error '%v'", indx, c.valid, err)
+		}
+	}
+}
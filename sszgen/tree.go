@@ -30,7 +30,7 @@ func (e *env) getTree(name string, v *Value) string {
 }
 
 func (v *Value) getTrees(isList bool, elem Type) string {
-	if elem != TypeUint {
+	if elem != TypeUint || v.e.isWideUint() {
 		panic("unimplemented")
 	}
 
@@ -86,6 +86,13 @@ func (v *Value) getTree() string {
 		})
 
 	case TypeUint:
+		if v.isWideUint() {
+			if v.c {
+				// little endian byte array
+				return fmt.Sprintf("w.AddBytes(::.%s[:])", v.name)
+			}
+			return fmt.Sprintf("w.Add%s(%s)", uintVToName(v), v.wideUint())
+		}
 		var name string
 		if v.ref != "" || v.obj != "" {
 			// alias to Uint64
@@ -97,7 +104,7 @@ func (v *Value) getTree() string {
 		method := uintVToName(v)
 		return fmt.Sprintf("w.Add%s(%s)", method, name)
 
-	case TypeBitList:
+	case TypeBitList, TypeUnion, TypeOptional:
 		panic("unimplemented")
 
 	case TypeBool:
@@ -133,6 +140,29 @@ func (v *Value) getTree() string {
 			"num":  v.m,
 		})
 
+	case TypeProgressiveList:
+		tmpl := `{
+			subIdx := w.Indx()
+			num := len(::.{{.name}})
+			{{if .basic}}for _, leaf := range ssz.LeavesFromUint64(::.{{.name}}) {
+				w.AddNode(leaf)
+			}{{else}}for i := 0; i < num; i++ {
+				n, err := ::.{{.name}}[i].GetTree()
+				if err != nil {
+					return err
+				}
+				w.AddNode(n)
+			}{{end}}
+			w.CommitProgressiveWithMixin(subIdx, num)
+		}`
+		if v.e.t == TypeUint && v.e.s != 8 {
+			panic("unimplemented")
+		}
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":  v.name,
+			"basic": v.e.t == TypeUint,
+		})
+
 	default:
 		panic(fmt.Errorf("hash not implemented for type %s", v.t.String()))
 	}
@@ -143,6 +173,10 @@ func (v *Value) getTreeContainer(start bool) string {
 		return fmt.Sprintf("if err := ::.%s.GetTreeWithWrapper(w); err != nil {\n return err\n}", v.name)
 	}
 
+	if v.stable != 0 || v.profile != nil {
+		panic(fmt.Errorf("getTree not implemented for stable container %s", v.name))
+	}
+
 	numLeaves := nextPowerOfTwo(uint64(len(v.o)))
 	out := []string{}
 	for indx, i := range v.o {
@@ -7,23 +7,90 @@ import (
 )
 
 // unmarshal creates a function that decodes the structs with the input byte in SSZ format.
+// It also creates the UnmarshalSSZFromReader and UnmarshalSSZFromDecoder functions that
+// decode the struct from a stream.
 func (e *env) unmarshal(name string, v *Value) string {
 	tmpl := `// UnmarshalSSZ ssz unmarshals the {{.name}} object
 	func (:: *{{.name}}) UnmarshalSSZ(buf []byte) error {
+		return ::.UnmarshalSSZWithState(buf, nil)
+	}
+
+	// UnmarshalSSZWithState ssz unmarshals the {{.name}} object within the limits of the decode state
+	func (:: *{{.name}}) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
 		var err error
-		{{.unmarshal}}
+		if err = st.Enter(); err != nil {
+			return ssz.WrapDecodeError(err, "{{.name}}", "", 0)
+		}
+		defer st.Leave()
+		{{ if .cache }}::.{{.cache}}.Reset()
+		{{ end }}{{.unmarshal}}
+		return err
+	}
+
+	{{ if .noCopy }}
+	// UnmarshalSSZNoCopy ssz unmarshals the {{.name}} object without copying the byte fields,
+	// they reference 'buf' and it must not be modified while the object is in use
+	func (:: *{{.name}}) UnmarshalSSZNoCopy(buf []byte) error {
+		var err error
+		{{ if .cache }}::.{{.cache}}.Reset()
+		{{ end }}{{.noCopy}}
+		return err
+	}
+	{{ end }}
+
+	// ValidateSSZ checks that buf is a valid SSZ encoding of a {{.name}} object without decoding
+	// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+	func (:: *{{.name}}) ValidateSSZ(buf []byte) error {
+		var err error
+		{{.validate}}
 		return err
+	}
+
+	// UnmarshalSSZFromReader ssz unmarshals the {{.name}} object from a reader with an encoded size of 'size' bytes
+	func (:: *{{.name}}) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+		return ssz.UnmarshalSSZFromReader(reader, size, ::)
+	}
+
+	// UnmarshalSSZFromDecoder ssz unmarshals the {{.name}} object from a decoder
+	func (:: *{{.name}}) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+		{{ if .cache }}::.{{.cache}}.Reset()
+		{{ end }}{{.decode}}
 	}`
 
-	str := execTmpl(tmpl, map[string]interface{}{
+	data := map[string]interface{}{
 		"name":      name,
 		"unmarshal": v.umarshalContainer(true, "buf"),
-	})
+		"decode":    v.decodeContainer(),
+		"cache":     v.cache,
+	}
+	if v.hasActiveFields() {
+		// the fixed part depends on the active fields, stable
+		// containers and profiles are read in full before decoding.
+		data["unmarshal"] = v.unmarshalStable()
+		data["decode"] = "buf, err := dec.Read(dec.Size())\nif err != nil {\nreturn err\n}\nreturn ::.UnmarshalSSZWithState(buf, dec.State())"
+	}
+	if vv := v.withValidateOnly(); v.hasActiveFields() {
+		data["validate"] = vv.unmarshalStable()
+	} else {
+		data["validate"] = vv.umarshalContainer(true, "buf")
+	}
+	if e.noCopy {
+		nc := v.withNoCopy()
+		if v.hasActiveFields() {
+			data["noCopy"] = nc.unmarshalStable()
+		} else {
+			data["noCopy"] = nc.umarshalContainer(true, "buf")
+		}
+	}
+	str := execTmpl(tmpl, data)
 
 	return appendObjSignature(str, v)
 }
 
 func (v *Value) unmarshal(dst string) string {
+	if v.validateOnly {
+		return v.validateEncoding(dst)
+	}
 	// we use dst as the input buffer where the SSZ data to decode the value is.
 	switch v.t {
 	case TypeContainer, TypeReference:
@@ -36,7 +103,10 @@ func (v *Value) unmarshal(dst string) string {
 		validate := ""
 		if !v.isFixed() {
 			// dynamic bytes, we need to validate the size of the buffer
-			validate = fmt.Sprintf("if len(%s) > %d { return ssz.ErrBytesLength }\n", dst, v.m)
+			validate = fmt.Sprintf("if len(%s) > %d {\n%s\n}\n", dst, v.m, v.returnErr(fmt.Sprintf("ssz.NewDecodeError(ssz.ErrBytesLength, \"\", %d, uint64(len(%s)))", v.m, dst)))
+		}
+		if v.noCopy {
+			return fmt.Sprintf("%s::.%s = %s", validate, v.name, capSlice(dst))
 		}
 		// both fixed and dynamic are decoded equally
 		tmpl := `{{.validate}}if cap(::.{{.name}}) == 0 {
@@ -51,6 +121,13 @@ func (v *Value) unmarshal(dst string) string {
 		})
 
 	case TypeUint:
+		if v.isWideUint() {
+			if v.c {
+				// little endian byte array
+				return fmt.Sprintf("copy(::.%s[:], %s)", v.name, dst)
+			}
+			return fmt.Sprintf("%s%s = ssz.Unmarshall%s(%s)", v.wideUintCheck(), v.wideUint(), uintVToName(v), dst)
+		}
 		if v.ref != "" {
 			// alias, we need to cast the value
 			return fmt.Sprintf("::.%s = %s.%s(ssz.Unmarshall%s(%s))", v.name, v.ref, v.obj, uintVToName(v), dst)
@@ -63,17 +140,22 @@ func (v *Value) unmarshal(dst string) string {
 
 	case TypeBitList:
 		tmpl := `if err = ssz.ValidateBitlist({{.dst}}, {{.size}}); err != nil {
-			return err
+			{{.returnErr}}
 		}
-		if cap(::.{{.name}}) == 0 {
+		{{ if .noCopy }}::.{{.name}} = {{.noCopy}}{{ else }}if cap(::.{{.name}}) == 0 {
 			::.{{.name}} = make([]byte, 0, len({{.dst}}))
 		}
-		::.{{.name}} = append(::.{{.name}}, {{.dst}}...)`
-		return execTmpl(tmpl, map[string]interface{}{
-			"name": v.name,
-			"dst":  dst,
-			"size": v.m,
-		})
+		::.{{.name}} = append(::.{{.name}}, {{.dst}}...){{ end }}`
+		data := map[string]interface{}{
+			"name":      v.name,
+			"dst":       dst,
+			"size":      v.m,
+			"returnErr": v.returnErr("err"),
+		}
+		if v.noCopy {
+			data["noCopy"] = capSlice(dst)
+		}
+		return execTmpl(tmpl, data)
 
 	case TypeVector:
 		if v.e.isFixed() {
@@ -83,30 +165,114 @@ func (v *Value) unmarshal(dst string) string {
 			for ii := 0; ii < {{.size}}; ii++ {
 				{{.unmarshal}}
 			}`
+			v.e.errWrap = v.itemErrWrap(fmt.Sprintf("ssz.WrapDecodeErrorIndex(%%s, ii, uint64(ii*%d))", v.e.fixedSize()))
 			return execTmpl(tmpl, map[string]interface{}{
-				"create":    v.createSlice(false),
+				"create":    v.createSlice(false, v.decodeState()),
 				"size":      v.s,
 				"unmarshal": v.e.unmarshal(dst),
 			})
 		}
 		fallthrough
 
-	case TypeList:
+	case TypeList, TypeProgressiveList:
 		return v.unmarshalList()
 
 	case TypeBool:
-		return unbTmpl(v.name, dst)
+		return unbTmpl(v.name, dst, v.returnErr("err"))
+
+	case TypeOptional:
+		tmpl := `body, present, err := ssz.DecodeOptional({{.dst}})
+		if err != nil {
+			{{.returnErr}}
+		}
+		if !present {
+			::.{{.name}} = nil
+		} else {
+			{{.unmarshal}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":      v.name,
+			"dst":       dst,
+			"unmarshal": v.unmarshalOptionalValue("body"),
+			"returnErr": v.returnErr("err"),
+		})
+
+	case TypeUnion:
+		tmpl := `selector, body, err := ssz.DecodeUnionSelector({{.dst}}, {{.num}})
+		if err != nil {
+			{{.returnErr}}
+		}
+		switch selector {
+		{{ range .types }}case {{.Selector}}:
+			{{ if .Obj }}opt := new({{.Obj}})
+			if err = {{ if $.noCopy }}ssz.UnmarshalNoCopy(opt, body){{ else }}ssz.UnmarshalWithState(opt, body, st){{ end }}; err != nil {
+				{{$.returnErr}}
+			}
+			::.{{$.name}} = opt
+			{{ else }}if len(body) != 0 {
+				{{$.returnErrSize}}
+			}
+			::.{{$.name}} = nil
+		{{ end }}{{ end }}}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":          v.name,
+			"dst":           dst,
+			"num":           len(v.o),
+			"types":         v.unionTypes(),
+			"noCopy":        v.noCopy,
+			"returnErr":     v.returnErr("err"),
+			"returnErrSize": v.returnErr("ssz.ErrSize"),
+		})
 
 	default:
 		panic(fmt.Errorf("unmarshal not implemented for type %d", v.t))
 	}
 }
 
-func unbTmpl(name, dst string) string {
+// unmarshalOptionalValue unmarshals the value of an optional type without the prefix
+func (v *Value) unmarshalOptionalValue(dst string) string {
+	v.e.name = v.name
+	v.e.errWrap = v.errWrap
+	if v.validateOnly {
+		check := v.e.unmarshal(dst)
+		if v.e.t != TypeContainer && v.e.t != TypeReference {
+			check = fmt.Sprintf("if len(%s) != %d {\n%s\n}\n%s", dst, v.e.fixedSize(), v.returnErr("ssz.ErrSize"), check)
+		}
+		return check
+	}
+	if v.e.t == TypeContainer || v.e.t == TypeReference {
+		return v.e.umarshalContainer(false, dst)
+	}
+	tmpl := `if len({{.dst}}) != {{.size}} {
+		{{.returnErrSize}}
+	}
+	{{ if .bool }}val, err := ssz.DecodeBool({{.dst}})
+	if err != nil {
+		{{.returnErr}}
+	}{{ else }}val := {{.val}}{{ end }}
+	::.{{.name}} = &val`
+	fn, _ := v.e.optionalBasic()
+	val := fmt.Sprintf("ssz.Unmarshall%s(%s)", fn, dst)
+	if v.e.ref != "" || v.e.obj != "" {
+		// alias, we need to cast the value
+		val = fmt.Sprintf("%s(%s)", v.e.objRef(), val)
+	}
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":          v.name,
+		"dst":           dst,
+		"size":          v.e.fixedSize(),
+		"bool":          v.e.t == TypeBool,
+		"val":           val,
+		"returnErr":     v.returnErr("err"),
+		"returnErrSize": v.returnErr("ssz.ErrSize"),
+	})
+}
+
+func unbTmpl(name, dst, returnErr string) string {
 	return fmt.Sprintf(`::.%s, err = ssz.DecodeBool(%s)
 	    if err != nil {
-		return err
-	}`, name, dst)
+		%s
+	}`, name, dst, returnErr)
 }
 
 func (v *Value) unmarshalList() string {
@@ -115,18 +281,29 @@ func (v *Value) unmarshalList() string {
 
 		tmpl := `num, err := ssz.DivideInt2(len(buf), {{.size}}, {{.max}})
 		if err != nil {
-			return err
+			{{.returnErr}}
 		}
 		{{.create}}
 		for ii := 0; ii < num; ii++ {
 			{{.unmarshal}}
 		}`
-		return execTmpl(tmpl, map[string]interface{}{
+		v.e.errWrap = v.itemErrWrap(fmt.Sprintf("ssz.WrapDecodeErrorIndex(%%s, ii, uint64(ii*%d))", v.e.fixedSize()))
+		data := map[string]interface{}{
 			"size":      v.e.fixedSize(),
-			"max":       v.s,
-			"create":    v.createSlice(true),
+			"max":       v.listMax("len(buf)"),
+			"create":    "",
 			"unmarshal": v.e.unmarshal(dst),
-		})
+			"returnErr": v.returnErr("err"),
+		}
+		if !v.validateOnly {
+			data["create"] = v.createSlice(true, v.decodeState())
+		} else if data["unmarshal"] == "" {
+			// only the size of the list needs to be checked
+			tmpl = `if _, err = ssz.DivideInt2(len(buf), {{.size}}, {{.max}}); err != nil {
+				{{.returnErr}}
+			}`
+		}
+		return execTmpl(tmpl, data)
 	}
 
 	if v.t == TypeVector {
@@ -138,23 +315,33 @@ func (v *Value) unmarshalList() string {
 
 	tmpl := `num, err := ssz.DecodeDynamicLength(buf, {{.max}})
 	if err != nil {
-		return err
+		{{.returnErr}}
 	}
 	{{.create}}
-	err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+	err = {{.dynamic}}(buf, num, func(indx int, buf []byte) (err error) {
 		{{.unmarshal}}
 		return nil
 	})
 	if err != nil {
-		return err
+		{{.returnErr}}
 	}`
 
+	// the items are wrapped with their index by UnmarshalDynamic at runtime
 	v.e.name = v.name + "[indx]"
+	v.e.errWrap = nil
 
 	data := map[string]interface{}{
-		"max":       v.s,
-		"create":    v.createSlice(true),
+		"max":       v.listMax("len(buf)"),
+		"create":    "",
+		"dynamic":   "ssz.UnmarshalDynamic",
 		"unmarshal": v.e.unmarshal("buf"),
+		"returnErr": v.returnErr("err"),
+	}
+	if !v.validateOnly {
+		data["create"] = v.createSlice(true, v.decodeState())
+		if !v.noCopy {
+			data["dynamic"] = "st.UnmarshalDynamic"
+		}
 	}
 	return execTmpl(tmpl, data)
 }
@@ -164,34 +351,23 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 		tmpl := `{{ if .check }}if ::.{{.name}} == nil {
 			::.{{.name}} = new({{.obj}})
 		}
-		{{ end }}if err = ::.{{.name}}.UnmarshalSSZ({{.dst}}); err != nil {
-			return err
+		{{ end }}if err = {{ if .noCopy }}ssz.UnmarshalNoCopy({{ if not .check }}&{{ end }}::.{{.name}}, {{.dst}}){{ else }}ssz.UnmarshalWithState({{ if not .check }}&{{ end }}::.{{.name}}, {{.dst}}, st){{ end }}; err != nil {
+			{{.returnErr}}
 		}`
 		check := true
 		if v.noPtr {
 			check = false
 		}
 		return execTmpl(tmpl, map[string]interface{}{
-			"name":  v.name,
-			"obj":   v.objRef(),
-			"dst":   dst,
-			"check": check,
+			"name":      v.name,
+			"obj":       v.objRef(),
+			"dst":       dst,
+			"check":     check,
+			"noCopy":    v.noCopy,
+			"returnErr": v.returnErr("err"),
 		})
 	}
 
-	var offsets []string
-	offsetsMatch := map[string]string{}
-
-	for indx, i := range v.o {
-		if !i.isFixed() {
-			name := "o" + strconv.Itoa(indx)
-			if len(offsets) != 0 {
-				offsetsMatch[name] = offsets[len(offsets)-1]
-			}
-			offsets = append(offsets, name)
-		}
-	}
-
 	// safe check for the size. Two cases:
 	// 1. Struct is fixed: The size of the input buffer must be the same as the struct.
 	// 2. Struct is dynamic. The size of the input buffer must be higher than the fixed part of the struct.
@@ -203,11 +379,13 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 		cmp = "<"
 	}
 
+	offsets, outs := v.unmarshalFixedPart(v.name)
+
 	// If the struct is dynamic we create a set of offset variables that will be readed later.
 
 	tmpl := `size := uint64(len(buf))
 	if size {{.cmp}} {{.size}} {
-		return ssz.ErrSize
+		return ssz.NewDecodeError(ssz.ErrSize, "{{.name}}", {{.size}}, size)
 	}
 	{{if .offsets}}
 		tail := buf
@@ -216,11 +394,64 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 	`
 
 	str += execTmpl(tmpl, map[string]interface{}{
+		"name":    v.name,
 		"cmp":     cmp,
 		"size":    v.fixedSize(),
 		"offsets": strings.Join(offsets, ", "),
 	})
 
+	// Marshal the dynamic parts
+
+	c := 0
+
+	for indx, i := range v.o {
+		if !i.isFixed() {
+			from := offsets[c]
+			var to string
+			if c == len(offsets)-1 {
+				to = ""
+			} else {
+				to = offsets[c+1]
+			}
+			tmpl := `// Field ({{.indx}}) '{{.name}}'
+			{
+				buf = tail[{{.from}}:{{.to}}]
+				{{.unmarshal}}
+			}`
+			i.errWrap = fieldErrWrap(v.name, i.name, from)
+			res := execTmpl(tmpl, map[string]interface{}{
+				"indx":      indx,
+				"name":      i.name,
+				"from":      from,
+				"to":        to,
+				"unmarshal": i.unmarshal("buf"),
+			})
+			outs = append(outs, res)
+			c++
+		}
+	}
+
+	str += strings.Join(outs, "\n\n")
+	return
+}
+
+// unmarshalFixedPart returns the names of the offset variables of the container and
+// the code that decodes the fixed fields and validates the offsets from the 'buf' variable.
+// The errors of each field are reported with the path of the field in the object 'typ'.
+func (v *Value) unmarshalFixedPart(typ string) ([]string, []string) {
+	var offsets []string
+	offsetsMatch := map[string]string{}
+
+	for indx, i := range v.o {
+		if !i.isFixed() {
+			name := "o" + strconv.Itoa(indx)
+			if len(offsets) != 0 {
+				offsetsMatch[name] = offsets[len(offsets)-1]
+			}
+			offsets = append(offsets, name)
+		}
+	}
+
 	var o0 uint64
 
 	// Marshal the fixed part and offsets
@@ -241,12 +472,18 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 			incr = bytesPerLengthOffset
 		}
 
-		dst = fmt.Sprintf("%s[%d:%d]", "buf", o0, o0+incr)
+		dst := fmt.Sprintf("%s[%d:%d]", "buf", o0, o0+incr)
+		i.errWrap = fieldErrWrap(typ, i.name, strconv.Itoa(int(o0)))
 		o0 += incr
 
 		var res string
 		if i.isFixed() {
-			res = fmt.Sprintf("// Field (%d) '%s'\n%s\n\n", indx, i.name, i.unmarshal(dst))
+			code := i.unmarshal(dst)
+			if code == "" {
+				// nothing to check in ValidateSSZ
+				continue
+			}
+			res = fmt.Sprintf("// Field (%d) '%s'\n%s\n\n", indx, i.name, code)
 
 		} else {
 			// read the offset
@@ -258,6 +495,8 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 				"offset":           offset,
 				"dst":              dst,
 				"firstOffsetCheck": firstOffsetCheck,
+				"returnErrOffset":  i.returnErr("ssz.ErrOffset"),
+				"returnErrFirst":   i.returnErr(fmt.Sprintf("ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, \"\", %s, %s)", firstOffsetCheck, offset)),
 			}
 
 			// We need to do two validations for the offset:
@@ -272,11 +511,11 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 
 			tmpl := `// Offset ({{.indx}}) '{{.name}}'
 			if {{.offset}} = ssz.ReadOffset({{.dst}}); {{.offset}} > size {{.more}} {
-				return ssz.ErrOffset
+				{{.returnErrOffset}}
 			}
 			{{ if .firstOffsetCheck }}
 			if {{.offset}} != {{.firstOffsetCheck}} {
-				return ssz.ErrInvalidVariableOffset
+				{{.returnErrFirst}}
 			}
 			{{ end }}
 			`
@@ -285,31 +524,70 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 		}
 		outs = append(outs, res)
 	}
+	return offsets, outs
+}
 
-	// Marshal the dynamic parts
+// decodeContainer mirrors umarshalContainer but reads the struct from a ssz.Decoder. Only
+// the fixed part of the struct is buffered, the dynamic fields are decoded one after
+// another from the stream.
+func (v *Value) decodeContainer() string {
+	if v.isFixed() {
+		tmpl := `if dec.Size() != {{.size}} {
+			return ssz.NewDecodeError(ssz.ErrSize, "{{.name}}", {{.size}}, dec.Size())
+		}
+		buf, err := dec.Read({{.size}})
+		if err != nil {
+			return err
+		}
+		return ::.UnmarshalSSZWithState(buf, dec.State())`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+			"size": v.fixedSize(),
+		})
+	}
 
-	c := 0
+	offsets, outs := v.unmarshalFixedPart(v.name)
 
+	tmpl := `size := dec.Size()
+	if size < {{.size}} {
+		return ssz.NewDecodeError(ssz.ErrSize, "{{.name}}", {{.size}}, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "{{.name}}", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read({{.size}})
+	if err != nil {
+		return err
+	}
+	var {{.offsets}} uint64
+
+	`
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":    v.name,
+		"size":    v.fixedSize(),
+		"offsets": strings.Join(offsets, ", "),
+	})
+
+	// Decode the dynamic parts
+
+	c := 0
 	for indx, i := range v.o {
 		if !i.isFixed() {
-			from := offsets[c]
-			var to string
-			if c == len(offsets)-1 {
-				to = ""
-			} else {
-				to = offsets[c+1]
+			size := "size - " + offsets[c]
+			if c != len(offsets)-1 {
+				size = offsets[c+1] + " - " + offsets[c]
 			}
 			tmpl := `// Field ({{.indx}}) '{{.name}}'
 			{
-				buf = tail[{{.from}}:{{.to}}]
-				{{.unmarshal}}
+				{{.decode}}
 			}`
+			i.errWrap = fieldErrWrap(v.name, i.name, offsets[c])
 			res := execTmpl(tmpl, map[string]interface{}{
-				"indx":      indx,
-				"name":      i.name,
-				"from":      from,
-				"to":        to,
-				"unmarshal": i.unmarshal("buf"),
+				"indx":   indx,
+				"name":   i.name,
+				"decode": i.decode(size),
 			})
 			outs = append(outs, res)
 			c++
@@ -317,12 +595,215 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 	}
 
 	str += strings.Join(outs, "\n\n")
-	return
+	str += "\nreturn nil"
+	return str
+}
+
+// decode reads a dynamic value with an encoded size of 'size' bytes from the decoder.
+func (v *Value) decode(size string) string {
+	switch v.t {
+	case TypeContainer, TypeReference:
+		tmpl := `{{ if .check }}if ::.{{.name}} == nil {
+			::.{{.name}} = new({{.obj}})
+		}
+		{{ end }}if err = dec.DecodeObject({{ if .addr }}&{{ end }}::.{{.name}}, {{.size}}); err != nil {
+			{{.returnErr}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":      v.name,
+			"obj":       v.objRef(),
+			"size":      size,
+			"check":     !v.noPtr,
+			"addr":      v.noPtr,
+			"returnErr": v.returnErr("err"),
+		})
+
+	case TypeBytes:
+		tmpl := `if {{.size}} > {{.max}} {
+			{{.returnErrLength}}
+		}
+		if buf, err = dec.Read({{.size}}); err != nil {
+			{{.returnErr}}
+		}
+		if cap(::.{{.name}}) == 0 {
+			::.{{.name}} = make([]byte, 0, len(buf))
+		}
+		::.{{.name}} = append(::.{{.name}}, buf...)`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":            v.name,
+			"size":            size,
+			"max":             v.m,
+			"returnErr":       v.returnErr("err"),
+			"returnErrLength": v.returnErr("ssz.ErrBytesLength"),
+		})
+
+	case TypeBitList:
+		tmpl := `if buf, err = dec.Read({{.size}}); err != nil {
+			{{.returnErr}}
+		}
+		{{.unmarshal}}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"size":      size,
+			"unmarshal": v.unmarshal("buf"),
+			"returnErr": v.returnErr("err"),
+		})
+
+	case TypeList, TypeProgressiveList:
+		return v.decodeList(size)
+
+	case TypeOptional:
+		if v.e.t != TypeContainer && v.e.t != TypeReference {
+			// basic types are decoded in memory
+			tmpl := `if buf, err = dec.Read({{.size}}); err != nil {
+				{{.returnErr}}
+			}
+			{{.unmarshal}}`
+			return execTmpl(tmpl, map[string]interface{}{
+				"size":      size,
+				"unmarshal": v.unmarshal("buf"),
+				"returnErr": v.returnErr("err"),
+			})
+		}
+		tmpl := `if {{.size}} == 0 {
+			::.{{.name}} = nil
+		} else {
+			if buf, err = dec.Read(1); err != nil {
+				{{.returnErr}}
+			}
+			if _, _, err = ssz.DecodeOptional(buf); err != nil {
+				{{.returnErr}}
+			}
+			if ::.{{.name}} == nil {
+				::.{{.name}} = new({{.obj}})
+			}
+			if err = dec.DecodeObject(::.{{.name}}, {{.size}}-1); err != nil {
+				{{.returnErr}}
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":      v.name,
+			"obj":       v.e.objRef(),
+			"size":      size,
+			"returnErr": v.returnErr("err"),
+		})
+
+	case TypeUnion:
+		tmpl := `if {{.size}} == 0 {
+			{{.returnErrSize}}
+		}
+		if buf, err = dec.Read(1); err != nil {
+			{{.returnErr}}
+		}
+		selector, _, err := ssz.DecodeUnionSelector(buf, {{.num}})
+		if err != nil {
+			{{.returnErr}}
+		}
+		switch selector {
+		{{ range .types }}case {{.Selector}}:
+			{{ if .Obj }}opt := new({{.Obj}})
+			if err = dec.DecodeObject(opt, {{$.size}}-1); err != nil {
+				{{$.returnErr}}
+			}
+			::.{{$.name}} = opt
+			{{ else }}if {{$.size}} != 1 {
+				{{$.returnErrSize}}
+			}
+			::.{{$.name}} = nil
+		{{ end }}{{ end }}}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":          v.name,
+			"size":          size,
+			"num":           len(v.o),
+			"types":         v.unionTypes(),
+			"returnErr":     v.returnErr("err"),
+			"returnErrSize": v.returnErr("ssz.ErrSize"),
+		})
+
+	default:
+		panic(fmt.Errorf("decode not implemented for type %s", v.t.String()))
+	}
+}
+
+func (v *Value) decodeList(size string) string {
+	if v.e.isFixed() {
+		v.e.name = v.name + "[ii]"
+
+		tmpl := `num, err := ssz.DivideInt2(int({{.size}}), {{.elemSize}}, {{.max}})
+		if err != nil {
+			{{.returnErr}}
+		}
+		{{.create}}
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read({{.elemSize}}); err != nil {
+				{{.returnErr}}
+			}
+			{{.unmarshal}}
+		}`
+		v.e.errWrap = v.itemErrWrap(fmt.Sprintf("ssz.WrapDecodeErrorIndex(%%s, ii, uint64(ii*%d))", v.e.fixedSize()))
+		return execTmpl(tmpl, map[string]interface{}{
+			"size":      size,
+			"elemSize":  v.e.fixedSize(),
+			"max":       v.listMax("int(" + size + ")"),
+			"create":    v.createSlice(true, "st"),
+			"unmarshal": v.e.unmarshal("buf"),
+			"returnErr": v.returnErr("err"),
+		})
+	}
+
+	// Decode list with a dynamic element. 'dec.ReadDynamicOffsets' performs the same
+	// checks as 'ssz.DecodeDynamicLength' and 'ssz.UnmarshalDynamic'.
+
+	v.e.name = v.name + "[indx]"
+
+	tmpl := `sizes, err := dec.ReadDynamicOffsets({{.size}}, {{.max}})
+	if err != nil {
+		{{.returnErr}}
+	}
+	num := len(sizes)
+	{{.create}}
+	if err = st.Enter(); err != nil {
+		{{.returnErr}}
+	}
+	pos := uint64(4 * num)
+	for indx := 0; indx < num; indx++ {
+		{
+			{{.decode}}
+		}
+		pos += sizes[indx]
+	}
+	st.Leave()`
+	v.e.errWrap = v.itemErrWrap("ssz.WrapDecodeErrorIndex(%s, indx, pos)")
+	return execTmpl(tmpl, map[string]interface{}{
+		"size":      size,
+		"max":       v.listMax("int(" + size + ")"),
+		"create":    v.createSlice(true, "st"),
+		"decode":    v.e.decode("sizes[indx]"),
+		"returnErr": v.returnErr("err"),
+	})
+}
+
+// listMax returns the maximum number of items of a list. Progressive lists
+// do not have a limit and they are only bounded by the size of the input.
+func (v *Value) listMax(size string) string {
+	if v.t == TypeProgressiveList {
+		return size
+	}
+	return strconv.Itoa(int(v.s))
 }
 
-// createItem is used to initialize slices of objects
-func (v *Value) createSlice(useNumVariable bool) string {
-	if v.t != TypeVector && v.t != TypeList {
+// decodeState returns the variable with the ssz.DecodeState that limits the
+// decoding of the value. The zero-copy decoding does not have limits.
+func (v *Value) decodeState() string {
+	if v.noCopy {
+		return ""
+	}
+	return "st"
+}
+
+// createSlice is used to initialize slices of objects. If 'st' is not empty it is the
+// ssz.DecodeState that accounts for the memory of the slice before it is allocated.
+func (v *Value) createSlice(useNumVariable bool, st string) string {
+	if v.t != TypeVector && v.t != TypeList && v.t != TypeProgressiveList {
 		panic("BUG: create item is only intended to be used with vectors and lists")
 	}
 
@@ -332,14 +813,44 @@ func (v *Value) createSlice(useNumVariable bool) string {
 		size = "num"
 	}
 
+	// alloc checks the budget for the items of 'itemSize' bytes before 'create' allocates them
+	alloc := func(itemSize string, create string) string {
+		if st == "" {
+			return create
+		}
+		return fmt.Sprintf("if err = %s.Alloc(%s, %s); err != nil {\n%s\n}\n%s", st, size, itemSize, v.returnErr("err"), create)
+	}
+
 	switch v.e.t {
 	case TypeUint:
+		if v.e.isWideUint() {
+			if v.c {
+				return ""
+			}
+			if v.e.c && v.e.obj == "" {
+				// [][32]byte
+				return alloc(strconv.Itoa(int(v.e.s)), fmt.Sprintf("::.%s = make([][%d]byte, %s)", v.name, v.e.s, size))
+			}
+			ptr := "*"
+			itemSize := strconv.Itoa(int(v.e.s))
+			if v.e.noPtr {
+				ptr = ""
+			} else {
+				// the pointer and the integer it points to
+				itemSize = strconv.Itoa(8 + int(v.e.s))
+			}
+			// []*uint256.Int
+			return alloc(itemSize, fmt.Sprintf("::.%s = make([]%s%s, %s)", v.name, ptr, v.e.objRef(), size))
+		}
 		// []int uses the Extend functions in the fastssz package
+		if st != "" {
+			return fmt.Sprintf("if ::.%s, err = %s.Extend%s(::.%s, %s); err != nil {\n%s\n}", v.name, st, uintVToName(v.e), v.name, size, v.returnErr("err"))
+		}
 		return fmt.Sprintf("::.%s = ssz.Extend%s(::.%s, %s)", v.name, uintVToName(v.e), v.name, size)
 
 	case TypeContainer:
-		// []*(ref.)Struct{}
-		return fmt.Sprintf("::.%s = make([]*%s, %s)", v.name, v.e.objRef(), size)
+		// []*(ref.)Struct{}, each item is a pointer to a new struct
+		return alloc(fmt.Sprintf("8+int(unsafe.Sizeof(%s{}))", v.e.objRef()), fmt.Sprintf("::.%s = make([]*%s, %s)", v.name, v.e.objRef(), size))
 
 	case TypeBytes:
 		// [][]byte
@@ -347,11 +858,137 @@ func (v *Value) createSlice(useNumVariable bool) string {
 			return ""
 		}
 		if v.e.c {
-			return fmt.Sprintf("::.%s = make([][%d]byte, %s)", v.name, v.e.s, size)
+			return alloc(strconv.Itoa(int(v.e.s)), fmt.Sprintf("::.%s = make([][%d]byte, %s)", v.name, v.e.s, size))
 		}
-		return fmt.Sprintf("::.%s = make([][]byte, %s)", v.name, size)
+		// the size of a slice header
+		return alloc("24", fmt.Sprintf("::.%s = make([][]byte, %s)", v.name, size))
 
 	default:
 		panic(fmt.Sprintf("create not implemented for type %s", v.e.t.String()))
 	}
 }
+
+// returnErr returns the statement that returns the error 'err' from the decoding code
+// of the value, wrapped with the path of the value in the object being decoded.
+func (v *Value) returnErr(err string) string {
+	for _, wrap := range v.errWrap {
+		err = fmt.Sprintf(wrap, err)
+	}
+	return "return " + err
+}
+
+// fieldErrWrap returns the wrappers of the errors of the field 'field' of the
+// object 'typ' at offset 'pos', which make them a ssz.DecodeError with the path
+// of the field.
+func fieldErrWrap(typ, field, pos string) []string {
+	return []string{fmt.Sprintf("ssz.WrapDecodeError(%%s, %q, %q, %s)", typ, field, pos)}
+}
+
+// itemErrWrap returns the wrappers of the errors of the items of a vector or list.
+// 'wrap' adds the index of the item and then the errors are wrapped as the ones
+// of the vector or list.
+func (v *Value) itemErrWrap(wrap string) []string {
+	return append([]string{wrap}, v.errWrap...)
+}
+
+// capSlice returns the 'dst' slice expression with its capacity limited to its
+// length so that appending to a field that references the input does not
+// overwrite the rest of the input.
+func capSlice(dst string) string {
+	if indx := strings.LastIndex(dst, "["); indx != -1 && strings.HasSuffix(dst, "]") {
+		if parts := strings.Split(dst[indx+1:len(dst)-1], ":"); len(parts) == 2 && parts[1] != "" {
+			return fmt.Sprintf("%s[%s:%s:%s]", dst[:indx], parts[0], parts[1], parts[1])
+		}
+	}
+	return fmt.Sprintf("%s[:len(%s):len(%s)]", dst, dst, dst)
+}
+
+// validateEncoding returns the checks of unmarshal for the value in 'dst' without
+// decoding it. It is used to generate the ValidateSSZ functions, which cannot
+// allocate, and it is empty for the values that do not need any check.
+func (v *Value) validateEncoding(dst string) string {
+	switch v.t {
+	case TypeContainer, TypeReference:
+		if v.t == TypeContainer && v.ref == "" {
+			return fmt.Sprintf("if err = (*%s)(nil).ValidateSSZ(%s); err != nil {\n%s\n}", v.obj, dst, v.returnErr("err"))
+		}
+		// the type may not have a ValidateSSZ function
+		return fmt.Sprintf("if err = ssz.ValidateObject((*%s)(nil), %s); err != nil {\n%s\n}", v.objRef(), dst, v.returnErr("err"))
+
+	case TypeBytes:
+		if v.isFixed() {
+			return ""
+		}
+		return fmt.Sprintf("if len(%s) > %d {\n%s\n}", dst, v.m, v.returnErr(fmt.Sprintf("ssz.NewDecodeError(ssz.ErrBytesLength, \"\", %d, uint64(len(%s)))", v.m, dst)))
+
+	case TypeUint:
+		return ""
+
+	case TypeBool:
+		return fmt.Sprintf("if _, err = ssz.DecodeBool(%s); err != nil {\n%s\n}", dst, v.returnErr("err"))
+
+	case TypeBitList:
+		return fmt.Sprintf("if err = ssz.ValidateBitlist(%s, %d); err != nil {\n%s\n}", dst, v.m, v.returnErr("err"))
+
+	case TypeVector:
+		if v.e.isFixed() {
+			dst = fmt.Sprintf("%s[ii*%d: (ii+1)*%d]", dst, v.e.fixedSize(), v.e.fixedSize())
+			v.e.errWrap = v.itemErrWrap(fmt.Sprintf("ssz.WrapDecodeErrorIndex(%%s, ii, uint64(ii*%d))", v.e.fixedSize()))
+			check := v.e.unmarshal(dst)
+			if check == "" {
+				return ""
+			}
+			return fmt.Sprintf("for ii := 0; ii < %d; ii++ {\n%s\n}", v.s, check)
+		}
+		fallthrough
+
+	case TypeList, TypeProgressiveList:
+		return v.unmarshalList()
+
+	case TypeOptional:
+		tmpl := `body, present, err := ssz.DecodeOptional({{.dst}})
+		if err != nil {
+			{{.returnErr}}
+		}
+		if present {
+			{{.check}}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"dst":       dst,
+			"check":     v.unmarshalOptionalValue("body"),
+			"returnErr": v.returnErr("err"),
+		})
+
+	case TypeUnion:
+		tmpl := `selector, body, err := ssz.DecodeUnionSelector({{.dst}}, {{.num}})
+		if err != nil {
+			{{.returnErr}}
+		}
+		switch selector {
+		{{ range .types }}case {{.Selector}}:
+			{{.Check}}
+		{{ end }}}`
+		type option struct {
+			Selector int
+			Check    string
+		}
+		types := []option{}
+		for indx, opt := range v.o {
+			check := fmt.Sprintf("if len(body) != 0 {\n%s\n}", v.returnErr("ssz.ErrSize"))
+			if opt != nil {
+				opt.errWrap = v.errWrap
+				check = opt.unmarshal("body")
+			}
+			types = append(types, option{Selector: indx, Check: check})
+		}
+		return execTmpl(tmpl, map[string]interface{}{
+			"dst":       dst,
+			"num":       len(v.o),
+			"types":     types,
+			"returnErr": v.returnErr("err"),
+		})
+
+	default:
+		panic(fmt.Errorf("validate not implemented for type %d", v.t))
+	}
+}
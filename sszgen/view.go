@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// view creates a read-only view type over the SSZ encoding of the container. The view
+// locates each field on demand by walking the offsets of the encoding with ssz.View.
+func (e *env) view(name string, v *Value) string {
+	if v.t != TypeContainer || v.hasActiveFields() {
+		// the layout of stable containers depends on the active fields
+		return ""
+	}
+
+	tmpl := `// {{.name}}View is a lazy view over the SSZ encoding of a {{.name}} object
+	type {{.name}}View struct {
+		v ssz.View
+	}
+
+	// New{{.name}}View creates a view over the SSZ encoding of a {{.name}} object
+	func New{{.name}}View(buf []byte) {{.name}}View {
+		return {{.name}}ViewOf(ssz.NewView(buf))
+	}
+
+	// {{.name}}ViewOf returns the view of the {{.name}} object encoded in v
+	func {{.name}}ViewOf(v ssz.View) {{.name}}View {
+		return {{.name}}View{v.Container({{.fixed}}, {{.first}})}
+	}
+
+	// Raw returns the untyped view of the {{.name}} object
+	func (v {{.name}}View) Raw() ssz.View {
+		return v.v
+	}
+
+	// {{.name}}ListView is a lazy view over a list of {{.name}} objects
+	type {{.name}}ListView struct {
+		ssz.ListView
+	}
+
+	// At returns the view of the i-th {{.name}} object of the list
+	func (l {{.name}}ListView) At(i int) {{.name}}View {
+		return {{.name}}ViewOf(l.ListView.At(i))
+	}
+
+	{{ range .fields }}
+	// {{.Name}} returns the {{.Name}} field of the {{$.name}} object
+	func (v {{$.name}}View) {{.Name}}() {{.Type}} {
+		{{.Body}}
+	}
+	{{ end }}`
+
+	type field struct {
+		Name, Type, Body string
+	}
+
+	// positions of the fixed fields and the offsets of the dynamic fields
+	pos := make([]uint64, len(v.o))
+	offsets := []int{}
+	first := -1
+	var o0 uint64
+	for indx, f := range v.o {
+		pos[indx] = o0
+		if f.isFixed() {
+			o0 += f.fixedSize()
+		} else {
+			if first == -1 {
+				first = int(o0)
+			}
+			offsets = append(offsets, indx)
+			o0 += bytesPerLengthOffset
+		}
+	}
+
+	fields := []*field{}
+	c := 0
+	for indx, f := range v.o {
+		var view string
+		if f.isFixed() {
+			view = fmt.Sprintf("v.v.Field(%d, %d)", pos[indx], pos[indx]+f.fixedSize())
+		} else {
+			next := uint64(0)
+			if c != len(offsets)-1 {
+				next = pos[offsets[c+1]]
+			}
+			view = fmt.Sprintf("v.v.DynamicField(%d, %d)", pos[indx], next)
+			c++
+		}
+		typ, body := f.viewField(view)
+		fields = append(fields, &field{Name: f.name, Type: typ, Body: body})
+	}
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":   name,
+		"fixed":  v.fixedSize(),
+		"first":  first,
+		"fields": fields,
+	})
+}
+
+// hasView returns true if the value is a container with a generated view
+func (v *Value) hasView() bool {
+	return v.t == TypeContainer && v.ref == "" && !v.hasActiveFields()
+}
+
+// viewField returns the type returned by the accessor of the field and
+// the body of the accessor for the ssz.View 'view' of the field.
+func (v *Value) viewField(view string) (string, string) {
+	switch v.t {
+	case TypeBool:
+		return "(bool, error)", fmt.Sprintf("return %s.Bool()", view)
+
+	case TypeUint:
+		if v.isWideUint() {
+			// little endian bytes
+			return "([]byte, error)", fmt.Sprintf("return %s.Bytes()", view)
+		}
+		fn := uintVToName(v)
+		if v.obj == "" {
+			return "(" + strings.ToLower(fn) + ", error)", fmt.Sprintf("return %s.%s()", view, fn)
+		}
+		// alias, we need to cast the value
+		body := fmt.Sprintf("val, err := %s.%s()\nreturn %s(val), err", view, fn, v.objRef())
+		return "(" + v.objRef() + ", error)", body
+
+	case TypeBytes:
+		if v.isFixed() {
+			return "([]byte, error)", fmt.Sprintf("return %s.Bytes()", view)
+		}
+		return "([]byte, error)", fmt.Sprintf("return %s.ByteList(%d)", view, v.m)
+
+	case TypeBitList:
+		return "([]byte, error)", fmt.Sprintf("return %s.Bitlist(%d)", view, v.m)
+
+	case TypeContainer:
+		if v.hasView() {
+			return v.obj + "View", fmt.Sprintf("return %sViewOf(%s)", v.obj, view)
+		}
+
+	case TypeVector, TypeList, TypeProgressiveList:
+		var size uint64
+		if v.e.isFixed() {
+			size = v.e.fixedSize()
+		}
+		max := strconv.Itoa(int(v.s))
+		if v.t == TypeProgressiveList {
+			max = "math.MaxInt"
+		}
+		list := fmt.Sprintf("%s.List(%d, %s)", view, size, max)
+		if v.e.hasView() {
+			return v.e.obj + "ListView", fmt.Sprintf("return %sListView{%s}", v.e.obj, list)
+		}
+		return "ssz.ListView", "return " + list
+	}
+
+	// unions, optionals and references are returned as
+	// an untyped view that can be unmarshalled
+	return "ssz.View", "return " + view
+}
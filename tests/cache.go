@@ -0,0 +1,30 @@
+package tests
+
+import ssz "github.com/prysmaticlabs/fastssz"
+
+// CachedValidator is an element of the cached lists of CachedState
+type CachedValidator struct {
+	Pubkey           []byte `ssz-size:"48"`
+	EffectiveBalance uint64
+	Slashed          bool
+}
+
+// CachedCheckpoint is a container field of CachedState
+type CachedCheckpoint struct {
+	Epoch uint64
+	Root  [32]byte `ssz-size:"32"`
+}
+
+// CachedState caches the roots of its fields and the chunks of its lists
+type CachedState struct {
+	Slot        uint64
+	Checkpoint  *CachedCheckpoint
+	Validators  []*CachedValidator `ssz-max:"1099511627776"`
+	Balances    []uint64           `ssz-max:"1099511627776"`
+	RandaoMixes [][]byte           `ssz-size:"64,32"`
+	Slashings   []uint64           `ssz-size:"16"`
+	Roots       [][32]byte         `ssz-size:"?,32" ssz-max:"1024"`
+	Graffiti    []byte             `ssz-max:"64"`
+
+	cache ssz.HashCache
+}
@@ -0,0 +1,1397 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 1b03500138ecba3312bdbc66abd485423aed4590d41283d5a296c4b676eba0c7
+package tests
+
+import (
+	"bytes"
+	"io"
+	"unsafe"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+)
+
+// MarshalSSZ ssz marshals the CachedValidator object
+func (c *CachedValidator) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CachedValidator object to a target array
+func (c *CachedValidator) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Pubkey'
+	if size := len(c.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, c.Pubkey...)
+
+	// Field (1) 'EffectiveBalance'
+	dst = ssz.MarshalUint64(dst, c.EffectiveBalance)
+
+	// Field (2) 'Slashed'
+	dst = ssz.MarshalBool(dst, c.Slashed)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the CachedValidator object to a writer
+func (c *CachedValidator) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, c)
+}
+
+// MarshalSSZToEncoder ssz marshals the CachedValidator object to an encoder
+func (c *CachedValidator) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	// Field (0) 'Pubkey'
+	if size := len(c.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	enc.EncodeBytes(c.Pubkey)
+
+	// Field (1) 'EffectiveBalance'
+	enc.EncodeUint64(c.EffectiveBalance)
+
+	// Field (2) 'Slashed'
+	enc.EncodeBool(c.Slashed)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CachedValidator object
+func (c *CachedValidator) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the CachedValidator object within the limits of the decode state
+func (c *CachedValidator) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "CachedValidator", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 57 {
+		return ssz.NewDecodeError(ssz.ErrSize, "CachedValidator", 57, size)
+	}
+
+	// Field (0) 'Pubkey'
+	if cap(c.Pubkey) == 0 {
+		c.Pubkey = make([]byte, 0, len(buf[0:48]))
+	}
+	c.Pubkey = append(c.Pubkey, buf[0:48]...)
+
+	// Field (1) 'EffectiveBalance'
+	c.EffectiveBalance = ssz.UnmarshallUint64(buf[48:56])
+
+	// Field (2) 'Slashed'
+	c.Slashed, err = ssz.DecodeBool(buf[56:57])
+	if err != nil {
+		return ssz.WrapDecodeError(err, "CachedValidator", "Slashed", 56)
+	}
+
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a CachedValidator object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (c *CachedValidator) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 57 {
+		return ssz.NewDecodeError(ssz.ErrSize, "CachedValidator", 57, size)
+	}
+
+	// Field (2) 'Slashed'
+	if _, err = ssz.DecodeBool(buf[56:57]); err != nil {
+		return ssz.WrapDecodeError(err, "CachedValidator", "Slashed", 56)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the CachedValidator object from a reader with an encoded size of 'size' bytes
+func (c *CachedValidator) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, c)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the CachedValidator object from a decoder
+func (c *CachedValidator) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 57 {
+		return ssz.NewDecodeError(ssz.ErrSize, "CachedValidator", 57, dec.Size())
+	}
+	buf, err := dec.Read(57)
+	if err != nil {
+		return err
+	}
+	return c.UnmarshalSSZWithState(buf, dec.State())
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CachedValidator object
+func (c *CachedValidator) SizeSSZ() (size int) {
+	size = 57
+	return
+}
+
+// HashTreeRoot ssz hashes the CachedValidator object
+func (c *CachedValidator) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the CachedValidator object with a hasher
+func (c *CachedValidator) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	if size := len(c.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	hh.PutBytes(c.Pubkey)
+
+	// Field (1) 'EffectiveBalance'
+	hh.PutUint64(c.EffectiveBalance)
+
+	// Field (2) 'Slashed'
+	hh.PutBool(c.Slashed)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// Copy returns a deep copy of the CachedValidator object
+func (c *CachedValidator) Copy() *CachedValidator {
+	if c == nil {
+		return nil
+	}
+	cpy := new(CachedValidator)
+	*cpy = *c
+
+	// Field 'Pubkey'
+	cpy.Pubkey = append(cpy.Pubkey[:0:0], cpy.Pubkey...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the CachedValidator objects have the same SSZ encoding
+func (c *CachedValidator) EqualSSZ(other *CachedValidator) bool {
+	if c == nil {
+		c = new(CachedValidator)
+	}
+	if other == nil {
+		other = new(CachedValidator)
+	}
+
+	// Field 'Pubkey'
+	if !bytes.Equal(c.Pubkey, other.Pubkey) {
+		return false
+	}
+
+	// Field 'EffectiveBalance'
+	if c.EffectiveBalance != other.EffectiveBalance {
+		return false
+	}
+
+	// Field 'Slashed'
+	if c.Slashed != other.Slashed {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the CachedValidator objects
+func (c *CachedValidator) DiffSSZ(other *CachedValidator) []ssz.FieldDiff {
+	if c == nil {
+		c = new(CachedValidator)
+	}
+	if other == nil {
+		other = new(CachedValidator)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Pubkey'
+	if !bytes.Equal(c.Pubkey, other.Pubkey) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Pubkey", A: c.Pubkey, B: other.Pubkey})
+	}
+
+	// Field 'EffectiveBalance'
+	if c.EffectiveBalance != other.EffectiveBalance {
+		diffs = append(diffs, ssz.FieldDiff{Path: "EffectiveBalance", A: c.EffectiveBalance, B: other.EffectiveBalance})
+	}
+
+	// Field 'Slashed'
+	if c.Slashed != other.Slashed {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Slashed", A: c.Slashed, B: other.Slashed})
+	}
+
+	return diffs
+}
+
+// MarshalSSZ ssz marshals the CachedCheckpoint object
+func (c *CachedCheckpoint) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CachedCheckpoint object to a target array
+func (c *CachedCheckpoint) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Epoch'
+	dst = ssz.MarshalUint64(dst, c.Epoch)
+
+	// Field (1) 'Root'
+	dst = append(dst, c.Root[:]...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the CachedCheckpoint object to a writer
+func (c *CachedCheckpoint) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, c)
+}
+
+// MarshalSSZToEncoder ssz marshals the CachedCheckpoint object to an encoder
+func (c *CachedCheckpoint) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	// Field (0) 'Epoch'
+	enc.EncodeUint64(c.Epoch)
+
+	// Field (1) 'Root'
+	enc.EncodeBytes(c.Root[:])
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CachedCheckpoint object
+func (c *CachedCheckpoint) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the CachedCheckpoint object within the limits of the decode state
+func (c *CachedCheckpoint) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "CachedCheckpoint", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.NewDecodeError(ssz.ErrSize, "CachedCheckpoint", 40, size)
+	}
+
+	// Field (0) 'Epoch'
+	c.Epoch = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Root'
+	copy(c.Root[:], buf[8:40])
+
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a CachedCheckpoint object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (c *CachedCheckpoint) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.NewDecodeError(ssz.ErrSize, "CachedCheckpoint", 40, size)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the CachedCheckpoint object from a reader with an encoded size of 'size' bytes
+func (c *CachedCheckpoint) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, c)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the CachedCheckpoint object from a decoder
+func (c *CachedCheckpoint) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 40 {
+		return ssz.NewDecodeError(ssz.ErrSize, "CachedCheckpoint", 40, dec.Size())
+	}
+	buf, err := dec.Read(40)
+	if err != nil {
+		return err
+	}
+	return c.UnmarshalSSZWithState(buf, dec.State())
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CachedCheckpoint object
+func (c *CachedCheckpoint) SizeSSZ() (size int) {
+	size = 40
+	return
+}
+
+// HashTreeRoot ssz hashes the CachedCheckpoint object
+func (c *CachedCheckpoint) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the CachedCheckpoint object with a hasher
+func (c *CachedCheckpoint) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Epoch'
+	hh.PutUint64(c.Epoch)
+
+	// Field (1) 'Root'
+	hh.PutBytes(c.Root[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// Copy returns a deep copy of the CachedCheckpoint object
+func (c *CachedCheckpoint) Copy() *CachedCheckpoint {
+	if c == nil {
+		return nil
+	}
+	cpy := new(CachedCheckpoint)
+	*cpy = *c
+	return cpy
+}
+
+// EqualSSZ returns true if the CachedCheckpoint objects have the same SSZ encoding
+func (c *CachedCheckpoint) EqualSSZ(other *CachedCheckpoint) bool {
+	if c == nil {
+		c = new(CachedCheckpoint)
+	}
+	if other == nil {
+		other = new(CachedCheckpoint)
+	}
+
+	// Field 'Epoch'
+	if c.Epoch != other.Epoch {
+		return false
+	}
+
+	// Field 'Root'
+	if c.Root != other.Root {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the CachedCheckpoint objects
+func (c *CachedCheckpoint) DiffSSZ(other *CachedCheckpoint) []ssz.FieldDiff {
+	if c == nil {
+		c = new(CachedCheckpoint)
+	}
+	if other == nil {
+		other = new(CachedCheckpoint)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Epoch'
+	if c.Epoch != other.Epoch {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Epoch", A: c.Epoch, B: other.Epoch})
+	}
+
+	// Field 'Root'
+	if c.Root != other.Root {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Root", A: c.Root, B: other.Root})
+	}
+
+	return diffs
+}
+
+// MarshalSSZ ssz marshals the CachedState object
+func (c *CachedState) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CachedState object to a target array
+func (c *CachedState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(2240)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, c.Slot)
+
+	// Field (1) 'Checkpoint'
+	if c.Checkpoint == nil {
+		c.Checkpoint = new(CachedCheckpoint)
+	}
+	if dst, err = c.Checkpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (2) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Validators) * 57
+
+	// Offset (3) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Balances) * 8
+
+	// Field (4) 'RandaoMixes'
+	if size := len(c.RandaoMixes); size != 64 {
+		err = ssz.ErrVectorLengthFn("--.RandaoMixes", size, 64)
+		return
+	}
+	for ii := 0; ii < 64; ii++ {
+		if size := len(c.RandaoMixes[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.RandaoMixes[ii]", size, 32)
+			return
+		}
+		dst = append(dst, c.RandaoMixes[ii]...)
+	}
+
+	// Field (5) 'Slashings'
+	if size := len(c.Slashings); size != 16 {
+		err = ssz.ErrVectorLengthFn("--.Slashings", size, 16)
+		return
+	}
+	for ii := 0; ii < 16; ii++ {
+		dst = ssz.MarshalUint64(dst, c.Slashings[ii])
+	}
+
+	// Offset (6) 'Roots'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Roots) * 32
+
+	// Offset (7) 'Graffiti'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Graffiti)
+
+	// Field (2) 'Validators'
+	if size := len(c.Validators); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Validators", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(c.Validators); ii++ {
+		if dst, err = c.Validators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (3) 'Balances'
+	if size := len(c.Balances); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Balances", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(c.Balances); ii++ {
+		dst = ssz.MarshalUint64(dst, c.Balances[ii])
+	}
+
+	// Field (6) 'Roots'
+	if size := len(c.Roots); size > 1024 {
+		err = ssz.ErrListTooBigFn("--.Roots", size, 1024)
+		return
+	}
+	for ii := 0; ii < len(c.Roots); ii++ {
+		dst = append(dst, c.Roots[ii][:]...)
+	}
+
+	// Field (7) 'Graffiti'
+	if size := len(c.Graffiti); size > 64 {
+		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 64)
+		return
+	}
+	dst = append(dst, c.Graffiti...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the CachedState object to a writer
+func (c *CachedState) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, c)
+}
+
+// MarshalSSZToEncoder ssz marshals the CachedState object to an encoder
+func (c *CachedState) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(2240)
+
+	// Field (0) 'Slot'
+	enc.EncodeUint64(c.Slot)
+
+	// Field (1) 'Checkpoint'
+	if c.Checkpoint == nil {
+		c.Checkpoint = new(CachedCheckpoint)
+	}
+	if err = enc.EncodeObject(c.Checkpoint); err != nil {
+		return
+	}
+
+	// Offset (2) 'Validators'
+	enc.EncodeOffset(offset)
+	offset += len(c.Validators) * 57
+
+	// Offset (3) 'Balances'
+	enc.EncodeOffset(offset)
+	offset += len(c.Balances) * 8
+
+	// Field (4) 'RandaoMixes'
+	if size := len(c.RandaoMixes); size != 64 {
+		err = ssz.ErrVectorLengthFn("--.RandaoMixes", size, 64)
+		return
+	}
+	for ii := 0; ii < 64; ii++ {
+		if size := len(c.RandaoMixes[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.RandaoMixes[ii]", size, 32)
+			return
+		}
+		enc.EncodeBytes(c.RandaoMixes[ii])
+	}
+
+	// Field (5) 'Slashings'
+	if size := len(c.Slashings); size != 16 {
+		err = ssz.ErrVectorLengthFn("--.Slashings", size, 16)
+		return
+	}
+	for ii := 0; ii < 16; ii++ {
+		enc.EncodeUint64(c.Slashings[ii])
+	}
+
+	// Offset (6) 'Roots'
+	enc.EncodeOffset(offset)
+	offset += len(c.Roots) * 32
+
+	// Offset (7) 'Graffiti'
+	enc.EncodeOffset(offset)
+	offset += len(c.Graffiti)
+
+	// Field (2) 'Validators'
+	if size := len(c.Validators); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Validators", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(c.Validators); ii++ {
+		if err = enc.EncodeObject(c.Validators[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (3) 'Balances'
+	if size := len(c.Balances); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Balances", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(c.Balances); ii++ {
+		enc.EncodeUint64(c.Balances[ii])
+	}
+
+	// Field (6) 'Roots'
+	if size := len(c.Roots); size > 1024 {
+		err = ssz.ErrListTooBigFn("--.Roots", size, 1024)
+		return
+	}
+	for ii := 0; ii < len(c.Roots); ii++ {
+		enc.EncodeBytes(c.Roots[ii][:])
+	}
+
+	// Field (7) 'Graffiti'
+	if size := len(c.Graffiti); size > 64 {
+		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 64)
+		return
+	}
+	enc.EncodeBytes(c.Graffiti)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CachedState object
+func (c *CachedState) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the CachedState object within the limits of the decode state
+func (c *CachedState) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "CachedState", "", 0)
+	}
+	defer st.Leave()
+	c.cache.Reset()
+	size := uint64(len(buf))
+	if size < 2240 {
+		return ssz.NewDecodeError(ssz.ErrSize, "CachedState", 2240, size)
+	}
+
+	tail := buf
+	var o2, o3, o6, o7 uint64
+
+	// Field (0) 'Slot'
+	c.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Checkpoint'
+	if c.Checkpoint == nil {
+		c.Checkpoint = new(CachedCheckpoint)
+	}
+	if err = ssz.UnmarshalWithState(c.Checkpoint, buf[8:48], st); err != nil {
+		return ssz.WrapDecodeError(err, "CachedState", "Checkpoint", 8)
+	}
+
+	// Offset (2) 'Validators'
+	if o2 = ssz.ReadOffset(buf[48:52]); o2 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CachedState", "Validators", 48)
+	}
+
+	if o2 != 2240 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 2240, o2), "CachedState", "Validators", 48)
+	}
+
+	// Offset (3) 'Balances'
+	if o3 = ssz.ReadOffset(buf[52:56]); o3 > size || o2 > o3 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CachedState", "Balances", 52)
+	}
+
+	// Field (4) 'RandaoMixes'
+	if err = st.Alloc(64, 24); err != nil {
+		return ssz.WrapDecodeError(err, "CachedState", "RandaoMixes", 56)
+	}
+	c.RandaoMixes = make([][]byte, 64)
+	for ii := 0; ii < 64; ii++ {
+		if cap(c.RandaoMixes[ii]) == 0 {
+			c.RandaoMixes[ii] = make([]byte, 0, len(buf[56:2104][ii*32:(ii+1)*32]))
+		}
+		c.RandaoMixes[ii] = append(c.RandaoMixes[ii], buf[56:2104][ii*32:(ii+1)*32]...)
+	}
+
+	// Field (5) 'Slashings'
+	if c.Slashings, err = st.ExtendUint64(c.Slashings, 16); err != nil {
+		return ssz.WrapDecodeError(err, "CachedState", "Slashings", 2104)
+	}
+	for ii := 0; ii < 16; ii++ {
+		c.Slashings[ii] = ssz.UnmarshallUint64(buf[2104:2232][ii*8 : (ii+1)*8])
+	}
+
+	// Offset (6) 'Roots'
+	if o6 = ssz.ReadOffset(buf[2232:2236]); o6 > size || o3 > o6 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CachedState", "Roots", 2232)
+	}
+
+	// Offset (7) 'Graffiti'
+	if o7 = ssz.ReadOffset(buf[2236:2240]); o7 > size || o6 > o7 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CachedState", "Graffiti", 2236)
+	}
+
+	// Field (2) 'Validators'
+	{
+		buf = tail[o2:o3]
+		num, err := ssz.DivideInt2(len(buf), 57, 1099511627776)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "CachedState", "Validators", o2)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(CachedValidator{}))); err != nil {
+			return ssz.WrapDecodeError(err, "CachedState", "Validators", o2)
+		}
+		c.Validators = make([]*CachedValidator, num)
+		for ii := 0; ii < num; ii++ {
+			if c.Validators[ii] == nil {
+				c.Validators[ii] = new(CachedValidator)
+			}
+			if err = ssz.UnmarshalWithState(c.Validators[ii], buf[ii*57:(ii+1)*57], st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*57)), "CachedState", "Validators", o2)
+			}
+		}
+	}
+
+	// Field (3) 'Balances'
+	{
+		buf = tail[o3:o6]
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "CachedState", "Balances", o3)
+		}
+		if c.Balances, err = st.ExtendUint64(c.Balances, num); err != nil {
+			return ssz.WrapDecodeError(err, "CachedState", "Balances", o3)
+		}
+		for ii := 0; ii < num; ii++ {
+			c.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (6) 'Roots'
+	{
+		buf = tail[o6:o7]
+		num, err := ssz.DivideInt2(len(buf), 32, 1024)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "CachedState", "Roots", o6)
+		}
+		if err = st.Alloc(num, 32); err != nil {
+			return ssz.WrapDecodeError(err, "CachedState", "Roots", o6)
+		}
+		c.Roots = make([][32]byte, num)
+		for ii := 0; ii < num; ii++ {
+			copy(c.Roots[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+
+	// Field (7) 'Graffiti'
+	{
+		buf = tail[o7:]
+		if len(buf) > 64 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 64, uint64(len(buf))), "CachedState", "Graffiti", o7)
+		}
+		if cap(c.Graffiti) == 0 {
+			c.Graffiti = make([]byte, 0, len(buf))
+		}
+		c.Graffiti = append(c.Graffiti, buf...)
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a CachedState object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (c *CachedState) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 2240 {
+		return ssz.NewDecodeError(ssz.ErrSize, "CachedState", 2240, size)
+	}
+
+	tail := buf
+	var o2, o3, o6, o7 uint64
+
+	// Field (1) 'Checkpoint'
+	if err = (*CachedCheckpoint)(nil).ValidateSSZ(buf[8:48]); err != nil {
+		return ssz.WrapDecodeError(err, "CachedState", "Checkpoint", 8)
+	}
+
+	// Offset (2) 'Validators'
+	if o2 = ssz.ReadOffset(buf[48:52]); o2 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CachedState", "Validators", 48)
+	}
+
+	if o2 != 2240 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 2240, o2), "CachedState", "Validators", 48)
+	}
+
+	// Offset (3) 'Balances'
+	if o3 = ssz.ReadOffset(buf[52:56]); o3 > size || o2 > o3 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CachedState", "Balances", 52)
+	}
+
+	// Offset (6) 'Roots'
+	if o6 = ssz.ReadOffset(buf[2232:2236]); o6 > size || o3 > o6 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CachedState", "Roots", 2232)
+	}
+
+	// Offset (7) 'Graffiti'
+	if o7 = ssz.ReadOffset(buf[2236:2240]); o7 > size || o6 > o7 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CachedState", "Graffiti", 2236)
+	}
+
+	// Field (2) 'Validators'
+	{
+		buf = tail[o2:o3]
+		num, err := ssz.DivideInt2(len(buf), 57, 1099511627776)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "CachedState", "Validators", o2)
+		}
+
+		for ii := 0; ii < num; ii++ {
+			if err = (*CachedValidator)(nil).ValidateSSZ(buf[ii*57 : (ii+1)*57]); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*57)), "CachedState", "Validators", o2)
+			}
+		}
+	}
+
+	// Field (3) 'Balances'
+	{
+		buf = tail[o3:o6]
+		if _, err = ssz.DivideInt2(len(buf), 8, 1099511627776); err != nil {
+			return ssz.WrapDecodeError(err, "CachedState", "Balances", o3)
+		}
+	}
+
+	// Field (6) 'Roots'
+	{
+		buf = tail[o6:o7]
+		if _, err = ssz.DivideInt2(len(buf), 32, 1024); err != nil {
+			return ssz.WrapDecodeError(err, "CachedState", "Roots", o6)
+		}
+	}
+
+	// Field (7) 'Graffiti'
+	{
+		buf = tail[o7:]
+		if len(buf) > 64 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 64, uint64(len(buf))), "CachedState", "Graffiti", o7)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the CachedState object from a reader with an encoded size of 'size' bytes
+func (c *CachedState) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, c)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the CachedState object from a decoder
+func (c *CachedState) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	c.cache.Reset()
+	size := dec.Size()
+	if size < 2240 {
+		return ssz.NewDecodeError(ssz.ErrSize, "CachedState", 2240, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "CachedState", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(2240)
+	if err != nil {
+		return err
+	}
+	var o2, o3, o6, o7 uint64
+
+	// Field (0) 'Slot'
+	c.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Checkpoint'
+	if c.Checkpoint == nil {
+		c.Checkpoint = new(CachedCheckpoint)
+	}
+	if err = ssz.UnmarshalWithState(c.Checkpoint, buf[8:48], st); err != nil {
+		return ssz.WrapDecodeError(err, "CachedState", "Checkpoint", 8)
+	}
+
+	// Offset (2) 'Validators'
+	if o2 = ssz.ReadOffset(buf[48:52]); o2 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CachedState", "Validators", 48)
+	}
+
+	if o2 != 2240 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 2240, o2), "CachedState", "Validators", 48)
+	}
+
+	// Offset (3) 'Balances'
+	if o3 = ssz.ReadOffset(buf[52:56]); o3 > size || o2 > o3 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CachedState", "Balances", 52)
+	}
+
+	// Field (4) 'RandaoMixes'
+	if err = st.Alloc(64, 24); err != nil {
+		return ssz.WrapDecodeError(err, "CachedState", "RandaoMixes", 56)
+	}
+	c.RandaoMixes = make([][]byte, 64)
+	for ii := 0; ii < 64; ii++ {
+		if cap(c.RandaoMixes[ii]) == 0 {
+			c.RandaoMixes[ii] = make([]byte, 0, len(buf[56:2104][ii*32:(ii+1)*32]))
+		}
+		c.RandaoMixes[ii] = append(c.RandaoMixes[ii], buf[56:2104][ii*32:(ii+1)*32]...)
+	}
+
+	// Field (5) 'Slashings'
+	if c.Slashings, err = st.ExtendUint64(c.Slashings, 16); err != nil {
+		return ssz.WrapDecodeError(err, "CachedState", "Slashings", 2104)
+	}
+	for ii := 0; ii < 16; ii++ {
+		c.Slashings[ii] = ssz.UnmarshallUint64(buf[2104:2232][ii*8 : (ii+1)*8])
+	}
+
+	// Offset (6) 'Roots'
+	if o6 = ssz.ReadOffset(buf[2232:2236]); o6 > size || o3 > o6 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CachedState", "Roots", 2232)
+	}
+
+	// Offset (7) 'Graffiti'
+	if o7 = ssz.ReadOffset(buf[2236:2240]); o7 > size || o6 > o7 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CachedState", "Graffiti", 2236)
+	}
+
+	// Field (2) 'Validators'
+	{
+		num, err := ssz.DivideInt2(int(o3-o2), 57, 1099511627776)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "CachedState", "Validators", o2)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(CachedValidator{}))); err != nil {
+			return ssz.WrapDecodeError(err, "CachedState", "Validators", o2)
+		}
+		c.Validators = make([]*CachedValidator, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(57); err != nil {
+				return ssz.WrapDecodeError(err, "CachedState", "Validators", o2)
+			}
+			if c.Validators[ii] == nil {
+				c.Validators[ii] = new(CachedValidator)
+			}
+			if err = ssz.UnmarshalWithState(c.Validators[ii], buf, st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*57)), "CachedState", "Validators", o2)
+			}
+		}
+	}
+
+	// Field (3) 'Balances'
+	{
+		num, err := ssz.DivideInt2(int(o6-o3), 8, 1099511627776)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "CachedState", "Balances", o3)
+		}
+		if c.Balances, err = st.ExtendUint64(c.Balances, num); err != nil {
+			return ssz.WrapDecodeError(err, "CachedState", "Balances", o3)
+		}
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(8); err != nil {
+				return ssz.WrapDecodeError(err, "CachedState", "Balances", o3)
+			}
+			c.Balances[ii] = ssz.UnmarshallUint64(buf)
+		}
+	}
+
+	// Field (6) 'Roots'
+	{
+		num, err := ssz.DivideInt2(int(o7-o6), 32, 1024)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "CachedState", "Roots", o6)
+		}
+		if err = st.Alloc(num, 32); err != nil {
+			return ssz.WrapDecodeError(err, "CachedState", "Roots", o6)
+		}
+		c.Roots = make([][32]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(32); err != nil {
+				return ssz.WrapDecodeError(err, "CachedState", "Roots", o6)
+			}
+			copy(c.Roots[ii][:], buf)
+		}
+	}
+
+	// Field (7) 'Graffiti'
+	{
+		if size-o7 > 64 {
+			return ssz.WrapDecodeError(ssz.ErrBytesLength, "CachedState", "Graffiti", o7)
+		}
+		if buf, err = dec.Read(size - o7); err != nil {
+			return ssz.WrapDecodeError(err, "CachedState", "Graffiti", o7)
+		}
+		if cap(c.Graffiti) == 0 {
+			c.Graffiti = make([]byte, 0, len(buf))
+		}
+		c.Graffiti = append(c.Graffiti, buf...)
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CachedState object
+func (c *CachedState) SizeSSZ() (size int) {
+	size = 2240
+
+	// Field (2) 'Validators'
+	size += len(c.Validators) * 57
+
+	// Field (3) 'Balances'
+	size += len(c.Balances) * 8
+
+	// Field (6) 'Roots'
+	size += len(c.Roots) * 32
+
+	// Field (7) 'Graffiti'
+	size += len(c.Graffiti)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the CachedState object
+func (c *CachedState) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the CachedState object with a hasher. The roots of the fields
+// are cached, the fields changed since the last call must be marked with the Mark*Dirty
+// methods or set with the setters.
+func (c *CachedState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	c.cache.Prepare(hh, 8)
+
+	// Field (0) 'Slot'
+	if err = c.cache.Field(hh, 0, func(hh *ssz.Hasher) (err error) {
+		hh.PutUint64(c.Slot)
+		return
+	}); err != nil {
+		return
+	}
+
+	// Field (1) 'Checkpoint'
+	if err = c.cache.Field(hh, 1, func(hh *ssz.Hasher) (err error) {
+		if err = c.Checkpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+		return
+	}); err != nil {
+		return
+	}
+
+	// Field (2) 'Validators'
+	if size := len(c.Validators); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Validators", size, 1099511627776)
+		return
+	}
+	if err = c.cache.List(hh, 2, len(c.Validators), 1, 1099511627776, func(indx int, hh *ssz.Hasher) error {
+		return c.Validators[indx].HashTreeRootWith(hh)
+	}); err != nil {
+		return
+	}
+
+	// Field (3) 'Balances'
+	if size := len(c.Balances); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Balances", size, 1099511627776)
+		return
+	}
+	if err = c.cache.List(hh, 3, len(c.Balances), 4, 274877906944, func(indx int, hh *ssz.Hasher) error {
+		hh.AppendUint64(c.Balances[indx])
+		return nil
+	}); err != nil {
+		return
+	}
+
+	// Field (4) 'RandaoMixes'
+	if size := len(c.RandaoMixes); size != 64 {
+		err = ssz.ErrVectorLengthFn("--.RandaoMixes", size, 64)
+		return
+	}
+	if err = c.cache.Vector(hh, 4, len(c.RandaoMixes), 1, func(indx int, hh *ssz.Hasher) error {
+		if len(c.RandaoMixes[indx]) != 32 {
+			return ssz.ErrBytesLength
+		}
+		hh.Append(c.RandaoMixes[indx])
+		return nil
+	}); err != nil {
+		return
+	}
+
+	// Field (5) 'Slashings'
+	if size := len(c.Slashings); size != 16 {
+		err = ssz.ErrVectorLengthFn("--.Slashings", size, 16)
+		return
+	}
+	if err = c.cache.Vector(hh, 5, len(c.Slashings), 4, func(indx int, hh *ssz.Hasher) error {
+		hh.AppendUint64(c.Slashings[indx])
+		return nil
+	}); err != nil {
+		return
+	}
+
+	// Field (6) 'Roots'
+	if size := len(c.Roots); size > 1024 {
+		err = ssz.ErrListTooBigFn("--.Roots", size, 1024)
+		return
+	}
+	if err = c.cache.List(hh, 6, len(c.Roots), 1, 1024, func(indx int, hh *ssz.Hasher) error {
+		hh.Append(c.Roots[indx][:])
+		return nil
+	}); err != nil {
+		return
+	}
+
+	// Field (7) 'Graffiti'
+	if err = c.cache.Field(hh, 7, func(hh *ssz.Hasher) (err error) {
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(c.Graffiti))
+			if byteLen > 64 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			hh.PutBytes(c.Graffiti)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (64+31)/32)
+		}
+		return
+	}); err != nil {
+		return
+	}
+
+	c.cache.Root(hh)
+	return
+}
+
+// SetSlot sets the field 'Slot' and marks it as changed
+func (c *CachedState) SetSlot(val uint64) {
+	c.Slot = val
+	c.cache.MarkDirty(0)
+}
+
+// MarkSlotDirty marks the field 'Slot' as changed
+func (c *CachedState) MarkSlotDirty() {
+	c.cache.MarkDirty(0)
+}
+
+// SetCheckpoint sets the field 'Checkpoint' and marks it as changed
+func (c *CachedState) SetCheckpoint(val *CachedCheckpoint) {
+	c.Checkpoint = val
+	c.cache.MarkDirty(1)
+}
+
+// MarkCheckpointDirty marks the field 'Checkpoint' as changed
+func (c *CachedState) MarkCheckpointDirty() {
+	c.cache.MarkDirty(1)
+}
+
+// SetValidators sets the field 'Validators' and marks it as changed
+func (c *CachedState) SetValidators(val []*CachedValidator) {
+	c.Validators = val
+	c.cache.MarkDirty(2)
+}
+
+// MarkValidatorsDirty marks the field 'Validators' as changed. 'indices' are the
+// changed elements, all of them if there are none.
+func (c *CachedState) MarkValidatorsDirty(indices ...int) {
+	c.cache.MarkDirty(2, indices...)
+}
+
+// SetBalances sets the field 'Balances' and marks it as changed
+func (c *CachedState) SetBalances(val []uint64) {
+	c.Balances = val
+	c.cache.MarkDirty(3)
+}
+
+// MarkBalancesDirty marks the field 'Balances' as changed. 'indices' are the
+// changed elements, all of them if there are none.
+func (c *CachedState) MarkBalancesDirty(indices ...int) {
+	c.cache.MarkDirty(3, indices...)
+}
+
+// SetRandaoMixes sets the field 'RandaoMixes' and marks it as changed
+func (c *CachedState) SetRandaoMixes(val [][]byte) {
+	c.RandaoMixes = val
+	c.cache.MarkDirty(4)
+}
+
+// MarkRandaoMixesDirty marks the field 'RandaoMixes' as changed. 'indices' are the
+// changed elements, all of them if there are none.
+func (c *CachedState) MarkRandaoMixesDirty(indices ...int) {
+	c.cache.MarkDirty(4, indices...)
+}
+
+// SetSlashings sets the field 'Slashings' and marks it as changed
+func (c *CachedState) SetSlashings(val []uint64) {
+	c.Slashings = val
+	c.cache.MarkDirty(5)
+}
+
+// MarkSlashingsDirty marks the field 'Slashings' as changed. 'indices' are the
+// changed elements, all of them if there are none.
+func (c *CachedState) MarkSlashingsDirty(indices ...int) {
+	c.cache.MarkDirty(5, indices...)
+}
+
+// SetRoots sets the field 'Roots' and marks it as changed
+func (c *CachedState) SetRoots(val [][32]byte) {
+	c.Roots = val
+	c.cache.MarkDirty(6)
+}
+
+// MarkRootsDirty marks the field 'Roots' as changed. 'indices' are the
+// changed elements, all of them if there are none.
+func (c *CachedState) MarkRootsDirty(indices ...int) {
+	c.cache.MarkDirty(6, indices...)
+}
+
+// SetGraffiti sets the field 'Graffiti' and marks it as changed
+func (c *CachedState) SetGraffiti(val []byte) {
+	c.Graffiti = val
+	c.cache.MarkDirty(7)
+}
+
+// MarkGraffitiDirty marks the field 'Graffiti' as changed
+func (c *CachedState) MarkGraffitiDirty() {
+	c.cache.MarkDirty(7)
+}
+
+// Copy returns a deep copy of the CachedState object
+func (c *CachedState) Copy() *CachedState {
+	if c == nil {
+		return nil
+	}
+	cpy := new(CachedState)
+	*cpy = *c
+
+	// Field 'Checkpoint'
+	cpy.Checkpoint = cpy.Checkpoint.Copy()
+
+	// Field 'Validators'
+	cpy.Validators = append(cpy.Validators[:0:0], cpy.Validators...)
+	for i0 := range cpy.Validators {
+		cpy.Validators[i0] = cpy.Validators[i0].Copy()
+	}
+
+	// Field 'Balances'
+	cpy.Balances = append(cpy.Balances[:0:0], cpy.Balances...)
+
+	// Field 'RandaoMixes'
+	cpy.RandaoMixes = append(cpy.RandaoMixes[:0:0], cpy.RandaoMixes...)
+	for i0 := range cpy.RandaoMixes {
+		cpy.RandaoMixes[i0] = append(cpy.RandaoMixes[i0][:0:0], cpy.RandaoMixes[i0]...)
+	}
+
+	// Field 'Slashings'
+	cpy.Slashings = append(cpy.Slashings[:0:0], cpy.Slashings...)
+
+	// Field 'Roots'
+	cpy.Roots = append(cpy.Roots[:0:0], cpy.Roots...)
+
+	// Field 'Graffiti'
+	cpy.Graffiti = append(cpy.Graffiti[:0:0], cpy.Graffiti...)
+
+	// the cache is not shared with the copy
+	cpy.cache = c.cache.Copy()
+
+	return cpy
+}
+
+// EqualSSZ returns true if the CachedState objects have the same SSZ encoding
+func (c *CachedState) EqualSSZ(other *CachedState) bool {
+	if c == nil {
+		c = new(CachedState)
+	}
+	if other == nil {
+		other = new(CachedState)
+	}
+
+	// Field 'Slot'
+	if c.Slot != other.Slot {
+		return false
+	}
+
+	// Field 'Checkpoint'
+	if !c.Checkpoint.EqualSSZ(other.Checkpoint) {
+		return false
+	}
+
+	// Field 'Validators'
+	if len(c.Validators) != len(other.Validators) {
+		return false
+	} else {
+		for i0 := range c.Validators {
+			if !c.Validators[i0].EqualSSZ(other.Validators[i0]) {
+				return false
+			}
+		}
+	}
+
+	// Field 'Balances'
+	if len(c.Balances) != len(other.Balances) {
+		return false
+	} else {
+		for i0 := range c.Balances {
+			if c.Balances[i0] != other.Balances[i0] {
+				return false
+			}
+		}
+	}
+
+	// Field 'RandaoMixes'
+	if len(c.RandaoMixes) != len(other.RandaoMixes) {
+		return false
+	} else {
+		for i0 := range c.RandaoMixes {
+			if !bytes.Equal(c.RandaoMixes[i0], other.RandaoMixes[i0]) {
+				return false
+			}
+		}
+	}
+
+	// Field 'Slashings'
+	if len(c.Slashings) != len(other.Slashings) {
+		return false
+	} else {
+		for i0 := range c.Slashings {
+			if c.Slashings[i0] != other.Slashings[i0] {
+				return false
+			}
+		}
+	}
+
+	// Field 'Roots'
+	if len(c.Roots) != len(other.Roots) {
+		return false
+	} else {
+		for i0 := range c.Roots {
+			if c.Roots[i0] != other.Roots[i0] {
+				return false
+			}
+		}
+	}
+
+	// Field 'Graffiti'
+	if !bytes.Equal(c.Graffiti, other.Graffiti) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the CachedState objects
+func (c *CachedState) DiffSSZ(other *CachedState) []ssz.FieldDiff {
+	if c == nil {
+		c = new(CachedState)
+	}
+	if other == nil {
+		other = new(CachedState)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Slot'
+	if c.Slot != other.Slot {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Slot", A: c.Slot, B: other.Slot})
+	}
+
+	// Field 'Checkpoint'
+	diffs = ssz.AppendDiffs(diffs, "Checkpoint", c.Checkpoint.DiffSSZ(other.Checkpoint))
+
+	// Field 'Validators'
+	if len(c.Validators) != len(other.Validators) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Validators", A: c.Validators, B: other.Validators})
+	} else {
+		for i0 := range c.Validators {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("Validators", i0), c.Validators[i0].DiffSSZ(other.Validators[i0]))
+		}
+	}
+
+	// Field 'Balances'
+	if len(c.Balances) != len(other.Balances) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Balances", A: c.Balances, B: other.Balances})
+	} else {
+		for i0 := range c.Balances {
+			if c.Balances[i0] != other.Balances[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("Balances", i0), A: c.Balances[i0], B: other.Balances[i0]})
+			}
+		}
+	}
+
+	// Field 'RandaoMixes'
+	if len(c.RandaoMixes) != len(other.RandaoMixes) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "RandaoMixes", A: c.RandaoMixes, B: other.RandaoMixes})
+	} else {
+		for i0 := range c.RandaoMixes {
+			if !bytes.Equal(c.RandaoMixes[i0], other.RandaoMixes[i0]) {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("RandaoMixes", i0), A: c.RandaoMixes[i0], B: other.RandaoMixes[i0]})
+			}
+		}
+	}
+
+	// Field 'Slashings'
+	if len(c.Slashings) != len(other.Slashings) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Slashings", A: c.Slashings, B: other.Slashings})
+	} else {
+		for i0 := range c.Slashings {
+			if c.Slashings[i0] != other.Slashings[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("Slashings", i0), A: c.Slashings[i0], B: other.Slashings[i0]})
+			}
+		}
+	}
+
+	// Field 'Roots'
+	if len(c.Roots) != len(other.Roots) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Roots", A: c.Roots, B: other.Roots})
+	} else {
+		for i0 := range c.Roots {
+			if c.Roots[i0] != other.Roots[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("Roots", i0), A: c.Roots[i0], B: other.Roots[i0]})
+			}
+		}
+	}
+
+	// Field 'Graffiti'
+	if !bytes.Equal(c.Graffiti, other.Graffiti) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Graffiti", A: c.Graffiti, B: other.Graffiti})
+	}
+
+	return diffs
+}
@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"testing"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+)
+
+// countingHash counts the pairs of chunks hashed with SHA-256
+type countingHash struct {
+	count *int
+}
+
+func (c countingHash) Hash(a, b [32]byte) [32]byte {
+	*c.count++
+	return ssz.SHA256.Hash(a, b)
+}
+
+func (c countingHash) HashPairs(digests, chunks [][32]byte) error {
+	*c.count += len(chunks) / 2
+	return ssz.SHA256.HashPairs(digests, chunks)
+}
+
+// uncachedState has the fields of CachedState and it is hashed with reflection
+type uncachedState struct {
+	Slot        uint64
+	Checkpoint  *CachedCheckpoint
+	Validators  []*CachedValidator `ssz-max:"1099511627776"`
+	Balances    []uint64           `ssz-max:"1099511627776"`
+	RandaoMixes [][]byte           `ssz-size:"64,32"`
+	Slashings   []uint64           `ssz-size:"16"`
+	Roots       [][32]byte         `ssz-size:"?,32" ssz-max:"1024"`
+	Graffiti    []byte             `ssz-max:"64"`
+}
+
+func TestHashCache(t *testing.T) {
+	state := &CachedState{
+		Checkpoint:  &CachedCheckpoint{Epoch: 1},
+		RandaoMixes: make([][]byte, 64),
+		Slashings:   make([]uint64, 16),
+		Graffiti:    []byte{1, 2},
+	}
+	for i := range state.RandaoMixes {
+		state.RandaoMixes[i] = make([]byte, 32)
+	}
+	for i := 0; i < 1000; i++ {
+		state.Validators = append(state.Validators, &CachedValidator{Pubkey: make([]byte, 48), EffectiveBalance: uint64(i)})
+		state.Balances = append(state.Balances, uint64(i))
+	}
+
+	var count int
+	hh := ssz.NewHasherWithHashFunction(countingHash{&count})
+
+	check := func(name string, maxHashes int) {
+		t.Helper()
+		count = 0
+		hh.Reset()
+		if err := state.HashTreeRootWith(hh); err != nil {
+			t.Fatal(err)
+		}
+		root, err := hh.HashRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, err := ssz.HashTreeRoot(&uncachedState{
+			state.Slot, state.Checkpoint, state.Validators, state.Balances,
+			state.RandaoMixes, state.Slashings, state.Roots, state.Graffiti,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != expected {
+			t.Fatalf("%s: bad root, expected %x but found %x", name, expected, root)
+		}
+		if maxHashes >= 0 && count > maxHashes {
+			t.Fatalf("%s: expected at most %d hashes but found %d", name, maxHashes, count)
+		}
+	}
+
+	check("full", -1)
+	check("clean", 0)
+
+	// one balance is the path of its chunk and the mix-in, up to the root
+	state.Balances[500] = 1
+	state.MarkBalancesDirty(500)
+	check("balance", 38+1+3)
+
+	// one validator is its root and its path
+	state.Validators[10].EffectiveBalance = 7
+	state.MarkValidatorsDirty(10)
+	check("validator", 4+40+1+3)
+
+	// appended and removed elements
+	state.Validators = append(state.Validators, &CachedValidator{Pubkey: make([]byte, 48)})
+	state.Balances = state.Balances[:997]
+	state.MarkValidatorsDirty(1000)
+	state.MarkBalancesDirty()
+	check("resize", -1)
+	state.Roots = [][32]byte{{1}, {2}, {3}}
+	state.MarkRootsDirty(0, 1, 2)
+	check("roots", -1)
+	state.Roots = state.Roots[:1]
+	state.MarkRootsDirty()
+	check("roots removed", -1)
+
+	// the setters mark the fields
+	state.SetSlot(5)
+	state.SetCheckpoint(&CachedCheckpoint{Epoch: 2})
+	state.SetGraffiti(nil)
+	check("setters", -1)
+
+	// the copy does not share the cache
+	cpy := state.Copy()
+	cpy.Slashings[3] = 4
+	cpy.MarkSlashingsDirty(3)
+	check("copy", 0)
+
+	// unmarshal resets the cache
+	buf, err := cpy.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := state.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	check("unmarshal", -1)
+
+	// invalid fields are not cached
+	state.RandaoMixes[3] = []byte{1}
+	state.MarkRandaoMixesDirty(3)
+	if err := state.HashTreeRootWith(hh); err != ssz.ErrBytesLength {
+		t.Fatalf("expected an error but found %v", err)
+	}
+	state.RandaoMixes[3] = make([]byte, 32)
+	check("fixed", -1)
+}
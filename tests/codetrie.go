@@ -20,3 +20,8 @@ type CodeTrieBig struct {
 	Metadata *Metadata
 	Chunks   []*Chunk `ssz-max:"1024"`
 }
+
+type CodeTrieProgressive struct {
+	Metadata *Metadata
+	Chunks   []*Chunk `ssz:"progressive-list"`
+}
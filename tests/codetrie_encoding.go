@@ -1,7 +1,14 @@
 // Code generated by fastssz. DO NOT EDIT.
+// Hash: 670b9fe5f9c909d878d1a8dc8cae4ccb070969919279db9c88b00faf574a453b
 package tests
 
-import ssz "github.com/prysmaticlabs/fastssz"
+import (
+	"bytes"
+	"io"
+	"unsafe"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+)
 
 // MarshalSSZ ssz marshals the Metadata object
 func (m *Metadata) MarshalSSZ() ([]byte, error) {
@@ -17,7 +24,7 @@ func (m *Metadata) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (1) 'CodeHash'
 	if size := len(m.CodeHash); size != 32 {
-		err = ssz.ErrBytesLengthFn("Metadata.CodeHash", size, 32)
+		err = ssz.ErrBytesLengthFn("--.CodeHash", size, 32)
 		return
 	}
 	dst = append(dst, m.CodeHash...)
@@ -28,12 +35,45 @@ func (m *Metadata) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Metadata object to a writer
+func (m *Metadata) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, m)
+}
+
+// MarshalSSZToEncoder ssz marshals the Metadata object to an encoder
+func (m *Metadata) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	// Field (0) 'Version'
+	enc.EncodeUint8(m.Version)
+
+	// Field (1) 'CodeHash'
+	if size := len(m.CodeHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.CodeHash", size, 32)
+		return
+	}
+	enc.EncodeBytes(m.CodeHash)
+
+	// Field (2) 'CodeLength'
+	enc.EncodeUint16(m.CodeLength)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Metadata object
 func (m *Metadata) UnmarshalSSZ(buf []byte) error {
+	return m.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the Metadata object within the limits of the decode state
+func (m *Metadata) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
 	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "Metadata", "", 0)
+	}
+	defer st.Leave()
 	size := uint64(len(buf))
 	if size != 35 {
-		return ssz.ErrSize
+		return ssz.NewDecodeError(ssz.ErrSize, "Metadata", 35, size)
 	}
 
 	// Field (0) 'Version'
@@ -51,6 +91,35 @@ func (m *Metadata) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// ValidateSSZ checks that buf is a valid SSZ encoding of a Metadata object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (m *Metadata) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 35 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Metadata", 35, size)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the Metadata object from a reader with an encoded size of 'size' bytes
+func (m *Metadata) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, m)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the Metadata object from a decoder
+func (m *Metadata) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 35 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Metadata", 35, dec.Size())
+	}
+	buf, err := dec.Read(35)
+	if err != nil {
+		return err
+	}
+	return m.UnmarshalSSZWithState(buf, dec.State())
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the Metadata object
 func (m *Metadata) SizeSSZ() (size int) {
 	size = 35
@@ -71,7 +140,7 @@ func (m *Metadata) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 
 	// Field (1) 'CodeHash'
 	if size := len(m.CodeHash); size != 32 {
-		err = ssz.ErrBytesLengthFn("Metadata.CodeHash", size, 32)
+		err = ssz.ErrBytesLengthFn("--.CodeHash", size, 32)
 		return
 	}
 	hh.PutBytes(m.CodeHash)
@@ -91,8 +160,8 @@ func (m *Metadata) GetTreeWithWrapper(w *ssz.Wrapper) (err error) {
 	w.AddUint8(m.Version)
 
 	// Field (1) 'CodeHash'
-	if len(m.CodeHash) != 32 {
-		err = ssz.ErrBytesLength
+	if size := len(m.CodeHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.CodeHash", size, 32)
 		return
 	}
 	w.AddBytes(m.CodeHash)
@@ -116,6 +185,75 @@ func (m *Metadata) GetTree() (*ssz.Node, error) {
 	return w.Node(), nil
 }
 
+// Copy returns a deep copy of the Metadata object
+func (m *Metadata) Copy() *Metadata {
+	if m == nil {
+		return nil
+	}
+	cpy := new(Metadata)
+	*cpy = *m
+
+	// Field 'CodeHash'
+	cpy.CodeHash = append(cpy.CodeHash[:0:0], cpy.CodeHash...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the Metadata objects have the same SSZ encoding
+func (m *Metadata) EqualSSZ(other *Metadata) bool {
+	if m == nil {
+		m = new(Metadata)
+	}
+	if other == nil {
+		other = new(Metadata)
+	}
+
+	// Field 'Version'
+	if m.Version != other.Version {
+		return false
+	}
+
+	// Field 'CodeHash'
+	if !bytes.Equal(m.CodeHash, other.CodeHash) {
+		return false
+	}
+
+	// Field 'CodeLength'
+	if m.CodeLength != other.CodeLength {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the Metadata objects
+func (m *Metadata) DiffSSZ(other *Metadata) []ssz.FieldDiff {
+	if m == nil {
+		m = new(Metadata)
+	}
+	if other == nil {
+		other = new(Metadata)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Version'
+	if m.Version != other.Version {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Version", A: m.Version, B: other.Version})
+	}
+
+	// Field 'CodeHash'
+	if !bytes.Equal(m.CodeHash, other.CodeHash) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "CodeHash", A: m.CodeHash, B: other.CodeHash})
+	}
+
+	// Field 'CodeLength'
+	if m.CodeLength != other.CodeLength {
+		diffs = append(diffs, ssz.FieldDiff{Path: "CodeLength", A: m.CodeLength, B: other.CodeLength})
+	}
+
+	return diffs
+}
+
 // MarshalSSZ ssz marshals the Chunk object
 func (c *Chunk) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -130,7 +268,7 @@ func (c *Chunk) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (1) 'Code'
 	if size := len(c.Code); size != 32 {
-		err = ssz.ErrBytesLengthFn("Chunk.Code", size, 32)
+		err = ssz.ErrBytesLengthFn("--.Code", size, 32)
 		return
 	}
 	dst = append(dst, c.Code...)
@@ -138,12 +276,42 @@ func (c *Chunk) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Chunk object to a writer
+func (c *Chunk) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, c)
+}
+
+// MarshalSSZToEncoder ssz marshals the Chunk object to an encoder
+func (c *Chunk) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	// Field (0) 'FIO'
+	enc.EncodeUint8(c.FIO)
+
+	// Field (1) 'Code'
+	if size := len(c.Code); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Code", size, 32)
+		return
+	}
+	enc.EncodeBytes(c.Code)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Chunk object
 func (c *Chunk) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the Chunk object within the limits of the decode state
+func (c *Chunk) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
 	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "Chunk", "", 0)
+	}
+	defer st.Leave()
 	size := uint64(len(buf))
 	if size != 33 {
-		return ssz.ErrSize
+		return ssz.NewDecodeError(ssz.ErrSize, "Chunk", 33, size)
 	}
 
 	// Field (0) 'FIO'
@@ -158,6 +326,35 @@ func (c *Chunk) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
+// ValidateSSZ checks that buf is a valid SSZ encoding of a Chunk object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (c *Chunk) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 33 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Chunk", 33, size)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the Chunk object from a reader with an encoded size of 'size' bytes
+func (c *Chunk) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, c)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the Chunk object from a decoder
+func (c *Chunk) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 33 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Chunk", 33, dec.Size())
+	}
+	buf, err := dec.Read(33)
+	if err != nil {
+		return err
+	}
+	return c.UnmarshalSSZWithState(buf, dec.State())
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the Chunk object
 func (c *Chunk) SizeSSZ() (size int) {
 	size = 33
@@ -178,7 +375,7 @@ func (c *Chunk) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 
 	// Field (1) 'Code'
 	if size := len(c.Code); size != 32 {
-		err = ssz.ErrBytesLengthFn("Chunk.Code", size, 32)
+		err = ssz.ErrBytesLengthFn("--.Code", size, 32)
 		return
 	}
 	hh.PutBytes(c.Code)
@@ -195,8 +392,8 @@ func (c *Chunk) GetTreeWithWrapper(w *ssz.Wrapper) (err error) {
 	w.AddUint8(c.FIO)
 
 	// Field (1) 'Code'
-	if len(c.Code) != 32 {
-		err = ssz.ErrBytesLength
+	if size := len(c.Code); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Code", size, 32)
 		return
 	}
 	w.AddBytes(c.Code)
@@ -213,6 +410,65 @@ func (c *Chunk) GetTree() (*ssz.Node, error) {
 	return w.Node(), nil
 }
 
+// Copy returns a deep copy of the Chunk object
+func (c *Chunk) Copy() *Chunk {
+	if c == nil {
+		return nil
+	}
+	cpy := new(Chunk)
+	*cpy = *c
+
+	// Field 'Code'
+	cpy.Code = append(cpy.Code[:0:0], cpy.Code...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the Chunk objects have the same SSZ encoding
+func (c *Chunk) EqualSSZ(other *Chunk) bool {
+	if c == nil {
+		c = new(Chunk)
+	}
+	if other == nil {
+		other = new(Chunk)
+	}
+
+	// Field 'FIO'
+	if c.FIO != other.FIO {
+		return false
+	}
+
+	// Field 'Code'
+	if !bytes.Equal(c.Code, other.Code) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the Chunk objects
+func (c *Chunk) DiffSSZ(other *Chunk) []ssz.FieldDiff {
+	if c == nil {
+		c = new(Chunk)
+	}
+	if other == nil {
+		other = new(Chunk)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'FIO'
+	if c.FIO != other.FIO {
+		diffs = append(diffs, ssz.FieldDiff{Path: "FIO", A: c.FIO, B: other.FIO})
+	}
+
+	// Field 'Code'
+	if !bytes.Equal(c.Code, other.Code) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Code", A: c.Code, B: other.Code})
+	}
+
+	return diffs
+}
+
 // MarshalSSZ ssz marshals the CodeTrieSmall object
 func (c *CodeTrieSmall) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -237,7 +493,7 @@ func (c *CodeTrieSmall) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (1) 'Chunks'
 	if size := len(c.Chunks); size > 4 {
-		err = ssz.ErrListTooBigFn("CodeTrieSmall.Chunks", size, 4)
+		err = ssz.ErrListTooBigFn("--.Chunks", size, 4)
 		return
 	}
 	for ii := 0; ii < len(c.Chunks); ii++ {
@@ -249,12 +505,56 @@ func (c *CodeTrieSmall) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the CodeTrieSmall object to a writer
+func (c *CodeTrieSmall) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, c)
+}
+
+// MarshalSSZToEncoder ssz marshals the CodeTrieSmall object to an encoder
+func (c *CodeTrieSmall) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(39)
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if err = enc.EncodeObject(c.Metadata); err != nil {
+		return
+	}
+
+	// Offset (1) 'Chunks'
+	enc.EncodeOffset(offset)
+	offset += len(c.Chunks) * 33
+
+	// Field (1) 'Chunks'
+	if size := len(c.Chunks); size > 4 {
+		err = ssz.ErrListTooBigFn("--.Chunks", size, 4)
+		return
+	}
+	for ii := 0; ii < len(c.Chunks); ii++ {
+		if err = enc.EncodeObject(c.Chunks[ii]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the CodeTrieSmall object
 func (c *CodeTrieSmall) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the CodeTrieSmall object within the limits of the decode state
+func (c *CodeTrieSmall) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
 	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "CodeTrieSmall", "", 0)
+	}
+	defer st.Leave()
 	size := uint64(len(buf))
 	if size < 39 {
-		return ssz.ErrSize
+		return ssz.NewDecodeError(ssz.ErrSize, "CodeTrieSmall", 39, size)
 	}
 
 	tail := buf
@@ -264,13 +564,17 @@ func (c *CodeTrieSmall) UnmarshalSSZ(buf []byte) error {
 	if c.Metadata == nil {
 		c.Metadata = new(Metadata)
 	}
-	if err = c.Metadata.UnmarshalSSZ(buf[0:35]); err != nil {
-		return err
+	if err = ssz.UnmarshalWithState(c.Metadata, buf[0:35], st); err != nil {
+		return ssz.WrapDecodeError(err, "CodeTrieSmall", "Metadata", 0)
 	}
 
 	// Offset (1) 'Chunks'
 	if o1 = ssz.ReadOffset(buf[35:39]); o1 > size {
-		return ssz.ErrOffset
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CodeTrieSmall", "Chunks", 35)
+	}
+
+	if o1 != 39 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 39, o1), "CodeTrieSmall", "Chunks", 35)
 	}
 
 	// Field (1) 'Chunks'
@@ -278,21 +582,131 @@ func (c *CodeTrieSmall) UnmarshalSSZ(buf []byte) error {
 		buf = tail[o1:]
 		num, err := ssz.DivideInt2(len(buf), 33, 4)
 		if err != nil {
-			return err
+			return ssz.WrapDecodeError(err, "CodeTrieSmall", "Chunks", o1)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Chunk{}))); err != nil {
+			return ssz.WrapDecodeError(err, "CodeTrieSmall", "Chunks", o1)
 		}
 		c.Chunks = make([]*Chunk, num)
 		for ii := 0; ii < num; ii++ {
 			if c.Chunks[ii] == nil {
 				c.Chunks[ii] = new(Chunk)
 			}
-			if err = c.Chunks[ii].UnmarshalSSZ(buf[ii*33 : (ii+1)*33]); err != nil {
-				return err
+			if err = ssz.UnmarshalWithState(c.Chunks[ii], buf[ii*33:(ii+1)*33], st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*33)), "CodeTrieSmall", "Chunks", o1)
+			}
+		}
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a CodeTrieSmall object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (c *CodeTrieSmall) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 39 {
+		return ssz.NewDecodeError(ssz.ErrSize, "CodeTrieSmall", 39, size)
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Metadata'
+	if err = (*Metadata)(nil).ValidateSSZ(buf[0:35]); err != nil {
+		return ssz.WrapDecodeError(err, "CodeTrieSmall", "Metadata", 0)
+	}
+
+	// Offset (1) 'Chunks'
+	if o1 = ssz.ReadOffset(buf[35:39]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CodeTrieSmall", "Chunks", 35)
+	}
+
+	if o1 != 39 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 39, o1), "CodeTrieSmall", "Chunks", 35)
+	}
+
+	// Field (1) 'Chunks'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 33, 4)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "CodeTrieSmall", "Chunks", o1)
+		}
+
+		for ii := 0; ii < num; ii++ {
+			if err = (*Chunk)(nil).ValidateSSZ(buf[ii*33 : (ii+1)*33]); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*33)), "CodeTrieSmall", "Chunks", o1)
 			}
 		}
 	}
 	return err
 }
 
+// UnmarshalSSZFromReader ssz unmarshals the CodeTrieSmall object from a reader with an encoded size of 'size' bytes
+func (c *CodeTrieSmall) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, c)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the CodeTrieSmall object from a decoder
+func (c *CodeTrieSmall) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 39 {
+		return ssz.NewDecodeError(ssz.ErrSize, "CodeTrieSmall", 39, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "CodeTrieSmall", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(39)
+	if err != nil {
+		return err
+	}
+	var o1 uint64
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if err = ssz.UnmarshalWithState(c.Metadata, buf[0:35], st); err != nil {
+		return ssz.WrapDecodeError(err, "CodeTrieSmall", "Metadata", 0)
+	}
+
+	// Offset (1) 'Chunks'
+	if o1 = ssz.ReadOffset(buf[35:39]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CodeTrieSmall", "Chunks", 35)
+	}
+
+	if o1 != 39 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 39, o1), "CodeTrieSmall", "Chunks", 35)
+	}
+
+	// Field (1) 'Chunks'
+	{
+		num, err := ssz.DivideInt2(int(size-o1), 33, 4)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "CodeTrieSmall", "Chunks", o1)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Chunk{}))); err != nil {
+			return ssz.WrapDecodeError(err, "CodeTrieSmall", "Chunks", o1)
+		}
+		c.Chunks = make([]*Chunk, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(33); err != nil {
+				return ssz.WrapDecodeError(err, "CodeTrieSmall", "Chunks", o1)
+			}
+			if c.Chunks[ii] == nil {
+				c.Chunks[ii] = new(Chunk)
+			}
+			if err = ssz.UnmarshalWithState(c.Chunks[ii], buf, st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*33)), "CodeTrieSmall", "Chunks", o1)
+			}
+		}
+	}
+	return nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the CodeTrieSmall object
 func (c *CodeTrieSmall) SizeSSZ() (size int) {
 	size = 39
@@ -325,10 +739,10 @@ func (c *CodeTrieSmall) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for i := uint64(0); i < num; i++ {
-			if err = c.Chunks[i].HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = hh.HashElements(len(c.Chunks), func(indx int, hh *ssz.Hasher) error {
+			return c.Chunks[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 4)
 	}
@@ -376,6 +790,79 @@ func (c *CodeTrieSmall) GetTree() (*ssz.Node, error) {
 	return w.Node(), nil
 }
 
+// Copy returns a deep copy of the CodeTrieSmall object
+func (c *CodeTrieSmall) Copy() *CodeTrieSmall {
+	if c == nil {
+		return nil
+	}
+	cpy := new(CodeTrieSmall)
+	*cpy = *c
+
+	// Field 'Metadata'
+	cpy.Metadata = cpy.Metadata.Copy()
+
+	// Field 'Chunks'
+	cpy.Chunks = append(cpy.Chunks[:0:0], cpy.Chunks...)
+	for i0 := range cpy.Chunks {
+		cpy.Chunks[i0] = cpy.Chunks[i0].Copy()
+	}
+
+	return cpy
+}
+
+// EqualSSZ returns true if the CodeTrieSmall objects have the same SSZ encoding
+func (c *CodeTrieSmall) EqualSSZ(other *CodeTrieSmall) bool {
+	if c == nil {
+		c = new(CodeTrieSmall)
+	}
+	if other == nil {
+		other = new(CodeTrieSmall)
+	}
+
+	// Field 'Metadata'
+	if !c.Metadata.EqualSSZ(other.Metadata) {
+		return false
+	}
+
+	// Field 'Chunks'
+	if len(c.Chunks) != len(other.Chunks) {
+		return false
+	} else {
+		for i0 := range c.Chunks {
+			if !c.Chunks[i0].EqualSSZ(other.Chunks[i0]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the CodeTrieSmall objects
+func (c *CodeTrieSmall) DiffSSZ(other *CodeTrieSmall) []ssz.FieldDiff {
+	if c == nil {
+		c = new(CodeTrieSmall)
+	}
+	if other == nil {
+		other = new(CodeTrieSmall)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Metadata'
+	diffs = ssz.AppendDiffs(diffs, "Metadata", c.Metadata.DiffSSZ(other.Metadata))
+
+	// Field 'Chunks'
+	if len(c.Chunks) != len(other.Chunks) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Chunks", A: c.Chunks, B: other.Chunks})
+	} else {
+		for i0 := range c.Chunks {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("Chunks", i0), c.Chunks[i0].DiffSSZ(other.Chunks[i0]))
+		}
+	}
+
+	return diffs
+}
+
 // MarshalSSZ ssz marshals the CodeTrieBig object
 func (c *CodeTrieBig) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -400,7 +887,7 @@ func (c *CodeTrieBig) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (1) 'Chunks'
 	if size := len(c.Chunks); size > 1024 {
-		err = ssz.ErrListTooBigFn("CodeTrieBig.Chunks", size, 1024)
+		err = ssz.ErrListTooBigFn("--.Chunks", size, 1024)
 		return
 	}
 	for ii := 0; ii < len(c.Chunks); ii++ {
@@ -412,13 +899,57 @@ func (c *CodeTrieBig) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the CodeTrieBig object
-func (c *CodeTrieBig) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 39 {
-		return ssz.ErrSize
-	}
+// MarshalSSZToWriter ssz marshals the CodeTrieBig object to a writer
+func (c *CodeTrieBig) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, c)
+}
+
+// MarshalSSZToEncoder ssz marshals the CodeTrieBig object to an encoder
+func (c *CodeTrieBig) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(39)
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if err = enc.EncodeObject(c.Metadata); err != nil {
+		return
+	}
+
+	// Offset (1) 'Chunks'
+	enc.EncodeOffset(offset)
+	offset += len(c.Chunks) * 33
+
+	// Field (1) 'Chunks'
+	if size := len(c.Chunks); size > 1024 {
+		err = ssz.ErrListTooBigFn("--.Chunks", size, 1024)
+		return
+	}
+	for ii := 0; ii < len(c.Chunks); ii++ {
+		if err = enc.EncodeObject(c.Chunks[ii]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CodeTrieBig object
+func (c *CodeTrieBig) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the CodeTrieBig object within the limits of the decode state
+func (c *CodeTrieBig) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "CodeTrieBig", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 39 {
+		return ssz.NewDecodeError(ssz.ErrSize, "CodeTrieBig", 39, size)
+	}
 
 	tail := buf
 	var o1 uint64
@@ -427,13 +958,17 @@ func (c *CodeTrieBig) UnmarshalSSZ(buf []byte) error {
 	if c.Metadata == nil {
 		c.Metadata = new(Metadata)
 	}
-	if err = c.Metadata.UnmarshalSSZ(buf[0:35]); err != nil {
-		return err
+	if err = ssz.UnmarshalWithState(c.Metadata, buf[0:35], st); err != nil {
+		return ssz.WrapDecodeError(err, "CodeTrieBig", "Metadata", 0)
 	}
 
 	// Offset (1) 'Chunks'
 	if o1 = ssz.ReadOffset(buf[35:39]); o1 > size {
-		return ssz.ErrOffset
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CodeTrieBig", "Chunks", 35)
+	}
+
+	if o1 != 39 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 39, o1), "CodeTrieBig", "Chunks", 35)
 	}
 
 	// Field (1) 'Chunks'
@@ -441,21 +976,131 @@ func (c *CodeTrieBig) UnmarshalSSZ(buf []byte) error {
 		buf = tail[o1:]
 		num, err := ssz.DivideInt2(len(buf), 33, 1024)
 		if err != nil {
-			return err
+			return ssz.WrapDecodeError(err, "CodeTrieBig", "Chunks", o1)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Chunk{}))); err != nil {
+			return ssz.WrapDecodeError(err, "CodeTrieBig", "Chunks", o1)
 		}
 		c.Chunks = make([]*Chunk, num)
 		for ii := 0; ii < num; ii++ {
 			if c.Chunks[ii] == nil {
 				c.Chunks[ii] = new(Chunk)
 			}
-			if err = c.Chunks[ii].UnmarshalSSZ(buf[ii*33 : (ii+1)*33]); err != nil {
-				return err
+			if err = ssz.UnmarshalWithState(c.Chunks[ii], buf[ii*33:(ii+1)*33], st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*33)), "CodeTrieBig", "Chunks", o1)
 			}
 		}
 	}
 	return err
 }
 
+// ValidateSSZ checks that buf is a valid SSZ encoding of a CodeTrieBig object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (c *CodeTrieBig) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 39 {
+		return ssz.NewDecodeError(ssz.ErrSize, "CodeTrieBig", 39, size)
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Metadata'
+	if err = (*Metadata)(nil).ValidateSSZ(buf[0:35]); err != nil {
+		return ssz.WrapDecodeError(err, "CodeTrieBig", "Metadata", 0)
+	}
+
+	// Offset (1) 'Chunks'
+	if o1 = ssz.ReadOffset(buf[35:39]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CodeTrieBig", "Chunks", 35)
+	}
+
+	if o1 != 39 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 39, o1), "CodeTrieBig", "Chunks", 35)
+	}
+
+	// Field (1) 'Chunks'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 33, 1024)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "CodeTrieBig", "Chunks", o1)
+		}
+
+		for ii := 0; ii < num; ii++ {
+			if err = (*Chunk)(nil).ValidateSSZ(buf[ii*33 : (ii+1)*33]); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*33)), "CodeTrieBig", "Chunks", o1)
+			}
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the CodeTrieBig object from a reader with an encoded size of 'size' bytes
+func (c *CodeTrieBig) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, c)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the CodeTrieBig object from a decoder
+func (c *CodeTrieBig) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 39 {
+		return ssz.NewDecodeError(ssz.ErrSize, "CodeTrieBig", 39, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "CodeTrieBig", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(39)
+	if err != nil {
+		return err
+	}
+	var o1 uint64
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if err = ssz.UnmarshalWithState(c.Metadata, buf[0:35], st); err != nil {
+		return ssz.WrapDecodeError(err, "CodeTrieBig", "Metadata", 0)
+	}
+
+	// Offset (1) 'Chunks'
+	if o1 = ssz.ReadOffset(buf[35:39]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CodeTrieBig", "Chunks", 35)
+	}
+
+	if o1 != 39 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 39, o1), "CodeTrieBig", "Chunks", 35)
+	}
+
+	// Field (1) 'Chunks'
+	{
+		num, err := ssz.DivideInt2(int(size-o1), 33, 1024)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "CodeTrieBig", "Chunks", o1)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Chunk{}))); err != nil {
+			return ssz.WrapDecodeError(err, "CodeTrieBig", "Chunks", o1)
+		}
+		c.Chunks = make([]*Chunk, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(33); err != nil {
+				return ssz.WrapDecodeError(err, "CodeTrieBig", "Chunks", o1)
+			}
+			if c.Chunks[ii] == nil {
+				c.Chunks[ii] = new(Chunk)
+			}
+			if err = ssz.UnmarshalWithState(c.Chunks[ii], buf, st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*33)), "CodeTrieBig", "Chunks", o1)
+			}
+		}
+	}
+	return nil
+}
+
 // SizeSSZ returns the ssz encoded size in bytes for the CodeTrieBig object
 func (c *CodeTrieBig) SizeSSZ() (size int) {
 	size = 39
@@ -488,10 +1133,10 @@ func (c *CodeTrieBig) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for i := uint64(0); i < num; i++ {
-			if err = c.Chunks[i].HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = hh.HashElements(len(c.Chunks), func(indx int, hh *ssz.Hasher) error {
+			return c.Chunks[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 1024)
 	}
@@ -538,3 +1183,454 @@ func (c *CodeTrieBig) GetTree() (*ssz.Node, error) {
 	}
 	return w.Node(), nil
 }
+
+// Copy returns a deep copy of the CodeTrieBig object
+func (c *CodeTrieBig) Copy() *CodeTrieBig {
+	if c == nil {
+		return nil
+	}
+	cpy := new(CodeTrieBig)
+	*cpy = *c
+
+	// Field 'Metadata'
+	cpy.Metadata = cpy.Metadata.Copy()
+
+	// Field 'Chunks'
+	cpy.Chunks = append(cpy.Chunks[:0:0], cpy.Chunks...)
+	for i0 := range cpy.Chunks {
+		cpy.Chunks[i0] = cpy.Chunks[i0].Copy()
+	}
+
+	return cpy
+}
+
+// EqualSSZ returns true if the CodeTrieBig objects have the same SSZ encoding
+func (c *CodeTrieBig) EqualSSZ(other *CodeTrieBig) bool {
+	if c == nil {
+		c = new(CodeTrieBig)
+	}
+	if other == nil {
+		other = new(CodeTrieBig)
+	}
+
+	// Field 'Metadata'
+	if !c.Metadata.EqualSSZ(other.Metadata) {
+		return false
+	}
+
+	// Field 'Chunks'
+	if len(c.Chunks) != len(other.Chunks) {
+		return false
+	} else {
+		for i0 := range c.Chunks {
+			if !c.Chunks[i0].EqualSSZ(other.Chunks[i0]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the CodeTrieBig objects
+func (c *CodeTrieBig) DiffSSZ(other *CodeTrieBig) []ssz.FieldDiff {
+	if c == nil {
+		c = new(CodeTrieBig)
+	}
+	if other == nil {
+		other = new(CodeTrieBig)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Metadata'
+	diffs = ssz.AppendDiffs(diffs, "Metadata", c.Metadata.DiffSSZ(other.Metadata))
+
+	// Field 'Chunks'
+	if len(c.Chunks) != len(other.Chunks) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Chunks", A: c.Chunks, B: other.Chunks})
+	} else {
+		for i0 := range c.Chunks {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("Chunks", i0), c.Chunks[i0].DiffSSZ(other.Chunks[i0]))
+		}
+	}
+
+	return diffs
+}
+
+// MarshalSSZ ssz marshals the CodeTrieProgressive object
+func (c *CodeTrieProgressive) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CodeTrieProgressive object to a target array
+func (c *CodeTrieProgressive) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(39)
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if dst, err = c.Metadata.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (1) 'Chunks'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Chunks) * 33
+
+	// Field (1) 'Chunks'
+	for ii := 0; ii < len(c.Chunks); ii++ {
+		if dst, err = c.Chunks[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the CodeTrieProgressive object to a writer
+func (c *CodeTrieProgressive) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, c)
+}
+
+// MarshalSSZToEncoder ssz marshals the CodeTrieProgressive object to an encoder
+func (c *CodeTrieProgressive) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(39)
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if err = enc.EncodeObject(c.Metadata); err != nil {
+		return
+	}
+
+	// Offset (1) 'Chunks'
+	enc.EncodeOffset(offset)
+	offset += len(c.Chunks) * 33
+
+	// Field (1) 'Chunks'
+	for ii := 0; ii < len(c.Chunks); ii++ {
+		if err = enc.EncodeObject(c.Chunks[ii]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CodeTrieProgressive object
+func (c *CodeTrieProgressive) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the CodeTrieProgressive object within the limits of the decode state
+func (c *CodeTrieProgressive) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "CodeTrieProgressive", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 39 {
+		return ssz.NewDecodeError(ssz.ErrSize, "CodeTrieProgressive", 39, size)
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if err = ssz.UnmarshalWithState(c.Metadata, buf[0:35], st); err != nil {
+		return ssz.WrapDecodeError(err, "CodeTrieProgressive", "Metadata", 0)
+	}
+
+	// Offset (1) 'Chunks'
+	if o1 = ssz.ReadOffset(buf[35:39]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CodeTrieProgressive", "Chunks", 35)
+	}
+
+	if o1 != 39 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 39, o1), "CodeTrieProgressive", "Chunks", 35)
+	}
+
+	// Field (1) 'Chunks'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 33, len(buf))
+		if err != nil {
+			return ssz.WrapDecodeError(err, "CodeTrieProgressive", "Chunks", o1)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Chunk{}))); err != nil {
+			return ssz.WrapDecodeError(err, "CodeTrieProgressive", "Chunks", o1)
+		}
+		c.Chunks = make([]*Chunk, num)
+		for ii := 0; ii < num; ii++ {
+			if c.Chunks[ii] == nil {
+				c.Chunks[ii] = new(Chunk)
+			}
+			if err = ssz.UnmarshalWithState(c.Chunks[ii], buf[ii*33:(ii+1)*33], st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*33)), "CodeTrieProgressive", "Chunks", o1)
+			}
+		}
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a CodeTrieProgressive object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (c *CodeTrieProgressive) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 39 {
+		return ssz.NewDecodeError(ssz.ErrSize, "CodeTrieProgressive", 39, size)
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Metadata'
+	if err = (*Metadata)(nil).ValidateSSZ(buf[0:35]); err != nil {
+		return ssz.WrapDecodeError(err, "CodeTrieProgressive", "Metadata", 0)
+	}
+
+	// Offset (1) 'Chunks'
+	if o1 = ssz.ReadOffset(buf[35:39]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CodeTrieProgressive", "Chunks", 35)
+	}
+
+	if o1 != 39 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 39, o1), "CodeTrieProgressive", "Chunks", 35)
+	}
+
+	// Field (1) 'Chunks'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 33, len(buf))
+		if err != nil {
+			return ssz.WrapDecodeError(err, "CodeTrieProgressive", "Chunks", o1)
+		}
+
+		for ii := 0; ii < num; ii++ {
+			if err = (*Chunk)(nil).ValidateSSZ(buf[ii*33 : (ii+1)*33]); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*33)), "CodeTrieProgressive", "Chunks", o1)
+			}
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the CodeTrieProgressive object from a reader with an encoded size of 'size' bytes
+func (c *CodeTrieProgressive) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, c)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the CodeTrieProgressive object from a decoder
+func (c *CodeTrieProgressive) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 39 {
+		return ssz.NewDecodeError(ssz.ErrSize, "CodeTrieProgressive", 39, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "CodeTrieProgressive", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(39)
+	if err != nil {
+		return err
+	}
+	var o1 uint64
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if err = ssz.UnmarshalWithState(c.Metadata, buf[0:35], st); err != nil {
+		return ssz.WrapDecodeError(err, "CodeTrieProgressive", "Metadata", 0)
+	}
+
+	// Offset (1) 'Chunks'
+	if o1 = ssz.ReadOffset(buf[35:39]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "CodeTrieProgressive", "Chunks", 35)
+	}
+
+	if o1 != 39 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 39, o1), "CodeTrieProgressive", "Chunks", 35)
+	}
+
+	// Field (1) 'Chunks'
+	{
+		num, err := ssz.DivideInt2(int(size-o1), 33, int(size-o1))
+		if err != nil {
+			return ssz.WrapDecodeError(err, "CodeTrieProgressive", "Chunks", o1)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(Chunk{}))); err != nil {
+			return ssz.WrapDecodeError(err, "CodeTrieProgressive", "Chunks", o1)
+		}
+		c.Chunks = make([]*Chunk, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(33); err != nil {
+				return ssz.WrapDecodeError(err, "CodeTrieProgressive", "Chunks", o1)
+			}
+			if c.Chunks[ii] == nil {
+				c.Chunks[ii] = new(Chunk)
+			}
+			if err = ssz.UnmarshalWithState(c.Chunks[ii], buf, st); err != nil {
+				return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, ii, uint64(ii*33)), "CodeTrieProgressive", "Chunks", o1)
+			}
+		}
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CodeTrieProgressive object
+func (c *CodeTrieProgressive) SizeSSZ() (size int) {
+	size = 39
+
+	// Field (1) 'Chunks'
+	size += len(c.Chunks) * 33
+
+	return
+}
+
+// HashTreeRoot ssz hashes the CodeTrieProgressive object
+func (c *CodeTrieProgressive) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the CodeTrieProgressive object with a hasher
+func (c *CodeTrieProgressive) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Metadata'
+	if err = c.Metadata.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Chunks'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(c.Chunks))
+		if err = hh.HashElements(len(c.Chunks), func(indx int, hh *ssz.Hasher) error {
+			return c.Chunks[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
+		}
+		hh.MerkleizeProgressiveWithMixin(subIndx, num)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree returns tree-backing for the CodeTrieProgressive object
+func (c *CodeTrieProgressive) GetTreeWithWrapper(w *ssz.Wrapper) (err error) {
+	indx := w.Indx()
+
+	// Field (0) 'Metadata'
+	if err := c.Metadata.GetTreeWithWrapper(w); err != nil {
+		return err
+	}
+
+	// Field (1) 'Chunks'
+	{
+		subIdx := w.Indx()
+		num := len(c.Chunks)
+		for i := 0; i < num; i++ {
+			n, err := c.Chunks[i].GetTree()
+			if err != nil {
+				return err
+			}
+			w.AddNode(n)
+		}
+		w.CommitProgressiveWithMixin(subIdx, num)
+	}
+
+	w.Commit(indx)
+	return nil
+}
+
+func (c *CodeTrieProgressive) GetTree() (*ssz.Node, error) {
+	w := &ssz.Wrapper{}
+	if err := c.GetTreeWithWrapper(w); err != nil {
+		return nil, err
+	}
+	return w.Node(), nil
+}
+
+// Copy returns a deep copy of the CodeTrieProgressive object
+func (c *CodeTrieProgressive) Copy() *CodeTrieProgressive {
+	if c == nil {
+		return nil
+	}
+	cpy := new(CodeTrieProgressive)
+	*cpy = *c
+
+	// Field 'Metadata'
+	cpy.Metadata = cpy.Metadata.Copy()
+
+	// Field 'Chunks'
+	cpy.Chunks = append(cpy.Chunks[:0:0], cpy.Chunks...)
+	for i0 := range cpy.Chunks {
+		cpy.Chunks[i0] = cpy.Chunks[i0].Copy()
+	}
+
+	return cpy
+}
+
+// EqualSSZ returns true if the CodeTrieProgressive objects have the same SSZ encoding
+func (c *CodeTrieProgressive) EqualSSZ(other *CodeTrieProgressive) bool {
+	if c == nil {
+		c = new(CodeTrieProgressive)
+	}
+	if other == nil {
+		other = new(CodeTrieProgressive)
+	}
+
+	// Field 'Metadata'
+	if !c.Metadata.EqualSSZ(other.Metadata) {
+		return false
+	}
+
+	// Field 'Chunks'
+	if len(c.Chunks) != len(other.Chunks) {
+		return false
+	} else {
+		for i0 := range c.Chunks {
+			if !c.Chunks[i0].EqualSSZ(other.Chunks[i0]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the CodeTrieProgressive objects
+func (c *CodeTrieProgressive) DiffSSZ(other *CodeTrieProgressive) []ssz.FieldDiff {
+	if c == nil {
+		c = new(CodeTrieProgressive)
+	}
+	if other == nil {
+		other = new(CodeTrieProgressive)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Metadata'
+	diffs = ssz.AppendDiffs(diffs, "Metadata", c.Metadata.DiffSSZ(other.Metadata))
+
+	// Field 'Chunks'
+	if len(c.Chunks) != len(other.Chunks) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Chunks", A: c.Chunks, B: other.Chunks})
+	} else {
+		for i0 := range c.Chunks {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("Chunks", i0), c.Chunks[i0].DiffSSZ(other.Chunks[i0]))
+		}
+	}
+
+	return diffs
+}
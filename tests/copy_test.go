@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/fastssz/tests/uint256"
+)
+
+func TestCopy(t *testing.T) {
+	a, c := uint64(1), true
+	optional := &OptionalFields{A: &a, B: &OptionalInner{B: []byte{1}}, C: &c, D: &uint256.Int{1}}
+	optionalCpy := optional.Copy()
+	*optionalCpy.A, *optionalCpy.C, optionalCpy.D[0] = 2, false, 2
+	optionalCpy.B.B[0] = 2
+	if a != 1 || !c || optional.D[0] != 1 || optional.B.B[0] != 1 {
+		t.Fatal("the copy of the optional fields is shared")
+	}
+
+	union := &UnionContainer{Value: &UnionB{B: []byte{1}}}
+	unionCpy := union.Copy()
+	unionCpy.Value.(*UnionB).B[0] = 2
+	if union.Value.(*UnionB).B[0] != 1 {
+		t.Fatal("the copy of the union is shared")
+	}
+
+	uints := &WideUints{E: [][32]byte{{1}}, F: []*uint256.Int{{1}}, I: []uint256.Int{}}
+	uintsCpy := uints.Copy()
+	uintsCpy.E[0][0], uintsCpy.F[0][0] = 2, 2
+	if uints.E[0][0] != 1 || uints.F[0][0] != 1 {
+		t.Fatal("the copy of the lists is shared")
+	}
+
+	shape := &Shape{Side: new(uint16), Label: &ShapeLabel{Name: []byte{}}}
+	for _, obj := range []interface{}{optional, union, uints, shape} {
+		cpy := reflect.ValueOf(obj).MethodByName("Copy").Call(nil)[0].Interface()
+		if !reflect.DeepEqual(obj, cpy) {
+			t.Fatalf("the copy of %T is not equal", obj)
+		}
+		// the types without a Copy function are copied with reflection
+		if cpy := ssz.CopyObject(reflect.ValueOf(obj).Elem().Interface()); !reflect.DeepEqual(reflect.ValueOf(obj).Elem().Interface(), cpy) {
+			t.Fatalf("the reflection copy of %T is not equal", obj)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package tests
+
+// DepositSnapshot has the layout of ssz.DepositSnapshot with generated methods
+type DepositSnapshot struct {
+	Finalized            [][32]byte `ssz-size:"?,32" ssz-max:"32"`
+	DepositRoot          [32]byte   `ssz-size:"32"`
+	DepositCount         uint64
+	ExecutionBlockHash   [32]byte `ssz-size:"32"`
+	ExecutionBlockHeight uint64
+}
@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+)
+
+func TestDepositSnapshotGenerated(t *testing.T) {
+	// the hand written methods of ssz.DepositSnapshot match the generated ones
+	r := rand.New(rand.NewSource(1))
+	tree := ssz.NewDepositTree()
+	for _, count := range []uint64{0, 1, 6, 7, 64, 100} {
+		for tree.Len() < count {
+			var leaf [32]byte
+			r.Read(leaf[:])
+			tree.Push(leaf)
+		}
+		eth1Data := &ssz.Eth1Data{DepositRoot: tree.Root(), DepositCount: count}
+		r.Read(eth1Data.BlockHash[:])
+		if err := tree.Finalize(eth1Data, r.Uint64()); err != nil {
+			t.Fatal(err)
+		}
+
+		snapshot := tree.Snapshot()
+		obj := &DepositSnapshot{
+			Finalized:            snapshot.Finalized,
+			DepositRoot:          snapshot.DepositRoot,
+			DepositCount:         snapshot.DepositCount,
+			ExecutionBlockHash:   snapshot.ExecutionBlockHash,
+			ExecutionBlockHeight: snapshot.ExecutionBlockHeight,
+		}
+
+		buf, err := snapshot.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, err := obj.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf, expected) || snapshot.SizeSSZ() != obj.SizeSSZ() {
+			t.Fatalf("%d deposits: bad encoding", count)
+		}
+
+		root, err := snapshot.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectedRoot, err := obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != expectedRoot {
+			t.Fatalf("%d deposits: expected root %x but found %x", count, expectedRoot, root)
+		}
+
+		var decoded ssz.DepositSnapshot
+		if err := decoded.UnmarshalSSZ(expected); err != nil {
+			t.Fatal(err)
+		}
+		if !ssz.EqualObject(snapshot, &decoded) {
+			t.Fatalf("%d deposits: bad decoding", count)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/fastssz/tests/uint256"
+)
+
+func TestEqualSSZ(t *testing.T) {
+	// the presence of an optional value is part of the encoding
+	a := uint64(0)
+	if (&OptionalFields{}).EqualSSZ(&OptionalFields{A: &a}) {
+		t.Fatal("a missing optional value is equal to a zero value")
+	}
+	if !(&OptionalFields{B: &OptionalInner{}}).EqualSSZ(&OptionalFields{B: &OptionalInner{B: []byte{}}}) {
+		t.Fatal("optional values are not equal")
+	}
+
+	// the selected types of the unions must match
+	if (&UnionContainer{}).EqualSSZ(&UnionContainer{Value: &UnionA{}}) {
+		t.Fatal("unions with different selectors are equal")
+	}
+	diffs := (&UnionContainer{Value: &UnionB{B: []byte{1}}}).DiffSSZ(&UnionContainer{Value: &UnionB{B: []byte{2}}})
+	if len(diffs) != 1 || diffs[0].Path != "Value.B" {
+		t.Fatalf("bad differences %v", diffs)
+	}
+
+	// nil uint256 pointers are encoded as zero
+	if !(&WideUints{F: []*uint256.Int{nil}}).EqualSSZ(&WideUints{F: []*uint256.Int{{}}}) {
+		t.Fatal("nil and zero uint256 are not equal")
+	}
+	diffs = (&WideUints{F: []*uint256.Int{{}, {1}}}).DiffSSZ(&WideUints{F: []*uint256.Int{{}, {2}}})
+	if len(diffs) != 1 || diffs[0].Path != "F[1]" {
+		t.Fatalf("bad differences %v", diffs)
+	}
+}
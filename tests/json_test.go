@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/fastssz/tests/uint256"
+)
+
+func TestJSON(t *testing.T) {
+	a, c := uint64(1), true
+	cases := []struct {
+		obj interface {
+			ssz.Marshaler
+			ssz.Unmarshaler
+		}
+		json string
+	}{
+		{
+			&UnionContainer{Value: &UnionB{B: []byte{1, 2}}, Other: 3},
+			`{"Value":{"selector":"2","value":{"B":"0x0102"}},"Other":"3"}`,
+		},
+		{
+			&UnionContainer{},
+			`{"Value":{"selector":"0","value":null},"Other":"0"}`,
+		},
+		{
+			&OptionalFields{A: &a, C: &c, D: &uint256.Int{0, 0, 0, 1}},
+			`{"A":"1","B":null,"C":true,"D":"6277101735386680763835789423207666416102355444464034512896","E":null,"F":"0"}`,
+		},
+		{
+			&Square{Side: 2, Color: 1},
+			`{"Side":"2","Color":"1"}`,
+		},
+		{
+			&ProgressiveLists{A: []uint64{1, 2}, B: []byte{3}, C: []*ProgressiveItem{{A: 4, B: []byte{}}}},
+			`{"A":["1","2"],"B":"0x03","C":[{"A":"4","B":"0x"}]}`,
+		},
+	}
+	for _, c := range cases {
+		data, err := json.Marshal(c.obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != c.json {
+			t.Fatalf("expected %s but found %s", c.json, data)
+		}
+
+		// the decoded object has the same ssz encoding
+		obj := reflect.New(reflect.TypeOf(c.obj).Elem()).Interface().(ssz.Marshaler)
+		if err := json.Unmarshal(data, obj); err != nil {
+			t.Fatal(err)
+		}
+		expected, _ := c.obj.MarshalSSZ()
+		found, _ := obj.MarshalSSZ()
+		if !bytes.Equal(expected, found) {
+			t.Fatalf("%T does not round trip", c.obj)
+		}
+	}
+
+	// uint128 and uint256 values are decimal numbers
+	uints := &WideUints{A: [16]byte{1}, C: &uint256.Int{2}, E: [][32]byte{{3}}, F: []*uint256.Int{nil}, H: [3][32]byte{{0, 1}}}
+	data, err := json.Marshal(uints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var uints2 WideUints
+	if err := json.Unmarshal(data, &uints2); err != nil {
+		t.Fatal(err)
+	}
+	if !uints.EqualSSZ(&uints2) || uints2.H[0][1] != 1 {
+		t.Fatalf("bad decoding %s", data)
+	}
+
+	// the selector of the union must be one of the union types
+	err = json.Unmarshal([]byte(`{"Value":{"selector":"3","value":null},"Other":"0"}`), &UnionContainer{})
+	if !errors.Is(err, ssz.ErrUnionSelector) {
+		t.Fatalf("expected union selector error but found %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"unsafe"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+)
+
+func TestUnmarshalWithOptions(t *testing.T) {
+	obj := &NoCopy{
+		Root:  bytes.Repeat([]byte{1}, 32),
+		Bits:  []byte{0x3},
+		Data:  []byte{1, 2, 3},
+		Roots: [][]byte{make([]byte, 32), make([]byte, 32), make([]byte, 32), make([]byte, 32)},
+		List:  [][]byte{bytes.Repeat([]byte{2}, 32)},
+		Inner: &NoCopyInner{Data: []byte{4, 5}},
+		Inners: []*NoCopyInner{
+			{Root: [32]byte{1}, Data: []byte{6}},
+			{Data: []byte{}},
+		},
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the slices of Roots, List and Inners with the structs of Inners
+	st := ssz.NewDecodeState(ssz.DecodeOptions{})
+	obj2 := new(NoCopy)
+	if err := obj2.UnmarshalSSZWithState(buf, st); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad unmarshal")
+	}
+	alloc := uint64(4*24 + 24 + 2*(8+unsafe.Sizeof(NoCopyInner{})))
+	if st.Allocated() != alloc {
+		t.Fatalf("bad allocation %d", st.Allocated())
+	}
+
+	cases := []struct {
+		opts ssz.DecodeOptions
+		err  error
+		path string
+	}{
+		{ssz.DecodeOptions{MaxAlloc: 100}, ssz.ErrMaxAlloc, "NoCopy.List"},
+		// the pointers of Inners fit but not the structs
+		{ssz.DecodeOptions{MaxAlloc: alloc - 1}, ssz.ErrMaxAlloc, "NoCopy.Inners"},
+		{ssz.DecodeOptions{MaxDepth: 1}, ssz.ErrMaxDepth, "NoCopy.Inner"},
+		// the list of Inners is a nesting level
+		{ssz.DecodeOptions{MaxDepth: 2}, ssz.ErrMaxDepth, "NoCopy.Inners[0]"},
+	}
+	for _, c := range cases {
+		errs := []error{
+			ssz.UnmarshalWithOptions(new(NoCopy), buf, c.opts),
+			ssz.NewDecoder(bytes.NewReader(buf)).WithOptions(c.opts).DecodeObject(new(NoCopy), uint64(len(buf))),
+		}
+		for _, err := range errs {
+			if !errors.Is(err, c.err) {
+				t.Fatalf("expected error %v but found %v", c.err, err)
+			}
+			var derr *ssz.DecodeError
+			if !errors.As(err, &derr) || derr.Path != c.path {
+				t.Fatalf("expected path %s but found %v", c.path, err)
+			}
+		}
+	}
+
+	// the limits are not exceeded
+	if err := ssz.UnmarshalWithOptions(new(NoCopy), buf, ssz.DecodeOptions{MaxAlloc: alloc, MaxDepth: 3}); err != nil {
+		t.Fatal(err)
+	}
+}
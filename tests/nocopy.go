@@ -0,0 +1,18 @@
+package tests
+
+// NoCopy is generated with the --nocopy flag
+
+type NoCopyInner struct {
+	Root [32]byte `ssz-size:"32"`
+	Data []byte   `ssz-max:"64"`
+}
+
+type NoCopy struct {
+	Root   []byte   `ssz-size:"32"`
+	Bits   []byte   `ssz:"bitlist" ssz-max:"16"`
+	Data   []byte   `ssz-max:"64"`
+	Roots  [][]byte `ssz-size:"4,32"`
+	List   [][]byte `ssz-size:"?,32" ssz-max:"4"`
+	Inner  *NoCopyInner
+	Inners []*NoCopyInner `ssz-max:"4"`
+}
@@ -0,0 +1,1349 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: c4cf681d4337b5c92c4e835c6cbf12e4b3d32dc9feda23e2605c27d4c3e6c87a
+package tests
+
+import (
+	"bytes"
+	"io"
+	"unsafe"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+)
+
+// MarshalSSZ ssz marshals the NoCopyInner object
+func (n *NoCopyInner) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(n)
+}
+
+// MarshalSSZTo ssz marshals the NoCopyInner object to a target array
+func (n *NoCopyInner) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(36)
+
+	// Field (0) 'Root'
+	dst = append(dst, n.Root[:]...)
+
+	// Offset (1) 'Data'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(n.Data)
+
+	// Field (1) 'Data'
+	if size := len(n.Data); size > 64 {
+		err = ssz.ErrBytesLengthFn("--.Data", size, 64)
+		return
+	}
+	dst = append(dst, n.Data...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the NoCopyInner object to a writer
+func (n *NoCopyInner) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, n)
+}
+
+// MarshalSSZToEncoder ssz marshals the NoCopyInner object to an encoder
+func (n *NoCopyInner) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(36)
+
+	// Field (0) 'Root'
+	enc.EncodeBytes(n.Root[:])
+
+	// Offset (1) 'Data'
+	enc.EncodeOffset(offset)
+	offset += len(n.Data)
+
+	// Field (1) 'Data'
+	if size := len(n.Data); size > 64 {
+		err = ssz.ErrBytesLengthFn("--.Data", size, 64)
+		return
+	}
+	enc.EncodeBytes(n.Data)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the NoCopyInner object
+func (n *NoCopyInner) UnmarshalSSZ(buf []byte) error {
+	return n.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the NoCopyInner object within the limits of the decode state
+func (n *NoCopyInner) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "NoCopyInner", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 36 {
+		return ssz.NewDecodeError(ssz.ErrSize, "NoCopyInner", 36, size)
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Root'
+	copy(n.Root[:], buf[0:32])
+
+	// Offset (1) 'Data'
+	if o1 = ssz.ReadOffset(buf[32:36]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopyInner", "Data", 32)
+	}
+
+	if o1 != 36 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 36, o1), "NoCopyInner", "Data", 32)
+	}
+
+	// Field (1) 'Data'
+	{
+		buf = tail[o1:]
+		if len(buf) > 64 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 64, uint64(len(buf))), "NoCopyInner", "Data", o1)
+		}
+		if cap(n.Data) == 0 {
+			n.Data = make([]byte, 0, len(buf))
+		}
+		n.Data = append(n.Data, buf...)
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the NoCopyInner object without copying the byte fields,
+// they reference 'buf' and it must not be modified while the object is in use
+func (n *NoCopyInner) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 36 {
+		return ssz.NewDecodeError(ssz.ErrSize, "NoCopyInner", 36, size)
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Root'
+	copy(n.Root[:], buf[0:32])
+
+	// Offset (1) 'Data'
+	if o1 = ssz.ReadOffset(buf[32:36]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopyInner", "Data", 32)
+	}
+
+	if o1 != 36 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 36, o1), "NoCopyInner", "Data", 32)
+	}
+
+	// Field (1) 'Data'
+	{
+		buf = tail[o1:]
+		if len(buf) > 64 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 64, uint64(len(buf))), "NoCopyInner", "Data", o1)
+		}
+		n.Data = buf[:len(buf):len(buf)]
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a NoCopyInner object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (n *NoCopyInner) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 36 {
+		return ssz.NewDecodeError(ssz.ErrSize, "NoCopyInner", 36, size)
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Offset (1) 'Data'
+	if o1 = ssz.ReadOffset(buf[32:36]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopyInner", "Data", 32)
+	}
+
+	if o1 != 36 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 36, o1), "NoCopyInner", "Data", 32)
+	}
+
+	// Field (1) 'Data'
+	{
+		buf = tail[o1:]
+		if len(buf) > 64 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 64, uint64(len(buf))), "NoCopyInner", "Data", o1)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the NoCopyInner object from a reader with an encoded size of 'size' bytes
+func (n *NoCopyInner) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, n)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the NoCopyInner object from a decoder
+func (n *NoCopyInner) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 36 {
+		return ssz.NewDecodeError(ssz.ErrSize, "NoCopyInner", 36, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "NoCopyInner", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(36)
+	if err != nil {
+		return err
+	}
+	var o1 uint64
+
+	// Field (0) 'Root'
+	copy(n.Root[:], buf[0:32])
+
+	// Offset (1) 'Data'
+	if o1 = ssz.ReadOffset(buf[32:36]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopyInner", "Data", 32)
+	}
+
+	if o1 != 36 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 36, o1), "NoCopyInner", "Data", 32)
+	}
+
+	// Field (1) 'Data'
+	{
+		if size-o1 > 64 {
+			return ssz.WrapDecodeError(ssz.ErrBytesLength, "NoCopyInner", "Data", o1)
+		}
+		if buf, err = dec.Read(size - o1); err != nil {
+			return ssz.WrapDecodeError(err, "NoCopyInner", "Data", o1)
+		}
+		if cap(n.Data) == 0 {
+			n.Data = make([]byte, 0, len(buf))
+		}
+		n.Data = append(n.Data, buf...)
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the NoCopyInner object
+func (n *NoCopyInner) SizeSSZ() (size int) {
+	size = 36
+
+	// Field (1) 'Data'
+	size += len(n.Data)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the NoCopyInner object
+func (n *NoCopyInner) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(n)
+}
+
+// HashTreeRootWith ssz hashes the NoCopyInner object with a hasher
+func (n *NoCopyInner) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Root'
+	hh.PutBytes(n.Root[:])
+
+	// Field (1) 'Data'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(n.Data))
+		if byteLen > 64 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.PutBytes(n.Data)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (64+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// Copy returns a deep copy of the NoCopyInner object
+func (n *NoCopyInner) Copy() *NoCopyInner {
+	if n == nil {
+		return nil
+	}
+	cpy := new(NoCopyInner)
+	*cpy = *n
+
+	// Field 'Data'
+	cpy.Data = append(cpy.Data[:0:0], cpy.Data...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the NoCopyInner objects have the same SSZ encoding
+func (n *NoCopyInner) EqualSSZ(other *NoCopyInner) bool {
+	if n == nil {
+		n = new(NoCopyInner)
+	}
+	if other == nil {
+		other = new(NoCopyInner)
+	}
+
+	// Field 'Root'
+	if n.Root != other.Root {
+		return false
+	}
+
+	// Field 'Data'
+	if !bytes.Equal(n.Data, other.Data) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the NoCopyInner objects
+func (n *NoCopyInner) DiffSSZ(other *NoCopyInner) []ssz.FieldDiff {
+	if n == nil {
+		n = new(NoCopyInner)
+	}
+	if other == nil {
+		other = new(NoCopyInner)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Root'
+	if n.Root != other.Root {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Root", A: n.Root, B: other.Root})
+	}
+
+	// Field 'Data'
+	if !bytes.Equal(n.Data, other.Data) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Data", A: n.Data, B: other.Data})
+	}
+
+	return diffs
+}
+
+// MarshalSSZ ssz marshals the NoCopy object
+func (n *NoCopy) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(n)
+}
+
+// MarshalSSZTo ssz marshals the NoCopy object to a target array
+func (n *NoCopy) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(180)
+
+	// Field (0) 'Root'
+	if size := len(n.Root); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Root", size, 32)
+		return
+	}
+	dst = append(dst, n.Root...)
+
+	// Offset (1) 'Bits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(n.Bits)
+
+	// Offset (2) 'Data'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(n.Data)
+
+	// Field (3) 'Roots'
+	if size := len(n.Roots); size != 4 {
+		err = ssz.ErrVectorLengthFn("--.Roots", size, 4)
+		return
+	}
+	for ii := 0; ii < 4; ii++ {
+		if size := len(n.Roots[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.Roots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, n.Roots[ii]...)
+	}
+
+	// Offset (4) 'List'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(n.List) * 32
+
+	// Offset (5) 'Inner'
+	dst = ssz.WriteOffset(dst, offset)
+	if n.Inner == nil {
+		n.Inner = new(NoCopyInner)
+	}
+	offset += n.Inner.SizeSSZ()
+
+	// Offset (6) 'Inners'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(n.Inners); ii++ {
+		offset += 4
+		offset += n.Inners[ii].SizeSSZ()
+	}
+
+	// Field (1) 'Bits'
+	if size := len(n.Bits); size > 16 {
+		err = ssz.ErrBytesLengthFn("--.Bits", size, 16)
+		return
+	}
+	dst = append(dst, n.Bits...)
+
+	// Field (2) 'Data'
+	if size := len(n.Data); size > 64 {
+		err = ssz.ErrBytesLengthFn("--.Data", size, 64)
+		return
+	}
+	dst = append(dst, n.Data...)
+
+	// Field (4) 'List'
+	if size := len(n.List); size > 4 {
+		err = ssz.ErrListTooBigFn("--.List", size, 4)
+		return
+	}
+	for ii := 0; ii < len(n.List); ii++ {
+		if size := len(n.List[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.List[ii]", size, 32)
+			return
+		}
+		dst = append(dst, n.List[ii]...)
+	}
+
+	// Field (5) 'Inner'
+	if dst, err = n.Inner.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (6) 'Inners'
+	if size := len(n.Inners); size > 4 {
+		err = ssz.ErrListTooBigFn("--.Inners", size, 4)
+		return
+	}
+	{
+		offset = 4 * len(n.Inners)
+		for ii := 0; ii < len(n.Inners); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += n.Inners[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(n.Inners); ii++ {
+		if dst, err = n.Inners[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the NoCopy object to a writer
+func (n *NoCopy) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, n)
+}
+
+// MarshalSSZToEncoder ssz marshals the NoCopy object to an encoder
+func (n *NoCopy) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(180)
+
+	// Field (0) 'Root'
+	if size := len(n.Root); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Root", size, 32)
+		return
+	}
+	enc.EncodeBytes(n.Root)
+
+	// Offset (1) 'Bits'
+	enc.EncodeOffset(offset)
+	offset += len(n.Bits)
+
+	// Offset (2) 'Data'
+	enc.EncodeOffset(offset)
+	offset += len(n.Data)
+
+	// Field (3) 'Roots'
+	if size := len(n.Roots); size != 4 {
+		err = ssz.ErrVectorLengthFn("--.Roots", size, 4)
+		return
+	}
+	for ii := 0; ii < 4; ii++ {
+		if size := len(n.Roots[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.Roots[ii]", size, 32)
+			return
+		}
+		enc.EncodeBytes(n.Roots[ii])
+	}
+
+	// Offset (4) 'List'
+	enc.EncodeOffset(offset)
+	offset += len(n.List) * 32
+
+	// Offset (5) 'Inner'
+	enc.EncodeOffset(offset)
+	if n.Inner == nil {
+		n.Inner = new(NoCopyInner)
+	}
+	offset += n.Inner.SizeSSZ()
+
+	// Offset (6) 'Inners'
+	enc.EncodeOffset(offset)
+	for ii := 0; ii < len(n.Inners); ii++ {
+		offset += 4
+		offset += n.Inners[ii].SizeSSZ()
+	}
+
+	// Field (1) 'Bits'
+	if size := len(n.Bits); size > 16 {
+		err = ssz.ErrBytesLengthFn("--.Bits", size, 16)
+		return
+	}
+	enc.EncodeBytes(n.Bits)
+
+	// Field (2) 'Data'
+	if size := len(n.Data); size > 64 {
+		err = ssz.ErrBytesLengthFn("--.Data", size, 64)
+		return
+	}
+	enc.EncodeBytes(n.Data)
+
+	// Field (4) 'List'
+	if size := len(n.List); size > 4 {
+		err = ssz.ErrListTooBigFn("--.List", size, 4)
+		return
+	}
+	for ii := 0; ii < len(n.List); ii++ {
+		if size := len(n.List[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.List[ii]", size, 32)
+			return
+		}
+		enc.EncodeBytes(n.List[ii])
+	}
+
+	// Field (5) 'Inner'
+	if err = enc.EncodeObject(n.Inner); err != nil {
+		return
+	}
+
+	// Field (6) 'Inners'
+	if size := len(n.Inners); size > 4 {
+		err = ssz.ErrListTooBigFn("--.Inners", size, 4)
+		return
+	}
+	{
+		offset = 4 * len(n.Inners)
+		for ii := 0; ii < len(n.Inners); ii++ {
+			enc.EncodeOffset(offset)
+			offset += n.Inners[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(n.Inners); ii++ {
+		if err = enc.EncodeObject(n.Inners[ii]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the NoCopy object
+func (n *NoCopy) UnmarshalSSZ(buf []byte) error {
+	return n.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the NoCopy object within the limits of the decode state
+func (n *NoCopy) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "NoCopy", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 180 {
+		return ssz.NewDecodeError(ssz.ErrSize, "NoCopy", 180, size)
+	}
+
+	tail := buf
+	var o1, o2, o4, o5, o6 uint64
+
+	// Field (0) 'Root'
+	if cap(n.Root) == 0 {
+		n.Root = make([]byte, 0, len(buf[0:32]))
+	}
+	n.Root = append(n.Root, buf[0:32]...)
+
+	// Offset (1) 'Bits'
+	if o1 = ssz.ReadOffset(buf[32:36]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "Bits", 32)
+	}
+
+	if o1 != 180 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 180, o1), "NoCopy", "Bits", 32)
+	}
+
+	// Offset (2) 'Data'
+	if o2 = ssz.ReadOffset(buf[36:40]); o2 > size || o1 > o2 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "Data", 36)
+	}
+
+	// Field (3) 'Roots'
+	if err = st.Alloc(4, 24); err != nil {
+		return ssz.WrapDecodeError(err, "NoCopy", "Roots", 40)
+	}
+	n.Roots = make([][]byte, 4)
+	for ii := 0; ii < 4; ii++ {
+		if cap(n.Roots[ii]) == 0 {
+			n.Roots[ii] = make([]byte, 0, len(buf[40:168][ii*32:(ii+1)*32]))
+		}
+		n.Roots[ii] = append(n.Roots[ii], buf[40:168][ii*32:(ii+1)*32]...)
+	}
+
+	// Offset (4) 'List'
+	if o4 = ssz.ReadOffset(buf[168:172]); o4 > size || o2 > o4 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "List", 168)
+	}
+
+	// Offset (5) 'Inner'
+	if o5 = ssz.ReadOffset(buf[172:176]); o5 > size || o4 > o5 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "Inner", 172)
+	}
+
+	// Offset (6) 'Inners'
+	if o6 = ssz.ReadOffset(buf[176:180]); o6 > size || o5 > o6 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "Inners", 176)
+	}
+
+	// Field (1) 'Bits'
+	{
+		buf = tail[o1:o2]
+		if err = ssz.ValidateBitlist(buf, 16); err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Bits", o1)
+		}
+		if cap(n.Bits) == 0 {
+			n.Bits = make([]byte, 0, len(buf))
+		}
+		n.Bits = append(n.Bits, buf...)
+	}
+
+	// Field (2) 'Data'
+	{
+		buf = tail[o2:o4]
+		if len(buf) > 64 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 64, uint64(len(buf))), "NoCopy", "Data", o2)
+		}
+		if cap(n.Data) == 0 {
+			n.Data = make([]byte, 0, len(buf))
+		}
+		n.Data = append(n.Data, buf...)
+	}
+
+	// Field (4) 'List'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DivideInt2(len(buf), 32, 4)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "List", o4)
+		}
+		if err = st.Alloc(num, 24); err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "List", o4)
+		}
+		n.List = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(n.List[ii]) == 0 {
+				n.List[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			n.List[ii] = append(n.List[ii], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+
+	// Field (5) 'Inner'
+	{
+		buf = tail[o5:o6]
+		if n.Inner == nil {
+			n.Inner = new(NoCopyInner)
+		}
+		if err = ssz.UnmarshalWithState(n.Inner, buf, st); err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Inner", o5)
+		}
+	}
+
+	// Field (6) 'Inners'
+	{
+		buf = tail[o6:]
+		num, err := ssz.DecodeDynamicLength(buf, 4)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Inners", o6)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(NoCopyInner{}))); err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Inners", o6)
+		}
+		n.Inners = make([]*NoCopyInner, num)
+		err = st.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if n.Inners[indx] == nil {
+				n.Inners[indx] = new(NoCopyInner)
+			}
+			if err = ssz.UnmarshalWithState(n.Inners[indx], buf, st); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Inners", o6)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZNoCopy ssz unmarshals the NoCopy object without copying the byte fields,
+// they reference 'buf' and it must not be modified while the object is in use
+func (n *NoCopy) UnmarshalSSZNoCopy(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 180 {
+		return ssz.NewDecodeError(ssz.ErrSize, "NoCopy", 180, size)
+	}
+
+	tail := buf
+	var o1, o2, o4, o5, o6 uint64
+
+	// Field (0) 'Root'
+	n.Root = buf[0:32:32]
+
+	// Offset (1) 'Bits'
+	if o1 = ssz.ReadOffset(buf[32:36]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "Bits", 32)
+	}
+
+	if o1 != 180 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 180, o1), "NoCopy", "Bits", 32)
+	}
+
+	// Offset (2) 'Data'
+	if o2 = ssz.ReadOffset(buf[36:40]); o2 > size || o1 > o2 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "Data", 36)
+	}
+
+	// Field (3) 'Roots'
+	n.Roots = make([][]byte, 4)
+	for ii := 0; ii < 4; ii++ {
+		n.Roots[ii] = buf[40:168][ii*32 : (ii+1)*32 : (ii+1)*32]
+	}
+
+	// Offset (4) 'List'
+	if o4 = ssz.ReadOffset(buf[168:172]); o4 > size || o2 > o4 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "List", 168)
+	}
+
+	// Offset (5) 'Inner'
+	if o5 = ssz.ReadOffset(buf[172:176]); o5 > size || o4 > o5 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "Inner", 172)
+	}
+
+	// Offset (6) 'Inners'
+	if o6 = ssz.ReadOffset(buf[176:180]); o6 > size || o5 > o6 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "Inners", 176)
+	}
+
+	// Field (1) 'Bits'
+	{
+		buf = tail[o1:o2]
+		if err = ssz.ValidateBitlist(buf, 16); err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Bits", o1)
+		}
+		n.Bits = buf[:len(buf):len(buf)]
+	}
+
+	// Field (2) 'Data'
+	{
+		buf = tail[o2:o4]
+		if len(buf) > 64 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 64, uint64(len(buf))), "NoCopy", "Data", o2)
+		}
+		n.Data = buf[:len(buf):len(buf)]
+	}
+
+	// Field (4) 'List'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DivideInt2(len(buf), 32, 4)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "List", o4)
+		}
+		n.List = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			n.List[ii] = buf[ii*32 : (ii+1)*32 : (ii+1)*32]
+		}
+	}
+
+	// Field (5) 'Inner'
+	{
+		buf = tail[o5:o6]
+		if n.Inner == nil {
+			n.Inner = new(NoCopyInner)
+		}
+		if err = ssz.UnmarshalNoCopy(n.Inner, buf); err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Inner", o5)
+		}
+	}
+
+	// Field (6) 'Inners'
+	{
+		buf = tail[o6:]
+		num, err := ssz.DecodeDynamicLength(buf, 4)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Inners", o6)
+		}
+		n.Inners = make([]*NoCopyInner, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if n.Inners[indx] == nil {
+				n.Inners[indx] = new(NoCopyInner)
+			}
+			if err = ssz.UnmarshalNoCopy(n.Inners[indx], buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Inners", o6)
+		}
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a NoCopy object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (n *NoCopy) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 180 {
+		return ssz.NewDecodeError(ssz.ErrSize, "NoCopy", 180, size)
+	}
+
+	tail := buf
+	var o1, o2, o4, o5, o6 uint64
+
+	// Offset (1) 'Bits'
+	if o1 = ssz.ReadOffset(buf[32:36]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "Bits", 32)
+	}
+
+	if o1 != 180 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 180, o1), "NoCopy", "Bits", 32)
+	}
+
+	// Offset (2) 'Data'
+	if o2 = ssz.ReadOffset(buf[36:40]); o2 > size || o1 > o2 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "Data", 36)
+	}
+
+	// Offset (4) 'List'
+	if o4 = ssz.ReadOffset(buf[168:172]); o4 > size || o2 > o4 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "List", 168)
+	}
+
+	// Offset (5) 'Inner'
+	if o5 = ssz.ReadOffset(buf[172:176]); o5 > size || o4 > o5 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "Inner", 172)
+	}
+
+	// Offset (6) 'Inners'
+	if o6 = ssz.ReadOffset(buf[176:180]); o6 > size || o5 > o6 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "Inners", 176)
+	}
+
+	// Field (1) 'Bits'
+	{
+		buf = tail[o1:o2]
+		if err = ssz.ValidateBitlist(buf, 16); err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Bits", o1)
+		}
+	}
+
+	// Field (2) 'Data'
+	{
+		buf = tail[o2:o4]
+		if len(buf) > 64 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 64, uint64(len(buf))), "NoCopy", "Data", o2)
+		}
+	}
+
+	// Field (4) 'List'
+	{
+		buf = tail[o4:o5]
+		if _, err = ssz.DivideInt2(len(buf), 32, 4); err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "List", o4)
+		}
+	}
+
+	// Field (5) 'Inner'
+	{
+		buf = tail[o5:o6]
+		if err = (*NoCopyInner)(nil).ValidateSSZ(buf); err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Inner", o5)
+		}
+	}
+
+	// Field (6) 'Inners'
+	{
+		buf = tail[o6:]
+		num, err := ssz.DecodeDynamicLength(buf, 4)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Inners", o6)
+		}
+
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if err = (*NoCopyInner)(nil).ValidateSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Inners", o6)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the NoCopy object from a reader with an encoded size of 'size' bytes
+func (n *NoCopy) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, n)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the NoCopy object from a decoder
+func (n *NoCopy) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 180 {
+		return ssz.NewDecodeError(ssz.ErrSize, "NoCopy", 180, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "NoCopy", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(180)
+	if err != nil {
+		return err
+	}
+	var o1, o2, o4, o5, o6 uint64
+
+	// Field (0) 'Root'
+	if cap(n.Root) == 0 {
+		n.Root = make([]byte, 0, len(buf[0:32]))
+	}
+	n.Root = append(n.Root, buf[0:32]...)
+
+	// Offset (1) 'Bits'
+	if o1 = ssz.ReadOffset(buf[32:36]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "Bits", 32)
+	}
+
+	if o1 != 180 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 180, o1), "NoCopy", "Bits", 32)
+	}
+
+	// Offset (2) 'Data'
+	if o2 = ssz.ReadOffset(buf[36:40]); o2 > size || o1 > o2 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "Data", 36)
+	}
+
+	// Field (3) 'Roots'
+	if err = st.Alloc(4, 24); err != nil {
+		return ssz.WrapDecodeError(err, "NoCopy", "Roots", 40)
+	}
+	n.Roots = make([][]byte, 4)
+	for ii := 0; ii < 4; ii++ {
+		if cap(n.Roots[ii]) == 0 {
+			n.Roots[ii] = make([]byte, 0, len(buf[40:168][ii*32:(ii+1)*32]))
+		}
+		n.Roots[ii] = append(n.Roots[ii], buf[40:168][ii*32:(ii+1)*32]...)
+	}
+
+	// Offset (4) 'List'
+	if o4 = ssz.ReadOffset(buf[168:172]); o4 > size || o2 > o4 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "List", 168)
+	}
+
+	// Offset (5) 'Inner'
+	if o5 = ssz.ReadOffset(buf[172:176]); o5 > size || o4 > o5 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "Inner", 172)
+	}
+
+	// Offset (6) 'Inners'
+	if o6 = ssz.ReadOffset(buf[176:180]); o6 > size || o5 > o6 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "NoCopy", "Inners", 176)
+	}
+
+	// Field (1) 'Bits'
+	{
+		if buf, err = dec.Read(o2 - o1); err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Bits", o1)
+		}
+		if err = ssz.ValidateBitlist(buf, 16); err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Bits", o1)
+		}
+		if cap(n.Bits) == 0 {
+			n.Bits = make([]byte, 0, len(buf))
+		}
+		n.Bits = append(n.Bits, buf...)
+	}
+
+	// Field (2) 'Data'
+	{
+		if o4-o2 > 64 {
+			return ssz.WrapDecodeError(ssz.ErrBytesLength, "NoCopy", "Data", o2)
+		}
+		if buf, err = dec.Read(o4 - o2); err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Data", o2)
+		}
+		if cap(n.Data) == 0 {
+			n.Data = make([]byte, 0, len(buf))
+		}
+		n.Data = append(n.Data, buf...)
+	}
+
+	// Field (4) 'List'
+	{
+		num, err := ssz.DivideInt2(int(o5-o4), 32, 4)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "List", o4)
+		}
+		if err = st.Alloc(num, 24); err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "List", o4)
+		}
+		n.List = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(32); err != nil {
+				return ssz.WrapDecodeError(err, "NoCopy", "List", o4)
+			}
+			if cap(n.List[ii]) == 0 {
+				n.List[ii] = make([]byte, 0, len(buf))
+			}
+			n.List[ii] = append(n.List[ii], buf...)
+		}
+	}
+
+	// Field (5) 'Inner'
+	{
+		if n.Inner == nil {
+			n.Inner = new(NoCopyInner)
+		}
+		if err = dec.DecodeObject(n.Inner, o6-o5); err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Inner", o5)
+		}
+	}
+
+	// Field (6) 'Inners'
+	{
+		sizes, err := dec.ReadDynamicOffsets(size-o6, 4)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Inners", o6)
+		}
+		num := len(sizes)
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(NoCopyInner{}))); err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Inners", o6)
+		}
+		n.Inners = make([]*NoCopyInner, num)
+		if err = st.Enter(); err != nil {
+			return ssz.WrapDecodeError(err, "NoCopy", "Inners", o6)
+		}
+		pos := uint64(4 * num)
+		for indx := 0; indx < num; indx++ {
+			{
+				if n.Inners[indx] == nil {
+					n.Inners[indx] = new(NoCopyInner)
+				}
+				if err = dec.DecodeObject(n.Inners[indx], sizes[indx]); err != nil {
+					return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, indx, pos), "NoCopy", "Inners", o6)
+				}
+			}
+			pos += sizes[indx]
+		}
+		st.Leave()
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the NoCopy object
+func (n *NoCopy) SizeSSZ() (size int) {
+	size = 180
+
+	// Field (1) 'Bits'
+	size += len(n.Bits)
+
+	// Field (2) 'Data'
+	size += len(n.Data)
+
+	// Field (4) 'List'
+	size += len(n.List) * 32
+
+	// Field (5) 'Inner'
+	if n.Inner == nil {
+		n.Inner = new(NoCopyInner)
+	}
+	size += n.Inner.SizeSSZ()
+
+	// Field (6) 'Inners'
+	for ii := 0; ii < len(n.Inners); ii++ {
+		size += 4
+		size += n.Inners[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the NoCopy object
+func (n *NoCopy) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(n)
+}
+
+// HashTreeRootWith ssz hashes the NoCopy object with a hasher
+func (n *NoCopy) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Root'
+	if size := len(n.Root); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Root", size, 32)
+		return
+	}
+	hh.PutBytes(n.Root)
+
+	// Field (1) 'Bits'
+	if len(n.Bits) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(n.Bits, 16)
+
+	// Field (2) 'Data'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(n.Data))
+		if byteLen > 64 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.PutBytes(n.Data)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (64+31)/32)
+	}
+
+	// Field (3) 'Roots'
+	{
+		if size := len(n.Roots); size != 4 {
+			err = ssz.ErrVectorLengthFn("--.Roots", size, 4)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range n.Roots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (4) 'List'
+	{
+		if size := len(n.List); size > 4 {
+			err = ssz.ErrListTooBigFn("--.List", size, 4)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range n.List {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+
+		numItems := uint64(len(n.List))
+		hh.MerkleizeWithMixin(subIndx, numItems, 4)
+	}
+
+	// Field (5) 'Inner'
+	if err = n.Inner.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (6) 'Inners'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(n.Inners))
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = hh.HashElements(len(n.Inners), func(indx int, hh *ssz.Hasher) error {
+			return n.Inners[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 4)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// Copy returns a deep copy of the NoCopy object
+func (n *NoCopy) Copy() *NoCopy {
+	if n == nil {
+		return nil
+	}
+	cpy := new(NoCopy)
+	*cpy = *n
+
+	// Field 'Root'
+	cpy.Root = append(cpy.Root[:0:0], cpy.Root...)
+
+	// Field 'Bits'
+	cpy.Bits = append(cpy.Bits[:0:0], cpy.Bits...)
+
+	// Field 'Data'
+	cpy.Data = append(cpy.Data[:0:0], cpy.Data...)
+
+	// Field 'Roots'
+	cpy.Roots = append(cpy.Roots[:0:0], cpy.Roots...)
+	for i0 := range cpy.Roots {
+		cpy.Roots[i0] = append(cpy.Roots[i0][:0:0], cpy.Roots[i0]...)
+	}
+
+	// Field 'List'
+	cpy.List = append(cpy.List[:0:0], cpy.List...)
+	for i0 := range cpy.List {
+		cpy.List[i0] = append(cpy.List[i0][:0:0], cpy.List[i0]...)
+	}
+
+	// Field 'Inner'
+	cpy.Inner = cpy.Inner.Copy()
+
+	// Field 'Inners'
+	cpy.Inners = append(cpy.Inners[:0:0], cpy.Inners...)
+	for i0 := range cpy.Inners {
+		cpy.Inners[i0] = cpy.Inners[i0].Copy()
+	}
+
+	return cpy
+}
+
+// EqualSSZ returns true if the NoCopy objects have the same SSZ encoding
+func (n *NoCopy) EqualSSZ(other *NoCopy) bool {
+	if n == nil {
+		n = new(NoCopy)
+	}
+	if other == nil {
+		other = new(NoCopy)
+	}
+
+	// Field 'Root'
+	if !bytes.Equal(n.Root, other.Root) {
+		return false
+	}
+
+	// Field 'Bits'
+	if !bytes.Equal(n.Bits, other.Bits) {
+		return false
+	}
+
+	// Field 'Data'
+	if !bytes.Equal(n.Data, other.Data) {
+		return false
+	}
+
+	// Field 'Roots'
+	if len(n.Roots) != len(other.Roots) {
+		return false
+	} else {
+		for i0 := range n.Roots {
+			if !bytes.Equal(n.Roots[i0], other.Roots[i0]) {
+				return false
+			}
+		}
+	}
+
+	// Field 'List'
+	if len(n.List) != len(other.List) {
+		return false
+	} else {
+		for i0 := range n.List {
+			if !bytes.Equal(n.List[i0], other.List[i0]) {
+				return false
+			}
+		}
+	}
+
+	// Field 'Inner'
+	if !n.Inner.EqualSSZ(other.Inner) {
+		return false
+	}
+
+	// Field 'Inners'
+	if len(n.Inners) != len(other.Inners) {
+		return false
+	} else {
+		for i0 := range n.Inners {
+			if !n.Inners[i0].EqualSSZ(other.Inners[i0]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the NoCopy objects
+func (n *NoCopy) DiffSSZ(other *NoCopy) []ssz.FieldDiff {
+	if n == nil {
+		n = new(NoCopy)
+	}
+	if other == nil {
+		other = new(NoCopy)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Root'
+	if !bytes.Equal(n.Root, other.Root) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Root", A: n.Root, B: other.Root})
+	}
+
+	// Field 'Bits'
+	if !bytes.Equal(n.Bits, other.Bits) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Bits", A: n.Bits, B: other.Bits})
+	}
+
+	// Field 'Data'
+	if !bytes.Equal(n.Data, other.Data) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Data", A: n.Data, B: other.Data})
+	}
+
+	// Field 'Roots'
+	if len(n.Roots) != len(other.Roots) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Roots", A: n.Roots, B: other.Roots})
+	} else {
+		for i0 := range n.Roots {
+			if !bytes.Equal(n.Roots[i0], other.Roots[i0]) {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("Roots", i0), A: n.Roots[i0], B: other.Roots[i0]})
+			}
+		}
+	}
+
+	// Field 'List'
+	if len(n.List) != len(other.List) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "List", A: n.List, B: other.List})
+	} else {
+		for i0 := range n.List {
+			if !bytes.Equal(n.List[i0], other.List[i0]) {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("List", i0), A: n.List[i0], B: other.List[i0]})
+			}
+		}
+	}
+
+	// Field 'Inner'
+	diffs = ssz.AppendDiffs(diffs, "Inner", n.Inner.DiffSSZ(other.Inner))
+
+	// Field 'Inners'
+	if len(n.Inners) != len(other.Inners) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Inners", A: n.Inners, B: other.Inners})
+	} else {
+		for i0 := range n.Inners {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("Inners", i0), n.Inners[i0].DiffSSZ(other.Inners[i0]))
+		}
+	}
+
+	return diffs
+}
@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalNoCopy(t *testing.T) {
+	obj := &NoCopy{
+		Root:  bytes.Repeat([]byte{1}, 32),
+		Bits:  []byte{0x3},
+		Data:  []byte{1, 2, 3},
+		Roots: [][]byte{make([]byte, 32), make([]byte, 32), make([]byte, 32), make([]byte, 32)},
+		List:  [][]byte{bytes.Repeat([]byte{2}, 32)},
+		Inner: &NoCopyInner{Data: []byte{4, 5}},
+		Inners: []*NoCopyInner{
+			{Root: [32]byte{1}, Data: []byte{6}},
+			{Data: []byte{}},
+		},
+	}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obj2 := new(NoCopy)
+	if err := obj2.UnmarshalSSZNoCopy(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad unmarshal")
+	}
+
+	// the byte fields reference the input
+	buf[0] = 0xff
+	if obj2.Root[0] != 0xff {
+		t.Fatal("root does not reference the input")
+	}
+	for _, b := range [][]byte{obj2.Bits, obj2.Data, obj2.List[0], obj2.Inner.Data, obj2.Inners[0].Data} {
+		b[0]++
+	}
+	obj3 := new(NoCopy)
+	if err := obj3.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj2, obj3) {
+		t.Fatal("fields do not reference the input")
+	}
+
+	// but appending to them does not overwrite the rest of the input
+	orig := append([]byte{}, buf...)
+	obj2.Root = append(obj2.Root, 1)
+	obj2.Data = append(obj2.Data, 1)
+	if !bytes.Equal(buf, orig) {
+		t.Fatal("append modified the input")
+	}
+
+	// the copy is not affected by changes in the input
+	buf[0] = 0
+	if obj3.Root[0] != 0xff {
+		t.Fatal("copy references the input")
+	}
+}
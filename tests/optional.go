@@ -0,0 +1,19 @@
+package tests
+
+import "github.com/prysmaticlabs/fastssz/tests/uint256"
+
+type Epoch uint64
+
+type OptionalInner struct {
+	A uint32
+	B []byte `ssz-max:"16"`
+}
+
+type OptionalFields struct {
+	A *uint64        `ssz:"optional"`
+	B *OptionalInner `ssz:"optional"`
+	C *bool          `ssz:"optional"`
+	D *uint256.Int   `ssz:"optional"`
+	E *Epoch         `ssz:"optional"`
+	F uint64
+}
@@ -0,0 +1,1326 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 7f05fdb69bc3abca9d1777a6e3783edb6e0748b2adb9ff1f1e90aa576590ddf5
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/fastssz/tests/uint256"
+)
+
+// MarshalSSZ ssz marshals the OptionalInner object
+func (o *OptionalInner) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(o)
+}
+
+// MarshalSSZTo ssz marshals the OptionalInner object to a target array
+func (o *OptionalInner) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint32(dst, o.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(o.B)
+
+	// Field (1) 'B'
+	if size := len(o.B); size > 16 {
+		err = ssz.ErrBytesLengthFn("--.B", size, 16)
+		return
+	}
+	dst = append(dst, o.B...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the OptionalInner object to a writer
+func (o *OptionalInner) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, o)
+}
+
+// MarshalSSZToEncoder ssz marshals the OptionalInner object to an encoder
+func (o *OptionalInner) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(8)
+
+	// Field (0) 'A'
+	enc.EncodeUint32(o.A)
+
+	// Offset (1) 'B'
+	enc.EncodeOffset(offset)
+	offset += len(o.B)
+
+	// Field (1) 'B'
+	if size := len(o.B); size > 16 {
+		err = ssz.ErrBytesLengthFn("--.B", size, 16)
+		return
+	}
+	enc.EncodeBytes(o.B)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the OptionalInner object
+func (o *OptionalInner) UnmarshalSSZ(buf []byte) error {
+	return o.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the OptionalInner object within the limits of the decode state
+func (o *OptionalInner) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "OptionalInner", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.NewDecodeError(ssz.ErrSize, "OptionalInner", 8, size)
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'A'
+	o.A = ssz.UnmarshallUint32(buf[0:4])
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalInner", "B", 4)
+	}
+
+	if o1 != 8 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 8, o1), "OptionalInner", "B", 4)
+	}
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:]
+		if len(buf) > 16 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 16, uint64(len(buf))), "OptionalInner", "B", o1)
+		}
+		if cap(o.B) == 0 {
+			o.B = make([]byte, 0, len(buf))
+		}
+		o.B = append(o.B, buf...)
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a OptionalInner object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (o *OptionalInner) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.NewDecodeError(ssz.ErrSize, "OptionalInner", 8, size)
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalInner", "B", 4)
+	}
+
+	if o1 != 8 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 8, o1), "OptionalInner", "B", 4)
+	}
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:]
+		if len(buf) > 16 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 16, uint64(len(buf))), "OptionalInner", "B", o1)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the OptionalInner object from a reader with an encoded size of 'size' bytes
+func (o *OptionalInner) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, o)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the OptionalInner object from a decoder
+func (o *OptionalInner) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 8 {
+		return ssz.NewDecodeError(ssz.ErrSize, "OptionalInner", 8, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "OptionalInner", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(8)
+	if err != nil {
+		return err
+	}
+	var o1 uint64
+
+	// Field (0) 'A'
+	o.A = ssz.UnmarshallUint32(buf[0:4])
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalInner", "B", 4)
+	}
+
+	if o1 != 8 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 8, o1), "OptionalInner", "B", 4)
+	}
+
+	// Field (1) 'B'
+	{
+		if size-o1 > 16 {
+			return ssz.WrapDecodeError(ssz.ErrBytesLength, "OptionalInner", "B", o1)
+		}
+		if buf, err = dec.Read(size - o1); err != nil {
+			return ssz.WrapDecodeError(err, "OptionalInner", "B", o1)
+		}
+		if cap(o.B) == 0 {
+			o.B = make([]byte, 0, len(buf))
+		}
+		o.B = append(o.B, buf...)
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the OptionalInner object
+func (o *OptionalInner) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (1) 'B'
+	size += len(o.B)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the OptionalInner object
+func (o *OptionalInner) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(o)
+}
+
+// HashTreeRootWith ssz hashes the OptionalInner object with a hasher
+func (o *OptionalInner) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint32(o.A)
+
+	// Field (1) 'B'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(o.B))
+		if byteLen > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.PutBytes(o.B)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (16+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// Copy returns a deep copy of the OptionalInner object
+func (o *OptionalInner) Copy() *OptionalInner {
+	if o == nil {
+		return nil
+	}
+	cpy := new(OptionalInner)
+	*cpy = *o
+
+	// Field 'B'
+	cpy.B = append(cpy.B[:0:0], cpy.B...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the OptionalInner objects have the same SSZ encoding
+func (o *OptionalInner) EqualSSZ(other *OptionalInner) bool {
+	if o == nil {
+		o = new(OptionalInner)
+	}
+	if other == nil {
+		other = new(OptionalInner)
+	}
+
+	// Field 'A'
+	if o.A != other.A {
+		return false
+	}
+
+	// Field 'B'
+	if !bytes.Equal(o.B, other.B) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the OptionalInner objects
+func (o *OptionalInner) DiffSSZ(other *OptionalInner) []ssz.FieldDiff {
+	if o == nil {
+		o = new(OptionalInner)
+	}
+	if other == nil {
+		other = new(OptionalInner)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'A'
+	if o.A != other.A {
+		diffs = append(diffs, ssz.FieldDiff{Path: "A", A: o.A, B: other.A})
+	}
+
+	// Field 'B'
+	if !bytes.Equal(o.B, other.B) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "B", A: o.B, B: other.B})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the OptionalInner object following the Beacon API conventions
+func (o *OptionalInner) MarshalJSON() ([]byte, error) {
+	if o == nil {
+		o = new(OptionalInner)
+	}
+	dst := []byte{'{'}
+
+	// Field (0) 'A'
+	dst = append(dst, "\"A\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(o.A))
+
+	// Field (1) 'B'
+	dst = append(dst, ",\"B\":"...)
+	dst = ssz.MarshalJSONBytes(dst, o.B)
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the OptionalInner object following the Beacon API conventions
+func (o *OptionalInner) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'A'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["A"], 4)
+		if err != nil {
+			return ssz.WrapJSONError(err, "A")
+		}
+		o.A = uint32(val)
+	}
+
+	// Field (1) 'B'
+	if o.B, err = ssz.UnmarshalJSONBytes(fields["B"], 0, 16); err != nil {
+		return ssz.WrapJSONError(err, "B")
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the OptionalFields object
+func (o *OptionalFields) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(o)
+}
+
+// MarshalSSZTo ssz marshals the OptionalFields object to a target array
+func (o *OptionalFields) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(28)
+
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
+	if o.A != nil {
+		offset += 1 + 8
+	}
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+	if o.B != nil {
+		offset += 1 + o.B.SizeSSZ()
+	}
+
+	// Offset (2) 'C'
+	dst = ssz.WriteOffset(dst, offset)
+	if o.C != nil {
+		offset += 1 + 1
+	}
+
+	// Offset (3) 'D'
+	dst = ssz.WriteOffset(dst, offset)
+	if o.D != nil {
+		offset += 1 + 32
+	}
+
+	// Offset (4) 'E'
+	dst = ssz.WriteOffset(dst, offset)
+	if o.E != nil {
+		offset += 1 + 8
+	}
+
+	// Field (5) 'F'
+	dst = ssz.MarshalUint64(dst, o.F)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalOptional(dst, o.A != nil)
+	if o.A != nil {
+		dst = ssz.MarshalUint64(dst, *o.A)
+	}
+
+	// Field (1) 'B'
+	dst = ssz.MarshalOptional(dst, o.B != nil)
+	if o.B != nil {
+		if dst, err = o.B.MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'C'
+	dst = ssz.MarshalOptional(dst, o.C != nil)
+	if o.C != nil {
+		dst = ssz.MarshalBool(dst, *o.C)
+	}
+
+	// Field (3) 'D'
+	dst = ssz.MarshalOptional(dst, o.D != nil)
+	if o.D != nil {
+		dst = ssz.MarshalUint256(dst, *o.D)
+	}
+
+	// Field (4) 'E'
+	dst = ssz.MarshalOptional(dst, o.E != nil)
+	if o.E != nil {
+		dst = ssz.MarshalUint64(dst, uint64(*o.E))
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the OptionalFields object to a writer
+func (o *OptionalFields) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, o)
+}
+
+// MarshalSSZToEncoder ssz marshals the OptionalFields object to an encoder
+func (o *OptionalFields) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(28)
+
+	// Offset (0) 'A'
+	enc.EncodeOffset(offset)
+	if o.A != nil {
+		offset += 1 + 8
+	}
+
+	// Offset (1) 'B'
+	enc.EncodeOffset(offset)
+	if o.B != nil {
+		offset += 1 + o.B.SizeSSZ()
+	}
+
+	// Offset (2) 'C'
+	enc.EncodeOffset(offset)
+	if o.C != nil {
+		offset += 1 + 1
+	}
+
+	// Offset (3) 'D'
+	enc.EncodeOffset(offset)
+	if o.D != nil {
+		offset += 1 + 32
+	}
+
+	// Offset (4) 'E'
+	enc.EncodeOffset(offset)
+	if o.E != nil {
+		offset += 1 + 8
+	}
+
+	// Field (5) 'F'
+	enc.EncodeUint64(o.F)
+
+	// Field (0) 'A'
+	if o.A != nil {
+		enc.EncodeUint8(1)
+		enc.EncodeUint64(*o.A)
+	}
+
+	// Field (1) 'B'
+	if o.B != nil {
+		enc.EncodeUint8(1)
+		if err = enc.EncodeObject(o.B); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'C'
+	if o.C != nil {
+		enc.EncodeUint8(1)
+		enc.EncodeBool(*o.C)
+	}
+
+	// Field (3) 'D'
+	if o.D != nil {
+		enc.EncodeUint8(1)
+		enc.EncodeUint256(*o.D)
+	}
+
+	// Field (4) 'E'
+	if o.E != nil {
+		enc.EncodeUint8(1)
+		enc.EncodeUint64(uint64(*o.E))
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the OptionalFields object
+func (o *OptionalFields) UnmarshalSSZ(buf []byte) error {
+	return o.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the OptionalFields object within the limits of the decode state
+func (o *OptionalFields) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "OptionalFields", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 28 {
+		return ssz.NewDecodeError(ssz.ErrSize, "OptionalFields", 28, size)
+	}
+
+	tail := buf
+	var o0, o1, o2, o3, o4 uint64
+
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalFields", "A", 0)
+	}
+
+	if o0 != 28 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 28, o0), "OptionalFields", "A", 0)
+	}
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalFields", "B", 4)
+	}
+
+	// Offset (2) 'C'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalFields", "C", 8)
+	}
+
+	// Offset (3) 'D'
+	if o3 = ssz.ReadOffset(buf[12:16]); o3 > size || o2 > o3 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalFields", "D", 12)
+	}
+
+	// Offset (4) 'E'
+	if o4 = ssz.ReadOffset(buf[16:20]); o4 > size || o3 > o4 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalFields", "E", 16)
+	}
+
+	// Field (5) 'F'
+	o.F = ssz.UnmarshallUint64(buf[20:28])
+
+	// Field (0) 'A'
+	{
+		buf = tail[o0:o1]
+		body, present, err := ssz.DecodeOptional(buf)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "A", o0)
+		}
+		if !present {
+			o.A = nil
+		} else {
+			if len(body) != 8 {
+				return ssz.WrapDecodeError(ssz.ErrSize, "OptionalFields", "A", o0)
+			}
+			val := ssz.UnmarshallUint64(body)
+			o.A = &val
+		}
+	}
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:o2]
+		body, present, err := ssz.DecodeOptional(buf)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "B", o1)
+		}
+		if !present {
+			o.B = nil
+		} else {
+			if o.B == nil {
+				o.B = new(OptionalInner)
+			}
+			if err = ssz.UnmarshalWithState(o.B, body, st); err != nil {
+				return ssz.WrapDecodeError(err, "OptionalFields", "B", o1)
+			}
+		}
+	}
+
+	// Field (2) 'C'
+	{
+		buf = tail[o2:o3]
+		body, present, err := ssz.DecodeOptional(buf)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "C", o2)
+		}
+		if !present {
+			o.C = nil
+		} else {
+			if len(body) != 1 {
+				return ssz.WrapDecodeError(ssz.ErrSize, "OptionalFields", "C", o2)
+			}
+			val, err := ssz.DecodeBool(body)
+			if err != nil {
+				return ssz.WrapDecodeError(err, "OptionalFields", "C", o2)
+			}
+			o.C = &val
+		}
+	}
+
+	// Field (3) 'D'
+	{
+		buf = tail[o3:o4]
+		body, present, err := ssz.DecodeOptional(buf)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "D", o3)
+		}
+		if !present {
+			o.D = nil
+		} else {
+			if len(body) != 32 {
+				return ssz.WrapDecodeError(ssz.ErrSize, "OptionalFields", "D", o3)
+			}
+			val := uint256.Int(ssz.UnmarshallUint256(body))
+			o.D = &val
+		}
+	}
+
+	// Field (4) 'E'
+	{
+		buf = tail[o4:]
+		body, present, err := ssz.DecodeOptional(buf)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "E", o4)
+		}
+		if !present {
+			o.E = nil
+		} else {
+			if len(body) != 8 {
+				return ssz.WrapDecodeError(ssz.ErrSize, "OptionalFields", "E", o4)
+			}
+			val := Epoch(ssz.UnmarshallUint64(body))
+			o.E = &val
+		}
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a OptionalFields object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (o *OptionalFields) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 28 {
+		return ssz.NewDecodeError(ssz.ErrSize, "OptionalFields", 28, size)
+	}
+
+	tail := buf
+	var o0, o1, o2, o3, o4 uint64
+
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalFields", "A", 0)
+	}
+
+	if o0 != 28 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 28, o0), "OptionalFields", "A", 0)
+	}
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalFields", "B", 4)
+	}
+
+	// Offset (2) 'C'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalFields", "C", 8)
+	}
+
+	// Offset (3) 'D'
+	if o3 = ssz.ReadOffset(buf[12:16]); o3 > size || o2 > o3 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalFields", "D", 12)
+	}
+
+	// Offset (4) 'E'
+	if o4 = ssz.ReadOffset(buf[16:20]); o4 > size || o3 > o4 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalFields", "E", 16)
+	}
+
+	// Field (0) 'A'
+	{
+		buf = tail[o0:o1]
+		body, present, err := ssz.DecodeOptional(buf)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "A", o0)
+		}
+		if present {
+			if len(body) != 8 {
+				return ssz.WrapDecodeError(ssz.ErrSize, "OptionalFields", "A", o0)
+			}
+
+		}
+	}
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:o2]
+		body, present, err := ssz.DecodeOptional(buf)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "B", o1)
+		}
+		if present {
+			if err = (*OptionalInner)(nil).ValidateSSZ(body); err != nil {
+				return ssz.WrapDecodeError(err, "OptionalFields", "B", o1)
+			}
+		}
+	}
+
+	// Field (2) 'C'
+	{
+		buf = tail[o2:o3]
+		body, present, err := ssz.DecodeOptional(buf)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "C", o2)
+		}
+		if present {
+			if len(body) != 1 {
+				return ssz.WrapDecodeError(ssz.ErrSize, "OptionalFields", "C", o2)
+			}
+			if _, err = ssz.DecodeBool(body); err != nil {
+				return ssz.WrapDecodeError(err, "OptionalFields", "C", o2)
+			}
+		}
+	}
+
+	// Field (3) 'D'
+	{
+		buf = tail[o3:o4]
+		body, present, err := ssz.DecodeOptional(buf)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "D", o3)
+		}
+		if present {
+			if len(body) != 32 {
+				return ssz.WrapDecodeError(ssz.ErrSize, "OptionalFields", "D", o3)
+			}
+
+		}
+	}
+
+	// Field (4) 'E'
+	{
+		buf = tail[o4:]
+		body, present, err := ssz.DecodeOptional(buf)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "E", o4)
+		}
+		if present {
+			if len(body) != 8 {
+				return ssz.WrapDecodeError(ssz.ErrSize, "OptionalFields", "E", o4)
+			}
+
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the OptionalFields object from a reader with an encoded size of 'size' bytes
+func (o *OptionalFields) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, o)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the OptionalFields object from a decoder
+func (o *OptionalFields) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 28 {
+		return ssz.NewDecodeError(ssz.ErrSize, "OptionalFields", 28, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "OptionalFields", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(28)
+	if err != nil {
+		return err
+	}
+	var o0, o1, o2, o3, o4 uint64
+
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalFields", "A", 0)
+	}
+
+	if o0 != 28 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 28, o0), "OptionalFields", "A", 0)
+	}
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalFields", "B", 4)
+	}
+
+	// Offset (2) 'C'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalFields", "C", 8)
+	}
+
+	// Offset (3) 'D'
+	if o3 = ssz.ReadOffset(buf[12:16]); o3 > size || o2 > o3 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalFields", "D", 12)
+	}
+
+	// Offset (4) 'E'
+	if o4 = ssz.ReadOffset(buf[16:20]); o4 > size || o3 > o4 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "OptionalFields", "E", 16)
+	}
+
+	// Field (5) 'F'
+	o.F = ssz.UnmarshallUint64(buf[20:28])
+
+	// Field (0) 'A'
+	{
+		if buf, err = dec.Read(o1 - o0); err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "A", o0)
+		}
+		body, present, err := ssz.DecodeOptional(buf)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "A", o0)
+		}
+		if !present {
+			o.A = nil
+		} else {
+			if len(body) != 8 {
+				return ssz.WrapDecodeError(ssz.ErrSize, "OptionalFields", "A", o0)
+			}
+			val := ssz.UnmarshallUint64(body)
+			o.A = &val
+		}
+	}
+
+	// Field (1) 'B'
+	{
+		if o2-o1 == 0 {
+			o.B = nil
+		} else {
+			if buf, err = dec.Read(1); err != nil {
+				return ssz.WrapDecodeError(err, "OptionalFields", "B", o1)
+			}
+			if _, _, err = ssz.DecodeOptional(buf); err != nil {
+				return ssz.WrapDecodeError(err, "OptionalFields", "B", o1)
+			}
+			if o.B == nil {
+				o.B = new(OptionalInner)
+			}
+			if err = dec.DecodeObject(o.B, o2-o1-1); err != nil {
+				return ssz.WrapDecodeError(err, "OptionalFields", "B", o1)
+			}
+		}
+	}
+
+	// Field (2) 'C'
+	{
+		if buf, err = dec.Read(o3 - o2); err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "C", o2)
+		}
+		body, present, err := ssz.DecodeOptional(buf)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "C", o2)
+		}
+		if !present {
+			o.C = nil
+		} else {
+			if len(body) != 1 {
+				return ssz.WrapDecodeError(ssz.ErrSize, "OptionalFields", "C", o2)
+			}
+			val, err := ssz.DecodeBool(body)
+			if err != nil {
+				return ssz.WrapDecodeError(err, "OptionalFields", "C", o2)
+			}
+			o.C = &val
+		}
+	}
+
+	// Field (3) 'D'
+	{
+		if buf, err = dec.Read(o4 - o3); err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "D", o3)
+		}
+		body, present, err := ssz.DecodeOptional(buf)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "D", o3)
+		}
+		if !present {
+			o.D = nil
+		} else {
+			if len(body) != 32 {
+				return ssz.WrapDecodeError(ssz.ErrSize, "OptionalFields", "D", o3)
+			}
+			val := uint256.Int(ssz.UnmarshallUint256(body))
+			o.D = &val
+		}
+	}
+
+	// Field (4) 'E'
+	{
+		if buf, err = dec.Read(size - o4); err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "E", o4)
+		}
+		body, present, err := ssz.DecodeOptional(buf)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "OptionalFields", "E", o4)
+		}
+		if !present {
+			o.E = nil
+		} else {
+			if len(body) != 8 {
+				return ssz.WrapDecodeError(ssz.ErrSize, "OptionalFields", "E", o4)
+			}
+			val := Epoch(ssz.UnmarshallUint64(body))
+			o.E = &val
+		}
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the OptionalFields object
+func (o *OptionalFields) SizeSSZ() (size int) {
+	size = 28
+
+	// Field (0) 'A'
+	if o.A != nil {
+		size += 1 + 8
+	}
+
+	// Field (1) 'B'
+	if o.B != nil {
+		size += 1 + o.B.SizeSSZ()
+	}
+
+	// Field (2) 'C'
+	if o.C != nil {
+		size += 1 + 1
+	}
+
+	// Field (3) 'D'
+	if o.D != nil {
+		size += 1 + 32
+	}
+
+	// Field (4) 'E'
+	if o.E != nil {
+		size += 1 + 8
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the OptionalFields object
+func (o *OptionalFields) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(o)
+}
+
+// HashTreeRootWith ssz hashes the OptionalFields object with a hasher
+func (o *OptionalFields) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	{
+		subIndx := hh.Index()
+		if o.A == nil {
+			hh.MerkleizeWithSelector(subIndx, 0)
+		} else {
+			hh.PutUint64(*o.A)
+			hh.MerkleizeWithSelector(subIndx, 1)
+		}
+	}
+
+	// Field (1) 'B'
+	{
+		subIndx := hh.Index()
+		if o.B == nil {
+			hh.MerkleizeWithSelector(subIndx, 0)
+		} else {
+			if err = o.B.HashTreeRootWith(hh); err != nil {
+				return
+			}
+			hh.MerkleizeWithSelector(subIndx, 1)
+		}
+	}
+
+	// Field (2) 'C'
+	{
+		subIndx := hh.Index()
+		if o.C == nil {
+			hh.MerkleizeWithSelector(subIndx, 0)
+		} else {
+			hh.PutBool(*o.C)
+			hh.MerkleizeWithSelector(subIndx, 1)
+		}
+	}
+
+	// Field (3) 'D'
+	{
+		subIndx := hh.Index()
+		if o.D == nil {
+			hh.MerkleizeWithSelector(subIndx, 0)
+		} else {
+			hh.PutUint256(*o.D)
+			hh.MerkleizeWithSelector(subIndx, 1)
+		}
+	}
+
+	// Field (4) 'E'
+	{
+		subIndx := hh.Index()
+		if o.E == nil {
+			hh.MerkleizeWithSelector(subIndx, 0)
+		} else {
+			hh.PutUint64(uint64(*o.E))
+			hh.MerkleizeWithSelector(subIndx, 1)
+		}
+	}
+
+	// Field (5) 'F'
+	hh.PutUint64(o.F)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// Copy returns a deep copy of the OptionalFields object
+func (o *OptionalFields) Copy() *OptionalFields {
+	if o == nil {
+		return nil
+	}
+	cpy := new(OptionalFields)
+	*cpy = *o
+
+	// Field 'A'
+	if cpy.A != nil {
+		val := *cpy.A
+		cpy.A = &val
+	}
+
+	// Field 'B'
+	cpy.B = cpy.B.Copy()
+
+	// Field 'C'
+	if cpy.C != nil {
+		val := *cpy.C
+		cpy.C = &val
+	}
+
+	// Field 'D'
+	if cpy.D != nil {
+		val := *cpy.D
+		cpy.D = &val
+	}
+
+	// Field 'E'
+	if cpy.E != nil {
+		val := *cpy.E
+		cpy.E = &val
+	}
+
+	return cpy
+}
+
+// EqualSSZ returns true if the OptionalFields objects have the same SSZ encoding
+func (o *OptionalFields) EqualSSZ(other *OptionalFields) bool {
+	if o == nil {
+		o = new(OptionalFields)
+	}
+	if other == nil {
+		other = new(OptionalFields)
+	}
+
+	// Field 'A'
+	if (o.A == nil) != (other.A == nil) {
+		return false
+	} else if o.A != nil {
+		if *o.A != *other.A {
+			return false
+		}
+	}
+
+	// Field 'B'
+	if (o.B == nil) != (other.B == nil) {
+		return false
+	} else if o.B != nil {
+		if !o.B.EqualSSZ(other.B) {
+			return false
+		}
+	}
+
+	// Field 'C'
+	if (o.C == nil) != (other.C == nil) {
+		return false
+	} else if o.C != nil {
+		if *o.C != *other.C {
+			return false
+		}
+	}
+
+	// Field 'D'
+	if (o.D == nil) != (other.D == nil) {
+		return false
+	} else if o.D != nil {
+		if *o.D != *other.D {
+			return false
+		}
+	}
+
+	// Field 'E'
+	if (o.E == nil) != (other.E == nil) {
+		return false
+	} else if o.E != nil {
+		if *o.E != *other.E {
+			return false
+		}
+	}
+
+	// Field 'F'
+	if o.F != other.F {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the OptionalFields objects
+func (o *OptionalFields) DiffSSZ(other *OptionalFields) []ssz.FieldDiff {
+	if o == nil {
+		o = new(OptionalFields)
+	}
+	if other == nil {
+		other = new(OptionalFields)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'A'
+	if (o.A == nil) != (other.A == nil) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "A", A: o.A, B: other.A})
+	} else if o.A != nil {
+		if *o.A != *other.A {
+			diffs = append(diffs, ssz.FieldDiff{Path: "A", A: o.A, B: other.A})
+		}
+	}
+
+	// Field 'B'
+	if (o.B == nil) != (other.B == nil) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "B", A: o.B, B: other.B})
+	} else if o.B != nil {
+		diffs = ssz.AppendDiffs(diffs, "B", o.B.DiffSSZ(other.B))
+	}
+
+	// Field 'C'
+	if (o.C == nil) != (other.C == nil) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "C", A: o.C, B: other.C})
+	} else if o.C != nil {
+		if *o.C != *other.C {
+			diffs = append(diffs, ssz.FieldDiff{Path: "C", A: o.C, B: other.C})
+		}
+	}
+
+	// Field 'D'
+	if (o.D == nil) != (other.D == nil) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "D", A: o.D, B: other.D})
+	} else if o.D != nil {
+		if *o.D != *other.D {
+			diffs = append(diffs, ssz.FieldDiff{Path: "D", A: o.D, B: other.D})
+		}
+	}
+
+	// Field 'E'
+	if (o.E == nil) != (other.E == nil) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "E", A: o.E, B: other.E})
+	} else if o.E != nil {
+		if *o.E != *other.E {
+			diffs = append(diffs, ssz.FieldDiff{Path: "E", A: o.E, B: other.E})
+		}
+	}
+
+	// Field 'F'
+	if o.F != other.F {
+		diffs = append(diffs, ssz.FieldDiff{Path: "F", A: o.F, B: other.F})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the OptionalFields object following the Beacon API conventions
+func (o *OptionalFields) MarshalJSON() ([]byte, error) {
+	if o == nil {
+		o = new(OptionalFields)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'A'
+	dst = append(dst, "\"A\":"...)
+	if o.A == nil {
+		dst = append(dst, "null"...)
+	} else {
+		dst = ssz.MarshalJSONUint64(dst, uint64(*o.A))
+	}
+
+	// Field (1) 'B'
+	dst = append(dst, ",\"B\":"...)
+	if o.B == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if dst, err = ssz.MarshalJSONObject(dst, o.B); err != nil {
+			return nil, err
+		}
+	}
+
+	// Field (2) 'C'
+	dst = append(dst, ",\"C\":"...)
+	if o.C == nil {
+		dst = append(dst, "null"...)
+	} else {
+		dst = ssz.MarshalJSONBool(dst, *o.C)
+	}
+
+	// Field (3) 'D'
+	dst = append(dst, ",\"D\":"...)
+	if o.D == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if o.D == nil {
+			dst = ssz.MarshalJSONUint(dst, nil)
+		} else {
+			dst = ssz.MarshalJSONUint(dst, ssz.MarshalUint256(nil, *o.D))
+		}
+	}
+
+	// Field (4) 'E'
+	dst = append(dst, ",\"E\":"...)
+	if o.E == nil {
+		dst = append(dst, "null"...)
+	} else {
+		dst = ssz.MarshalJSONUint64(dst, uint64(*o.E))
+	}
+
+	// Field (5) 'F'
+	dst = append(dst, ",\"F\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(o.F))
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the OptionalFields object following the Beacon API conventions
+func (o *OptionalFields) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'A'
+	if ssz.IsJSONNull(fields["A"]) {
+		o.A = nil
+	} else {
+		o.A = new(uint64)
+		{
+			val, err := ssz.UnmarshalJSONUint64(fields["A"], 8)
+			if err != nil {
+				return ssz.WrapJSONError(err, "A")
+			}
+			*o.A = uint64(val)
+		}
+	}
+
+	// Field (1) 'B'
+	if ssz.IsJSONNull(fields["B"]) {
+		o.B = nil
+	} else {
+		if o.B == nil {
+			o.B = new(OptionalInner)
+		}
+		if err = ssz.UnmarshalJSONObject(fields["B"], o.B); err != nil {
+			return ssz.WrapJSONError(err, "B")
+		}
+	}
+
+	// Field (2) 'C'
+	if ssz.IsJSONNull(fields["C"]) {
+		o.C = nil
+	} else {
+		o.C = new(bool)
+		if *o.C, err = ssz.UnmarshalJSONBool(fields["C"]); err != nil {
+			return ssz.WrapJSONError(err, "C")
+		}
+	}
+
+	// Field (3) 'D'
+	if ssz.IsJSONNull(fields["D"]) {
+		o.D = nil
+	} else {
+		{
+			val, err := ssz.UnmarshalJSONUint(fields["D"], 32)
+			if err != nil {
+				return ssz.WrapJSONError(err, "D")
+			}
+			o.D = new(uint256.Int)
+			*o.D = ssz.UnmarshallUint256(val)
+		}
+	}
+
+	// Field (4) 'E'
+	if ssz.IsJSONNull(fields["E"]) {
+		o.E = nil
+	} else {
+		o.E = new(Epoch)
+		{
+			val, err := ssz.UnmarshalJSONUint64(fields["E"], 8)
+			if err != nil {
+				return ssz.WrapJSONError(err, "E")
+			}
+			*o.E = Epoch(val)
+		}
+	}
+
+	// Field (5) 'F'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["F"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "F")
+		}
+		o.F = uint64(val)
+	}
+
+	return nil
+}
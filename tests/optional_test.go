@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/minio/sha256-simd"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/fastssz/tests/uint256"
+)
+
+func TestOptional(t *testing.T) {
+	a := uint64(10)
+	c := true
+	e := Epoch(5)
+
+	cases := []*OptionalFields{
+		{F: 1},
+		{A: &a, C: &c, E: &e, F: 2},
+		{
+			A: &a,
+			B: &OptionalInner{A: 1, B: []byte{1, 2}},
+			C: &c,
+			D: &uint256.Int{1, 2, 3, 4},
+			E: &e,
+			F: 3,
+		},
+	}
+	for _, obj := range cases {
+		buf, err := obj.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(buf) != obj.SizeSSZ() {
+			t.Fatalf("bad size, expected %d but found %d", obj.SizeSSZ(), len(buf))
+		}
+
+		obj2 := new(OptionalFields)
+		if err := obj2.UnmarshalSSZ(buf); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(obj, obj2) {
+			t.Fatal("bad unmarshal")
+		}
+
+		obj3 := new(OptionalFields)
+		if err := obj3.UnmarshalSSZFromReader(bytes.NewReader(buf), len(buf)); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(obj, obj3) {
+			t.Fatal("bad streamed decoding")
+		}
+	}
+}
+
+func TestOptionalEncoding(t *testing.T) {
+	a := uint64(10)
+
+	// None has an empty encoding
+	buf, err := (&OptionalFields{}).MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buf) != 28 {
+		t.Fatalf("bad size %d", len(buf))
+	}
+
+	// Some is prefixed with 0x01
+	buf, err = (&OptionalFields{A: &a}).MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf[28:], append([]byte{1}, ssz.MarshalUint64(nil, a)...)) {
+		t.Fatalf("bad encoding %x", buf[28:])
+	}
+
+	// any other prefix is invalid
+	buf[28] = 2
+	if err := new(OptionalFields).UnmarshalSSZ(buf); !errors.Is(err, ssz.ErrInvalidEncoding) {
+		t.Fatalf("expected invalid encoding but found %v", err)
+	}
+	if err := new(OptionalFields).ValidateSSZ(buf); !errors.Is(err, ssz.ErrInvalidEncoding) {
+		t.Fatalf("expected invalid encoding on validation but found %v", err)
+	}
+}
+
+func TestOptionalHashTreeRoot(t *testing.T) {
+	a := uint64(10)
+
+	mixInSelector := func(root []byte, selector byte) []byte {
+		mix := make([]byte, 32)
+		mix[0] = selector
+		res := sha256.Sum256(append(root, mix...))
+		return res[:]
+	}
+
+	// None is mix_in_selector(Bytes32(), 0) and Some is mix_in_selector(hash_tree_root(value), 1)
+	none := mixInSelector(make([]byte, 32), 0)
+	leaf := make([]byte, 32)
+	ssz.MarshalUint64(leaf[:0], a)
+	some := mixInSelector(leaf, 1)
+
+	for _, c := range []struct {
+		obj  *OptionalFields
+		root []byte
+	}{
+		{&OptionalFields{}, none},
+		{&OptionalFields{A: &a}, some},
+	} {
+		root, err := c.obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// F is zero so its leaf is empty
+		leaves := make([]byte, 8*32)
+		copy(leaves, c.root)
+		for i := 1; i < 5; i++ {
+			copy(leaves[i*32:], none)
+		}
+		expected := ssz.NewHasher()
+		expected.PutBytes(leaves)
+		expectedRoot, err := expected.HashRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != expectedRoot {
+			t.Fatalf("bad root, expected %x but found %x", expectedRoot, root)
+		}
+	}
+}
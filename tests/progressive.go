@@ -0,0 +1,12 @@
+package tests
+
+type ProgressiveItem struct {
+	A uint64
+	B []byte `ssz-max:"8"`
+}
+
+type ProgressiveLists struct {
+	A []uint64           `ssz:"progressive-list"`
+	B []byte             `ssz:"progressive-list"`
+	C []*ProgressiveItem `ssz:"progressive-list"`
+}
@@ -0,0 +1,1061 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 04189312449b4dde7677d52a8ae9ffbb420ebfb7e8cb12d79d286fd8d319479c
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math"
+	"unsafe"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+)
+
+// MarshalSSZ ssz marshals the ProgressiveItem object
+func (p *ProgressiveItem) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the ProgressiveItem object to a target array
+func (p *ProgressiveItem) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(12)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, p.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.B)
+
+	// Field (1) 'B'
+	if size := len(p.B); size > 8 {
+		err = ssz.ErrBytesLengthFn("--.B", size, 8)
+		return
+	}
+	dst = append(dst, p.B...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ProgressiveItem object to a writer
+func (p *ProgressiveItem) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, p)
+}
+
+// MarshalSSZToEncoder ssz marshals the ProgressiveItem object to an encoder
+func (p *ProgressiveItem) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(12)
+
+	// Field (0) 'A'
+	enc.EncodeUint64(p.A)
+
+	// Offset (1) 'B'
+	enc.EncodeOffset(offset)
+	offset += len(p.B)
+
+	// Field (1) 'B'
+	if size := len(p.B); size > 8 {
+		err = ssz.ErrBytesLengthFn("--.B", size, 8)
+		return
+	}
+	enc.EncodeBytes(p.B)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ProgressiveItem object
+func (p *ProgressiveItem) UnmarshalSSZ(buf []byte) error {
+	return p.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the ProgressiveItem object within the limits of the decode state
+func (p *ProgressiveItem) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "ProgressiveItem", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 12 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ProgressiveItem", 12, size)
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'A'
+	p.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ProgressiveItem", "B", 8)
+	}
+
+	if o1 != 12 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 12, o1), "ProgressiveItem", "B", 8)
+	}
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:]
+		if len(buf) > 8 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 8, uint64(len(buf))), "ProgressiveItem", "B", o1)
+		}
+		if cap(p.B) == 0 {
+			p.B = make([]byte, 0, len(buf))
+		}
+		p.B = append(p.B, buf...)
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a ProgressiveItem object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (p *ProgressiveItem) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ProgressiveItem", 12, size)
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ProgressiveItem", "B", 8)
+	}
+
+	if o1 != 12 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 12, o1), "ProgressiveItem", "B", 8)
+	}
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:]
+		if len(buf) > 8 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 8, uint64(len(buf))), "ProgressiveItem", "B", o1)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the ProgressiveItem object from a reader with an encoded size of 'size' bytes
+func (p *ProgressiveItem) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, p)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the ProgressiveItem object from a decoder
+func (p *ProgressiveItem) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 12 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ProgressiveItem", 12, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "ProgressiveItem", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(12)
+	if err != nil {
+		return err
+	}
+	var o1 uint64
+
+	// Field (0) 'A'
+	p.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ProgressiveItem", "B", 8)
+	}
+
+	if o1 != 12 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 12, o1), "ProgressiveItem", "B", 8)
+	}
+
+	// Field (1) 'B'
+	{
+		if size-o1 > 8 {
+			return ssz.WrapDecodeError(ssz.ErrBytesLength, "ProgressiveItem", "B", o1)
+		}
+		if buf, err = dec.Read(size - o1); err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveItem", "B", o1)
+		}
+		if cap(p.B) == 0 {
+			p.B = make([]byte, 0, len(buf))
+		}
+		p.B = append(p.B, buf...)
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ProgressiveItem object
+func (p *ProgressiveItem) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (1) 'B'
+	size += len(p.B)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ProgressiveItem object
+func (p *ProgressiveItem) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the ProgressiveItem object with a hasher
+func (p *ProgressiveItem) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(p.A)
+
+	// Field (1) 'B'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(p.B))
+		if byteLen > 8 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.PutBytes(p.B)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (8+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// ProgressiveItemView is a lazy view over the SSZ encoding of a ProgressiveItem object
+type ProgressiveItemView struct {
+	v ssz.View
+}
+
+// NewProgressiveItemView creates a view over the SSZ encoding of a ProgressiveItem object
+func NewProgressiveItemView(buf []byte) ProgressiveItemView {
+	return ProgressiveItemViewOf(ssz.NewView(buf))
+}
+
+// ProgressiveItemViewOf returns the view of the ProgressiveItem object encoded in v
+func ProgressiveItemViewOf(v ssz.View) ProgressiveItemView {
+	return ProgressiveItemView{v.Container(12, 8)}
+}
+
+// Raw returns the untyped view of the ProgressiveItem object
+func (v ProgressiveItemView) Raw() ssz.View {
+	return v.v
+}
+
+// ProgressiveItemListView is a lazy view over a list of ProgressiveItem objects
+type ProgressiveItemListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th ProgressiveItem object of the list
+func (l ProgressiveItemListView) At(i int) ProgressiveItemView {
+	return ProgressiveItemViewOf(l.ListView.At(i))
+}
+
+// A returns the A field of the ProgressiveItem object
+func (v ProgressiveItemView) A() (uint64, error) {
+	return v.v.Field(0, 8).Uint64()
+}
+
+// B returns the B field of the ProgressiveItem object
+func (v ProgressiveItemView) B() ([]byte, error) {
+	return v.v.DynamicField(8, 0).ByteList(8)
+}
+
+// Copy returns a deep copy of the ProgressiveItem object
+func (p *ProgressiveItem) Copy() *ProgressiveItem {
+	if p == nil {
+		return nil
+	}
+	cpy := new(ProgressiveItem)
+	*cpy = *p
+
+	// Field 'B'
+	cpy.B = append(cpy.B[:0:0], cpy.B...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the ProgressiveItem objects have the same SSZ encoding
+func (p *ProgressiveItem) EqualSSZ(other *ProgressiveItem) bool {
+	if p == nil {
+		p = new(ProgressiveItem)
+	}
+	if other == nil {
+		other = new(ProgressiveItem)
+	}
+
+	// Field 'A'
+	if p.A != other.A {
+		return false
+	}
+
+	// Field 'B'
+	if !bytes.Equal(p.B, other.B) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the ProgressiveItem objects
+func (p *ProgressiveItem) DiffSSZ(other *ProgressiveItem) []ssz.FieldDiff {
+	if p == nil {
+		p = new(ProgressiveItem)
+	}
+	if other == nil {
+		other = new(ProgressiveItem)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'A'
+	if p.A != other.A {
+		diffs = append(diffs, ssz.FieldDiff{Path: "A", A: p.A, B: other.A})
+	}
+
+	// Field 'B'
+	if !bytes.Equal(p.B, other.B) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "B", A: p.B, B: other.B})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the ProgressiveItem object following the Beacon API conventions
+func (p *ProgressiveItem) MarshalJSON() ([]byte, error) {
+	if p == nil {
+		p = new(ProgressiveItem)
+	}
+	dst := []byte{'{'}
+
+	// Field (0) 'A'
+	dst = append(dst, "\"A\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(p.A))
+
+	// Field (1) 'B'
+	dst = append(dst, ",\"B\":"...)
+	dst = ssz.MarshalJSONBytes(dst, p.B)
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the ProgressiveItem object following the Beacon API conventions
+func (p *ProgressiveItem) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'A'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["A"], 8)
+		if err != nil {
+			return ssz.WrapJSONError(err, "A")
+		}
+		p.A = uint64(val)
+	}
+
+	// Field (1) 'B'
+	if p.B, err = ssz.UnmarshalJSONBytes(fields["B"], 0, 8); err != nil {
+		return ssz.WrapJSONError(err, "B")
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the ProgressiveLists object
+func (p *ProgressiveLists) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the ProgressiveLists object to a target array
+func (p *ProgressiveLists) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(12)
+
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.A) * 8
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.B) * 1
+
+	// Offset (2) 'C'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(p.C); ii++ {
+		offset += 4
+		offset += p.C[ii].SizeSSZ()
+	}
+
+	// Field (0) 'A'
+	for ii := 0; ii < len(p.A); ii++ {
+		dst = ssz.MarshalUint64(dst, p.A[ii])
+	}
+
+	// Field (1) 'B'
+	for ii := 0; ii < len(p.B); ii++ {
+		dst = ssz.MarshalUint8(dst, p.B[ii])
+	}
+
+	// Field (2) 'C'
+	{
+		offset = 4 * len(p.C)
+		for ii := 0; ii < len(p.C); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += p.C[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(p.C); ii++ {
+		if dst, err = p.C[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ProgressiveLists object to a writer
+func (p *ProgressiveLists) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, p)
+}
+
+// MarshalSSZToEncoder ssz marshals the ProgressiveLists object to an encoder
+func (p *ProgressiveLists) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(12)
+
+	// Offset (0) 'A'
+	enc.EncodeOffset(offset)
+	offset += len(p.A) * 8
+
+	// Offset (1) 'B'
+	enc.EncodeOffset(offset)
+	offset += len(p.B) * 1
+
+	// Offset (2) 'C'
+	enc.EncodeOffset(offset)
+	for ii := 0; ii < len(p.C); ii++ {
+		offset += 4
+		offset += p.C[ii].SizeSSZ()
+	}
+
+	// Field (0) 'A'
+	for ii := 0; ii < len(p.A); ii++ {
+		enc.EncodeUint64(p.A[ii])
+	}
+
+	// Field (1) 'B'
+	for ii := 0; ii < len(p.B); ii++ {
+		enc.EncodeUint8(p.B[ii])
+	}
+
+	// Field (2) 'C'
+	{
+		offset = 4 * len(p.C)
+		for ii := 0; ii < len(p.C); ii++ {
+			enc.EncodeOffset(offset)
+			offset += p.C[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(p.C); ii++ {
+		if err = enc.EncodeObject(p.C[ii]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ProgressiveLists object
+func (p *ProgressiveLists) UnmarshalSSZ(buf []byte) error {
+	return p.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the ProgressiveLists object within the limits of the decode state
+func (p *ProgressiveLists) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "ProgressiveLists", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 12 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ProgressiveLists", 12, size)
+	}
+
+	tail := buf
+	var o0, o1, o2 uint64
+
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ProgressiveLists", "A", 0)
+	}
+
+	if o0 != 12 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 12, o0), "ProgressiveLists", "A", 0)
+	}
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ProgressiveLists", "B", 4)
+	}
+
+	// Offset (2) 'C'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ProgressiveLists", "C", 8)
+	}
+
+	// Field (0) 'A'
+	{
+		buf = tail[o0:o1]
+		num, err := ssz.DivideInt2(len(buf), 8, len(buf))
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "A", o0)
+		}
+		if p.A, err = st.ExtendUint64(p.A, num); err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "A", o0)
+		}
+		for ii := 0; ii < num; ii++ {
+			p.A[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:o2]
+		num, err := ssz.DivideInt2(len(buf), 1, len(buf))
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "B", o1)
+		}
+		if p.B, err = st.ExtendUint8(p.B, num); err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "B", o1)
+		}
+		for ii := 0; ii < num; ii++ {
+			p.B[ii] = ssz.UnmarshallUint8(buf[ii*1 : (ii+1)*1])
+		}
+	}
+
+	// Field (2) 'C'
+	{
+		buf = tail[o2:]
+		num, err := ssz.DecodeDynamicLength(buf, len(buf))
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "C", o2)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(ProgressiveItem{}))); err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "C", o2)
+		}
+		p.C = make([]*ProgressiveItem, num)
+		err = st.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if p.C[indx] == nil {
+				p.C[indx] = new(ProgressiveItem)
+			}
+			if err = ssz.UnmarshalWithState(p.C[indx], buf, st); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "C", o2)
+		}
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a ProgressiveLists object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (p *ProgressiveLists) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ProgressiveLists", 12, size)
+	}
+
+	tail := buf
+	var o0, o1, o2 uint64
+
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ProgressiveLists", "A", 0)
+	}
+
+	if o0 != 12 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 12, o0), "ProgressiveLists", "A", 0)
+	}
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ProgressiveLists", "B", 4)
+	}
+
+	// Offset (2) 'C'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ProgressiveLists", "C", 8)
+	}
+
+	// Field (0) 'A'
+	{
+		buf = tail[o0:o1]
+		if _, err = ssz.DivideInt2(len(buf), 8, len(buf)); err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "A", o0)
+		}
+	}
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:o2]
+		if _, err = ssz.DivideInt2(len(buf), 1, len(buf)); err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "B", o1)
+		}
+	}
+
+	// Field (2) 'C'
+	{
+		buf = tail[o2:]
+		num, err := ssz.DecodeDynamicLength(buf, len(buf))
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "C", o2)
+		}
+
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if err = (*ProgressiveItem)(nil).ValidateSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "C", o2)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the ProgressiveLists object from a reader with an encoded size of 'size' bytes
+func (p *ProgressiveLists) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, p)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the ProgressiveLists object from a decoder
+func (p *ProgressiveLists) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 12 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ProgressiveLists", 12, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "ProgressiveLists", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(12)
+	if err != nil {
+		return err
+	}
+	var o0, o1, o2 uint64
+
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ProgressiveLists", "A", 0)
+	}
+
+	if o0 != 12 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 12, o0), "ProgressiveLists", "A", 0)
+	}
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ProgressiveLists", "B", 4)
+	}
+
+	// Offset (2) 'C'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ProgressiveLists", "C", 8)
+	}
+
+	// Field (0) 'A'
+	{
+		num, err := ssz.DivideInt2(int(o1-o0), 8, int(o1-o0))
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "A", o0)
+		}
+		if p.A, err = st.ExtendUint64(p.A, num); err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "A", o0)
+		}
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(8); err != nil {
+				return ssz.WrapDecodeError(err, "ProgressiveLists", "A", o0)
+			}
+			p.A[ii] = ssz.UnmarshallUint64(buf)
+		}
+	}
+
+	// Field (1) 'B'
+	{
+		num, err := ssz.DivideInt2(int(o2-o1), 1, int(o2-o1))
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "B", o1)
+		}
+		if p.B, err = st.ExtendUint8(p.B, num); err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "B", o1)
+		}
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(1); err != nil {
+				return ssz.WrapDecodeError(err, "ProgressiveLists", "B", o1)
+			}
+			p.B[ii] = ssz.UnmarshallUint8(buf)
+		}
+	}
+
+	// Field (2) 'C'
+	{
+		sizes, err := dec.ReadDynamicOffsets(size-o2, int(size-o2))
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "C", o2)
+		}
+		num := len(sizes)
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(ProgressiveItem{}))); err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "C", o2)
+		}
+		p.C = make([]*ProgressiveItem, num)
+		if err = st.Enter(); err != nil {
+			return ssz.WrapDecodeError(err, "ProgressiveLists", "C", o2)
+		}
+		pos := uint64(4 * num)
+		for indx := 0; indx < num; indx++ {
+			{
+				if p.C[indx] == nil {
+					p.C[indx] = new(ProgressiveItem)
+				}
+				if err = dec.DecodeObject(p.C[indx], sizes[indx]); err != nil {
+					return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, indx, pos), "ProgressiveLists", "C", o2)
+				}
+			}
+			pos += sizes[indx]
+		}
+		st.Leave()
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ProgressiveLists object
+func (p *ProgressiveLists) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (0) 'A'
+	size += len(p.A) * 8
+
+	// Field (1) 'B'
+	size += len(p.B) * 1
+
+	// Field (2) 'C'
+	for ii := 0; ii < len(p.C); ii++ {
+		size += 4
+		size += p.C[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ProgressiveLists object
+func (p *ProgressiveLists) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the ProgressiveLists object with a hasher
+func (p *ProgressiveLists) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(p.A))
+		for _, elem := range p.A {
+			hh.AppendUint64(elem)
+		}
+		hh.FillUpTo32()
+		hh.MerkleizeProgressiveWithMixin(subIndx, num)
+	}
+
+	// Field (1) 'B'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(p.B))
+		for _, elem := range p.B {
+			hh.AppendUint8(elem)
+		}
+		hh.FillUpTo32()
+		hh.MerkleizeProgressiveWithMixin(subIndx, num)
+	}
+
+	// Field (2) 'C'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(p.C))
+		if err = hh.HashElements(len(p.C), func(indx int, hh *ssz.Hasher) error {
+			return p.C[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
+		}
+		hh.MerkleizeProgressiveWithMixin(subIndx, num)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// ProgressiveListsView is a lazy view over the SSZ encoding of a ProgressiveLists object
+type ProgressiveListsView struct {
+	v ssz.View
+}
+
+// NewProgressiveListsView creates a view over the SSZ encoding of a ProgressiveLists object
+func NewProgressiveListsView(buf []byte) ProgressiveListsView {
+	return ProgressiveListsViewOf(ssz.NewView(buf))
+}
+
+// ProgressiveListsViewOf returns the view of the ProgressiveLists object encoded in v
+func ProgressiveListsViewOf(v ssz.View) ProgressiveListsView {
+	return ProgressiveListsView{v.Container(12, 0)}
+}
+
+// Raw returns the untyped view of the ProgressiveLists object
+func (v ProgressiveListsView) Raw() ssz.View {
+	return v.v
+}
+
+// ProgressiveListsListView is a lazy view over a list of ProgressiveLists objects
+type ProgressiveListsListView struct {
+	ssz.ListView
+}
+
+// At returns the view of the i-th ProgressiveLists object of the list
+func (l ProgressiveListsListView) At(i int) ProgressiveListsView {
+	return ProgressiveListsViewOf(l.ListView.At(i))
+}
+
+// A returns the A field of the ProgressiveLists object
+func (v ProgressiveListsView) A() ssz.ListView {
+	return v.v.DynamicField(0, 4).List(8, math.MaxInt)
+}
+
+// B returns the B field of the ProgressiveLists object
+func (v ProgressiveListsView) B() ssz.ListView {
+	return v.v.DynamicField(4, 8).List(1, math.MaxInt)
+}
+
+// C returns the C field of the ProgressiveLists object
+func (v ProgressiveListsView) C() ProgressiveItemListView {
+	return ProgressiveItemListView{v.v.DynamicField(8, 0).List(0, math.MaxInt)}
+}
+
+// Copy returns a deep copy of the ProgressiveLists object
+func (p *ProgressiveLists) Copy() *ProgressiveLists {
+	if p == nil {
+		return nil
+	}
+	cpy := new(ProgressiveLists)
+	*cpy = *p
+
+	// Field 'A'
+	cpy.A = append(cpy.A[:0:0], cpy.A...)
+
+	// Field 'B'
+	cpy.B = append(cpy.B[:0:0], cpy.B...)
+
+	// Field 'C'
+	cpy.C = append(cpy.C[:0:0], cpy.C...)
+	for i0 := range cpy.C {
+		cpy.C[i0] = cpy.C[i0].Copy()
+	}
+
+	return cpy
+}
+
+// EqualSSZ returns true if the ProgressiveLists objects have the same SSZ encoding
+func (p *ProgressiveLists) EqualSSZ(other *ProgressiveLists) bool {
+	if p == nil {
+		p = new(ProgressiveLists)
+	}
+	if other == nil {
+		other = new(ProgressiveLists)
+	}
+
+	// Field 'A'
+	if len(p.A) != len(other.A) {
+		return false
+	} else {
+		for i0 := range p.A {
+			if p.A[i0] != other.A[i0] {
+				return false
+			}
+		}
+	}
+
+	// Field 'B'
+	if len(p.B) != len(other.B) {
+		return false
+	} else {
+		for i0 := range p.B {
+			if p.B[i0] != other.B[i0] {
+				return false
+			}
+		}
+	}
+
+	// Field 'C'
+	if len(p.C) != len(other.C) {
+		return false
+	} else {
+		for i0 := range p.C {
+			if !p.C[i0].EqualSSZ(other.C[i0]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the ProgressiveLists objects
+func (p *ProgressiveLists) DiffSSZ(other *ProgressiveLists) []ssz.FieldDiff {
+	if p == nil {
+		p = new(ProgressiveLists)
+	}
+	if other == nil {
+		other = new(ProgressiveLists)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'A'
+	if len(p.A) != len(other.A) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "A", A: p.A, B: other.A})
+	} else {
+		for i0 := range p.A {
+			if p.A[i0] != other.A[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("A", i0), A: p.A[i0], B: other.A[i0]})
+			}
+		}
+	}
+
+	// Field 'B'
+	if len(p.B) != len(other.B) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "B", A: p.B, B: other.B})
+	} else {
+		for i0 := range p.B {
+			if p.B[i0] != other.B[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("B", i0), A: p.B[i0], B: other.B[i0]})
+			}
+		}
+	}
+
+	// Field 'C'
+	if len(p.C) != len(other.C) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "C", A: p.C, B: other.C})
+	} else {
+		for i0 := range p.C {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("C", i0), p.C[i0].DiffSSZ(other.C[i0]))
+		}
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the ProgressiveLists object following the Beacon API conventions
+func (p *ProgressiveLists) MarshalJSON() ([]byte, error) {
+	if p == nil {
+		p = new(ProgressiveLists)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'A'
+	dst = append(dst, "\"A\":"...)
+	dst = append(dst, '[')
+	for i0 := range p.A {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONUint64(dst, uint64(p.A[i0]))
+	}
+	dst = append(dst, ']')
+
+	// Field (1) 'B'
+	dst = append(dst, ",\"B\":"...)
+	dst = ssz.MarshalJSONBytes(dst, p.B)
+
+	// Field (2) 'C'
+	dst = append(dst, ",\"C\":"...)
+	dst = append(dst, '[')
+	for i0 := range p.C {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		if dst, err = ssz.MarshalJSONObject(dst, p.C[i0]); err != nil {
+			return nil, err
+		}
+	}
+	dst = append(dst, ']')
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the ProgressiveLists object following the Beacon API conventions
+func (p *ProgressiveLists) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'A'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["A"], 0, math.MaxUint64)
+		if err != nil {
+			return ssz.WrapJSONError(err, "A")
+		}
+		p.A = make([]uint64, len(items0))
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONUint64(items0[i0], 8)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("A", i0))
+				}
+				p.A[i0] = uint64(val)
+			}
+		}
+	}
+
+	// Field (1) 'B'
+	if p.B, err = ssz.UnmarshalJSONBytes(fields["B"], 0, math.MaxUint64); err != nil {
+		return ssz.WrapJSONError(err, "B")
+	}
+
+	// Field (2) 'C'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["C"], 0, math.MaxUint64)
+		if err != nil {
+			return ssz.WrapJSONError(err, "C")
+		}
+		p.C = make([]*ProgressiveItem, len(items0))
+		for i0 := range items0 {
+			if p.C[i0] == nil {
+				p.C[i0] = new(ProgressiveItem)
+			}
+			if err = ssz.UnmarshalJSONObject(items0[i0], p.C[i0]); err != nil {
+				return ssz.WrapJSONError(err, ssz.IndexPath("C", i0))
+			}
+		}
+	}
+
+	return nil
+}
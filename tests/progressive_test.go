@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+)
+
+func TestProgressiveList(t *testing.T) {
+	cases := []*ProgressiveLists{
+		{C: []*ProgressiveItem{}},
+		{
+			A: []uint64{1, 2, 3, 4, 5},
+			B: bytes.Repeat([]byte{1}, 100),
+			C: []*ProgressiveItem{{A: 1, B: []byte{1}}, {A: 2, B: []byte{}}},
+		},
+	}
+	for _, obj := range cases {
+		buf, err := obj.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(buf) != obj.SizeSSZ() {
+			t.Fatalf("bad size, expected %d but found %d", obj.SizeSSZ(), len(buf))
+		}
+
+		obj2 := new(ProgressiveLists)
+		if err := obj2.UnmarshalSSZ(buf); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(obj, obj2) {
+			t.Fatal("bad unmarshal")
+		}
+
+		obj3 := new(ProgressiveLists)
+		if err := obj3.UnmarshalSSZFromReader(bytes.NewReader(buf), len(buf)); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(obj, obj3) {
+			t.Fatal("bad streamed decoding")
+		}
+	}
+}
+
+func TestProgressiveListProof(t *testing.T) {
+	obj := &CodeTrieProgressive{
+		Metadata: &Metadata{Version: 1, CodeHash: make([]byte, 32)},
+	}
+	for i := 0; i < 6; i++ {
+		obj.Chunks = append(obj.Chunks, &Chunk{FIO: uint8(i + 1), Code: make([]byte, 32)})
+	}
+
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree, err := obj.GetTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tree.Hash(), root[:]) {
+		t.Fatalf("bad tree root, expected %x but found %x", root, tree.Hash())
+	}
+
+	// 'Chunks' is the field 3, its items are the left child of the mixin (6). The first
+	// chunk is the right child (13) and the next four are the subtree at 25 (12*2 + 1)
+	for _, c := range []struct {
+		index int
+		fio   uint8
+	}{
+		{13 * 2, 1},
+		{100 * 2, 2},
+		{103 * 2, 5},
+		{(24*2 + 1) * 16 * 2, 6},
+	} {
+		proof, err := tree.Prove(c.index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if proof.Leaf[0] != c.fio {
+			t.Fatalf("bad leaf at %d, expected %d but found %d", c.index, c.fio, proof.Leaf[0])
+		}
+		ok, err := ssz.VerifyProof(root[:], proof)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			t.Fatalf("proof for %d not valid", c.index)
+		}
+	}
+}
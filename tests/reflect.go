@@ -0,0 +1,26 @@
+package tests
+
+// ReflectInner and ReflectAll cover the types supported by the reflection codec
+
+type ReflectInner struct {
+	A uint64
+	B []byte `ssz-max:"16"`
+}
+
+type ReflectAll struct {
+	A uint8
+	B uint16
+	C uint32
+	D uint64
+	E bool
+	F [4]byte         `ssz-size:"4"`
+	G []byte          `ssz-size:"8"`
+	H []byte          `ssz-max:"10"`
+	I []byte          `ssz:"bitlist" ssz-max:"20"`
+	J []uint64        `ssz-size:"3"`
+	K []uint64        `ssz-max:"7"`
+	L [][]byte        `ssz-size:"?,32" ssz-max:"4"`
+	M []*ReflectInner `ssz-max:"3"`
+	N *ReflectInner
+	O *Metadata
+}
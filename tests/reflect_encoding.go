@@ -0,0 +1,1514 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 9e74df67e082810584859ae249ce7e96aebe83af1e28bba0d959f6cf3f02dfaf
+package tests
+
+import (
+	"bytes"
+	"io"
+	"unsafe"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+)
+
+// MarshalSSZ ssz marshals the ReflectInner object
+func (r *ReflectInner) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
+}
+
+// MarshalSSZTo ssz marshals the ReflectInner object to a target array
+func (r *ReflectInner) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(12)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, r.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.B)
+
+	// Field (1) 'B'
+	if size := len(r.B); size > 16 {
+		err = ssz.ErrBytesLengthFn("--.B", size, 16)
+		return
+	}
+	dst = append(dst, r.B...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ReflectInner object to a writer
+func (r *ReflectInner) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, r)
+}
+
+// MarshalSSZToEncoder ssz marshals the ReflectInner object to an encoder
+func (r *ReflectInner) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(12)
+
+	// Field (0) 'A'
+	enc.EncodeUint64(r.A)
+
+	// Offset (1) 'B'
+	enc.EncodeOffset(offset)
+	offset += len(r.B)
+
+	// Field (1) 'B'
+	if size := len(r.B); size > 16 {
+		err = ssz.ErrBytesLengthFn("--.B", size, 16)
+		return
+	}
+	enc.EncodeBytes(r.B)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ReflectInner object
+func (r *ReflectInner) UnmarshalSSZ(buf []byte) error {
+	return r.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the ReflectInner object within the limits of the decode state
+func (r *ReflectInner) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "ReflectInner", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 12 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ReflectInner", 12, size)
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'A'
+	r.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectInner", "B", 8)
+	}
+
+	if o1 != 12 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 12, o1), "ReflectInner", "B", 8)
+	}
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:]
+		if len(buf) > 16 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 16, uint64(len(buf))), "ReflectInner", "B", o1)
+		}
+		if cap(r.B) == 0 {
+			r.B = make([]byte, 0, len(buf))
+		}
+		r.B = append(r.B, buf...)
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a ReflectInner object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (r *ReflectInner) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ReflectInner", 12, size)
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectInner", "B", 8)
+	}
+
+	if o1 != 12 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 12, o1), "ReflectInner", "B", 8)
+	}
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:]
+		if len(buf) > 16 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 16, uint64(len(buf))), "ReflectInner", "B", o1)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the ReflectInner object from a reader with an encoded size of 'size' bytes
+func (r *ReflectInner) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, r)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the ReflectInner object from a decoder
+func (r *ReflectInner) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 12 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ReflectInner", 12, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "ReflectInner", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(12)
+	if err != nil {
+		return err
+	}
+	var o1 uint64
+
+	// Field (0) 'A'
+	r.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectInner", "B", 8)
+	}
+
+	if o1 != 12 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 12, o1), "ReflectInner", "B", 8)
+	}
+
+	// Field (1) 'B'
+	{
+		if size-o1 > 16 {
+			return ssz.WrapDecodeError(ssz.ErrBytesLength, "ReflectInner", "B", o1)
+		}
+		if buf, err = dec.Read(size - o1); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectInner", "B", o1)
+		}
+		if cap(r.B) == 0 {
+			r.B = make([]byte, 0, len(buf))
+		}
+		r.B = append(r.B, buf...)
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ReflectInner object
+func (r *ReflectInner) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (1) 'B'
+	size += len(r.B)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ReflectInner object
+func (r *ReflectInner) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+// HashTreeRootWith ssz hashes the ReflectInner object with a hasher
+func (r *ReflectInner) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(r.A)
+
+	// Field (1) 'B'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(r.B))
+		if byteLen > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.PutBytes(r.B)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (16+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// Copy returns a deep copy of the ReflectInner object
+func (r *ReflectInner) Copy() *ReflectInner {
+	if r == nil {
+		return nil
+	}
+	cpy := new(ReflectInner)
+	*cpy = *r
+
+	// Field 'B'
+	cpy.B = append(cpy.B[:0:0], cpy.B...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the ReflectInner objects have the same SSZ encoding
+func (r *ReflectInner) EqualSSZ(other *ReflectInner) bool {
+	if r == nil {
+		r = new(ReflectInner)
+	}
+	if other == nil {
+		other = new(ReflectInner)
+	}
+
+	// Field 'A'
+	if r.A != other.A {
+		return false
+	}
+
+	// Field 'B'
+	if !bytes.Equal(r.B, other.B) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the ReflectInner objects
+func (r *ReflectInner) DiffSSZ(other *ReflectInner) []ssz.FieldDiff {
+	if r == nil {
+		r = new(ReflectInner)
+	}
+	if other == nil {
+		other = new(ReflectInner)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'A'
+	if r.A != other.A {
+		diffs = append(diffs, ssz.FieldDiff{Path: "A", A: r.A, B: other.A})
+	}
+
+	// Field 'B'
+	if !bytes.Equal(r.B, other.B) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "B", A: r.B, B: other.B})
+	}
+
+	return diffs
+}
+
+// MarshalSSZ ssz marshals the ReflectAll object
+func (r *ReflectAll) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
+}
+
+// MarshalSSZTo ssz marshals the ReflectAll object to a target array
+func (r *ReflectAll) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(111)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint8(dst, r.A)
+
+	// Field (1) 'B'
+	dst = ssz.MarshalUint16(dst, r.B)
+
+	// Field (2) 'C'
+	dst = ssz.MarshalUint32(dst, r.C)
+
+	// Field (3) 'D'
+	dst = ssz.MarshalUint64(dst, r.D)
+
+	// Field (4) 'E'
+	dst = ssz.MarshalBool(dst, r.E)
+
+	// Field (5) 'F'
+	dst = append(dst, r.F[:]...)
+
+	// Field (6) 'G'
+	if size := len(r.G); size != 8 {
+		err = ssz.ErrBytesLengthFn("--.G", size, 8)
+		return
+	}
+	dst = append(dst, r.G...)
+
+	// Offset (7) 'H'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.H)
+
+	// Offset (8) 'I'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.I)
+
+	// Field (9) 'J'
+	if size := len(r.J); size != 3 {
+		err = ssz.ErrVectorLengthFn("--.J", size, 3)
+		return
+	}
+	for ii := 0; ii < 3; ii++ {
+		dst = ssz.MarshalUint64(dst, r.J[ii])
+	}
+
+	// Offset (10) 'K'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.K) * 8
+
+	// Offset (11) 'L'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.L) * 32
+
+	// Offset (12) 'M'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(r.M); ii++ {
+		offset += 4
+		offset += r.M[ii].SizeSSZ()
+	}
+
+	// Offset (13) 'N'
+	dst = ssz.WriteOffset(dst, offset)
+	if r.N == nil {
+		r.N = new(ReflectInner)
+	}
+	offset += r.N.SizeSSZ()
+
+	// Field (14) 'O'
+	if r.O == nil {
+		r.O = new(Metadata)
+	}
+	if dst, err = r.O.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (7) 'H'
+	if size := len(r.H); size > 10 {
+		err = ssz.ErrBytesLengthFn("--.H", size, 10)
+		return
+	}
+	dst = append(dst, r.H...)
+
+	// Field (8) 'I'
+	if size := len(r.I); size > 20 {
+		err = ssz.ErrBytesLengthFn("--.I", size, 20)
+		return
+	}
+	dst = append(dst, r.I...)
+
+	// Field (10) 'K'
+	if size := len(r.K); size > 7 {
+		err = ssz.ErrListTooBigFn("--.K", size, 7)
+		return
+	}
+	for ii := 0; ii < len(r.K); ii++ {
+		dst = ssz.MarshalUint64(dst, r.K[ii])
+	}
+
+	// Field (11) 'L'
+	if size := len(r.L); size > 4 {
+		err = ssz.ErrListTooBigFn("--.L", size, 4)
+		return
+	}
+	for ii := 0; ii < len(r.L); ii++ {
+		if size := len(r.L[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.L[ii]", size, 32)
+			return
+		}
+		dst = append(dst, r.L[ii]...)
+	}
+
+	// Field (12) 'M'
+	if size := len(r.M); size > 3 {
+		err = ssz.ErrListTooBigFn("--.M", size, 3)
+		return
+	}
+	{
+		offset = 4 * len(r.M)
+		for ii := 0; ii < len(r.M); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += r.M[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(r.M); ii++ {
+		if dst, err = r.M[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (13) 'N'
+	if dst, err = r.N.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ReflectAll object to a writer
+func (r *ReflectAll) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, r)
+}
+
+// MarshalSSZToEncoder ssz marshals the ReflectAll object to an encoder
+func (r *ReflectAll) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(111)
+
+	// Field (0) 'A'
+	enc.EncodeUint8(r.A)
+
+	// Field (1) 'B'
+	enc.EncodeUint16(r.B)
+
+	// Field (2) 'C'
+	enc.EncodeUint32(r.C)
+
+	// Field (3) 'D'
+	enc.EncodeUint64(r.D)
+
+	// Field (4) 'E'
+	enc.EncodeBool(r.E)
+
+	// Field (5) 'F'
+	enc.EncodeBytes(r.F[:])
+
+	// Field (6) 'G'
+	if size := len(r.G); size != 8 {
+		err = ssz.ErrBytesLengthFn("--.G", size, 8)
+		return
+	}
+	enc.EncodeBytes(r.G)
+
+	// Offset (7) 'H'
+	enc.EncodeOffset(offset)
+	offset += len(r.H)
+
+	// Offset (8) 'I'
+	enc.EncodeOffset(offset)
+	offset += len(r.I)
+
+	// Field (9) 'J'
+	if size := len(r.J); size != 3 {
+		err = ssz.ErrVectorLengthFn("--.J", size, 3)
+		return
+	}
+	for ii := 0; ii < 3; ii++ {
+		enc.EncodeUint64(r.J[ii])
+	}
+
+	// Offset (10) 'K'
+	enc.EncodeOffset(offset)
+	offset += len(r.K) * 8
+
+	// Offset (11) 'L'
+	enc.EncodeOffset(offset)
+	offset += len(r.L) * 32
+
+	// Offset (12) 'M'
+	enc.EncodeOffset(offset)
+	for ii := 0; ii < len(r.M); ii++ {
+		offset += 4
+		offset += r.M[ii].SizeSSZ()
+	}
+
+	// Offset (13) 'N'
+	enc.EncodeOffset(offset)
+	if r.N == nil {
+		r.N = new(ReflectInner)
+	}
+	offset += r.N.SizeSSZ()
+
+	// Field (14) 'O'
+	if r.O == nil {
+		r.O = new(Metadata)
+	}
+	if err = enc.EncodeObject(r.O); err != nil {
+		return
+	}
+
+	// Field (7) 'H'
+	if size := len(r.H); size > 10 {
+		err = ssz.ErrBytesLengthFn("--.H", size, 10)
+		return
+	}
+	enc.EncodeBytes(r.H)
+
+	// Field (8) 'I'
+	if size := len(r.I); size > 20 {
+		err = ssz.ErrBytesLengthFn("--.I", size, 20)
+		return
+	}
+	enc.EncodeBytes(r.I)
+
+	// Field (10) 'K'
+	if size := len(r.K); size > 7 {
+		err = ssz.ErrListTooBigFn("--.K", size, 7)
+		return
+	}
+	for ii := 0; ii < len(r.K); ii++ {
+		enc.EncodeUint64(r.K[ii])
+	}
+
+	// Field (11) 'L'
+	if size := len(r.L); size > 4 {
+		err = ssz.ErrListTooBigFn("--.L", size, 4)
+		return
+	}
+	for ii := 0; ii < len(r.L); ii++ {
+		if size := len(r.L[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.L[ii]", size, 32)
+			return
+		}
+		enc.EncodeBytes(r.L[ii])
+	}
+
+	// Field (12) 'M'
+	if size := len(r.M); size > 3 {
+		err = ssz.ErrListTooBigFn("--.M", size, 3)
+		return
+	}
+	{
+		offset = 4 * len(r.M)
+		for ii := 0; ii < len(r.M); ii++ {
+			enc.EncodeOffset(offset)
+			offset += r.M[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(r.M); ii++ {
+		if err = enc.EncodeObject(r.M[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (13) 'N'
+	if err = enc.EncodeObject(r.N); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ReflectAll object
+func (r *ReflectAll) UnmarshalSSZ(buf []byte) error {
+	return r.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the ReflectAll object within the limits of the decode state
+func (r *ReflectAll) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "ReflectAll", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 111 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ReflectAll", 111, size)
+	}
+
+	tail := buf
+	var o7, o8, o10, o11, o12, o13 uint64
+
+	// Field (0) 'A'
+	r.A = ssz.UnmarshallUint8(buf[0:1])
+
+	// Field (1) 'B'
+	r.B = ssz.UnmarshallUint16(buf[1:3])
+
+	// Field (2) 'C'
+	r.C = ssz.UnmarshallUint32(buf[3:7])
+
+	// Field (3) 'D'
+	r.D = ssz.UnmarshallUint64(buf[7:15])
+
+	// Field (4) 'E'
+	r.E, err = ssz.DecodeBool(buf[15:16])
+	if err != nil {
+		return ssz.WrapDecodeError(err, "ReflectAll", "E", 15)
+	}
+
+	// Field (5) 'F'
+	copy(r.F[:], buf[16:20])
+
+	// Field (6) 'G'
+	if cap(r.G) == 0 {
+		r.G = make([]byte, 0, len(buf[20:28]))
+	}
+	r.G = append(r.G, buf[20:28]...)
+
+	// Offset (7) 'H'
+	if o7 = ssz.ReadOffset(buf[28:32]); o7 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "H", 28)
+	}
+
+	if o7 != 111 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 111, o7), "ReflectAll", "H", 28)
+	}
+
+	// Offset (8) 'I'
+	if o8 = ssz.ReadOffset(buf[32:36]); o8 > size || o7 > o8 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "I", 32)
+	}
+
+	// Field (9) 'J'
+	if r.J, err = st.ExtendUint64(r.J, 3); err != nil {
+		return ssz.WrapDecodeError(err, "ReflectAll", "J", 36)
+	}
+	for ii := 0; ii < 3; ii++ {
+		r.J[ii] = ssz.UnmarshallUint64(buf[36:60][ii*8 : (ii+1)*8])
+	}
+
+	// Offset (10) 'K'
+	if o10 = ssz.ReadOffset(buf[60:64]); o10 > size || o8 > o10 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "K", 60)
+	}
+
+	// Offset (11) 'L'
+	if o11 = ssz.ReadOffset(buf[64:68]); o11 > size || o10 > o11 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "L", 64)
+	}
+
+	// Offset (12) 'M'
+	if o12 = ssz.ReadOffset(buf[68:72]); o12 > size || o11 > o12 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "M", 68)
+	}
+
+	// Offset (13) 'N'
+	if o13 = ssz.ReadOffset(buf[72:76]); o13 > size || o12 > o13 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "N", 72)
+	}
+
+	// Field (14) 'O'
+	if r.O == nil {
+		r.O = new(Metadata)
+	}
+	if err = ssz.UnmarshalWithState(r.O, buf[76:111], st); err != nil {
+		return ssz.WrapDecodeError(err, "ReflectAll", "O", 76)
+	}
+
+	// Field (7) 'H'
+	{
+		buf = tail[o7:o8]
+		if len(buf) > 10 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 10, uint64(len(buf))), "ReflectAll", "H", o7)
+		}
+		if cap(r.H) == 0 {
+			r.H = make([]byte, 0, len(buf))
+		}
+		r.H = append(r.H, buf...)
+	}
+
+	// Field (8) 'I'
+	{
+		buf = tail[o8:o10]
+		if err = ssz.ValidateBitlist(buf, 20); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "I", o8)
+		}
+		if cap(r.I) == 0 {
+			r.I = make([]byte, 0, len(buf))
+		}
+		r.I = append(r.I, buf...)
+	}
+
+	// Field (10) 'K'
+	{
+		buf = tail[o10:o11]
+		num, err := ssz.DivideInt2(len(buf), 8, 7)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "K", o10)
+		}
+		if r.K, err = st.ExtendUint64(r.K, num); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "K", o10)
+		}
+		for ii := 0; ii < num; ii++ {
+			r.K[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (11) 'L'
+	{
+		buf = tail[o11:o12]
+		num, err := ssz.DivideInt2(len(buf), 32, 4)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "L", o11)
+		}
+		if err = st.Alloc(num, 24); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "L", o11)
+		}
+		r.L = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(r.L[ii]) == 0 {
+				r.L[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			r.L[ii] = append(r.L[ii], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+
+	// Field (12) 'M'
+	{
+		buf = tail[o12:o13]
+		num, err := ssz.DecodeDynamicLength(buf, 3)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "M", o12)
+		}
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(ReflectInner{}))); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "M", o12)
+		}
+		r.M = make([]*ReflectInner, num)
+		err = st.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if r.M[indx] == nil {
+				r.M[indx] = new(ReflectInner)
+			}
+			if err = ssz.UnmarshalWithState(r.M[indx], buf, st); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "M", o12)
+		}
+	}
+
+	// Field (13) 'N'
+	{
+		buf = tail[o13:]
+		if r.N == nil {
+			r.N = new(ReflectInner)
+		}
+		if err = ssz.UnmarshalWithState(r.N, buf, st); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "N", o13)
+		}
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a ReflectAll object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (r *ReflectAll) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 111 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ReflectAll", 111, size)
+	}
+
+	tail := buf
+	var o7, o8, o10, o11, o12, o13 uint64
+
+	// Field (4) 'E'
+	if _, err = ssz.DecodeBool(buf[15:16]); err != nil {
+		return ssz.WrapDecodeError(err, "ReflectAll", "E", 15)
+	}
+
+	// Offset (7) 'H'
+	if o7 = ssz.ReadOffset(buf[28:32]); o7 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "H", 28)
+	}
+
+	if o7 != 111 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 111, o7), "ReflectAll", "H", 28)
+	}
+
+	// Offset (8) 'I'
+	if o8 = ssz.ReadOffset(buf[32:36]); o8 > size || o7 > o8 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "I", 32)
+	}
+
+	// Offset (10) 'K'
+	if o10 = ssz.ReadOffset(buf[60:64]); o10 > size || o8 > o10 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "K", 60)
+	}
+
+	// Offset (11) 'L'
+	if o11 = ssz.ReadOffset(buf[64:68]); o11 > size || o10 > o11 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "L", 64)
+	}
+
+	// Offset (12) 'M'
+	if o12 = ssz.ReadOffset(buf[68:72]); o12 > size || o11 > o12 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "M", 68)
+	}
+
+	// Offset (13) 'N'
+	if o13 = ssz.ReadOffset(buf[72:76]); o13 > size || o12 > o13 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "N", 72)
+	}
+
+	// Field (14) 'O'
+	if err = (*Metadata)(nil).ValidateSSZ(buf[76:111]); err != nil {
+		return ssz.WrapDecodeError(err, "ReflectAll", "O", 76)
+	}
+
+	// Field (7) 'H'
+	{
+		buf = tail[o7:o8]
+		if len(buf) > 10 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 10, uint64(len(buf))), "ReflectAll", "H", o7)
+		}
+	}
+
+	// Field (8) 'I'
+	{
+		buf = tail[o8:o10]
+		if err = ssz.ValidateBitlist(buf, 20); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "I", o8)
+		}
+	}
+
+	// Field (10) 'K'
+	{
+		buf = tail[o10:o11]
+		if _, err = ssz.DivideInt2(len(buf), 8, 7); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "K", o10)
+		}
+	}
+
+	// Field (11) 'L'
+	{
+		buf = tail[o11:o12]
+		if _, err = ssz.DivideInt2(len(buf), 32, 4); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "L", o11)
+		}
+	}
+
+	// Field (12) 'M'
+	{
+		buf = tail[o12:o13]
+		num, err := ssz.DecodeDynamicLength(buf, 3)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "M", o12)
+		}
+
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if err = (*ReflectInner)(nil).ValidateSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "M", o12)
+		}
+	}
+
+	// Field (13) 'N'
+	{
+		buf = tail[o13:]
+		if err = (*ReflectInner)(nil).ValidateSSZ(buf); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "N", o13)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the ReflectAll object from a reader with an encoded size of 'size' bytes
+func (r *ReflectAll) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, r)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the ReflectAll object from a decoder
+func (r *ReflectAll) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 111 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ReflectAll", 111, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "ReflectAll", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(111)
+	if err != nil {
+		return err
+	}
+	var o7, o8, o10, o11, o12, o13 uint64
+
+	// Field (0) 'A'
+	r.A = ssz.UnmarshallUint8(buf[0:1])
+
+	// Field (1) 'B'
+	r.B = ssz.UnmarshallUint16(buf[1:3])
+
+	// Field (2) 'C'
+	r.C = ssz.UnmarshallUint32(buf[3:7])
+
+	// Field (3) 'D'
+	r.D = ssz.UnmarshallUint64(buf[7:15])
+
+	// Field (4) 'E'
+	r.E, err = ssz.DecodeBool(buf[15:16])
+	if err != nil {
+		return ssz.WrapDecodeError(err, "ReflectAll", "E", 15)
+	}
+
+	// Field (5) 'F'
+	copy(r.F[:], buf[16:20])
+
+	// Field (6) 'G'
+	if cap(r.G) == 0 {
+		r.G = make([]byte, 0, len(buf[20:28]))
+	}
+	r.G = append(r.G, buf[20:28]...)
+
+	// Offset (7) 'H'
+	if o7 = ssz.ReadOffset(buf[28:32]); o7 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "H", 28)
+	}
+
+	if o7 != 111 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 111, o7), "ReflectAll", "H", 28)
+	}
+
+	// Offset (8) 'I'
+	if o8 = ssz.ReadOffset(buf[32:36]); o8 > size || o7 > o8 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "I", 32)
+	}
+
+	// Field (9) 'J'
+	if r.J, err = st.ExtendUint64(r.J, 3); err != nil {
+		return ssz.WrapDecodeError(err, "ReflectAll", "J", 36)
+	}
+	for ii := 0; ii < 3; ii++ {
+		r.J[ii] = ssz.UnmarshallUint64(buf[36:60][ii*8 : (ii+1)*8])
+	}
+
+	// Offset (10) 'K'
+	if o10 = ssz.ReadOffset(buf[60:64]); o10 > size || o8 > o10 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "K", 60)
+	}
+
+	// Offset (11) 'L'
+	if o11 = ssz.ReadOffset(buf[64:68]); o11 > size || o10 > o11 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "L", 64)
+	}
+
+	// Offset (12) 'M'
+	if o12 = ssz.ReadOffset(buf[68:72]); o12 > size || o11 > o12 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "M", 68)
+	}
+
+	// Offset (13) 'N'
+	if o13 = ssz.ReadOffset(buf[72:76]); o13 > size || o12 > o13 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ReflectAll", "N", 72)
+	}
+
+	// Field (14) 'O'
+	if r.O == nil {
+		r.O = new(Metadata)
+	}
+	if err = ssz.UnmarshalWithState(r.O, buf[76:111], st); err != nil {
+		return ssz.WrapDecodeError(err, "ReflectAll", "O", 76)
+	}
+
+	// Field (7) 'H'
+	{
+		if o8-o7 > 10 {
+			return ssz.WrapDecodeError(ssz.ErrBytesLength, "ReflectAll", "H", o7)
+		}
+		if buf, err = dec.Read(o8 - o7); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "H", o7)
+		}
+		if cap(r.H) == 0 {
+			r.H = make([]byte, 0, len(buf))
+		}
+		r.H = append(r.H, buf...)
+	}
+
+	// Field (8) 'I'
+	{
+		if buf, err = dec.Read(o10 - o8); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "I", o8)
+		}
+		if err = ssz.ValidateBitlist(buf, 20); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "I", o8)
+		}
+		if cap(r.I) == 0 {
+			r.I = make([]byte, 0, len(buf))
+		}
+		r.I = append(r.I, buf...)
+	}
+
+	// Field (10) 'K'
+	{
+		num, err := ssz.DivideInt2(int(o11-o10), 8, 7)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "K", o10)
+		}
+		if r.K, err = st.ExtendUint64(r.K, num); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "K", o10)
+		}
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(8); err != nil {
+				return ssz.WrapDecodeError(err, "ReflectAll", "K", o10)
+			}
+			r.K[ii] = ssz.UnmarshallUint64(buf)
+		}
+	}
+
+	// Field (11) 'L'
+	{
+		num, err := ssz.DivideInt2(int(o12-o11), 32, 4)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "L", o11)
+		}
+		if err = st.Alloc(num, 24); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "L", o11)
+		}
+		r.L = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(32); err != nil {
+				return ssz.WrapDecodeError(err, "ReflectAll", "L", o11)
+			}
+			if cap(r.L[ii]) == 0 {
+				r.L[ii] = make([]byte, 0, len(buf))
+			}
+			r.L[ii] = append(r.L[ii], buf...)
+		}
+	}
+
+	// Field (12) 'M'
+	{
+		sizes, err := dec.ReadDynamicOffsets(o13-o12, 3)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "M", o12)
+		}
+		num := len(sizes)
+		if err = st.Alloc(num, 8+int(unsafe.Sizeof(ReflectInner{}))); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "M", o12)
+		}
+		r.M = make([]*ReflectInner, num)
+		if err = st.Enter(); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "M", o12)
+		}
+		pos := uint64(4 * num)
+		for indx := 0; indx < num; indx++ {
+			{
+				if r.M[indx] == nil {
+					r.M[indx] = new(ReflectInner)
+				}
+				if err = dec.DecodeObject(r.M[indx], sizes[indx]); err != nil {
+					return ssz.WrapDecodeError(ssz.WrapDecodeErrorIndex(err, indx, pos), "ReflectAll", "M", o12)
+				}
+			}
+			pos += sizes[indx]
+		}
+		st.Leave()
+	}
+
+	// Field (13) 'N'
+	{
+		if r.N == nil {
+			r.N = new(ReflectInner)
+		}
+		if err = dec.DecodeObject(r.N, size-o13); err != nil {
+			return ssz.WrapDecodeError(err, "ReflectAll", "N", o13)
+		}
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ReflectAll object
+func (r *ReflectAll) SizeSSZ() (size int) {
+	size = 111
+
+	// Field (7) 'H'
+	size += len(r.H)
+
+	// Field (8) 'I'
+	size += len(r.I)
+
+	// Field (10) 'K'
+	size += len(r.K) * 8
+
+	// Field (11) 'L'
+	size += len(r.L) * 32
+
+	// Field (12) 'M'
+	for ii := 0; ii < len(r.M); ii++ {
+		size += 4
+		size += r.M[ii].SizeSSZ()
+	}
+
+	// Field (13) 'N'
+	if r.N == nil {
+		r.N = new(ReflectInner)
+	}
+	size += r.N.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ReflectAll object
+func (r *ReflectAll) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+// HashTreeRootWith ssz hashes the ReflectAll object with a hasher
+func (r *ReflectAll) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint8(r.A)
+
+	// Field (1) 'B'
+	hh.PutUint16(r.B)
+
+	// Field (2) 'C'
+	hh.PutUint32(r.C)
+
+	// Field (3) 'D'
+	hh.PutUint64(r.D)
+
+	// Field (4) 'E'
+	hh.PutBool(r.E)
+
+	// Field (5) 'F'
+	hh.PutBytes(r.F[:])
+
+	// Field (6) 'G'
+	if size := len(r.G); size != 8 {
+		err = ssz.ErrBytesLengthFn("--.G", size, 8)
+		return
+	}
+	hh.PutBytes(r.G)
+
+	// Field (7) 'H'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(r.H))
+		if byteLen > 10 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.PutBytes(r.H)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (10+31)/32)
+	}
+
+	// Field (8) 'I'
+	if len(r.I) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(r.I, 20)
+
+	// Field (9) 'J'
+	{
+		if size := len(r.J); size != 3 {
+			err = ssz.ErrVectorLengthFn("--.J", size, 3)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range r.J {
+			hh.AppendUint64(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (10) 'K'
+	{
+		if size := len(r.K); size > 7 {
+			err = ssz.ErrListTooBigFn("--.K", size, 7)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range r.K {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(r.K))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(7, numItems, 8))
+	}
+
+	// Field (11) 'L'
+	{
+		if size := len(r.L); size > 4 {
+			err = ssz.ErrListTooBigFn("--.L", size, 4)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range r.L {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+
+		numItems := uint64(len(r.L))
+		hh.MerkleizeWithMixin(subIndx, numItems, 4)
+	}
+
+	// Field (12) 'M'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(r.M))
+		if num > 3 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = hh.HashElements(len(r.M), func(indx int, hh *ssz.Hasher) error {
+			return r.M[indx].HashTreeRootWith(hh)
+		}); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 3)
+	}
+
+	// Field (13) 'N'
+	if err = r.N.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (14) 'O'
+	if err = r.O.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// Copy returns a deep copy of the ReflectAll object
+func (r *ReflectAll) Copy() *ReflectAll {
+	if r == nil {
+		return nil
+	}
+	cpy := new(ReflectAll)
+	*cpy = *r
+
+	// Field 'G'
+	cpy.G = append(cpy.G[:0:0], cpy.G...)
+
+	// Field 'H'
+	cpy.H = append(cpy.H[:0:0], cpy.H...)
+
+	// Field 'I'
+	cpy.I = append(cpy.I[:0:0], cpy.I...)
+
+	// Field 'J'
+	cpy.J = append(cpy.J[:0:0], cpy.J...)
+
+	// Field 'K'
+	cpy.K = append(cpy.K[:0:0], cpy.K...)
+
+	// Field 'L'
+	cpy.L = append(cpy.L[:0:0], cpy.L...)
+	for i0 := range cpy.L {
+		cpy.L[i0] = append(cpy.L[i0][:0:0], cpy.L[i0]...)
+	}
+
+	// Field 'M'
+	cpy.M = append(cpy.M[:0:0], cpy.M...)
+	for i0 := range cpy.M {
+		cpy.M[i0] = cpy.M[i0].Copy()
+	}
+
+	// Field 'N'
+	cpy.N = cpy.N.Copy()
+
+	// Field 'O'
+	cpy.O = cpy.O.Copy()
+
+	return cpy
+}
+
+// EqualSSZ returns true if the ReflectAll objects have the same SSZ encoding
+func (r *ReflectAll) EqualSSZ(other *ReflectAll) bool {
+	if r == nil {
+		r = new(ReflectAll)
+	}
+	if other == nil {
+		other = new(ReflectAll)
+	}
+
+	// Field 'A'
+	if r.A != other.A {
+		return false
+	}
+
+	// Field 'B'
+	if r.B != other.B {
+		return false
+	}
+
+	// Field 'C'
+	if r.C != other.C {
+		return false
+	}
+
+	// Field 'D'
+	if r.D != other.D {
+		return false
+	}
+
+	// Field 'E'
+	if r.E != other.E {
+		return false
+	}
+
+	// Field 'F'
+	if r.F != other.F {
+		return false
+	}
+
+	// Field 'G'
+	if !bytes.Equal(r.G, other.G) {
+		return false
+	}
+
+	// Field 'H'
+	if !bytes.Equal(r.H, other.H) {
+		return false
+	}
+
+	// Field 'I'
+	if !bytes.Equal(r.I, other.I) {
+		return false
+	}
+
+	// Field 'J'
+	if len(r.J) != len(other.J) {
+		return false
+	} else {
+		for i0 := range r.J {
+			if r.J[i0] != other.J[i0] {
+				return false
+			}
+		}
+	}
+
+	// Field 'K'
+	if len(r.K) != len(other.K) {
+		return false
+	} else {
+		for i0 := range r.K {
+			if r.K[i0] != other.K[i0] {
+				return false
+			}
+		}
+	}
+
+	// Field 'L'
+	if len(r.L) != len(other.L) {
+		return false
+	} else {
+		for i0 := range r.L {
+			if !bytes.Equal(r.L[i0], other.L[i0]) {
+				return false
+			}
+		}
+	}
+
+	// Field 'M'
+	if len(r.M) != len(other.M) {
+		return false
+	} else {
+		for i0 := range r.M {
+			if !r.M[i0].EqualSSZ(other.M[i0]) {
+				return false
+			}
+		}
+	}
+
+	// Field 'N'
+	if !r.N.EqualSSZ(other.N) {
+		return false
+	}
+
+	// Field 'O'
+	if !r.O.EqualSSZ(other.O) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the ReflectAll objects
+func (r *ReflectAll) DiffSSZ(other *ReflectAll) []ssz.FieldDiff {
+	if r == nil {
+		r = new(ReflectAll)
+	}
+	if other == nil {
+		other = new(ReflectAll)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'A'
+	if r.A != other.A {
+		diffs = append(diffs, ssz.FieldDiff{Path: "A", A: r.A, B: other.A})
+	}
+
+	// Field 'B'
+	if r.B != other.B {
+		diffs = append(diffs, ssz.FieldDiff{Path: "B", A: r.B, B: other.B})
+	}
+
+	// Field 'C'
+	if r.C != other.C {
+		diffs = append(diffs, ssz.FieldDiff{Path: "C", A: r.C, B: other.C})
+	}
+
+	// Field 'D'
+	if r.D != other.D {
+		diffs = append(diffs, ssz.FieldDiff{Path: "D", A: r.D, B: other.D})
+	}
+
+	// Field 'E'
+	if r.E != other.E {
+		diffs = append(diffs, ssz.FieldDiff{Path: "E", A: r.E, B: other.E})
+	}
+
+	// Field 'F'
+	if r.F != other.F {
+		diffs = append(diffs, ssz.FieldDiff{Path: "F", A: r.F, B: other.F})
+	}
+
+	// Field 'G'
+	if !bytes.Equal(r.G, other.G) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "G", A: r.G, B: other.G})
+	}
+
+	// Field 'H'
+	if !bytes.Equal(r.H, other.H) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "H", A: r.H, B: other.H})
+	}
+
+	// Field 'I'
+	if !bytes.Equal(r.I, other.I) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "I", A: r.I, B: other.I})
+	}
+
+	// Field 'J'
+	if len(r.J) != len(other.J) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "J", A: r.J, B: other.J})
+	} else {
+		for i0 := range r.J {
+			if r.J[i0] != other.J[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("J", i0), A: r.J[i0], B: other.J[i0]})
+			}
+		}
+	}
+
+	// Field 'K'
+	if len(r.K) != len(other.K) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "K", A: r.K, B: other.K})
+	} else {
+		for i0 := range r.K {
+			if r.K[i0] != other.K[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("K", i0), A: r.K[i0], B: other.K[i0]})
+			}
+		}
+	}
+
+	// Field 'L'
+	if len(r.L) != len(other.L) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "L", A: r.L, B: other.L})
+	} else {
+		for i0 := range r.L {
+			if !bytes.Equal(r.L[i0], other.L[i0]) {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("L", i0), A: r.L[i0], B: other.L[i0]})
+			}
+		}
+	}
+
+	// Field 'M'
+	if len(r.M) != len(other.M) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "M", A: r.M, B: other.M})
+	} else {
+		for i0 := range r.M {
+			diffs = ssz.AppendDiffs(diffs, ssz.IndexPath("M", i0), r.M[i0].DiffSSZ(other.M[i0]))
+		}
+	}
+
+	// Field 'N'
+	diffs = ssz.AppendDiffs(diffs, "N", r.N.DiffSSZ(other.N))
+
+	// Field 'O'
+	diffs = ssz.AppendDiffs(diffs, "O", r.O.DiffSSZ(other.O))
+
+	return diffs
+}
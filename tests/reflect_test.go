@@ -0,0 +1,226 @@
+package tests
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+)
+
+// reflectInner and reflectAll have the same layout as ReflectInner
+// and ReflectAll without the generated methods
+type reflectInner ReflectInner
+
+type reflectAll struct {
+	A uint8
+	B uint16
+	C uint32
+	D uint64
+	E bool
+	F [4]byte
+	G []byte          `ssz-size:"8"`
+	H []byte          `ssz-max:"10"`
+	I []byte          `ssz:"bitlist" ssz-max:"20"`
+	J []uint64        `ssz-size:"3"`
+	K []uint64        `ssz-max:"7"`
+	L [][]byte        `ssz-size:"?,32" ssz-max:"4"`
+	M []*reflectInner `ssz-max:"3"`
+	N *reflectInner
+	O *Metadata
+}
+
+func newReflectAll(obj *ReflectAll) *reflectAll {
+	res := &reflectAll{
+		A: obj.A, B: obj.B, C: obj.C, D: obj.D, E: obj.E, F: obj.F,
+		G: obj.G, H: obj.H, I: obj.I, J: obj.J, K: obj.K, L: obj.L,
+		N: (*reflectInner)(obj.N),
+		O: obj.O,
+	}
+	for _, m := range obj.M {
+		res.M = append(res.M, (*reflectInner)(m))
+	}
+	return res
+}
+
+func TestReflectCodec(t *testing.T) {
+	obj := &ReflectAll{
+		A: 1, B: 2, C: 3, D: 4, E: true,
+		F: [4]byte{1, 2, 3, 4},
+		G: make([]byte, 8),
+		H: []byte{1, 2, 3},
+		I: []byte{0x0f, 0x01},
+		J: []uint64{1, 2, 3},
+		K: []uint64{4, 5},
+		L: [][]byte{make([]byte, 32), bytes.Repeat([]byte{1}, 32)},
+		M: []*ReflectInner{{A: 1, B: []byte{1}}, {A: 2, B: []byte{}}},
+		N: &ReflectInner{A: 3, B: []byte{1, 2, 3}},
+		O: &Metadata{Version: 1, CodeHash: make([]byte, 32), CodeLength: 10},
+	}
+	robj := newReflectAll(obj)
+
+	expected, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf, err := ssz.Marshal(robj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf, expected) {
+		t.Fatalf("bad encoding, expected %x but found %x", expected, buf)
+	}
+
+	// values are encoded the same way as pointers
+	if buf, err = ssz.Marshal(*robj); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf, expected) {
+		t.Fatalf("bad encoding of value, expected %x but found %x", expected, buf)
+	}
+
+	expectedRoot, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRoot(robj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expectedRoot {
+		t.Fatalf("bad root, expected %x but found %x", expectedRoot, root)
+	}
+
+	robj2 := new(reflectAll)
+	if err := ssz.Unmarshal(buf, robj2); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(robj, robj2) {
+		t.Fatal("bad unmarshal")
+	}
+}
+
+func TestReflectCodecMethods(t *testing.T) {
+	// types with generated methods use them
+	obj := &Metadata{Version: 1, CodeHash: make([]byte, 32), CodeLength: 10}
+
+	expected, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf, err := ssz.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf, expected) {
+		t.Fatalf("bad encoding, expected %x but found %x", expected, buf)
+	}
+
+	obj2 := new(Metadata)
+	if err := ssz.Unmarshal(buf, obj2); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad unmarshal")
+	}
+}
+
+func TestReflectCodecInvalid(t *testing.T) {
+	obj := newReflectAll(&ReflectAll{
+		G: make([]byte, 8),
+		I: []byte{0x01},
+		J: []uint64{1, 2, 3},
+		K: make([]uint64, 8),
+	})
+	if _, err := ssz.Marshal(obj); err == nil {
+		t.Fatal("list bigger than ssz-max expected to fail")
+	}
+
+	// types that cannot be encoded
+	if _, err := ssz.Marshal(&struct{ A []uint64 }{}); err == nil {
+		t.Fatal("slice without tags expected to fail")
+	}
+	if _, err := ssz.Marshal(&struct{ A int }{}); err == nil {
+		t.Fatal("int expected to fail")
+	}
+	if err := ssz.Unmarshal(nil, reflectAll{}); err == nil {
+		t.Fatal("unmarshal into a value expected to fail")
+	}
+	if err := ssz.Unmarshal([]byte{1, 2}, new(reflectAll)); err != ssz.ErrSize {
+		t.Fatalf("expected size error but found %v", err)
+	}
+}
+
+// opaqueKey encodes itself but its layout cannot be inferred by reflection
+type opaqueKey struct {
+	key [48]byte
+}
+
+func (o *opaqueKey) SizeSSZ() int {
+	return 48
+}
+
+func (o *opaqueKey) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(o)
+}
+
+func (o *opaqueKey) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, o.key[:]...), nil
+}
+
+func (o *opaqueKey) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != 48 {
+		return ssz.ErrSize
+	}
+	copy(o.key[:], buf)
+	return nil
+}
+
+func (o *opaqueKey) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(o)
+}
+
+func (o *opaqueKey) HashTreeRootWith(hh *ssz.Hasher) error {
+	hh.PutBytes(o.key[:])
+	return nil
+}
+
+// fixedKey declares the fixed size of its encoding
+type fixedKey struct {
+	opaqueKey
+}
+
+func (f *fixedKey) FixedSizeSSZ() (int, bool) {
+	return 48, true
+}
+
+func TestReflectCodecFixedSizer(t *testing.T) {
+	type container struct {
+		A uint64
+		K fixedKey
+		B []byte `ssz-max:"8"`
+	}
+	obj := &container{A: 1, B: []byte{1, 2}}
+	obj.K.key[0] = 0xff
+
+	buf, err := ssz.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the key is encoded in the fixed part, the offset of B is after it
+	if len(buf) != 8+48+4+2 || ssz.ReadOffset(buf[56:60]) != 60 || buf[8] != 0xff {
+		t.Fatalf("bad encoding %x", buf)
+	}
+	obj2 := new(container)
+	if err := ssz.Unmarshal(buf, obj2); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad unmarshal")
+	}
+
+	// the size of the type is not guessed
+	if _, err := ssz.Marshal(&struct{ K opaqueKey }{}); err == nil {
+		t.Fatal("type without FixedSizer expected to fail")
+	}
+}
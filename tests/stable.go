@@ -0,0 +1,40 @@
+package tests
+
+// Shape, Square and Circle are the examples of EIP-7495
+// with an extra variable size field
+
+type ShapeLabel struct {
+	Name []byte `ssz-max:"32"`
+}
+
+type Shape struct {
+	_ struct{} `ssz-stable-container:"4"`
+
+	Side   *uint16     `ssz:"optional"`
+	Color  *uint8      `ssz:"optional"`
+	Radius *uint16     `ssz:"optional"`
+	Label  *ShapeLabel `ssz:"optional"`
+}
+
+type Square struct {
+	_ struct{} `ssz-profile:"Shape"`
+
+	Side  uint16
+	Color uint8
+}
+
+type Circle struct {
+	_ struct{} `ssz-profile:"Shape"`
+
+	Color  uint8
+	Radius *uint16     `ssz:"optional"`
+	Label  *ShapeLabel `ssz:"optional"`
+}
+
+// Point is a StableContainer with room for more fields than the defined ones
+type Point struct {
+	_ struct{} `ssz-stable-container:"8"`
+
+	X *uint16 `ssz:"optional"`
+	Y *uint16 `ssz:"optional"`
+}
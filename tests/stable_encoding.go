@@ -0,0 +1,1999 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 28f4519a5b6353c7abbdc4f999eca72a7cf44166e6d611b86a435b68af1749bf
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+)
+
+// MarshalSSZ ssz marshals the ShapeLabel object
+func (s *ShapeLabel) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the ShapeLabel object to a target array
+func (s *ShapeLabel) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Name'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.Name)
+
+	// Field (0) 'Name'
+	if size := len(s.Name); size > 32 {
+		err = ssz.ErrBytesLengthFn("--.Name", size, 32)
+		return
+	}
+	dst = append(dst, s.Name...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ShapeLabel object to a writer
+func (s *ShapeLabel) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, s)
+}
+
+// MarshalSSZToEncoder ssz marshals the ShapeLabel object to an encoder
+func (s *ShapeLabel) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(4)
+
+	// Offset (0) 'Name'
+	enc.EncodeOffset(offset)
+	offset += len(s.Name)
+
+	// Field (0) 'Name'
+	if size := len(s.Name); size > 32 {
+		err = ssz.ErrBytesLengthFn("--.Name", size, 32)
+		return
+	}
+	enc.EncodeBytes(s.Name)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ShapeLabel object
+func (s *ShapeLabel) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the ShapeLabel object within the limits of the decode state
+func (s *ShapeLabel) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "ShapeLabel", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ShapeLabel", 4, size)
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Name'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ShapeLabel", "Name", 0)
+	}
+
+	if o0 != 4 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 4, o0), "ShapeLabel", "Name", 0)
+	}
+
+	// Field (0) 'Name'
+	{
+		buf = tail[o0:]
+		if len(buf) > 32 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 32, uint64(len(buf))), "ShapeLabel", "Name", o0)
+		}
+		if cap(s.Name) == 0 {
+			s.Name = make([]byte, 0, len(buf))
+		}
+		s.Name = append(s.Name, buf...)
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a ShapeLabel object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (s *ShapeLabel) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ShapeLabel", 4, size)
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Name'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ShapeLabel", "Name", 0)
+	}
+
+	if o0 != 4 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 4, o0), "ShapeLabel", "Name", 0)
+	}
+
+	// Field (0) 'Name'
+	{
+		buf = tail[o0:]
+		if len(buf) > 32 {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrBytesLength, "", 32, uint64(len(buf))), "ShapeLabel", "Name", o0)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the ShapeLabel object from a reader with an encoded size of 'size' bytes
+func (s *ShapeLabel) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, s)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the ShapeLabel object from a decoder
+func (s *ShapeLabel) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 4 {
+		return ssz.NewDecodeError(ssz.ErrSize, "ShapeLabel", 4, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "ShapeLabel", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(4)
+	if err != nil {
+		return err
+	}
+	var o0 uint64
+
+	// Offset (0) 'Name'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "ShapeLabel", "Name", 0)
+	}
+
+	if o0 != 4 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 4, o0), "ShapeLabel", "Name", 0)
+	}
+
+	// Field (0) 'Name'
+	{
+		if size-o0 > 32 {
+			return ssz.WrapDecodeError(ssz.ErrBytesLength, "ShapeLabel", "Name", o0)
+		}
+		if buf, err = dec.Read(size - o0); err != nil {
+			return ssz.WrapDecodeError(err, "ShapeLabel", "Name", o0)
+		}
+		if cap(s.Name) == 0 {
+			s.Name = make([]byte, 0, len(buf))
+		}
+		s.Name = append(s.Name, buf...)
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ShapeLabel object
+func (s *ShapeLabel) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'Name'
+	size += len(s.Name)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ShapeLabel object
+func (s *ShapeLabel) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the ShapeLabel object with a hasher
+func (s *ShapeLabel) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Name'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(s.Name))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.PutBytes(s.Name)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// Copy returns a deep copy of the ShapeLabel object
+func (s *ShapeLabel) Copy() *ShapeLabel {
+	if s == nil {
+		return nil
+	}
+	cpy := new(ShapeLabel)
+	*cpy = *s
+
+	// Field 'Name'
+	cpy.Name = append(cpy.Name[:0:0], cpy.Name...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the ShapeLabel objects have the same SSZ encoding
+func (s *ShapeLabel) EqualSSZ(other *ShapeLabel) bool {
+	if s == nil {
+		s = new(ShapeLabel)
+	}
+	if other == nil {
+		other = new(ShapeLabel)
+	}
+
+	// Field 'Name'
+	if !bytes.Equal(s.Name, other.Name) {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the ShapeLabel objects
+func (s *ShapeLabel) DiffSSZ(other *ShapeLabel) []ssz.FieldDiff {
+	if s == nil {
+		s = new(ShapeLabel)
+	}
+	if other == nil {
+		other = new(ShapeLabel)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Name'
+	if !bytes.Equal(s.Name, other.Name) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Name", A: s.Name, B: other.Name})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the ShapeLabel object following the Beacon API conventions
+func (s *ShapeLabel) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		s = new(ShapeLabel)
+	}
+	dst := []byte{'{'}
+
+	// Field (0) 'Name'
+	dst = append(dst, "\"Name\":"...)
+	dst = ssz.MarshalJSONBytes(dst, s.Name)
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the ShapeLabel object following the Beacon API conventions
+func (s *ShapeLabel) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Name'
+	if s.Name, err = ssz.UnmarshalJSONBytes(fields["Name"], 0, 32); err != nil {
+		return ssz.WrapJSONError(err, "Name")
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Shape object
+func (s *Shape) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the Shape object to a target array
+func (s *Shape) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Active fields
+	active := [1]byte{}
+	if s.Side != nil {
+		active[0] |= 1
+	}
+	if s.Color != nil {
+		active[0] |= 2
+	}
+	if s.Radius != nil {
+		active[0] |= 4
+	}
+	if s.Label != nil {
+		active[0] |= 8
+	}
+	dst = append(dst, active[:]...)
+
+	offset := int(0)
+	if s.Side != nil {
+		offset += 2
+	}
+	if s.Color != nil {
+		offset += 1
+	}
+	if s.Radius != nil {
+		offset += 2
+	}
+	if s.Label != nil {
+		offset += 4
+	}
+
+	// Field (0) 'Side'
+	if s.Side != nil {
+		dst = ssz.MarshalUint16(dst, *s.Side)
+	}
+
+	// Field (1) 'Color'
+	if s.Color != nil {
+		dst = ssz.MarshalUint8(dst, *s.Color)
+	}
+
+	// Field (2) 'Radius'
+	if s.Radius != nil {
+		dst = ssz.MarshalUint16(dst, *s.Radius)
+	}
+
+	// Offset (3) 'Label'
+	if s.Label != nil {
+		dst = ssz.WriteOffset(dst, offset)
+		offset += s.Label.SizeSSZ()
+	}
+
+	// Field (3) 'Label'
+	if s.Label != nil {
+		if dst, err = s.Label.MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Shape object to a writer
+func (s *Shape) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, s)
+}
+
+// MarshalSSZToEncoder ssz marshals the Shape object to an encoder
+func (s *Shape) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	var buf []byte
+	if buf, err = s.MarshalSSZTo(nil); err != nil {
+		return
+	}
+	enc.EncodeBytes(buf)
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Shape object
+func (s *Shape) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the Shape object within the limits of the decode state
+func (s *Shape) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "Shape", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 1 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Shape", 1, size)
+	}
+	if err = ssz.ValidateBitvector(buf[:1], 4); err != nil {
+		return ssz.WrapDecodeError(err, "Shape", "", 0)
+	}
+	active := buf[:1]
+	buf = buf[1:]
+	size -= 1
+
+	// the size of the fixed part depends on the active fields
+	fixed := uint64(0)
+	if active[0]&1 != 0 {
+		fixed += 2
+	}
+	if active[0]&2 != 0 {
+		fixed += 1
+	}
+	if active[0]&4 != 0 {
+		fixed += 2
+	}
+	if active[0]&8 != 0 {
+		fixed += 4
+	}
+	if size < fixed {
+		return ssz.NewDecodeError(ssz.ErrSize, "Shape", 1+fixed, 1+size)
+	}
+
+	tail := buf
+	var o3 uint64
+	var last uint64
+
+	pos := uint64(0)
+
+	// Field (0) 'Side'
+	if active[0]&1 != 0 {
+		if len(buf[pos:pos+2]) != 2 {
+			return ssz.WrapDecodeError(ssz.ErrSize, "Shape", "Side", 1+pos)
+		}
+		val := ssz.UnmarshallUint16(buf[pos : pos+2])
+		s.Side = &val
+		pos += 2
+	} else {
+		s.Side = nil
+	}
+
+	// Field (1) 'Color'
+	if active[0]&2 != 0 {
+		if len(buf[pos:pos+1]) != 1 {
+			return ssz.WrapDecodeError(ssz.ErrSize, "Shape", "Color", 1+pos)
+		}
+		val := ssz.UnmarshallUint8(buf[pos : pos+1])
+		s.Color = &val
+		pos += 1
+	} else {
+		s.Color = nil
+	}
+
+	// Field (2) 'Radius'
+	if active[0]&4 != 0 {
+		if len(buf[pos:pos+2]) != 2 {
+			return ssz.WrapDecodeError(ssz.ErrSize, "Shape", "Radius", 1+pos)
+		}
+		val := ssz.UnmarshallUint16(buf[pos : pos+2])
+		s.Radius = &val
+		pos += 2
+	} else {
+		s.Radius = nil
+	}
+
+	// Field (3) 'Label'
+	if active[0]&8 != 0 {
+		if o3 = ssz.ReadOffset(buf[pos : pos+4]); o3 > size || o3 < last {
+			return ssz.WrapDecodeError(ssz.ErrOffset, "Shape", "Label", 1+pos)
+		}
+		if last == 0 && o3 != fixed {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", fixed, o3), "Shape", "Label", 1+pos)
+		}
+		last = o3
+		pos += 4
+	} else {
+		s.Label = nil
+	}
+
+	if last == 0 && size != fixed {
+		return ssz.NewDecodeError(ssz.ErrSize, "Shape", 1+fixed, 1+size)
+	}
+
+	end := size
+
+	// Field (3) 'Label'
+	if active[0]&8 != 0 {
+		buf = tail[o3:end]
+		if s.Label == nil {
+			s.Label = new(ShapeLabel)
+		}
+		if err = ssz.UnmarshalWithState(s.Label, buf, st); err != nil {
+			return ssz.WrapDecodeError(err, "Shape", "Label", 1+o3)
+		}
+		end = o3
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a Shape object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (s *Shape) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 1 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Shape", 1, size)
+	}
+	if err = ssz.ValidateBitvector(buf[:1], 4); err != nil {
+		return ssz.WrapDecodeError(err, "Shape", "", 0)
+	}
+	active := buf[:1]
+	buf = buf[1:]
+	size -= 1
+
+	// the size of the fixed part depends on the active fields
+	fixed := uint64(0)
+	if active[0]&1 != 0 {
+		fixed += 2
+	}
+	if active[0]&2 != 0 {
+		fixed += 1
+	}
+	if active[0]&4 != 0 {
+		fixed += 2
+	}
+	if active[0]&8 != 0 {
+		fixed += 4
+	}
+	if size < fixed {
+		return ssz.NewDecodeError(ssz.ErrSize, "Shape", 1+fixed, 1+size)
+	}
+
+	tail := buf
+	var o3 uint64
+	var last uint64
+
+	pos := uint64(0)
+
+	// Field (0) 'Side'
+	if active[0]&1 != 0 {
+		if len(buf[pos:pos+2]) != 2 {
+			return ssz.WrapDecodeError(ssz.ErrSize, "Shape", "Side", 1+pos)
+		}
+
+		pos += 2
+	}
+
+	// Field (1) 'Color'
+	if active[0]&2 != 0 {
+		if len(buf[pos:pos+1]) != 1 {
+			return ssz.WrapDecodeError(ssz.ErrSize, "Shape", "Color", 1+pos)
+		}
+
+		pos += 1
+	}
+
+	// Field (2) 'Radius'
+	if active[0]&4 != 0 {
+		if len(buf[pos:pos+2]) != 2 {
+			return ssz.WrapDecodeError(ssz.ErrSize, "Shape", "Radius", 1+pos)
+		}
+
+		pos += 2
+	}
+
+	// Field (3) 'Label'
+	if active[0]&8 != 0 {
+		if o3 = ssz.ReadOffset(buf[pos : pos+4]); o3 > size || o3 < last {
+			return ssz.WrapDecodeError(ssz.ErrOffset, "Shape", "Label", 1+pos)
+		}
+		if last == 0 && o3 != fixed {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", fixed, o3), "Shape", "Label", 1+pos)
+		}
+		last = o3
+		pos += 4
+	}
+
+	if last == 0 && size != fixed {
+		return ssz.NewDecodeError(ssz.ErrSize, "Shape", 1+fixed, 1+size)
+	}
+
+	end := size
+
+	// Field (3) 'Label'
+	if active[0]&8 != 0 {
+		buf = tail[o3:end]
+		if err = (*ShapeLabel)(nil).ValidateSSZ(buf); err != nil {
+			return ssz.WrapDecodeError(err, "Shape", "Label", 1+o3)
+		}
+		end = o3
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the Shape object from a reader with an encoded size of 'size' bytes
+func (s *Shape) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, s)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the Shape object from a decoder
+func (s *Shape) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	buf, err := dec.Read(dec.Size())
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalSSZWithState(buf, dec.State())
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Shape object
+func (s *Shape) SizeSSZ() (size int) {
+	size = 1
+
+	// Field (0) 'Side'
+	if s.Side != nil {
+		size += 2
+	}
+
+	// Field (1) 'Color'
+	if s.Color != nil {
+		size += 1
+	}
+
+	// Field (2) 'Radius'
+	if s.Radius != nil {
+		size += 2
+	}
+
+	// Field (3) 'Label'
+	if s.Label != nil {
+		size += 4 + s.Label.SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the Shape object
+func (s *Shape) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the Shape object with a hasher
+func (s *Shape) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+	active := [1]byte{}
+
+	// Field (0) 'Side'
+	if s.Side != nil {
+		active[0] |= 1
+		hh.PutUint16(*s.Side)
+	} else {
+		hh.PutZero()
+	}
+
+	// Field (1) 'Color'
+	if s.Color != nil {
+		active[0] |= 2
+		hh.PutUint8(*s.Color)
+	} else {
+		hh.PutZero()
+	}
+
+	// Field (2) 'Radius'
+	if s.Radius != nil {
+		active[0] |= 4
+		hh.PutUint16(*s.Radius)
+	} else {
+		hh.PutZero()
+	}
+
+	// Field (3) 'Label'
+	if s.Label != nil {
+		active[0] |= 8
+		if err = s.Label.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else {
+		hh.PutZero()
+	}
+
+	hh.MerkleizeWithActiveFields(indx, active[:], 4)
+	return
+}
+
+// Copy returns a deep copy of the Shape object
+func (s *Shape) Copy() *Shape {
+	if s == nil {
+		return nil
+	}
+	cpy := new(Shape)
+	*cpy = *s
+
+	// Field 'Side'
+	if cpy.Side != nil {
+		val := *cpy.Side
+		cpy.Side = &val
+	}
+
+	// Field 'Color'
+	if cpy.Color != nil {
+		val := *cpy.Color
+		cpy.Color = &val
+	}
+
+	// Field 'Radius'
+	if cpy.Radius != nil {
+		val := *cpy.Radius
+		cpy.Radius = &val
+	}
+
+	// Field 'Label'
+	cpy.Label = cpy.Label.Copy()
+
+	return cpy
+}
+
+// EqualSSZ returns true if the Shape objects have the same SSZ encoding
+func (s *Shape) EqualSSZ(other *Shape) bool {
+	if s == nil {
+		s = new(Shape)
+	}
+	if other == nil {
+		other = new(Shape)
+	}
+
+	// Field 'Side'
+	if (s.Side == nil) != (other.Side == nil) {
+		return false
+	} else if s.Side != nil {
+		if *s.Side != *other.Side {
+			return false
+		}
+	}
+
+	// Field 'Color'
+	if (s.Color == nil) != (other.Color == nil) {
+		return false
+	} else if s.Color != nil {
+		if *s.Color != *other.Color {
+			return false
+		}
+	}
+
+	// Field 'Radius'
+	if (s.Radius == nil) != (other.Radius == nil) {
+		return false
+	} else if s.Radius != nil {
+		if *s.Radius != *other.Radius {
+			return false
+		}
+	}
+
+	// Field 'Label'
+	if (s.Label == nil) != (other.Label == nil) {
+		return false
+	} else if s.Label != nil {
+		if !s.Label.EqualSSZ(other.Label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the Shape objects
+func (s *Shape) DiffSSZ(other *Shape) []ssz.FieldDiff {
+	if s == nil {
+		s = new(Shape)
+	}
+	if other == nil {
+		other = new(Shape)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Side'
+	if (s.Side == nil) != (other.Side == nil) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Side", A: s.Side, B: other.Side})
+	} else if s.Side != nil {
+		if *s.Side != *other.Side {
+			diffs = append(diffs, ssz.FieldDiff{Path: "Side", A: s.Side, B: other.Side})
+		}
+	}
+
+	// Field 'Color'
+	if (s.Color == nil) != (other.Color == nil) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Color", A: s.Color, B: other.Color})
+	} else if s.Color != nil {
+		if *s.Color != *other.Color {
+			diffs = append(diffs, ssz.FieldDiff{Path: "Color", A: s.Color, B: other.Color})
+		}
+	}
+
+	// Field 'Radius'
+	if (s.Radius == nil) != (other.Radius == nil) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Radius", A: s.Radius, B: other.Radius})
+	} else if s.Radius != nil {
+		if *s.Radius != *other.Radius {
+			diffs = append(diffs, ssz.FieldDiff{Path: "Radius", A: s.Radius, B: other.Radius})
+		}
+	}
+
+	// Field 'Label'
+	if (s.Label == nil) != (other.Label == nil) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Label", A: s.Label, B: other.Label})
+	} else if s.Label != nil {
+		diffs = ssz.AppendDiffs(diffs, "Label", s.Label.DiffSSZ(other.Label))
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the Shape object following the Beacon API conventions
+func (s *Shape) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		s = new(Shape)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'Side'
+	dst = append(dst, "\"Side\":"...)
+	if s.Side == nil {
+		dst = append(dst, "null"...)
+	} else {
+		dst = ssz.MarshalJSONUint64(dst, uint64(*s.Side))
+	}
+
+	// Field (1) 'Color'
+	dst = append(dst, ",\"Color\":"...)
+	if s.Color == nil {
+		dst = append(dst, "null"...)
+	} else {
+		dst = ssz.MarshalJSONUint64(dst, uint64(*s.Color))
+	}
+
+	// Field (2) 'Radius'
+	dst = append(dst, ",\"Radius\":"...)
+	if s.Radius == nil {
+		dst = append(dst, "null"...)
+	} else {
+		dst = ssz.MarshalJSONUint64(dst, uint64(*s.Radius))
+	}
+
+	// Field (3) 'Label'
+	dst = append(dst, ",\"Label\":"...)
+	if s.Label == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if dst, err = ssz.MarshalJSONObject(dst, s.Label); err != nil {
+			return nil, err
+		}
+	}
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the Shape object following the Beacon API conventions
+func (s *Shape) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Side'
+	if ssz.IsJSONNull(fields["Side"]) {
+		s.Side = nil
+	} else {
+		s.Side = new(uint16)
+		{
+			val, err := ssz.UnmarshalJSONUint64(fields["Side"], 2)
+			if err != nil {
+				return ssz.WrapJSONError(err, "Side")
+			}
+			*s.Side = uint16(val)
+		}
+	}
+
+	// Field (1) 'Color'
+	if ssz.IsJSONNull(fields["Color"]) {
+		s.Color = nil
+	} else {
+		s.Color = new(uint8)
+		{
+			val, err := ssz.UnmarshalJSONUint64(fields["Color"], 1)
+			if err != nil {
+				return ssz.WrapJSONError(err, "Color")
+			}
+			*s.Color = uint8(val)
+		}
+	}
+
+	// Field (2) 'Radius'
+	if ssz.IsJSONNull(fields["Radius"]) {
+		s.Radius = nil
+	} else {
+		s.Radius = new(uint16)
+		{
+			val, err := ssz.UnmarshalJSONUint64(fields["Radius"], 2)
+			if err != nil {
+				return ssz.WrapJSONError(err, "Radius")
+			}
+			*s.Radius = uint16(val)
+		}
+	}
+
+	// Field (3) 'Label'
+	if ssz.IsJSONNull(fields["Label"]) {
+		s.Label = nil
+	} else {
+		if s.Label == nil {
+			s.Label = new(ShapeLabel)
+		}
+		if err = ssz.UnmarshalJSONObject(fields["Label"], s.Label); err != nil {
+			return ssz.WrapJSONError(err, "Label")
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Square object
+func (s *Square) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the Square object to a target array
+func (s *Square) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Side'
+	dst = ssz.MarshalUint16(dst, s.Side)
+
+	// Field (1) 'Color'
+	dst = ssz.MarshalUint8(dst, s.Color)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Square object to a writer
+func (s *Square) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, s)
+}
+
+// MarshalSSZToEncoder ssz marshals the Square object to an encoder
+func (s *Square) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	// Field (0) 'Side'
+	enc.EncodeUint16(s.Side)
+
+	// Field (1) 'Color'
+	enc.EncodeUint8(s.Color)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Square object
+func (s *Square) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the Square object within the limits of the decode state
+func (s *Square) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "Square", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size != 3 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Square", 3, size)
+	}
+
+	// Field (0) 'Side'
+	s.Side = ssz.UnmarshallUint16(buf[0:2])
+
+	// Field (1) 'Color'
+	s.Color = ssz.UnmarshallUint8(buf[2:3])
+
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a Square object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (s *Square) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 3 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Square", 3, size)
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the Square object from a reader with an encoded size of 'size' bytes
+func (s *Square) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, s)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the Square object from a decoder
+func (s *Square) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	if dec.Size() != 3 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Square", 3, dec.Size())
+	}
+	buf, err := dec.Read(3)
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalSSZWithState(buf, dec.State())
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Square object
+func (s *Square) SizeSSZ() (size int) {
+	size = 3
+	return
+}
+
+// HashTreeRoot ssz hashes the Square object
+func (s *Square) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the Square object with a hasher
+func (s *Square) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+	active := [1]byte{}
+
+	// Field (0) 'Side'
+	active[0] |= 1
+	hh.PutUint16(s.Side)
+
+	// Field (1) 'Color'
+	active[0] |= 2
+	hh.PutUint8(s.Color)
+
+	// Field (2) 'Radius'
+	hh.PutZero()
+
+	// Field (3) 'Label'
+	hh.PutZero()
+
+	hh.MerkleizeWithActiveFields(indx, active[:], 4)
+	return
+}
+
+// Copy returns a deep copy of the Square object
+func (s *Square) Copy() *Square {
+	if s == nil {
+		return nil
+	}
+	cpy := new(Square)
+	*cpy = *s
+	return cpy
+}
+
+// EqualSSZ returns true if the Square objects have the same SSZ encoding
+func (s *Square) EqualSSZ(other *Square) bool {
+	if s == nil {
+		s = new(Square)
+	}
+	if other == nil {
+		other = new(Square)
+	}
+
+	// Field 'Side'
+	if s.Side != other.Side {
+		return false
+	}
+
+	// Field 'Color'
+	if s.Color != other.Color {
+		return false
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the Square objects
+func (s *Square) DiffSSZ(other *Square) []ssz.FieldDiff {
+	if s == nil {
+		s = new(Square)
+	}
+	if other == nil {
+		other = new(Square)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Side'
+	if s.Side != other.Side {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Side", A: s.Side, B: other.Side})
+	}
+
+	// Field 'Color'
+	if s.Color != other.Color {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Color", A: s.Color, B: other.Color})
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the Square object following the Beacon API conventions
+func (s *Square) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		s = new(Square)
+	}
+	dst := []byte{'{'}
+
+	// Field (0) 'Side'
+	dst = append(dst, "\"Side\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(s.Side))
+
+	// Field (1) 'Color'
+	dst = append(dst, ",\"Color\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(s.Color))
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the Square object following the Beacon API conventions
+func (s *Square) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Side'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["Side"], 2)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Side")
+		}
+		s.Side = uint16(val)
+	}
+
+	// Field (1) 'Color'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["Color"], 1)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Color")
+		}
+		s.Color = uint8(val)
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Circle object
+func (c *Circle) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the Circle object to a target array
+func (c *Circle) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Active fields
+	active := [1]byte{}
+	if c.Radius != nil {
+		active[0] |= 1
+	}
+	if c.Label != nil {
+		active[0] |= 2
+	}
+	dst = append(dst, active[:]...)
+
+	offset := int(1)
+	if c.Radius != nil {
+		offset += 2
+	}
+	if c.Label != nil {
+		offset += 4
+	}
+
+	// Field (0) 'Color'
+	dst = ssz.MarshalUint8(dst, c.Color)
+
+	// Field (1) 'Radius'
+	if c.Radius != nil {
+		dst = ssz.MarshalUint16(dst, *c.Radius)
+	}
+
+	// Offset (2) 'Label'
+	if c.Label != nil {
+		dst = ssz.WriteOffset(dst, offset)
+		offset += c.Label.SizeSSZ()
+	}
+
+	// Field (2) 'Label'
+	if c.Label != nil {
+		if dst, err = c.Label.MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Circle object to a writer
+func (c *Circle) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, c)
+}
+
+// MarshalSSZToEncoder ssz marshals the Circle object to an encoder
+func (c *Circle) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	var buf []byte
+	if buf, err = c.MarshalSSZTo(nil); err != nil {
+		return
+	}
+	enc.EncodeBytes(buf)
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Circle object
+func (c *Circle) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the Circle object within the limits of the decode state
+func (c *Circle) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "Circle", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 1 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Circle", 1, size)
+	}
+	if err = ssz.ValidateBitvector(buf[:1], 2); err != nil {
+		return ssz.WrapDecodeError(err, "Circle", "", 0)
+	}
+	active := buf[:1]
+	buf = buf[1:]
+	size -= 1
+
+	// the size of the fixed part depends on the active fields
+	fixed := uint64(1)
+	if active[0]&1 != 0 {
+		fixed += 2
+	}
+	if active[0]&2 != 0 {
+		fixed += 4
+	}
+	if size < fixed {
+		return ssz.NewDecodeError(ssz.ErrSize, "Circle", 1+fixed, 1+size)
+	}
+
+	tail := buf
+	var o2 uint64
+	var last uint64
+
+	pos := uint64(0)
+
+	// Field (0) 'Color'
+	c.Color = ssz.UnmarshallUint8(buf[pos : pos+1])
+	pos += 1
+
+	// Field (1) 'Radius'
+	if active[0]&1 != 0 {
+		if len(buf[pos:pos+2]) != 2 {
+			return ssz.WrapDecodeError(ssz.ErrSize, "Circle", "Radius", 1+pos)
+		}
+		val := ssz.UnmarshallUint16(buf[pos : pos+2])
+		c.Radius = &val
+		pos += 2
+	} else {
+		c.Radius = nil
+	}
+
+	// Field (2) 'Label'
+	if active[0]&2 != 0 {
+		if o2 = ssz.ReadOffset(buf[pos : pos+4]); o2 > size || o2 < last {
+			return ssz.WrapDecodeError(ssz.ErrOffset, "Circle", "Label", 1+pos)
+		}
+		if last == 0 && o2 != fixed {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", fixed, o2), "Circle", "Label", 1+pos)
+		}
+		last = o2
+		pos += 4
+	} else {
+		c.Label = nil
+	}
+
+	if last == 0 && size != fixed {
+		return ssz.NewDecodeError(ssz.ErrSize, "Circle", 1+fixed, 1+size)
+	}
+
+	end := size
+
+	// Field (2) 'Label'
+	if active[0]&2 != 0 {
+		buf = tail[o2:end]
+		if c.Label == nil {
+			c.Label = new(ShapeLabel)
+		}
+		if err = ssz.UnmarshalWithState(c.Label, buf, st); err != nil {
+			return ssz.WrapDecodeError(err, "Circle", "Label", 1+o2)
+		}
+		end = o2
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a Circle object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (c *Circle) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 1 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Circle", 1, size)
+	}
+	if err = ssz.ValidateBitvector(buf[:1], 2); err != nil {
+		return ssz.WrapDecodeError(err, "Circle", "", 0)
+	}
+	active := buf[:1]
+	buf = buf[1:]
+	size -= 1
+
+	// the size of the fixed part depends on the active fields
+	fixed := uint64(1)
+	if active[0]&1 != 0 {
+		fixed += 2
+	}
+	if active[0]&2 != 0 {
+		fixed += 4
+	}
+	if size < fixed {
+		return ssz.NewDecodeError(ssz.ErrSize, "Circle", 1+fixed, 1+size)
+	}
+
+	tail := buf
+	var o2 uint64
+	var last uint64
+
+	pos := uint64(0)
+
+	// Field (0) 'Color'
+
+	pos += 1
+
+	// Field (1) 'Radius'
+	if active[0]&1 != 0 {
+		if len(buf[pos:pos+2]) != 2 {
+			return ssz.WrapDecodeError(ssz.ErrSize, "Circle", "Radius", 1+pos)
+		}
+
+		pos += 2
+	}
+
+	// Field (2) 'Label'
+	if active[0]&2 != 0 {
+		if o2 = ssz.ReadOffset(buf[pos : pos+4]); o2 > size || o2 < last {
+			return ssz.WrapDecodeError(ssz.ErrOffset, "Circle", "Label", 1+pos)
+		}
+		if last == 0 && o2 != fixed {
+			return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", fixed, o2), "Circle", "Label", 1+pos)
+		}
+		last = o2
+		pos += 4
+	}
+
+	if last == 0 && size != fixed {
+		return ssz.NewDecodeError(ssz.ErrSize, "Circle", 1+fixed, 1+size)
+	}
+
+	end := size
+
+	// Field (2) 'Label'
+	if active[0]&2 != 0 {
+		buf = tail[o2:end]
+		if err = (*ShapeLabel)(nil).ValidateSSZ(buf); err != nil {
+			return ssz.WrapDecodeError(err, "Circle", "Label", 1+o2)
+		}
+		end = o2
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the Circle object from a reader with an encoded size of 'size' bytes
+func (c *Circle) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, c)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the Circle object from a decoder
+func (c *Circle) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	buf, err := dec.Read(dec.Size())
+	if err != nil {
+		return err
+	}
+	return c.UnmarshalSSZWithState(buf, dec.State())
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Circle object
+func (c *Circle) SizeSSZ() (size int) {
+	size = 2
+
+	// Field (1) 'Radius'
+	if c.Radius != nil {
+		size += 2
+	}
+
+	// Field (2) 'Label'
+	if c.Label != nil {
+		size += 4 + c.Label.SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the Circle object
+func (c *Circle) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the Circle object with a hasher
+func (c *Circle) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+	active := [1]byte{}
+
+	// Field (0) 'Side'
+	hh.PutZero()
+
+	// Field (1) 'Color'
+	active[0] |= 2
+	hh.PutUint8(c.Color)
+
+	// Field (2) 'Radius'
+	if c.Radius != nil {
+		active[0] |= 4
+		hh.PutUint16(*c.Radius)
+	} else {
+		hh.PutZero()
+	}
+
+	// Field (3) 'Label'
+	if c.Label != nil {
+		active[0] |= 8
+		if err = c.Label.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else {
+		hh.PutZero()
+	}
+
+	hh.MerkleizeWithActiveFields(indx, active[:], 4)
+	return
+}
+
+// Copy returns a deep copy of the Circle object
+func (c *Circle) Copy() *Circle {
+	if c == nil {
+		return nil
+	}
+	cpy := new(Circle)
+	*cpy = *c
+
+	// Field 'Radius'
+	if cpy.Radius != nil {
+		val := *cpy.Radius
+		cpy.Radius = &val
+	}
+
+	// Field 'Label'
+	cpy.Label = cpy.Label.Copy()
+
+	return cpy
+}
+
+// EqualSSZ returns true if the Circle objects have the same SSZ encoding
+func (c *Circle) EqualSSZ(other *Circle) bool {
+	if c == nil {
+		c = new(Circle)
+	}
+	if other == nil {
+		other = new(Circle)
+	}
+
+	// Field 'Color'
+	if c.Color != other.Color {
+		return false
+	}
+
+	// Field 'Radius'
+	if (c.Radius == nil) != (other.Radius == nil) {
+		return false
+	} else if c.Radius != nil {
+		if *c.Radius != *other.Radius {
+			return false
+		}
+	}
+
+	// Field 'Label'
+	if (c.Label == nil) != (other.Label == nil) {
+		return false
+	} else if c.Label != nil {
+		if !c.Label.EqualSSZ(other.Label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the Circle objects
+func (c *Circle) DiffSSZ(other *Circle) []ssz.FieldDiff {
+	if c == nil {
+		c = new(Circle)
+	}
+	if other == nil {
+		other = new(Circle)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'Color'
+	if c.Color != other.Color {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Color", A: c.Color, B: other.Color})
+	}
+
+	// Field 'Radius'
+	if (c.Radius == nil) != (other.Radius == nil) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Radius", A: c.Radius, B: other.Radius})
+	} else if c.Radius != nil {
+		if *c.Radius != *other.Radius {
+			diffs = append(diffs, ssz.FieldDiff{Path: "Radius", A: c.Radius, B: other.Radius})
+		}
+	}
+
+	// Field 'Label'
+	if (c.Label == nil) != (other.Label == nil) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Label", A: c.Label, B: other.Label})
+	} else if c.Label != nil {
+		diffs = ssz.AppendDiffs(diffs, "Label", c.Label.DiffSSZ(other.Label))
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the Circle object following the Beacon API conventions
+func (c *Circle) MarshalJSON() ([]byte, error) {
+	if c == nil {
+		c = new(Circle)
+	}
+	var err error
+	dst := []byte{'{'}
+
+	// Field (0) 'Color'
+	dst = append(dst, "\"Color\":"...)
+	dst = ssz.MarshalJSONUint64(dst, uint64(c.Color))
+
+	// Field (1) 'Radius'
+	dst = append(dst, ",\"Radius\":"...)
+	if c.Radius == nil {
+		dst = append(dst, "null"...)
+	} else {
+		dst = ssz.MarshalJSONUint64(dst, uint64(*c.Radius))
+	}
+
+	// Field (2) 'Label'
+	dst = append(dst, ",\"Label\":"...)
+	if c.Label == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if dst, err = ssz.MarshalJSONObject(dst, c.Label); err != nil {
+			return nil, err
+		}
+	}
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the Circle object following the Beacon API conventions
+func (c *Circle) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'Color'
+	{
+		val, err := ssz.UnmarshalJSONUint64(fields["Color"], 1)
+		if err != nil {
+			return ssz.WrapJSONError(err, "Color")
+		}
+		c.Color = uint8(val)
+	}
+
+	// Field (1) 'Radius'
+	if ssz.IsJSONNull(fields["Radius"]) {
+		c.Radius = nil
+	} else {
+		c.Radius = new(uint16)
+		{
+			val, err := ssz.UnmarshalJSONUint64(fields["Radius"], 2)
+			if err != nil {
+				return ssz.WrapJSONError(err, "Radius")
+			}
+			*c.Radius = uint16(val)
+		}
+	}
+
+	// Field (2) 'Label'
+	if ssz.IsJSONNull(fields["Label"]) {
+		c.Label = nil
+	} else {
+		if c.Label == nil {
+			c.Label = new(ShapeLabel)
+		}
+		if err = ssz.UnmarshalJSONObject(fields["Label"], c.Label); err != nil {
+			return ssz.WrapJSONError(err, "Label")
+		}
+	}
+
+	return nil
+}
+
+// MarshalSSZ ssz marshals the Point object
+func (p *Point) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the Point object to a target array
+func (p *Point) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Active fields
+	active := [1]byte{}
+	if p.X != nil {
+		active[0] |= 1
+	}
+	if p.Y != nil {
+		active[0] |= 2
+	}
+	dst = append(dst, active[:]...)
+
+	offset := int(0)
+	if p.X != nil {
+		offset += 2
+	}
+	if p.Y != nil {
+		offset += 2
+	}
+
+	// Field (0) 'X'
+	if p.X != nil {
+		dst = ssz.MarshalUint16(dst, *p.X)
+	}
+
+	// Field (1) 'Y'
+	if p.Y != nil {
+		dst = ssz.MarshalUint16(dst, *p.Y)
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Point object to a writer
+func (p *Point) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, p)
+}
+
+// MarshalSSZToEncoder ssz marshals the Point object to an encoder
+func (p *Point) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+
+	var buf []byte
+	if buf, err = p.MarshalSSZTo(nil); err != nil {
+		return
+	}
+	enc.EncodeBytes(buf)
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Point object
+func (p *Point) UnmarshalSSZ(buf []byte) error {
+	return p.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the Point object within the limits of the decode state
+func (p *Point) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "Point", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 1 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Point", 1, size)
+	}
+	if err = ssz.ValidateActiveFields(buf[:1], 8, 2); err != nil {
+		return ssz.WrapDecodeError(err, "Point", "", 0)
+	}
+	active := buf[:1]
+	buf = buf[1:]
+	size -= 1
+
+	// the size of the fixed part depends on the active fields
+	fixed := uint64(0)
+	if active[0]&1 != 0 {
+		fixed += 2
+	}
+	if active[0]&2 != 0 {
+		fixed += 2
+	}
+	if size != fixed {
+		return ssz.NewDecodeError(ssz.ErrSize, "Point", 1+fixed, 1+size)
+	}
+
+	pos := uint64(0)
+
+	// Field (0) 'X'
+	if active[0]&1 != 0 {
+		if len(buf[pos:pos+2]) != 2 {
+			return ssz.WrapDecodeError(ssz.ErrSize, "Point", "X", 1+pos)
+		}
+		val := ssz.UnmarshallUint16(buf[pos : pos+2])
+		p.X = &val
+		pos += 2
+	} else {
+		p.X = nil
+	}
+
+	// Field (1) 'Y'
+	if active[0]&2 != 0 {
+		if len(buf[pos:pos+2]) != 2 {
+			return ssz.WrapDecodeError(ssz.ErrSize, "Point", "Y", 1+pos)
+		}
+		val := ssz.UnmarshallUint16(buf[pos : pos+2])
+		p.Y = &val
+		pos += 2
+	} else {
+		p.Y = nil
+	}
+
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a Point object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (p *Point) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 1 {
+		return ssz.NewDecodeError(ssz.ErrSize, "Point", 1, size)
+	}
+	if err = ssz.ValidateActiveFields(buf[:1], 8, 2); err != nil {
+		return ssz.WrapDecodeError(err, "Point", "", 0)
+	}
+	active := buf[:1]
+	buf = buf[1:]
+	size -= 1
+
+	// the size of the fixed part depends on the active fields
+	fixed := uint64(0)
+	if active[0]&1 != 0 {
+		fixed += 2
+	}
+	if active[0]&2 != 0 {
+		fixed += 2
+	}
+	if size != fixed {
+		return ssz.NewDecodeError(ssz.ErrSize, "Point", 1+fixed, 1+size)
+	}
+
+	pos := uint64(0)
+
+	// Field (0) 'X'
+	if active[0]&1 != 0 {
+		if len(buf[pos:pos+2]) != 2 {
+			return ssz.WrapDecodeError(ssz.ErrSize, "Point", "X", 1+pos)
+		}
+
+		pos += 2
+	}
+
+	// Field (1) 'Y'
+	if active[0]&2 != 0 {
+		if len(buf[pos:pos+2]) != 2 {
+			return ssz.WrapDecodeError(ssz.ErrSize, "Point", "Y", 1+pos)
+		}
+
+		pos += 2
+	}
+
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the Point object from a reader with an encoded size of 'size' bytes
+func (p *Point) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, p)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the Point object from a decoder
+func (p *Point) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	buf, err := dec.Read(dec.Size())
+	if err != nil {
+		return err
+	}
+	return p.UnmarshalSSZWithState(buf, dec.State())
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Point object
+func (p *Point) SizeSSZ() (size int) {
+	size = 1
+
+	// Field (0) 'X'
+	if p.X != nil {
+		size += 2
+	}
+
+	// Field (1) 'Y'
+	if p.Y != nil {
+		size += 2
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the Point object
+func (p *Point) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the Point object with a hasher
+func (p *Point) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+	active := [1]byte{}
+
+	// Field (0) 'X'
+	if p.X != nil {
+		active[0] |= 1
+		hh.PutUint16(*p.X)
+	} else {
+		hh.PutZero()
+	}
+
+	// Field (1) 'Y'
+	if p.Y != nil {
+		active[0] |= 2
+		hh.PutUint16(*p.Y)
+	} else {
+		hh.PutZero()
+	}
+
+	hh.MerkleizeWithActiveFields(indx, active[:], 8)
+	return
+}
+
+// Copy returns a deep copy of the Point object
+func (p *Point) Copy() *Point {
+	if p == nil {
+		return nil
+	}
+	cpy := new(Point)
+	*cpy = *p
+
+	// Field 'X'
+	if cpy.X != nil {
+		val := *cpy.X
+		cpy.X = &val
+	}
+
+	// Field 'Y'
+	if cpy.Y != nil {
+		val := *cpy.Y
+		cpy.Y = &val
+	}
+
+	return cpy
+}
+
+// EqualSSZ returns true if the Point objects have the same SSZ encoding
+func (p *Point) EqualSSZ(other *Point) bool {
+	if p == nil {
+		p = new(Point)
+	}
+	if other == nil {
+		other = new(Point)
+	}
+
+	// Field 'X'
+	if (p.X == nil) != (other.X == nil) {
+		return false
+	} else if p.X != nil {
+		if *p.X != *other.X {
+			return false
+		}
+	}
+
+	// Field 'Y'
+	if (p.Y == nil) != (other.Y == nil) {
+		return false
+	} else if p.Y != nil {
+		if *p.Y != *other.Y {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the Point objects
+func (p *Point) DiffSSZ(other *Point) []ssz.FieldDiff {
+	if p == nil {
+		p = new(Point)
+	}
+	if other == nil {
+		other = new(Point)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'X'
+	if (p.X == nil) != (other.X == nil) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "X", A: p.X, B: other.X})
+	} else if p.X != nil {
+		if *p.X != *other.X {
+			diffs = append(diffs, ssz.FieldDiff{Path: "X", A: p.X, B: other.X})
+		}
+	}
+
+	// Field 'Y'
+	if (p.Y == nil) != (other.Y == nil) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "Y", A: p.Y, B: other.Y})
+	} else if p.Y != nil {
+		if *p.Y != *other.Y {
+			diffs = append(diffs, ssz.FieldDiff{Path: "Y", A: p.Y, B: other.Y})
+		}
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the Point object following the Beacon API conventions
+func (p *Point) MarshalJSON() ([]byte, error) {
+	if p == nil {
+		p = new(Point)
+	}
+	dst := []byte{'{'}
+
+	// Field (0) 'X'
+	dst = append(dst, "\"X\":"...)
+	if p.X == nil {
+		dst = append(dst, "null"...)
+	} else {
+		dst = ssz.MarshalJSONUint64(dst, uint64(*p.X))
+	}
+
+	// Field (1) 'Y'
+	dst = append(dst, ",\"Y\":"...)
+	if p.Y == nil {
+		dst = append(dst, "null"...)
+	} else {
+		dst = ssz.MarshalJSONUint64(dst, uint64(*p.Y))
+	}
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the Point object following the Beacon API conventions
+func (p *Point) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'X'
+	if ssz.IsJSONNull(fields["X"]) {
+		p.X = nil
+	} else {
+		p.X = new(uint16)
+		{
+			val, err := ssz.UnmarshalJSONUint64(fields["X"], 2)
+			if err != nil {
+				return ssz.WrapJSONError(err, "X")
+			}
+			*p.X = uint16(val)
+		}
+	}
+
+	// Field (1) 'Y'
+	if ssz.IsJSONNull(fields["Y"]) {
+		p.Y = nil
+	} else {
+		p.Y = new(uint16)
+		{
+			val, err := ssz.UnmarshalJSONUint64(fields["Y"], 2)
+			if err != nil {
+				return ssz.WrapJSONError(err, "Y")
+			}
+			*p.Y = uint16(val)
+		}
+	}
+
+	return nil
+}
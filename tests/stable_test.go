@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/minio/sha256-simd"
+	ssz "github.com/prysmaticlabs/fastssz"
+)
+
+func TestStableContainer(t *testing.T) {
+	side, color, radius := uint16(0x42), uint8(1), uint16(0x42)
+
+	cases := []struct {
+		obj      ssz.Marshaler
+		expected string
+	}{
+		// test vectors of EIP-7495
+		{&Shape{Side: &side, Color: &color}, "03420001"},
+		{&Square{Side: side, Color: color}, "420001"},
+		{&Shape{Color: &color, Radius: &radius}, "06014200"},
+		{&Circle{Color: color, Radius: &radius}, "01014200"},
+		// variable size fields
+		{&Shape{Label: &ShapeLabel{Name: []byte{1, 2}}}, "0804000000040000000102"},
+		{&Circle{Color: color, Label: &ShapeLabel{Name: []byte{}}}, "02010500000004000000"},
+	}
+	for _, c := range cases {
+		buf, err := c.obj.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(buf) != c.expected {
+			t.Fatalf("bad encoding, expected %s but found %x", c.expected, buf)
+		}
+		if len(buf) != c.obj.SizeSSZ() {
+			t.Fatalf("bad size, expected %d but found %d", c.obj.SizeSSZ(), len(buf))
+		}
+
+		obj2 := reflect.New(reflect.TypeOf(c.obj).Elem()).Interface().(ssz.Unmarshaler)
+		if err := obj2.UnmarshalSSZ(buf); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(c.obj, obj2) {
+			t.Fatal("bad unmarshal")
+		}
+
+		obj3 := reflect.New(reflect.TypeOf(c.obj).Elem()).Interface().(ssz.Unmarshaler)
+		if err := ssz.UnmarshalSSZFromReader(bytes.NewReader(buf), len(buf), obj3); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(c.obj, obj3) {
+			t.Fatal("bad streamed decoding")
+		}
+	}
+}
+
+func TestStableContainerInvalid(t *testing.T) {
+	cases := []struct {
+		buf string
+		err error
+	}{
+		// bit of a field beyond the capacity
+		{"13420001", ssz.ErrBitvectorPadding},
+		// missing field
+		{"034200", ssz.ErrSize},
+		// extra bytes without variable fields
+		{"0342000100", ssz.ErrSize},
+		// offset does not point to the end of the fixed part
+		{"0805000000040000000102", ssz.ErrInvalidVariableOffset},
+	}
+	for _, c := range cases {
+		buf, _ := hex.DecodeString(c.buf)
+		if err := new(Shape).UnmarshalSSZ(buf); !errors.Is(err, c.err) {
+			t.Fatalf("%s: expected %v but found %v", c.buf, c.err, err)
+		}
+		if err := new(Shape).ValidateSSZ(buf); !errors.Is(err, c.err) {
+			t.Fatalf("%s: expected %v on validation but found %v", c.buf, c.err, err)
+		}
+	}
+}
+
+func TestStableContainerUndefinedField(t *testing.T) {
+	// the bit 5 is in the capacity but there is no field for it
+	buf, _ := hex.DecodeString("20")
+	if err := new(Point).UnmarshalSSZ(buf); !errors.Is(err, ssz.ErrUndefinedActiveField) {
+		t.Fatalf("expected ErrUndefinedActiveField but found %v", err)
+	}
+	if err := new(Point).ValidateSSZ(buf); !errors.Is(err, ssz.ErrUndefinedActiveField) {
+		t.Fatalf("expected ErrUndefinedActiveField on validation but found %v", err)
+	}
+
+	buf, _ = hex.DecodeString("024200")
+	var p Point
+	if err := p.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if p.X != nil || p.Y == nil || *p.Y != 0x42 {
+		t.Fatal("bad unmarshal")
+	}
+}
+
+func TestStableContainerHashTreeRoot(t *testing.T) {
+	side, color := uint16(0x42), uint8(1)
+
+	// merkleize the fields of the stable container and mix in the active fields
+	leaves := make([]byte, 4*32)
+	ssz.MarshalUint16(leaves[:0], side)
+	ssz.MarshalUint8(leaves[32:32], color)
+	fieldsRoot := hashPair(hashPair(leaves[:32], leaves[32:64]), hashPair(leaves[64:96], leaves[96:]))
+
+	active := make([]byte, 32)
+	active[0] = 3
+	expected := hashPair(fieldsRoot, active)
+
+	// a profile has the same root as the stable container with the same fields
+	for _, obj := range []ssz.HashRoot{
+		&Shape{Side: &side, Color: &color},
+		&Square{Side: side, Color: color},
+	} {
+		root, err := obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(root[:], expected) {
+			t.Fatalf("bad root, expected %x but found %x", expected, root)
+		}
+	}
+}
+
+func hashPair(a, b []byte) []byte {
+	res := sha256.Sum256(append(append([]byte{}, a...), b...))
+	return res[:]
+}
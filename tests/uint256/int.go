@@ -0,0 +1,7 @@
+// Package uint256 is a minimal stand-in for github.com/holiman/uint256 used
+// to test the code generated for uint256.Int fields.
+package uint256
+
+// Int is a 256 bits unsigned integer represented as four
+// uint64 limbs, the least significant limb first.
+type Int [4]uint64
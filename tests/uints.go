@@ -0,0 +1,15 @@
+package tests
+
+import "github.com/prysmaticlabs/fastssz/tests/uint256"
+
+type WideUints struct {
+	A [16]byte `ssz:"uint128"`
+	B [32]byte `ssz:"uint256"`
+	C *uint256.Int
+	D uint256.Int
+	E [][32]byte     `ssz:"uint256" ssz-max:"16"`
+	F []*uint256.Int `ssz-max:"16"`
+	G [][16]byte     `ssz:"uint128" ssz-max:"15"`
+	H [3][32]byte    `ssz:"uint256" ssz-size:"3"`
+	I []uint256.Int  `ssz-max:"4"`
+}
@@ -0,0 +1,1122 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 1e8b10eb6051f9f1c87932b958d5426586d3b39e0af22a2dd50c97a722ffd977
+package tests
+
+import (
+	"encoding/json"
+	"io"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/fastssz/tests/uint256"
+)
+
+// MarshalSSZ ssz marshals the WideUints object
+func (w *WideUints) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(w)
+}
+
+// MarshalSSZTo ssz marshals the WideUints object to a target array
+func (w *WideUints) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(224)
+
+	// Field (0) 'A'
+	dst = append(dst, w.A[:]...)
+
+	// Field (1) 'B'
+	dst = append(dst, w.B[:]...)
+
+	// Field (2) 'C'
+	if w.C == nil {
+		w.C = new(uint256.Int)
+	}
+	dst = ssz.MarshalUint256(dst, *w.C)
+
+	// Field (3) 'D'
+	dst = ssz.MarshalUint256(dst, w.D)
+
+	// Offset (4) 'E'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(w.E) * 32
+
+	// Offset (5) 'F'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(w.F) * 32
+
+	// Offset (6) 'G'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(w.G) * 16
+
+	// Field (7) 'H'
+	for ii := 0; ii < 3; ii++ {
+		dst = append(dst, w.H[ii][:]...)
+	}
+
+	// Offset (8) 'I'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(w.I) * 32
+
+	// Field (4) 'E'
+	if size := len(w.E); size > 16 {
+		err = ssz.ErrListTooBigFn("--.E", size, 16)
+		return
+	}
+	for ii := 0; ii < len(w.E); ii++ {
+		dst = append(dst, w.E[ii][:]...)
+	}
+
+	// Field (5) 'F'
+	if size := len(w.F); size > 16 {
+		err = ssz.ErrListTooBigFn("--.F", size, 16)
+		return
+	}
+	for ii := 0; ii < len(w.F); ii++ {
+		if w.F[ii] == nil {
+			w.F[ii] = new(uint256.Int)
+		}
+		dst = ssz.MarshalUint256(dst, *w.F[ii])
+	}
+
+	// Field (6) 'G'
+	if size := len(w.G); size > 15 {
+		err = ssz.ErrListTooBigFn("--.G", size, 15)
+		return
+	}
+	for ii := 0; ii < len(w.G); ii++ {
+		dst = append(dst, w.G[ii][:]...)
+	}
+
+	// Field (8) 'I'
+	if size := len(w.I); size > 4 {
+		err = ssz.ErrListTooBigFn("--.I", size, 4)
+		return
+	}
+	for ii := 0; ii < len(w.I); ii++ {
+		dst = ssz.MarshalUint256(dst, w.I[ii])
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the WideUints object to a writer
+func (w *WideUints) MarshalSSZToWriter(writer io.Writer) error {
+	return ssz.MarshalSSZToWriter(writer, w)
+}
+
+// MarshalSSZToEncoder ssz marshals the WideUints object to an encoder
+func (w *WideUints) MarshalSSZToEncoder(enc *ssz.Encoder) (err error) {
+	offset := int(224)
+
+	// Field (0) 'A'
+	enc.EncodeBytes(w.A[:])
+
+	// Field (1) 'B'
+	enc.EncodeBytes(w.B[:])
+
+	// Field (2) 'C'
+	if w.C == nil {
+		w.C = new(uint256.Int)
+	}
+	enc.EncodeUint256(*w.C)
+
+	// Field (3) 'D'
+	enc.EncodeUint256(w.D)
+
+	// Offset (4) 'E'
+	enc.EncodeOffset(offset)
+	offset += len(w.E) * 32
+
+	// Offset (5) 'F'
+	enc.EncodeOffset(offset)
+	offset += len(w.F) * 32
+
+	// Offset (6) 'G'
+	enc.EncodeOffset(offset)
+	offset += len(w.G) * 16
+
+	// Field (7) 'H'
+	for ii := 0; ii < 3; ii++ {
+		enc.EncodeBytes(w.H[ii][:])
+	}
+
+	// Offset (8) 'I'
+	enc.EncodeOffset(offset)
+	offset += len(w.I) * 32
+
+	// Field (4) 'E'
+	if size := len(w.E); size > 16 {
+		err = ssz.ErrListTooBigFn("--.E", size, 16)
+		return
+	}
+	for ii := 0; ii < len(w.E); ii++ {
+		enc.EncodeBytes(w.E[ii][:])
+	}
+
+	// Field (5) 'F'
+	if size := len(w.F); size > 16 {
+		err = ssz.ErrListTooBigFn("--.F", size, 16)
+		return
+	}
+	for ii := 0; ii < len(w.F); ii++ {
+		if w.F[ii] == nil {
+			w.F[ii] = new(uint256.Int)
+		}
+		enc.EncodeUint256(*w.F[ii])
+	}
+
+	// Field (6) 'G'
+	if size := len(w.G); size > 15 {
+		err = ssz.ErrListTooBigFn("--.G", size, 15)
+		return
+	}
+	for ii := 0; ii < len(w.G); ii++ {
+		enc.EncodeBytes(w.G[ii][:])
+	}
+
+	// Field (8) 'I'
+	if size := len(w.I); size > 4 {
+		err = ssz.ErrListTooBigFn("--.I", size, 4)
+		return
+	}
+	for ii := 0; ii < len(w.I); ii++ {
+		enc.EncodeUint256(w.I[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the WideUints object
+func (w *WideUints) UnmarshalSSZ(buf []byte) error {
+	return w.UnmarshalSSZWithState(buf, nil)
+}
+
+// UnmarshalSSZWithState ssz unmarshals the WideUints object within the limits of the decode state
+func (w *WideUints) UnmarshalSSZWithState(buf []byte, st *ssz.DecodeState) error {
+	var err error
+	if err = st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "WideUints", "", 0)
+	}
+	defer st.Leave()
+	size := uint64(len(buf))
+	if size < 224 {
+		return ssz.NewDecodeError(ssz.ErrSize, "WideUints", 224, size)
+	}
+
+	tail := buf
+	var o4, o5, o6, o8 uint64
+
+	// Field (0) 'A'
+	copy(w.A[:], buf[0:16])
+
+	// Field (1) 'B'
+	copy(w.B[:], buf[16:48])
+
+	// Field (2) 'C'
+	if w.C == nil {
+		w.C = new(uint256.Int)
+	}
+	*w.C = ssz.UnmarshallUint256(buf[48:80])
+
+	// Field (3) 'D'
+	w.D = ssz.UnmarshallUint256(buf[80:112])
+
+	// Offset (4) 'E'
+	if o4 = ssz.ReadOffset(buf[112:116]); o4 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "WideUints", "E", 112)
+	}
+
+	if o4 != 224 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 224, o4), "WideUints", "E", 112)
+	}
+
+	// Offset (5) 'F'
+	if o5 = ssz.ReadOffset(buf[116:120]); o5 > size || o4 > o5 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "WideUints", "F", 116)
+	}
+
+	// Offset (6) 'G'
+	if o6 = ssz.ReadOffset(buf[120:124]); o6 > size || o5 > o6 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "WideUints", "G", 120)
+	}
+
+	// Field (7) 'H'
+
+	for ii := 0; ii < 3; ii++ {
+		copy(w.H[ii][:], buf[124:220][ii*32:(ii+1)*32])
+	}
+
+	// Offset (8) 'I'
+	if o8 = ssz.ReadOffset(buf[220:224]); o8 > size || o6 > o8 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "WideUints", "I", 220)
+	}
+
+	// Field (4) 'E'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DivideInt2(len(buf), 32, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "E", o4)
+		}
+		if err = st.Alloc(num, 32); err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "E", o4)
+		}
+		w.E = make([][32]byte, num)
+		for ii := 0; ii < num; ii++ {
+			copy(w.E[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+
+	// Field (5) 'F'
+	{
+		buf = tail[o5:o6]
+		num, err := ssz.DivideInt2(len(buf), 32, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "F", o5)
+		}
+		if err = st.Alloc(num, 40); err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "F", o5)
+		}
+		w.F = make([]*uint256.Int, num)
+		for ii := 0; ii < num; ii++ {
+			if w.F[ii] == nil {
+				w.F[ii] = new(uint256.Int)
+			}
+			*w.F[ii] = ssz.UnmarshallUint256(buf[ii*32 : (ii+1)*32])
+		}
+	}
+
+	// Field (6) 'G'
+	{
+		buf = tail[o6:o8]
+		num, err := ssz.DivideInt2(len(buf), 16, 15)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "G", o6)
+		}
+		if err = st.Alloc(num, 16); err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "G", o6)
+		}
+		w.G = make([][16]byte, num)
+		for ii := 0; ii < num; ii++ {
+			copy(w.G[ii][:], buf[ii*16:(ii+1)*16])
+		}
+	}
+
+	// Field (8) 'I'
+	{
+		buf = tail[o8:]
+		num, err := ssz.DivideInt2(len(buf), 32, 4)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "I", o8)
+		}
+		if err = st.Alloc(num, 32); err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "I", o8)
+		}
+		w.I = make([]uint256.Int, num)
+		for ii := 0; ii < num; ii++ {
+			w.I[ii] = ssz.UnmarshallUint256(buf[ii*32 : (ii+1)*32])
+		}
+	}
+	return err
+}
+
+// ValidateSSZ checks that buf is a valid SSZ encoding of a WideUints object without decoding
+// it. It accepts the same inputs as UnmarshalSSZ and it does not use the receiver.
+func (w *WideUints) ValidateSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 224 {
+		return ssz.NewDecodeError(ssz.ErrSize, "WideUints", 224, size)
+	}
+
+	tail := buf
+	var o4, o5, o6, o8 uint64
+
+	// Offset (4) 'E'
+	if o4 = ssz.ReadOffset(buf[112:116]); o4 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "WideUints", "E", 112)
+	}
+
+	if o4 != 224 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 224, o4), "WideUints", "E", 112)
+	}
+
+	// Offset (5) 'F'
+	if o5 = ssz.ReadOffset(buf[116:120]); o5 > size || o4 > o5 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "WideUints", "F", 116)
+	}
+
+	// Offset (6) 'G'
+	if o6 = ssz.ReadOffset(buf[120:124]); o6 > size || o5 > o6 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "WideUints", "G", 120)
+	}
+
+	// Offset (8) 'I'
+	if o8 = ssz.ReadOffset(buf[220:224]); o8 > size || o6 > o8 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "WideUints", "I", 220)
+	}
+
+	// Field (4) 'E'
+	{
+		buf = tail[o4:o5]
+		if _, err = ssz.DivideInt2(len(buf), 32, 16); err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "E", o4)
+		}
+	}
+
+	// Field (5) 'F'
+	{
+		buf = tail[o5:o6]
+		if _, err = ssz.DivideInt2(len(buf), 32, 16); err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "F", o5)
+		}
+	}
+
+	// Field (6) 'G'
+	{
+		buf = tail[o6:o8]
+		if _, err = ssz.DivideInt2(len(buf), 16, 15); err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "G", o6)
+		}
+	}
+
+	// Field (8) 'I'
+	{
+		buf = tail[o8:]
+		if _, err = ssz.DivideInt2(len(buf), 32, 4); err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "I", o8)
+		}
+	}
+	return err
+}
+
+// UnmarshalSSZFromReader ssz unmarshals the WideUints object from a reader with an encoded size of 'size' bytes
+func (w *WideUints) UnmarshalSSZFromReader(reader io.Reader, size int) error {
+	return ssz.UnmarshalSSZFromReader(reader, size, w)
+}
+
+// UnmarshalSSZFromDecoder ssz unmarshals the WideUints object from a decoder
+func (w *WideUints) UnmarshalSSZFromDecoder(dec *ssz.Decoder) error {
+	size := dec.Size()
+	if size < 224 {
+		return ssz.NewDecodeError(ssz.ErrSize, "WideUints", 224, size)
+	}
+	st := dec.State()
+	if err := st.Enter(); err != nil {
+		return ssz.WrapDecodeError(err, "WideUints", "", 0)
+	}
+	defer st.Leave()
+	buf, err := dec.Read(224)
+	if err != nil {
+		return err
+	}
+	var o4, o5, o6, o8 uint64
+
+	// Field (0) 'A'
+	copy(w.A[:], buf[0:16])
+
+	// Field (1) 'B'
+	copy(w.B[:], buf[16:48])
+
+	// Field (2) 'C'
+	if w.C == nil {
+		w.C = new(uint256.Int)
+	}
+	*w.C = ssz.UnmarshallUint256(buf[48:80])
+
+	// Field (3) 'D'
+	w.D = ssz.UnmarshallUint256(buf[80:112])
+
+	// Offset (4) 'E'
+	if o4 = ssz.ReadOffset(buf[112:116]); o4 > size {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "WideUints", "E", 112)
+	}
+
+	if o4 != 224 {
+		return ssz.WrapDecodeError(ssz.NewDecodeError(ssz.ErrInvalidVariableOffset, "", 224, o4), "WideUints", "E", 112)
+	}
+
+	// Offset (5) 'F'
+	if o5 = ssz.ReadOffset(buf[116:120]); o5 > size || o4 > o5 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "WideUints", "F", 116)
+	}
+
+	// Offset (6) 'G'
+	if o6 = ssz.ReadOffset(buf[120:124]); o6 > size || o5 > o6 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "WideUints", "G", 120)
+	}
+
+	// Field (7) 'H'
+
+	for ii := 0; ii < 3; ii++ {
+		copy(w.H[ii][:], buf[124:220][ii*32:(ii+1)*32])
+	}
+
+	// Offset (8) 'I'
+	if o8 = ssz.ReadOffset(buf[220:224]); o8 > size || o6 > o8 {
+		return ssz.WrapDecodeError(ssz.ErrOffset, "WideUints", "I", 220)
+	}
+
+	// Field (4) 'E'
+	{
+		num, err := ssz.DivideInt2(int(o5-o4), 32, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "E", o4)
+		}
+		if err = st.Alloc(num, 32); err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "E", o4)
+		}
+		w.E = make([][32]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(32); err != nil {
+				return ssz.WrapDecodeError(err, "WideUints", "E", o4)
+			}
+			copy(w.E[ii][:], buf)
+		}
+	}
+
+	// Field (5) 'F'
+	{
+		num, err := ssz.DivideInt2(int(o6-o5), 32, 16)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "F", o5)
+		}
+		if err = st.Alloc(num, 40); err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "F", o5)
+		}
+		w.F = make([]*uint256.Int, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(32); err != nil {
+				return ssz.WrapDecodeError(err, "WideUints", "F", o5)
+			}
+			if w.F[ii] == nil {
+				w.F[ii] = new(uint256.Int)
+			}
+			*w.F[ii] = ssz.UnmarshallUint256(buf)
+		}
+	}
+
+	// Field (6) 'G'
+	{
+		num, err := ssz.DivideInt2(int(o8-o6), 16, 15)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "G", o6)
+		}
+		if err = st.Alloc(num, 16); err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "G", o6)
+		}
+		w.G = make([][16]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(16); err != nil {
+				return ssz.WrapDecodeError(err, "WideUints", "G", o6)
+			}
+			copy(w.G[ii][:], buf)
+		}
+	}
+
+	// Field (8) 'I'
+	{
+		num, err := ssz.DivideInt2(int(size-o8), 32, 4)
+		if err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "I", o8)
+		}
+		if err = st.Alloc(num, 32); err != nil {
+			return ssz.WrapDecodeError(err, "WideUints", "I", o8)
+		}
+		w.I = make([]uint256.Int, num)
+		for ii := 0; ii < num; ii++ {
+			if buf, err = dec.Read(32); err != nil {
+				return ssz.WrapDecodeError(err, "WideUints", "I", o8)
+			}
+			w.I[ii] = ssz.UnmarshallUint256(buf)
+		}
+	}
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the WideUints object
+func (w *WideUints) SizeSSZ() (size int) {
+	size = 224
+
+	// Field (4) 'E'
+	size += len(w.E) * 32
+
+	// Field (5) 'F'
+	size += len(w.F) * 32
+
+	// Field (6) 'G'
+	size += len(w.G) * 16
+
+	// Field (8) 'I'
+	size += len(w.I) * 32
+
+	return
+}
+
+// HashTreeRoot ssz hashes the WideUints object
+func (w *WideUints) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(w)
+}
+
+// HashTreeRootWith ssz hashes the WideUints object with a hasher
+func (w *WideUints) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutBytes(w.A[:])
+
+	// Field (1) 'B'
+	hh.PutBytes(w.B[:])
+
+	// Field (2) 'C'
+	if w.C == nil {
+		hh.PutUint256([4]uint64{})
+	} else {
+		hh.PutUint256(*w.C)
+	}
+
+	// Field (3) 'D'
+	hh.PutUint256(w.D)
+
+	// Field (4) 'E'
+	{
+		if size := len(w.E); size > 16 {
+			err = ssz.ErrListTooBigFn("--.E", size, 16)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range w.E {
+			hh.Append(i[:])
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(w.E))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(16, numItems, 32))
+	}
+
+	// Field (5) 'F'
+	{
+		if size := len(w.F); size > 16 {
+			err = ssz.ErrListTooBigFn("--.F", size, 16)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range w.F {
+			if i == nil {
+				hh.AppendUint256([4]uint64{})
+			} else {
+				hh.AppendUint256(*i)
+			}
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(w.F))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(16, numItems, 32))
+	}
+
+	// Field (6) 'G'
+	{
+		if size := len(w.G); size > 15 {
+			err = ssz.ErrListTooBigFn("--.G", size, 15)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range w.G {
+			hh.Append(i[:])
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(w.G))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(15, numItems, 16))
+	}
+
+	// Field (7) 'H'
+	{
+		subIndx := hh.Index()
+		for _, i := range w.H {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (8) 'I'
+	{
+		if size := len(w.I); size > 4 {
+			err = ssz.ErrListTooBigFn("--.I", size, 4)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range w.I {
+			hh.AppendUint256(i)
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(w.I))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(4, numItems, 32))
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// Copy returns a deep copy of the WideUints object
+func (w *WideUints) Copy() *WideUints {
+	if w == nil {
+		return nil
+	}
+	cpy := new(WideUints)
+	*cpy = *w
+
+	// Field 'C'
+	if cpy.C != nil {
+		val := *cpy.C
+		cpy.C = &val
+	}
+
+	// Field 'E'
+	cpy.E = append(cpy.E[:0:0], cpy.E...)
+
+	// Field 'F'
+	cpy.F = append(cpy.F[:0:0], cpy.F...)
+	for i0 := range cpy.F {
+		if cpy.F[i0] != nil {
+			val := *cpy.F[i0]
+			cpy.F[i0] = &val
+		}
+	}
+
+	// Field 'G'
+	cpy.G = append(cpy.G[:0:0], cpy.G...)
+
+	// Field 'I'
+	cpy.I = append(cpy.I[:0:0], cpy.I...)
+
+	return cpy
+}
+
+// EqualSSZ returns true if the WideUints objects have the same SSZ encoding
+func (w *WideUints) EqualSSZ(other *WideUints) bool {
+	if w == nil {
+		w = new(WideUints)
+	}
+	if other == nil {
+		other = new(WideUints)
+	}
+
+	// Field 'A'
+	if w.A != other.A {
+		return false
+	}
+
+	// Field 'B'
+	if w.B != other.B {
+		return false
+	}
+
+	// Field 'C'
+	{
+		var va, vb uint256.Int
+		if w.C != nil {
+			va = *w.C
+		}
+		if other.C != nil {
+			vb = *other.C
+		}
+		if va != vb {
+			return false
+		}
+	}
+
+	// Field 'D'
+	if w.D != other.D {
+		return false
+	}
+
+	// Field 'E'
+	if len(w.E) != len(other.E) {
+		return false
+	} else {
+		for i0 := range w.E {
+			if w.E[i0] != other.E[i0] {
+				return false
+			}
+		}
+	}
+
+	// Field 'F'
+	if len(w.F) != len(other.F) {
+		return false
+	} else {
+		for i0 := range w.F {
+			{
+				var va, vb uint256.Int
+				if w.F[i0] != nil {
+					va = *w.F[i0]
+				}
+				if other.F[i0] != nil {
+					vb = *other.F[i0]
+				}
+				if va != vb {
+					return false
+				}
+			}
+		}
+	}
+
+	// Field 'G'
+	if len(w.G) != len(other.G) {
+		return false
+	} else {
+		for i0 := range w.G {
+			if w.G[i0] != other.G[i0] {
+				return false
+			}
+		}
+	}
+
+	// Field 'H'
+	if w.H != other.H {
+		return false
+	}
+
+	// Field 'I'
+	if len(w.I) != len(other.I) {
+		return false
+	} else {
+		for i0 := range w.I {
+			if w.I[i0] != other.I[i0] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DiffSSZ returns the fields with a different SSZ encoding in the WideUints objects
+func (w *WideUints) DiffSSZ(other *WideUints) []ssz.FieldDiff {
+	if w == nil {
+		w = new(WideUints)
+	}
+	if other == nil {
+		other = new(WideUints)
+	}
+	var diffs []ssz.FieldDiff
+
+	// Field 'A'
+	if w.A != other.A {
+		diffs = append(diffs, ssz.FieldDiff{Path: "A", A: w.A, B: other.A})
+	}
+
+	// Field 'B'
+	if w.B != other.B {
+		diffs = append(diffs, ssz.FieldDiff{Path: "B", A: w.B, B: other.B})
+	}
+
+	// Field 'C'
+	{
+		var va, vb uint256.Int
+		if w.C != nil {
+			va = *w.C
+		}
+		if other.C != nil {
+			vb = *other.C
+		}
+		if va != vb {
+			diffs = append(diffs, ssz.FieldDiff{Path: "C", A: w.C, B: other.C})
+		}
+	}
+
+	// Field 'D'
+	if w.D != other.D {
+		diffs = append(diffs, ssz.FieldDiff{Path: "D", A: w.D, B: other.D})
+	}
+
+	// Field 'E'
+	if len(w.E) != len(other.E) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "E", A: w.E, B: other.E})
+	} else {
+		for i0 := range w.E {
+			if w.E[i0] != other.E[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("E", i0), A: w.E[i0], B: other.E[i0]})
+			}
+		}
+	}
+
+	// Field 'F'
+	if len(w.F) != len(other.F) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "F", A: w.F, B: other.F})
+	} else {
+		for i0 := range w.F {
+			{
+				var va, vb uint256.Int
+				if w.F[i0] != nil {
+					va = *w.F[i0]
+				}
+				if other.F[i0] != nil {
+					vb = *other.F[i0]
+				}
+				if va != vb {
+					diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("F", i0), A: w.F[i0], B: other.F[i0]})
+				}
+			}
+		}
+	}
+
+	// Field 'G'
+	if len(w.G) != len(other.G) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "G", A: w.G, B: other.G})
+	} else {
+		for i0 := range w.G {
+			if w.G[i0] != other.G[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("G", i0), A: w.G[i0], B: other.G[i0]})
+			}
+		}
+	}
+
+	// Field 'H'
+	if w.H != other.H {
+		diffs = append(diffs, ssz.FieldDiff{Path: "H", A: w.H, B: other.H})
+	}
+
+	// Field 'I'
+	if len(w.I) != len(other.I) {
+		diffs = append(diffs, ssz.FieldDiff{Path: "I", A: w.I, B: other.I})
+	} else {
+		for i0 := range w.I {
+			if w.I[i0] != other.I[i0] {
+				diffs = append(diffs, ssz.FieldDiff{Path: ssz.IndexPath("I", i0), A: w.I[i0], B: other.I[i0]})
+			}
+		}
+	}
+
+	return diffs
+}
+
+// MarshalJSON returns the JSON encoding of the WideUints object following the Beacon API conventions
+func (w *WideUints) MarshalJSON() ([]byte, error) {
+	if w == nil {
+		w = new(WideUints)
+	}
+	dst := []byte{'{'}
+
+	// Field (0) 'A'
+	dst = append(dst, "\"A\":"...)
+	dst = ssz.MarshalJSONUint(dst, w.A[:])
+
+	// Field (1) 'B'
+	dst = append(dst, ",\"B\":"...)
+	dst = ssz.MarshalJSONUint(dst, w.B[:])
+
+	// Field (2) 'C'
+	dst = append(dst, ",\"C\":"...)
+	if w.C == nil {
+		dst = ssz.MarshalJSONUint(dst, nil)
+	} else {
+		dst = ssz.MarshalJSONUint(dst, ssz.MarshalUint256(nil, *w.C))
+	}
+
+	// Field (3) 'D'
+	dst = append(dst, ",\"D\":"...)
+	dst = ssz.MarshalJSONUint(dst, ssz.MarshalUint256(nil, w.D))
+
+	// Field (4) 'E'
+	dst = append(dst, ",\"E\":"...)
+	dst = append(dst, '[')
+	for i0 := range w.E {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONUint(dst, w.E[i0][:])
+	}
+	dst = append(dst, ']')
+
+	// Field (5) 'F'
+	dst = append(dst, ",\"F\":"...)
+	dst = append(dst, '[')
+	for i0 := range w.F {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		if w.F[i0] == nil {
+			dst = ssz.MarshalJSONUint(dst, nil)
+		} else {
+			dst = ssz.MarshalJSONUint(dst, ssz.MarshalUint256(nil, *w.F[i0]))
+		}
+	}
+	dst = append(dst, ']')
+
+	// Field (6) 'G'
+	dst = append(dst, ",\"G\":"...)
+	dst = append(dst, '[')
+	for i0 := range w.G {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONUint(dst, w.G[i0][:])
+	}
+	dst = append(dst, ']')
+
+	// Field (7) 'H'
+	dst = append(dst, ",\"H\":"...)
+	dst = append(dst, '[')
+	for i0 := range w.H {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONUint(dst, w.H[i0][:])
+	}
+	dst = append(dst, ']')
+
+	// Field (8) 'I'
+	dst = append(dst, ",\"I\":"...)
+	dst = append(dst, '[')
+	for i0 := range w.I {
+		if i0 != 0 {
+			dst = append(dst, ',')
+		}
+		dst = ssz.MarshalJSONUint(dst, ssz.MarshalUint256(nil, w.I[i0]))
+	}
+	dst = append(dst, ']')
+
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// UnmarshalJSON decodes the JSON encoding of the WideUints object following the Beacon API conventions
+func (w *WideUints) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// Field (0) 'A'
+	{
+		val, err := ssz.UnmarshalJSONUint(fields["A"], 16)
+		if err != nil {
+			return ssz.WrapJSONError(err, "A")
+		}
+		copy(w.A[:], val)
+	}
+
+	// Field (1) 'B'
+	{
+		val, err := ssz.UnmarshalJSONUint(fields["B"], 32)
+		if err != nil {
+			return ssz.WrapJSONError(err, "B")
+		}
+		copy(w.B[:], val)
+	}
+
+	// Field (2) 'C'
+	{
+		val, err := ssz.UnmarshalJSONUint(fields["C"], 32)
+		if err != nil {
+			return ssz.WrapJSONError(err, "C")
+		}
+		w.C = new(uint256.Int)
+		*w.C = ssz.UnmarshallUint256(val)
+	}
+
+	// Field (3) 'D'
+	{
+		val, err := ssz.UnmarshalJSONUint(fields["D"], 32)
+		if err != nil {
+			return ssz.WrapJSONError(err, "D")
+		}
+		w.D = ssz.UnmarshallUint256(val)
+	}
+
+	// Field (4) 'E'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["E"], 0, 16)
+		if err != nil {
+			return ssz.WrapJSONError(err, "E")
+		}
+		w.E = make([][32]byte, len(items0))
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONUint(items0[i0], 32)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("E", i0))
+				}
+				copy(w.E[i0][:], val)
+			}
+		}
+	}
+
+	// Field (5) 'F'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["F"], 0, 16)
+		if err != nil {
+			return ssz.WrapJSONError(err, "F")
+		}
+		w.F = make([]*uint256.Int, len(items0))
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONUint(items0[i0], 32)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("F", i0))
+				}
+				w.F[i0] = new(uint256.Int)
+				*w.F[i0] = ssz.UnmarshallUint256(val)
+			}
+		}
+	}
+
+	// Field (6) 'G'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["G"], 0, 15)
+		if err != nil {
+			return ssz.WrapJSONError(err, "G")
+		}
+		w.G = make([][16]byte, len(items0))
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONUint(items0[i0], 16)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("G", i0))
+				}
+				copy(w.G[i0][:], val)
+			}
+		}
+	}
+
+	// Field (7) 'H'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["H"], 3, 3)
+		if err != nil {
+			return ssz.WrapJSONError(err, "H")
+		}
+
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONUint(items0[i0], 32)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("H", i0))
+				}
+				copy(w.H[i0][:], val)
+			}
+		}
+	}
+
+	// Field (8) 'I'
+	{
+		items0, err := ssz.UnmarshalJSONList(fields["I"], 0, 4)
+		if err != nil {
+			return ssz.WrapJSONError(err, "I")
+		}
+		w.I = make([]uint256.Int, len(items0))
+		for i0 := range items0 {
+			{
+				val, err := ssz.UnmarshalJSONUint(items0[i0], 32)
+				if err != nil {
+					return ssz.WrapJSONError(err, ssz.IndexPath("I", i0))
+				}
+				w.I[i0] = ssz.UnmarshallUint256(val)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/fastssz/tests/uint256"
+)
+
+func TestWideUints(t *testing.T) {
+	var b [32]byte
+	for i := range b {
+		b[i] = byte(i + 1)
+	}
+	d := uint256.Int(ssz.UnmarshallUint256(b[:]))
+
+	obj := &WideUints{
+		A: [16]byte{1, 2, 3},
+		B: b,
+		C: &uint256.Int{1, 2, 3, 4},
+		D: d,
+		E: [][32]byte{b, {5}},
+		F: []*uint256.Int{&d, {5}},
+		G: [][16]byte{{1}, {2}, {3}},
+		H: [3][32]byte{{1}, {2}, b},
+		I: []uint256.Int{d},
+	}
+
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buf) != obj.SizeSSZ() {
+		t.Fatalf("bad size, expected %d but found %d", obj.SizeSSZ(), len(buf))
+	}
+	// the byte array and the limbs representations encode the same uint256
+	if !bytes.Equal(buf[16:48], buf[80:112]) {
+		t.Fatal("uint256 representations do not match")
+	}
+
+	obj2 := new(WideUints)
+	if err := obj2.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj2) {
+		t.Fatal("bad unmarshal")
+	}
+
+	// stream the object back and forth
+	var w bytes.Buffer
+	if err := obj.MarshalSSZToWriter(&w); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(w.Bytes(), buf) {
+		t.Fatal("bad streamed encoding")
+	}
+	obj3 := new(WideUints)
+	if err := obj3.UnmarshalSSZFromReader(&w, len(buf)); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(obj, obj3) {
+		t.Fatal("bad streamed decoding")
+	}
+
+	// the lists of byte arrays and limbs with the same values have the same root
+	hashList := func(fn func(hh *ssz.Hasher)) [32]byte {
+		hh := ssz.NewHasher()
+		fn(hh)
+		root, err := hh.HashRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return root
+	}
+	bytesRoot := hashList(func(hh *ssz.Hasher) {
+		indx := hh.Index()
+		for _, i := range obj.E {
+			hh.Append(i[:])
+		}
+		hh.MerkleizeWithMixin(indx, uint64(len(obj.E)), ssz.CalculateLimit(16, uint64(len(obj.E)), 32))
+	})
+	limbsRoot := hashList(func(hh *ssz.Hasher) {
+		hh.PutUint256Array([][4]uint64{d, {5}}, 16)
+	})
+	if bytesRoot != limbsRoot {
+		t.Fatal("uint256 list roots do not match")
+	}
+
+	if _, err := obj.HashTreeRoot(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWideUintsNil(t *testing.T) {
+	// nil pointers are hashed as zero, like they are encoded
+	obj := &WideUints{F: []*uint256.Int{nil, {5}}}
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	zero := &WideUints{C: &uint256.Int{}, F: []*uint256.Int{{}, {5}}}
+	expected, err := zero.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Fatalf("expected root %x but found %x", expected, root)
+	}
+
+	if _, err := (&WideUints{}).HashTreeRoot(); err != nil {
+		t.Fatal(err)
+	}
+}
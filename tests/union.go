@@ -0,0 +1,14 @@
+package tests
+
+type UnionA struct {
+	A uint64
+}
+
+type UnionB struct {
+	B []byte `ssz-max:"32"`
+}
+
+type UnionContainer struct {
+	Value interface{} `ssz-union:"None,UnionA,UnionB"`
+	Other uint64
+}
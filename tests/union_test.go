@@ -0,0 +1,103 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/minio/sha256-simd"
+	ssz "github.com/prysmaticlabs/fastssz"
+)
+
+func TestUnion(t *testing.T) {
+	cases := []struct {
+		value    interface{}
+		selector byte
+	}{
+		{nil, 0},
+		{&UnionA{A: 10}, 1},
+		{&UnionB{B: []byte{1, 2, 3}}, 2},
+		{&UnionB{B: []byte{}}, 2},
+	}
+	for _, c := range cases {
+		obj := &UnionContainer{Value: c.value, Other: 5}
+
+		buf, err := obj.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(buf) != obj.SizeSSZ() {
+			t.Fatalf("bad size, expected %d but found %d", obj.SizeSSZ(), len(buf))
+		}
+		if buf[12] != c.selector {
+			t.Fatalf("bad selector %d", buf[12])
+		}
+
+		obj2 := new(UnionContainer)
+		if err := obj2.UnmarshalSSZ(buf); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(obj, obj2) {
+			t.Fatal("bad unmarshal")
+		}
+
+		obj3 := new(UnionContainer)
+		if err := obj3.UnmarshalSSZFromReader(bytes.NewReader(buf), len(buf)); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(obj, obj3) {
+			t.Fatal("bad streamed decoding")
+		}
+
+		// root of the union is mix_in_selector(hash_tree_root(value), selector)
+		var valueRoot [32]byte
+		if c.value != nil {
+			if valueRoot, err = c.value.(ssz.HashRoot).HashTreeRoot(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		selector := make([]byte, 32)
+		selector[0] = c.selector
+		unionRoot := sha256.Sum256(append(valueRoot[:], selector...))
+
+		other := make([]byte, 32)
+		other[0] = 5
+		expected := sha256.Sum256(append(unionRoot[:], other...))
+
+		root, err := obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != expected {
+			t.Fatalf("bad root, expected %x but found %x", expected, root)
+		}
+	}
+}
+
+func TestUnionInvalid(t *testing.T) {
+	if _, err := (&UnionContainer{Value: &Chunk{}}).MarshalSSZ(); err != ssz.ErrUnionType {
+		t.Fatalf("expected union type error but found %v", err)
+	}
+
+	buf, err := (&UnionContainer{Value: &UnionA{}}).MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// selector out of range
+	buf[12] = 3
+	if err := new(UnionContainer).UnmarshalSSZ(buf); !errors.Is(err, ssz.ErrUnionSelector) {
+		t.Fatalf("expected union selector error but found %v", err)
+	}
+	if err := new(UnionContainer).ValidateSSZ(buf); !errors.Is(err, ssz.ErrUnionSelector) {
+		t.Fatalf("expected union selector error on validation but found %v", err)
+	}
+	// None with a value
+	buf[12] = 0
+	if err := new(UnionContainer).UnmarshalSSZ(buf); !errors.Is(err, ssz.ErrSize) {
+		t.Fatalf("expected size error but found %v", err)
+	}
+	if err := new(UnionContainer).ValidateSSZ(buf); !errors.Is(err, ssz.ErrSize) {
+		t.Fatalf("expected size error on validation but found %v", err)
+	}
+}
@@ -165,6 +165,54 @@ func TreeFromNodesWithMixin(leaves []*Node, num, limit int) (*Node, error) {
 	return NewNodeWithLR(mainTree, countLeaf), nil
 }
 
+// TreeFromNodesProgressive constructs a progressive tree (EIP-7916) from leaf nodes.
+// The leaves are split in subtrees of 1, 4, 16... leaves, each subtree is the right
+// child of a node whose left child holds the rest of the leaves.
+func TreeFromNodesProgressive(leaves []*Node) (*Node, error) {
+	return treeFromNodesProgressive(leaves, 1)
+}
+
+func treeFromNodesProgressive(leaves []*Node, numLeaves int) (*Node, error) {
+	if len(leaves) == 0 {
+		return EmptyLeaf(), nil
+	}
+	num := numLeaves
+	if len(leaves) < num {
+		num = len(leaves)
+	}
+	rest, err := treeFromNodesProgressive(leaves[num:], numLeaves*4)
+	if err != nil {
+		return nil, err
+	}
+	subLeaves := make([]*Node, numLeaves)
+	emptyLeaf := EmptyLeaf()
+	for i := 0; i < numLeaves; i++ {
+		if i < num {
+			subLeaves[i] = leaves[i]
+		} else {
+			subLeaves[i] = emptyLeaf
+		}
+	}
+	subtree, err := TreeFromNodes(subLeaves)
+	if err != nil {
+		return nil, err
+	}
+	return NewNodeWithLR(rest, subtree), nil
+}
+
+// TreeFromNodesProgressiveWithMixin constructs a progressive tree (EIP-7916)
+// from leaf nodes and mixes in the number of items.
+func TreeFromNodesProgressiveWithMixin(leaves []*Node, num int) (*Node, error) {
+	mainTree, err := TreeFromNodesProgressive(leaves)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mixin len
+	countLeaf := LeafFromUint64(uint64(num))
+	return NewNodeWithLR(mainTree, countLeaf), nil
+}
+
 // Get fetches a node with the given general index.
 func (n *Node) Get(index int) (*Node, error) {
 	pathLen := getPathLength(index)
@@ -186,11 +234,17 @@ func (n *Node) Get(index int) (*Node, error) {
 // Hash returns the hash of the subtree with the given Node as its root.
 // If root has no children, it returns root's value (not its hash).
 func (n *Node) Hash() []byte {
+	return n.HashWith(SHA256)
+}
+
+// HashWith returns the hash of the subtree with the hash function 'fn'.
+// If root has no children, it returns root's value (not its hash).
+func (n *Node) HashWith(fn HashFunction) []byte {
 	// TODO: handle special cases: empty root, one non-empty node
-	return hashNode(n)
+	return hashNode(n, fn)
 }
 
-func hashNode(n *Node) []byte {
+func hashNode(n *Node, fn HashFunction) []byte {
 	// Leaf
 	if n.left == nil && n.right == nil {
 		return n.value
@@ -199,12 +253,18 @@ func hashNode(n *Node) []byte {
 	if n.left == nil || n.right == nil {
 		panic("Tree incomplete")
 	}
-	return hashFn(append(hashNode(n.left), hashNode(n.right)...))
+	return hashPair(fn, hashNode(n.left, fn), hashNode(n.right, fn))
 }
 
 // Prove returns a list of sibling values and hashes needed
 // to compute the root hash for a given general index.
 func (n *Node) Prove(index int) (*Proof, error) {
+	return n.ProveWith(index, SHA256)
+}
+
+// ProveWith returns the proof of a general index with the
+// sibling hashes computed with the hash function 'fn'.
+func (n *Node) ProveWith(index int, fn HashFunction) (*Proof, error) {
 	pathLen := getPathLength(index)
 	proof := &Proof{Index: index}
 	hashes := make([][]byte, 0, pathLen)
@@ -213,10 +273,10 @@ func (n *Node) Prove(index int) (*Proof, error) {
 	for i := pathLen - 1; i >= 0; i-- {
 		var siblingHash []byte
 		if isRight := getPosAtLevel(index, i); isRight {
-			siblingHash = hashNode(cur.left)
+			siblingHash = hashNode(cur.left, fn)
 			cur = cur.right
 		} else {
-			siblingHash = hashNode(cur.right)
+			siblingHash = hashNode(cur.right, fn)
 			cur = cur.left
 		}
 		hashes = append([][]byte{siblingHash}, hashes...)
@@ -232,6 +292,12 @@ func (n *Node) Prove(index int) (*Proof, error) {
 }
 
 func (n *Node) ProveMulti(indices []int) (*Multiproof, error) {
+	return n.ProveMultiWith(indices, SHA256)
+}
+
+// ProveMultiWith returns the proof of several general indices with
+// the hashes computed with the hash function 'fn'.
+func (n *Node) ProveMultiWith(indices []int, fn HashFunction) (*Multiproof, error) {
 	reqIndices := getRequiredIndices(indices)
 	proof := &Multiproof{Indices: indices, Leaves: make([][]byte, len(indices)), Hashes: make([][]byte, len(reqIndices))}
 
@@ -248,12 +314,22 @@ func (n *Node) ProveMulti(indices []int) (*Multiproof, error) {
 		if err != nil {
 			return nil, err
 		}
-		proof.Hashes[i] = hashNode(cur)
+		proof.Hashes[i] = hashNode(cur, fn)
 	}
 
 	return proof, nil
 }
 
+func LeafFromUint256(i [4]uint64) *Node {
+	return NewNodeWithValue(MarshalUint256(make([]byte, 0, 32), i))
+}
+
+func LeafFromUint128(i [2]uint64) *Node {
+	buf := make([]byte, 32)
+	MarshalUint128(buf[:0], i)
+	return NewNodeWithValue(buf)
+}
+
 func LeafFromUint64(i uint64) *Node {
 	buf := make([]byte, 32)
 	binary.LittleEndian.PutUint64(buf[:8], i)
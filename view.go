@@ -0,0 +1,250 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// ErrViewIndex is returned when accessing an item beyond the length of a list view
+var ErrViewIndex = errors.New("index out of range")
+
+// View is a read-only view over the SSZ encoding of a value. The fields of
+// the value are located on demand by walking the offsets of the encoding
+// instead of decoding it in full. The first error found while walking the
+// encoding is kept in the view and returned by its accessors.
+// The byte slices returned by the view reference the input buffer.
+type View struct {
+	buf   []byte
+	fixed uint64
+	err   error
+}
+
+// NewView creates a view over the SSZ encoding in buf
+func NewView(buf []byte) View {
+	return View{buf: buf}
+}
+
+// Err returns the error found while walking the encoding if any
+func (v View) Err() error {
+	return v.err
+}
+
+// Bytes returns the encoding of the value
+func (v View) Bytes() ([]byte, error) {
+	if v.err != nil {
+		return nil, v.err
+	}
+	return v.buf, nil
+}
+
+// Unmarshal decodes the value into u
+func (v View) Unmarshal(u Unmarshaler) error {
+	if v.err != nil {
+		return v.err
+	}
+	return u.UnmarshalSSZ(v.buf)
+}
+
+func (v View) fail(err error) View {
+	v.buf, v.err = nil, err
+	return v
+}
+
+// Container checks that the view is a container with a fixed part of 'fixed' bytes.
+// 'first' is the position of the offset of the first dynamic field or -1 if the
+// container is fixed, in which case the size of the encoding must be 'fixed' bytes.
+func (v View) Container(fixed uint64, first int) View {
+	if v.err != nil {
+		return v
+	}
+	size := uint64(len(v.buf))
+	if first < 0 {
+		if size != fixed {
+			return v.fail(ErrSize)
+		}
+	} else {
+		if size < fixed {
+			return v.fail(ErrSize)
+		}
+		if ReadOffset(v.buf[first:first+4]) != fixed {
+			return v.fail(ErrInvalidVariableOffset)
+		}
+	}
+	v.fixed = fixed
+	return v
+}
+
+// Field returns the view of the fixed field of the container at [start:end]
+func (v View) Field(start, end uint64) View {
+	if v.err != nil {
+		return v
+	}
+	return View{buf: v.buf[start:end]}
+}
+
+// DynamicField returns the view of the dynamic field of the container with the offset
+// at 'pos'. 'next' is the position of the offset of the next dynamic field or 0 if it
+// is the last one. The offsets are checked with the same rules as UnmarshalSSZ.
+func (v View) DynamicField(pos, next uint64) View {
+	if v.err != nil {
+		return v
+	}
+	size := uint64(len(v.buf))
+	start, end := ReadOffset(v.buf[pos:pos+4]), size
+	if next != 0 {
+		end = ReadOffset(v.buf[next : next+4])
+	}
+	if start < v.fixed || start > end || end > size {
+		return v.fail(ErrOffset)
+	}
+	return View{buf: v.buf[start:end]}
+}
+
+// List returns the view of a list with at most 'max' items of 'itemSize' bytes. If
+// 'itemSize' is zero the items are dynamic and they are located with the same
+// rules as DecodeDynamicLength and UnmarshalDynamic.
+func (v View) List(itemSize uint64, max int) ListView {
+	l := ListView{view: v, itemSize: itemSize}
+	if v.err != nil {
+		return l
+	}
+	size := uint64(len(v.buf))
+	if itemSize != 0 {
+		if size%itemSize != 0 {
+			l.view = v.fail(ErrSize)
+		} else if l.num = int(size / itemSize); l.num > max {
+			l.view = v.fail(ErrListTooBig)
+		}
+		return l
+	}
+	num, err := DecodeDynamicLength(v.buf, max)
+	if err != nil {
+		l.view = v.fail(err)
+	} else {
+		l.num = num
+	}
+	return l
+}
+
+// Bool decodes the value as a boolean
+func (v View) Bool() (bool, error) {
+	if v.err != nil {
+		return false, v.err
+	}
+	if len(v.buf) != 1 {
+		return false, ErrSize
+	}
+	return DecodeBool(v.buf)
+}
+
+// Uint8 decodes the value as an uint8
+func (v View) Uint8() (uint8, error) {
+	if v.err != nil {
+		return 0, v.err
+	}
+	if len(v.buf) != 1 {
+		return 0, ErrSize
+	}
+	return v.buf[0], nil
+}
+
+// Uint16 decodes the value as an uint16
+func (v View) Uint16() (uint16, error) {
+	if v.err != nil {
+		return 0, v.err
+	}
+	if len(v.buf) != 2 {
+		return 0, ErrSize
+	}
+	return binary.LittleEndian.Uint16(v.buf), nil
+}
+
+// Uint32 decodes the value as an uint32
+func (v View) Uint32() (uint32, error) {
+	if v.err != nil {
+		return 0, v.err
+	}
+	if len(v.buf) != 4 {
+		return 0, ErrSize
+	}
+	return binary.LittleEndian.Uint32(v.buf), nil
+}
+
+// Uint64 decodes the value as an uint64
+func (v View) Uint64() (uint64, error) {
+	if v.err != nil {
+		return 0, v.err
+	}
+	if len(v.buf) != 8 {
+		return 0, ErrSize
+	}
+	return binary.LittleEndian.Uint64(v.buf), nil
+}
+
+// ByteList returns the encoding of a byte list with at most 'max' bytes
+func (v View) ByteList(max uint64) ([]byte, error) {
+	if v.err != nil {
+		return nil, v.err
+	}
+	if uint64(len(v.buf)) > max {
+		return nil, ErrBytesLength
+	}
+	return v.buf, nil
+}
+
+// Bitlist returns the encoding of a bitlist with at most 'max' bits
+func (v View) Bitlist(max uint64) ([]byte, error) {
+	if v.err != nil {
+		return nil, v.err
+	}
+	if err := ValidateBitlist(v.buf, max); err != nil {
+		return nil, err
+	}
+	return v.buf, nil
+}
+
+// ListView is a read-only view over the SSZ encoding of a list or a vector
+type ListView struct {
+	view     View
+	itemSize uint64
+	num      int
+}
+
+// Err returns the error found while walking the encoding if any
+func (l ListView) Err() error {
+	return l.view.err
+}
+
+// Len returns the number of items of the list
+func (l ListView) Len() (int, error) {
+	return l.num, l.view.err
+}
+
+// At returns the view of the i-th item of the list
+func (l ListView) At(i int) View {
+	v := l.view
+	if v.err != nil {
+		return v
+	}
+	if i < 0 || i >= l.num {
+		return v.fail(ErrViewIndex)
+	}
+	if l.itemSize != 0 {
+		return View{buf: v.buf[uint64(i)*l.itemSize : uint64(i+1)*l.itemSize]}
+	}
+
+	// the offsets cannot point to the offsets
+	// and each item ends where the next starts
+	size := uint64(len(v.buf))
+	start, end := ReadOffset(v.buf[4*i:]), size
+	if i != l.num-1 {
+		end = ReadOffset(v.buf[4*(i+1):])
+	}
+	if start < uint64(4*l.num) || start > end {
+		return v.fail(ErrOffsetOrdering)
+	}
+	if end > size {
+		return v.fail(ErrOffsetExceedsSize)
+	}
+	return View{buf: v.buf[start:end]}
+}
@@ -0,0 +1,71 @@
+package ssz
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestViewDynamicList(t *testing.T) {
+	// list with the items {1}, {} and {2, 3}
+	buf := []byte{12, 0, 0, 0, 13, 0, 0, 0, 13, 0, 0, 0, 1, 2, 3}
+
+	l := NewView(buf).List(0, 3)
+	num, err := l.Len()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if num != 3 {
+		t.Fatalf("expected 3 items but found %d", num)
+	}
+	for i, item := range [][]byte{{1}, {}, {2, 3}} {
+		res, err := l.At(i).Bytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(res, item) {
+			t.Fatalf("bad item %d: %x", i, res)
+		}
+	}
+	if _, err := l.At(3).Bytes(); !errors.Is(err, ErrViewIndex) {
+		t.Fatalf("expected index error but found %v", err)
+	}
+
+	// too many items
+	if _, err := NewView(buf).List(0, 2).Len(); !errors.Is(err, ErrDynamicLengthExceedsMax) {
+		t.Fatalf("expected max length error but found %v", err)
+	}
+
+	// the offsets go backwards
+	buf[8] = 12
+	if _, err := NewView(buf).List(0, 3).At(1).Bytes(); !errors.Is(err, ErrOffsetOrdering) {
+		t.Fatalf("expected ordering error but found %v", err)
+	}
+
+	// the first offset is beyond the input
+	if _, err := NewView([]byte{8, 0, 0, 0}).List(0, 3).Len(); !errors.Is(err, ErrOffsetExceedsSize) {
+		t.Fatalf("expected size error but found %v", err)
+	}
+}
+
+func TestViewContainer(t *testing.T) {
+	// container with an uint16 and a byte list
+	buf := []byte{1, 0, 6, 0, 0, 0, 5}
+
+	v := NewView(buf).Container(6, 2)
+	if val, err := v.Field(0, 2).Uint16(); err != nil || val != 1 {
+		t.Fatalf("bad uint16 %d: %v", val, err)
+	}
+	if val, err := v.DynamicField(2, 0).ByteList(1); err != nil || !bytes.Equal(val, []byte{5}) {
+		t.Fatalf("bad bytes %x: %v", val, err)
+	}
+	if _, err := v.DynamicField(2, 0).ByteList(0); !errors.Is(err, ErrBytesLength) {
+		t.Fatalf("expected bytes length error but found %v", err)
+	}
+
+	// the first offset must be the end of the fixed part
+	buf[2] = 7
+	if _, err := NewView(buf).Container(6, 2).Field(0, 2).Uint16(); !errors.Is(err, ErrInvalidVariableOffset) {
+		t.Fatalf("expected invalid offset but found %v", err)
+	}
+}
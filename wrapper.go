@@ -14,6 +14,14 @@ func (w *Wrapper) AddBytes(b []byte) {
 	w.AddNode(LeafFromBytes(b))
 }
 
+func (w *Wrapper) AddUint256(i [4]uint64) {
+	w.AddNode(LeafFromUint256(i))
+}
+
+func (w *Wrapper) AddUint128(i [2]uint64) {
+	w.AddNode(LeafFromUint128(i))
+}
+
 func (w *Wrapper) AddUint64(i uint64) {
 	w.AddNode(LeafFromUint64(i))
 }
@@ -67,6 +75,28 @@ func (w *Wrapper) CommitWithMixin(i, num, limit int) {
 	w.AddNode(res)
 }
 
+func (w *Wrapper) CommitProgressive(i int) {
+	res, err := TreeFromNodesProgressive(w.nodes[i:])
+	if err != nil {
+		panic(err)
+	}
+	// remove the old nodes
+	w.nodes = w.nodes[:i]
+	// add the new node
+	w.AddNode(res)
+}
+
+func (w *Wrapper) CommitProgressiveWithMixin(i, num int) {
+	res, err := TreeFromNodesProgressiveWithMixin(w.nodes[i:], num)
+	if err != nil {
+		panic(err)
+	}
+	// remove the old nodes
+	w.nodes = w.nodes[:i]
+	// add the new node
+	w.AddNode(res)
+}
+
 func (w *Wrapper) AddEmpty() {
 	w.AddNode(EmptyLeaf())
 }
@@ -0,0 +1,399 @@
+package ssz
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// yamlWidth is the column after which the flow collections are split in lines
+const yamlWidth = 80
+
+// MarshalYAMLSpec encodes the object in the format of the value.yaml files of
+// the consensus spec tests. Containers are mappings with the keys of the 'json'
+// tags, uints are integers, bytes and bitlists are quoted 0x prefixed hex strings
+// and lists and vectors are sequences. The collections of scalars are written in
+// flow style and split in lines after 80 columns like the spec test generators do.
+func MarshalYAMLSpec(v interface{}) ([]byte, error) {
+	rv, p, err := reflectValue(v)
+	if err != nil {
+		return nil, err
+	}
+	node, err := p.yamlNode(rv, false)
+	if err != nil {
+		return nil, err
+	}
+	e := &yamlEmitter{}
+	switch {
+	case node.kind == yamlScalar:
+		e.write(node.scalar)
+		if !node.quoted {
+			// plain scalars at the root are followed by the end of document
+			e.write("\n...")
+		}
+	case node.isLeaf():
+		e.flow(node, 2)
+	default:
+		e.block(node, 0)
+	}
+	return append(e.buf, '\n'), nil
+}
+
+// UnmarshalYAMLSpec decodes the object from the format of the value.yaml files
+// of the consensus spec tests. Uints can also be quoted decimal strings. The
+// sizes of the bytes, bitlists, lists and vectors are checked.
+func UnmarshalYAMLSpec(content []byte, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("cannot decode into a non pointer value")
+	}
+	p, err := planOf(rv.Type().Elem())
+	if err != nil {
+		return err
+	}
+	var node interface{}
+	if err := yaml.Unmarshal(content, &node); err != nil {
+		return err
+	}
+	return p.fromYAML(node, rv.Elem(), "")
+}
+
+type yamlKind int
+
+const (
+	yamlScalar yamlKind = iota
+	yamlSequence
+	yamlMapping
+)
+
+// yamlNode is a value of the YAML document
+type yamlNode struct {
+	kind yamlKind
+	// scalar is the encoded scalar and quoted is true if it is a quoted string
+	scalar string
+	quoted bool
+	// keys are the keys of a mapping and items the values
+	// of the mapping or the items of the sequence
+	keys  []string
+	items []*yamlNode
+}
+
+// isLeaf returns true if the node is a collection of scalars, these are written in flow style
+func (n *yamlNode) isLeaf() bool {
+	if n.kind == yamlScalar {
+		return false
+	}
+	for _, item := range n.items {
+		if item.kind != yamlScalar {
+			return false
+		}
+	}
+	return true
+}
+
+// yamlKey returns the key of the field in the YAML encoding, the
+// name in the 'json' tag if there is one and the field name otherwise.
+func yamlKey(f reflect.StructField) string {
+	if key := strings.Split(f.Tag.Get("json"), ",")[0]; key != "" && key != "-" {
+		return key
+	}
+	return f.Name
+}
+
+// isYAMLHex returns true if the field is a struct tagged with a ssz-size (i.e. a signature
+// type from another package). These fields are encoded as the hex string of their SSZ encoding.
+func isYAMLHex(f reflect.StructField) bool {
+	t := f.Type
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	_, ok := f.Tag.Lookup("ssz-size")
+	return t.Kind() == reflect.Struct && ok
+}
+
+// yamlHex encodes the bytes as a 0x prefixed hex string. It is quoted because
+// it would be read as an integer, unless it is empty.
+func yamlHex(b []byte) *yamlNode {
+	if len(b) == 0 {
+		return &yamlNode{kind: yamlScalar, scalar: "0x"}
+	}
+	return &yamlNode{kind: yamlScalar, scalar: "'0x" + hex.EncodeToString(b) + "'", quoted: true}
+}
+
+func (p *reflectPlan) yamlNode(v reflect.Value, hexSSZ bool) (*yamlNode, error) {
+	v = indirect(v, false)
+	if hexSSZ || (p.kind == reflectContainer && len(p.fields) == 0) {
+		// the layout of the type is only known by its methods
+		buf, err := p.marshal(nil, v)
+		if err != nil {
+			return nil, err
+		}
+		return yamlHex(buf), nil
+	}
+
+	switch p.kind {
+	case reflectUint:
+		return &yamlNode{kind: yamlScalar, scalar: strconv.FormatUint(v.Uint(), 10)}, nil
+
+	case reflectBool:
+		return &yamlNode{kind: yamlScalar, scalar: strconv.FormatBool(v.Bool())}, nil
+
+	case reflectBytes, reflectByteList, reflectBitlist:
+		return yamlHex(bytesOf(v)), nil
+
+	case reflectVector, reflectList:
+		node := &yamlNode{kind: yamlSequence, items: []*yamlNode{}}
+		for i := 0; i < v.Len(); i++ {
+			item, err := p.elem.yamlNode(v.Index(i), false)
+			if err != nil {
+				return nil, err
+			}
+			node.items = append(node.items, item)
+		}
+		return node, nil
+
+	case reflectContainer:
+		node := &yamlNode{kind: yamlMapping}
+		for _, f := range p.fields {
+			sf := p.typ.Field(f.index)
+			item, err := f.plan.yamlNode(v.Field(f.index), isYAMLHex(sf))
+			if err != nil {
+				return nil, err
+			}
+			node.keys = append(node.keys, yamlKey(sf))
+			node.items = append(node.items, item)
+		}
+		return node, nil
+
+	default:
+		panic(fmt.Errorf("yaml not implemented for type %s", p.typ))
+	}
+}
+
+// yamlEmitter writes the YAML nodes with the layout of the spec test generators
+type yamlEmitter struct {
+	buf    []byte
+	column int
+}
+
+func (e *yamlEmitter) write(str string) {
+	e.buf = append(e.buf, str...)
+	e.column += len(str)
+}
+
+func (e *yamlEmitter) newline(indent int) {
+	e.buf = append(e.buf, '\n')
+	e.buf = append(e.buf, strings.Repeat(" ", indent)...)
+	e.column = indent
+}
+
+// flow writes a collection of scalars in a single line that is split after
+// the items that end beyond the width. The next lines start at 'indent'.
+func (e *yamlEmitter) flow(n *yamlNode, indent int) {
+	open, end := "[", "]"
+	if n.kind == yamlMapping {
+		open, end = "{", "}"
+	}
+	e.write(open)
+	for i, item := range n.items {
+		if i != 0 {
+			e.write(",")
+		}
+		if e.column > yamlWidth {
+			e.newline(indent)
+		} else if i != 0 {
+			e.write(" ")
+		}
+		if n.kind == yamlMapping {
+			e.write(n.keys[i] + ": ")
+		}
+		e.write(item.scalar)
+	}
+	e.write(end)
+}
+
+// block writes a collection that has other collections. The first line
+// continues the current one and the next lines start at 'indent'.
+func (e *yamlEmitter) block(n *yamlNode, indent int) {
+	for i, item := range n.items {
+		if i != 0 {
+			e.newline(indent)
+		}
+		if n.kind == yamlMapping {
+			e.write(n.keys[i] + ":")
+		} else {
+			e.write("-")
+		}
+
+		switch {
+		case item.kind == yamlScalar:
+			e.write(" " + item.scalar)
+		case item.isLeaf():
+			e.write(" ")
+			e.flow(item, indent+2)
+		case n.kind == yamlMapping && item.kind == yamlSequence:
+			// the sequences in a mapping are not indented
+			e.newline(indent)
+			e.block(item, indent)
+		case n.kind == yamlMapping:
+			e.newline(indent + 2)
+			e.block(item, indent+2)
+		default:
+			e.write(" ")
+			e.block(item, indent+2)
+		}
+	}
+}
+
+func (p *reflectPlan) fromYAML(node interface{}, v reflect.Value, path string) error {
+	v = indirect(v, true)
+	if p.kind == reflectContainer && len(p.fields) == 0 {
+		return p.fromYAMLHex(node, v, path)
+	}
+
+	switch p.kind {
+	case reflectUint:
+		i, err := yamlUint(node, p.size)
+		if err != nil {
+			return yamlError(path, err)
+		}
+		v.SetUint(i)
+		return nil
+
+	case reflectBool:
+		b, ok := node.(bool)
+		if !ok {
+			return yamlError(path, fmt.Errorf("expected a bool but found %v", node))
+		}
+		v.SetBool(b)
+		return nil
+
+	case reflectBytes, reflectByteList, reflectBitlist:
+		buf, err := yamlBytes(node)
+		if err != nil {
+			return yamlError(path, err)
+		}
+		switch {
+		case p.kind == reflectBytes && len(buf) != int(p.length):
+			return yamlError(path, ErrBytesLengthFn(p.typ.String(), len(buf), int(p.length)))
+		case p.kind == reflectByteList && len(buf) > int(p.length):
+			return yamlError(path, ErrBytesLengthFn(p.typ.String(), len(buf), int(p.length)))
+		case p.kind == reflectBitlist:
+			if err := ValidateBitlist(buf, p.length); err != nil {
+				return yamlError(path, err)
+			}
+		}
+		if v.Kind() == reflect.Array {
+			reflect.Copy(v, reflect.ValueOf(buf))
+		} else {
+			setBytes(v, buf)
+		}
+		return nil
+
+	case reflectVector, reflectList:
+		items, ok := node.([]interface{})
+		if node != nil && !ok {
+			return yamlError(path, fmt.Errorf("expected a sequence but found %v", node))
+		}
+		num := len(items)
+		if p.kind == reflectVector && num != int(p.length) {
+			return yamlError(path, ErrVectorLengthFn(p.typ.String(), num, int(p.length)))
+		}
+		if p.kind == reflectList && num > int(p.length) {
+			return yamlError(path, ErrListTooBigFn(p.typ.String(), num, int(p.length)))
+		}
+		if v.Kind() == reflect.Slice {
+			v.Set(reflect.MakeSlice(v.Type(), num, num))
+		}
+		for i, item := range items {
+			if err := p.elem.fromYAML(item, v.Index(i), path+"["+strconv.Itoa(i)+"]"); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflectContainer:
+		fields, ok := node.(map[interface{}]interface{})
+		if !ok {
+			return yamlError(path, fmt.Errorf("expected a mapping but found %v", node))
+		}
+		for _, f := range p.fields {
+			sf := p.typ.Field(f.index)
+			key := yamlKey(sf)
+			item, ok := fields[key]
+			if !ok {
+				return yamlError(joinDecodePath(path, key), fmt.Errorf("missing field"))
+			}
+			var err error
+			if isYAMLHex(sf) {
+				err = f.plan.fromYAMLHex(item, indirect(v.Field(f.index), true), joinDecodePath(path, key))
+			} else {
+				err = f.plan.fromYAML(item, v.Field(f.index), joinDecodePath(path, key))
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+
+	default:
+		panic(fmt.Errorf("yaml not implemented for type %s", p.typ))
+	}
+}
+
+// fromYAMLHex decodes a type from the hex string of its SSZ encoding
+func (p *reflectPlan) fromYAMLHex(node interface{}, v reflect.Value, path string) error {
+	buf, err := yamlBytes(node)
+	if err != nil {
+		return yamlError(path, err)
+	}
+	if err := p.unmarshal(buf, v); err != nil {
+		return yamlError(path, err)
+	}
+	return nil
+}
+
+func yamlError(path string, err error) error {
+	if path == "" {
+		return err
+	}
+	return fmt.Errorf("%s: %w", path, err)
+}
+
+// yamlUint decodes an uint of 'size' bytes from an integer or a quoted decimal string
+func yamlUint(node interface{}, size uint64) (uint64, error) {
+	var i uint64
+	switch obj := node.(type) {
+	case int:
+		if obj < 0 {
+			return 0, fmt.Errorf("negative uint %d", obj)
+		}
+		i = uint64(obj)
+	case uint64:
+		i = obj
+	case string:
+		var err error
+		if i, err = strconv.ParseUint(obj, 10, 64); err != nil {
+			return 0, fmt.Errorf("invalid uint '%s'", obj)
+		}
+	default:
+		return 0, fmt.Errorf("expected an uint but found %v", node)
+	}
+	if size < 8 && i>>(size*8) != 0 {
+		return 0, fmt.Errorf("uint %d overflows %d bytes", i, size)
+	}
+	return i, nil
+}
+
+// yamlBytes decodes a 0x prefixed hex string
+func yamlBytes(node interface{}) ([]byte, error) {
+	str, ok := node.(string)
+	if !ok || !strings.HasPrefix(str, "0x") {
+		return nil, fmt.Errorf("expected a 0x prefixed hex string but found %v", node)
+	}
+	return hex.DecodeString(str[2:])
+}
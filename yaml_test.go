@@ -0,0 +1,102 @@
+package ssz
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type yamlInner struct {
+	A uint16 `json:"a"`
+	B []byte `json:"b" ssz-max:"4"`
+}
+
+type yamlTest struct {
+	Roots   [][]byte     `json:"roots" ssz-size:"?,2" ssz-max:"32"`
+	Nested  [][]uint64   `json:"nested" ssz-max:"4,4"`
+	Inners  []*yamlInner `json:"inners" ssz-max:"4"`
+	Bits    []byte       `json:"bits" ssz:"bitlist" ssz-max:"8"`
+	Flag    bool         `json:"flag"`
+	Inner   *yamlInner
+	Vectors [2][]*yamlInner `json:"vectors" ssz-size:"2,?" ssz-max:"?,2"`
+}
+
+func TestYAMLSpec(t *testing.T) {
+	obj := &yamlTest{
+		Nested: [][]uint64{{1, 2}, {}},
+		Inners: []*yamlInner{{A: 1, B: []byte{}}, {A: 2, B: []byte{0xab}}},
+		Bits:   []byte{0x05},
+		Inner:  &yamlInner{},
+	}
+	for i := 0; i < 32; i++ {
+		obj.Roots = append(obj.Roots, []byte{byte(i), 0xff})
+	}
+	obj.Vectors[1] = []*yamlInner{{A: 3}}
+
+	expected := `roots: ['0x00ff', '0x01ff', '0x02ff', '0x03ff', '0x04ff', '0x05ff', '0x06ff', '0x07ff',
+  '0x08ff', '0x09ff', '0x0aff', '0x0bff', '0x0cff', '0x0dff', '0x0eff', '0x0fff',
+  '0x10ff', '0x11ff', '0x12ff', '0x13ff', '0x14ff', '0x15ff', '0x16ff', '0x17ff',
+  '0x18ff', '0x19ff', '0x1aff', '0x1bff', '0x1cff', '0x1dff', '0x1eff', '0x1fff']
+nested:
+- [1, 2]
+- []
+inners:
+- {a: 1, b: 0x}
+- {a: 2, b: '0xab'}
+bits: '0x05'
+flag: false
+Inner: {a: 0, b: 0x}
+vectors:
+- []
+- - {a: 3, b: 0x}
+`
+	found, err := MarshalYAMLSpec(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(found) != expected {
+		t.Fatalf("bad encoding\n%s", found)
+	}
+
+	obj2 := new(yamlTest)
+	if err := UnmarshalYAMLSpec(found, obj2); err != nil {
+		t.Fatal(err)
+	}
+	buf, _ := Marshal(obj)
+	buf2, _ := Marshal(obj2)
+	if !bytes.Equal(buf, buf2) {
+		t.Fatal("the yaml encoding does not round trip")
+	}
+
+	// uints can be quoted and the sizes are checked
+	cases := []struct {
+		old, new, err string
+	}{
+		{"{a: 2,", "{a: '2',", ""},
+		{"{a: 2,", "{a: 65536,", "inners[1].a: uint 65536 overflows 2 bytes"},
+		{"'0xab'", "'0xabababab01'", "inners[1].b: "},
+		{"bits: '0x05'", "bits: '0x000002'", "bits: "},
+		{"- []\n- - {a: 3", "- - {a: 3", "vectors: "},
+		{"flag: false\n", "", "flag: missing field"},
+	}
+	for _, c := range cases {
+		err := UnmarshalYAMLSpec([]byte(strings.Replace(expected, c.old, c.new, 1)), new(yamlTest))
+		if c.err == "" {
+			if err != nil {
+				t.Fatal(err)
+			}
+		} else if err == nil || !strings.HasPrefix(err.Error(), c.err) {
+			t.Fatalf("expected error '%s' but found %v", c.err, err)
+		}
+	}
+}
+
+func TestYAMLSpecRoot(t *testing.T) {
+	found, err := MarshalYAMLSpec(&yamlInner{A: 1, B: []byte{1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(found) != "{a: 1, b: '0x01'}\n" {
+		t.Fatalf("bad encoding %s", found)
+	}
+}